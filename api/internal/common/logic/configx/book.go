@@ -0,0 +1,75 @@
+package configx
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/micro/go-micro/v2/client"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/manage/proto/app"
+)
+
+// GetBooks 获取主账簿以外的会计账簿
+func GetBooks(db, appID string) (books []leasecalc.Book, err error) {
+	configService := app.NewAppService("manage", client.DefaultClient)
+
+	var req app.FindAppRequest
+	req.AppId = appID
+	req.Database = db
+
+	response, err := configService.FindApp(context.TODO(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range response.GetApp().GetBooks() {
+		book, err := ToBook(b)
+		if err != nil {
+			return nil, err
+		}
+		books = append(books, book)
+	}
+
+	return books, nil
+}
+
+// CheckSingleBook 确认应用只使用主账簿
+// 事件分录(情报变更、债务变更、中途解约、减损等)只按主账簿的契约履历生成,
+// 设定了其他账簿的应用不受理这些事件处理,避免其他账簿的偿还数据和分录不一致
+func CheckSingleBook(db, appID string) error {
+	books, err := GetBooks(db, appID)
+	if err != nil {
+		return err
+	}
+
+	if len(books) > 0 {
+		return errors.New("複数の会計帳簿を設定したアプリでは、この操作は利用できません")
+	}
+
+	return nil
+}
+
+// ToBook 账簿设定转换为计算用的账簿(阈值未设定的场合为0)
+func ToBook(b *app.Book) (book leasecalc.Book, err error) {
+	book = leasecalc.Book{
+		BookID:                b.GetBookId(),
+		BookName:              b.GetBookName(),
+		Standard:              leasecalc.Standard(b.GetStandard()),
+		RateSource:            leasecalc.RateSource(b.GetRateSource()),
+		CurveMethod:           leasecalc.ParseInterpolationMethod(b.GetCurveMethod()),
+		OperatingStraightLine: b.GetOperatingStraightLine(),
+	}
+	if len(b.GetShortLeases()) > 0 {
+		if book.ShortLeases, err = strconv.Atoi(b.GetShortLeases()); err != nil {
+			return book, err
+		}
+	}
+	if len(b.GetMinorBaseAmount()) > 0 {
+		if book.MinorBaseAmount, err = leasecalc.ParseMoney(b.GetMinorBaseAmount()); err != nil {
+			return book, err
+		}
+	}
+
+	return book, nil
+}
@@ -0,0 +1,354 @@
+package journalx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/v2/client"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/configx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/field"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+	"rxcsoft.cn/pit3/srv/global/proto/language"
+)
+
+// unconfirmedCondition 未确定分录的检索条件(确定日为初期值)
+func unconfirmedCondition() *item.Condition {
+	defaultTime := time.Date(1, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return &item.Condition{
+		FieldId:     "kakuteidate",
+		FieldType:   "date",
+		SearchValue: defaultTime.Format(time.RFC3339),
+		Operator:    "=",
+		IsDynamic:   true,
+	}
+}
+
+// journalLineOf 分录数据编辑为贷借平衡验证用的明细
+// 增减分录按履历番号,其他分录按集计番号作为生成单位
+func journalLineOf(items map[string]*item.Value) (l leasecalc.JournalLine, err error) {
+	l = leasecalc.JournalLine{
+		Shiwakeno: items["shiwakeno"].GetValue(),
+		Group:     items["historyno"].GetValue(),
+		Pattern:   items["partten"].GetValue(),
+		Keiyakuno: items["keiyakuno"].GetValue(),
+		Book:      items["book"].GetValue(),
+		Division:  items["taishakukubun"].GetValue(),
+	}
+	if len(l.Group) == 0 {
+		l.Group = items["shiwakeaggno_parent"].GetValue()
+	}
+	if l.Amount, err = leasecalc.ParseMoney(items["shiwakekingaku"].GetValue()); err != nil {
+		return l, fmt.Errorf("仕訳番号[%s]の契約番号[%s]の仕訳金額が不正です:%v", l.Shiwakeno, l.Keiyakuno, err)
+	}
+	return l, nil
+}
+
+// CheckBalance 处理月度的未确定分录按生成单位验证借方合计与贷方合计一致(分录确定前的验证)
+func CheckBalance(db, appID, userID, handleMonth string) ([]leasecalc.JournalImbalance, error) {
+	dsMap, err := getDatastoreMap(db, appID)
+	if err != nil {
+		return nil, err
+	}
+	p := InsertParam{
+		db:     db,
+		appID:  appID,
+		userID: userID,
+		dsMap:  dsMap,
+	}
+
+	items, err := findAllItems(p, dsMap["shiwake"], []*item.Condition{
+		{
+			FieldId:     "shiwakeym",
+			FieldType:   "text",
+			SearchValue: handleMonth,
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+		unconfirmedCondition(),
+	}, "index")
+	if err != nil {
+		loggerx.ErrorLog("checkBalance", err.Error())
+		return nil, err
+	}
+
+	var lines []leasecalc.JournalLine
+	for _, it := range items {
+		l, err := journalLineOf(it.GetItems())
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, l)
+	}
+
+	return leasecalc.CheckJournalBalance(lines), nil
+}
+
+// ReverseJournal 生成已确定分录的取消分录(贷借对调)
+// 取消分录作为处理月度的分录登录,原来的分录不删除,记录取消分录的分录番号
+// keiyakuno指定的场合只取消该契约的分录
+func ReverseJournal(domain, db, appID, userID, lang string, owners []string, shiwakeno, keiyakuno string) (reversedno string, count int, err error) {
+	dsMap, err := getDatastoreMap(db, appID)
+	if err != nil {
+		return "", 0, err
+	}
+	cfg, err := configx.GetConfigVal(db, appID)
+	if err != nil {
+		loggerx.ErrorLog("reverseJournal", err.Error())
+		return "", 0, err
+	}
+	handleMonth := cfg.GetSyoriYm()
+
+	p := InsertParam{
+		db:          db,
+		domain:      domain,
+		lang:        lang,
+		handleMonth: handleMonth,
+		appID:       appID,
+		userID:      userID,
+		owners:      owners,
+		dsMap:       dsMap,
+	}
+
+	conditions := []*item.Condition{
+		{
+			FieldId:     "shiwakeno",
+			FieldType:   "text",
+			SearchValue: shiwakeno,
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+	}
+	if len(keiyakuno) > 0 {
+		conditions = append(conditions, &item.Condition{
+			FieldId:     "keiyakuno",
+			FieldType:   "lookup",
+			SearchValue: keiyakuno,
+			Operator:    "=",
+			IsDynamic:   true,
+		})
+	}
+	originals, err := findAllItems(p, dsMap["shiwake"], conditions, "index")
+	if err != nil {
+		loggerx.ErrorLog("reverseJournal", err.Error())
+		return "", 0, err
+	}
+	if len(originals) == 0 {
+		return "", 0, fmt.Errorf("仕訳番号[%s]の仕訳が存在しません", shiwakeno)
+	}
+	for _, it := range originals {
+		items := it.GetItems()
+		// 只有已确定的分录可以取消,未确定的分录重新作成即可
+		if kakutei := items["kakuteidate"].GetValue(); len(kakutei) == 0 || strings.HasPrefix(kakutei, "0001-01-01") {
+			return "", 0, fmt.Errorf("仕訳番号[%s]は確定されていません", shiwakeno)
+		}
+		if no := items["reversedno"].GetValue(); len(no) > 0 {
+			return "", 0, fmt.Errorf("仕訳番号[%s]は仕訳番号[%s]で取消済みです", shiwakeno, no)
+		}
+		if no := items["reverseof"].GetValue(); len(no) > 0 {
+			return "", 0, fmt.Errorf("仕訳番号[%s]は仕訳番号[%s]の取消仕訳です", shiwakeno, no)
+		}
+	}
+
+	// 分录台账中没有取消关联字段的场合追加(可以显示和检索)
+	if err := addReverseFields(db, appID, dsMap["shiwake"], userID, lang, domain); err != nil {
+		return "", 0, err
+	}
+
+	// 获取分录番号
+	if p.shiwakeno, err = genShiwakeno(db, appID); err != nil {
+		loggerx.ErrorLog("reverseJournal", err.Error())
+		return "", 0, err
+	}
+
+	// 先在原来的分录上记录取消分录的分录番号,重复执行时不会重复作成取消分录
+	writeOwners := sessionx.GetAccessKeys(db, userID, dsMap["shiwake"], "W")
+	var marked []string
+	for _, it := range originals {
+		if err := markReversed(p, writeOwners, it.GetItemId(), p.shiwakeno); err != nil {
+			loggerx.ErrorLog("reverseJournal", err.Error())
+			unmarkReversed(p, writeOwners, marked)
+			return "", 0, err
+		}
+		marked = append(marked, it.GetItemId())
+	}
+
+	var its ImportData
+	for index, it := range originals {
+		itemsData := copyMap(it.GetItems())
+		// 取消分录为未作成和未确定的状态
+		delete(itemsData, "kakuteidate")
+		delete(itemsData, "sakuseidate")
+
+		itemsData["taishakukubun"] = &item.Value{
+			DataType: "text",
+			Value:    leasecalc.ReverseDivision(itemsData["taishakukubun"].GetValue()),
+		}
+		itemsData["shiwakeno"] = &item.Value{
+			DataType: "text",
+			Value:    p.shiwakeno,
+		}
+		itemsData["shiwakeymd"] = &item.Value{
+			DataType: "date",
+			Value:    time.Now().Format("2006-01-02"),
+		}
+		itemsData["shiwakeym"] = &item.Value{
+			DataType: "text",
+			Value:    handleMonth,
+		}
+		// 计上日为处理月度(处理月度的分录确定时一起确定)
+		itemsData["keijoudate"] = &item.Value{
+			DataType: "date",
+			Value:    handleMonth + "-01",
+		}
+		itemsData["reverseof"] = &item.Value{
+			DataType: "text",
+			Value:    shiwakeno,
+		}
+		itemsData["remark"] = &item.Value{
+			DataType: "text",
+			Value:    "取消_" + itemsData["remark"].GetValue(),
+		}
+		itemsData["index"] = &item.Value{
+			DataType: "number",
+			Value:    strconv.Itoa(index + 1),
+		}
+
+		its = append(its, &item.ListItems{
+			Items: itemsData,
+		})
+	}
+
+	result, err := importData(p, its)
+	if err != nil {
+		loggerx.ErrorLog("reverseJournal", err.Error())
+		// 取消分录一件也没有作成的场合,恢复原来的分录后可以再次取消
+		created, ferr := findAllItems(p, dsMap["shiwake"], []*item.Condition{
+			{
+				FieldId:     "shiwakeno",
+				FieldType:   "text",
+				SearchValue: p.shiwakeno,
+				Operator:    "=",
+				IsDynamic:   true,
+			},
+		}, "index")
+		if ferr == nil && len(created) == 0 {
+			unmarkReversed(p, writeOwners, marked)
+		}
+		return "", 0, err
+	}
+	loggerx.DebugLog("reverseJournal", fmt.Sprintf("result %v", result))
+
+	return p.shiwakeno, len(its), nil
+}
+
+// markReversed 原来的分录上记录取消分录的分录番号
+func markReversed(p InsertParam, owners []string, itemID, reversedno string) error {
+	itemService := item.NewItemService("database", client.DefaultClient)
+
+	var req item.ModifyRequest
+	req.AppId = p.appID
+	req.DatastoreId = p.dsMap["shiwake"]
+	req.ItemId = itemID
+	req.Items = map[string]*item.Value{
+		"reversedno": {
+			DataType: "text",
+			Value:    reversedno,
+		},
+	}
+	req.Writer = p.userID
+	req.Owners = owners
+	req.LangCd = p.lang
+	req.Domain = p.domain
+	req.Database = p.db
+
+	_, err := itemService.ModifyItem(context.TODO(), &req)
+	return err
+}
+
+// unmarkReversed 清除原来的分录上记录的取消分录番号(失败只记录日志)
+func unmarkReversed(p InsertParam, owners []string, itemIDs []string) {
+	for _, id := range itemIDs {
+		if err := markReversed(p, owners, id, ""); err != nil {
+			loggerx.ErrorLog("unmarkReversed", err.Error())
+		}
+	}
+}
+
+// reverseFields 取消分录关联的字段
+var reverseFields = []struct {
+	id   string
+	name string
+}{
+	{id: "reversedno", name: "取消仕訳番号"},
+	{id: "reverseof", name: "取消元仕訳番号"},
+}
+
+// addReverseFields 分录台账中没有取消关联字段的场合追加
+func addReverseFields(db, appID, datastoreID, userID, lang, domain string) error {
+	fieldService := field.NewFieldService("database", client.DefaultClient)
+	langService := language.NewLanguageService("global", client.DefaultClient)
+
+	var fReq field.FieldsRequest
+	fReq.AppId = appID
+	fReq.DatastoreId = datastoreID
+	fReq.Database = db
+
+	fResp, err := fieldService.FindFields(context.TODO(), &fReq)
+	if err != nil {
+		loggerx.ErrorLog("addReverseFields", err.Error())
+		return err
+	}
+
+	exist := make(map[string]bool)
+	for _, f := range fResp.GetFields() {
+		exist[f.GetFieldId()] = true
+	}
+
+	for _, rf := range reverseFields {
+		if exist[rf.id] {
+			continue
+		}
+
+		var req field.AddRequest
+		req.AppId = appID
+		req.DatastoreId = datastoreID
+		req.FieldName = rf.name
+		req.FieldType = "text"
+		req.FieldId = rf.id
+		req.IsFixed = true
+		req.Writer = userID
+		req.Database = db
+
+		response, err := fieldService.AddField(context.TODO(), &req)
+		if err != nil {
+			loggerx.ErrorLog("addReverseFields", err.Error())
+			return err
+		}
+
+		// 添加多语言数据
+		languageReq := language.AddAppLanguageDataRequest{
+			Domain:   domain,
+			LangCd:   lang,
+			AppId:    appID,
+			Type:     "fields",
+			Key:      datastoreID + "_" + response.GetFieldId(),
+			Value:    rf.name,
+			Writer:   userID,
+			Database: db,
+		}
+
+		_, err = langService.AddAppLanguageData(context.TODO(), &languageReq)
+		if err != nil {
+			loggerx.ErrorLog("addReverseFields", err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
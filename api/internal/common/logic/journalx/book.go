@@ -0,0 +1,24 @@
+package journalx
+
+import (
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+)
+
+// bookValue 分录的账簿(利息和偿还数据上未记录账簿的场合为主账簿)
+func bookValue(book string) *item.Value {
+	return &item.Value{
+		DataType: "text",
+		Value:    leasecalc.BookOf(book),
+	}
+}
+
+// findBookItem 取得与指定账簿相同的第一条数据(没有的场合返回nil)
+func findBookItem(items []*item.Item, book string) *item.Item {
+	for _, it := range items {
+		if leasecalc.BookOf(it.Items["book"].GetValue()) == leasecalc.BookOf(book) {
+			return it
+		}
+	}
+	return nil
+}
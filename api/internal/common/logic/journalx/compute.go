@@ -22,8 +22,10 @@ import (
 	"rxcsoft.cn/pit3/api/internal/common/loggerx"
 	"rxcsoft.cn/pit3/api/internal/common/logic/configx"
 	"rxcsoft.cn/pit3/api/internal/common/logic/langx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/leasex"
 	"rxcsoft.cn/pit3/api/internal/system/jobx"
 	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
 	"rxcsoft.cn/pit3/lib/msg"
 	"rxcsoft.cn/pit3/srv/database/proto/datastore"
 	"rxcsoft.cn/pit3/srv/database/proto/item"
@@ -47,10 +49,14 @@ type InsertParam struct {
 	lang         string
 	shiwakeno    string
 	handleMonth  string
+	rounding     leasecalc.Rounding
 	appID        string
 	datastoreID  string
 	userID       string
 	confimMethod string
+	costModel    leasecalc.CostModel
+	functional   string
+	books        map[string]leasecalc.Book
 	owners       []string
 	dsMap        map[string]string
 	jouData      *journal.Journal
@@ -529,6 +535,10 @@ func GenAddAndSubData(domain, db, appID, userID, lang string, owners []string) (
 			return
 		}
 		handleMonth := cfg.GetSyoriYm()
+		// 金额端数处理设定
+		rounding := leasecalc.Rounding{
+			Mode: leasecalc.ParseRoundingMode(cfg.GetRoundingMode()),
+		}
 
 		// 获取所有分录数据
 		jouDataMap := make(map[string]*journal.Journal)
@@ -679,6 +689,7 @@ func GenAddAndSubData(domain, db, appID, userID, lang string, owners []string) (
 			lang:        lang,
 			shiwakeno:   shiwakeno,
 			handleMonth: handleMonth,
+			rounding:    rounding,
 			appID:       appID,
 			datastoreID: dsMap["zougenrireki"],
 			userID:      userID,
@@ -686,6 +697,8 @@ func GenAddAndSubData(domain, db, appID, userID, lang string, owners []string) (
 			dsMap:       dsMap,
 			asSubMap:    asSubMap,
 			jouDataMap:  jouDataMap,
+			jouData:     jouDataMap["01"],
+			functional:  cfg.GetFunctionalCurrency(),
 		}
 
 		//  生成数据
@@ -707,6 +720,25 @@ func GenAddAndSubData(domain, db, appID, userID, lang string, owners []string) (
 			return
 		}
 
+		// 外币契约的月末换算
+		err = buildFxData(param)
+		if err != nil {
+			path := filex.WriteAndSaveFile(domain, appID, []string{err.Error()})
+			// 发送消息 获取数据失败，终止任务
+			jobx.ModifyTask(task.ModifyRequest{
+				JobId:       jobID,
+				Message:     err.Error(),
+				CurrentStep: "gen-data",
+				EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+				ErrorFile: &task.File{
+					Url:  path.MediaLink,
+					Name: path.Name,
+				},
+				Database: db,
+			}, userID)
+			return
+		}
+
 		// 发送消息 任务成功结束
 		jobx.ModifyTask(task.ModifyRequest{
 			JobId:       jobID,
@@ -885,7 +917,10 @@ func buildObtainData(p InsertParam) (e error) {
 					return err
 				}
 
-				if fv == 0.0 {
+				// 按顾客设定的端数处理方式确定分录金额
+				amount := p.rounding.RoundFloat(fv)
+
+				if amount == 0 {
 					continue
 				}
 
@@ -937,7 +972,7 @@ func buildObtainData(p InsertParam) (e error) {
 				}
 				itemsData["shiwakekingaku"] = &item.Value{
 					DataType: "number",
-					Value:    result.String(),
+					Value:    amount.String(),
 				}
 				itemsData["shiwakeaggno_parent"] = &item.Value{
 					DataType: "text",
@@ -955,6 +990,8 @@ func buildObtainData(p InsertParam) (e error) {
 					DataType: "text",
 					Value:    pattern.PatternName,
 				}
+				// 账簿
+				itemsData["book"] = bookValue(leasecalc.PrimaryBookID)
 				itemsData["index"] = &item.Value{
 					DataType: "number",
 					Value:    strconv.Itoa(index),
@@ -1014,6 +1051,9 @@ func buildObtainData(p InsertParam) (e error) {
 	return nil
 }
 
+// genShiwakeData 按契约履历生成事件分录(契约追加、情报变更、债务变更、中途解约)
+// 契约履历只记录主账簿的金额,事件分录只按主账簿生成;其他账簿只生成偿还和支付的分录,
+// 契约追加以外的事件处理在设定了其他账簿的应用上不受理(参照configx.CheckSingleBook)
 func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e error) {
 	// 分录数据
 	var items ImportData
@@ -1060,7 +1100,10 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 					return nil, err
 				}
 
-				if fv == 0.0 {
+				// 按顾客设定的端数处理方式确定分录金额
+				amount := p.rounding.RoundFloat(fv)
+
+				if amount == 0 {
 					continue
 				}
 
@@ -1100,7 +1143,7 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 				}
 				itemsData["shiwakekingaku"] = &item.Value{
 					DataType: "number",
-					Value:    result.String(),
+					Value:    amount.String(),
 				}
 				itemsData["shiwakeaggno_parent"] = &item.Value{
 					DataType: "text",
@@ -1118,6 +1161,8 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 					DataType: "text",
 					Value:    keiyakuno + "_" + pattern.PatternName,
 				}
+				// 账簿
+				itemsData["book"] = bookValue(leasecalc.PrimaryBookID)
 				itemsData["index"] = &item.Value{
 					DataType: "number",
 					Value:    strconv.Itoa(index),
@@ -1218,7 +1263,10 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 					return nil, err
 				}
 
-				if fv == 0.0 {
+				// 按顾客设定的端数处理方式确定分录金额
+				amount := p.rounding.RoundFloat(fv)
+
+				if amount == 0 {
 					continue
 				}
 
@@ -1258,7 +1306,7 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 				}
 				itemsData["shiwakekingaku"] = &item.Value{
 					DataType: "number",
-					Value:    result.String(),
+					Value:    amount.String(),
 				}
 				itemsData["shiwakeaggno_parent"] = &item.Value{
 					DataType: "text",
@@ -1276,6 +1324,8 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 					DataType: "text",
 					Value:    keiyakuno + "_" + pattern.PatternName,
 				}
+				// 账簿
+				itemsData["book"] = bookValue(leasecalc.PrimaryBookID)
 				itemsData["index"] = &item.Value{
 					DataType: "number",
 					Value:    strconv.Itoa(index),
@@ -1354,7 +1404,10 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 							return nil, err
 						}
 
-						if fv == 0.0 {
+						// 按顾客设定的端数处理方式确定分录金额
+						amount := p.rounding.RoundFloat(fv)
+
+						if amount == 0 {
 							continue
 						}
 
@@ -1394,7 +1447,7 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 						}
 						itemsData["shiwakekingaku"] = &item.Value{
 							DataType: "number",
-							Value:    result.String(),
+							Value:    amount.String(),
 						}
 						itemsData["shiwakeaggno_parent"] = &item.Value{
 							DataType: "text",
@@ -1412,6 +1465,8 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 							DataType: "text",
 							Value:    keiyakuno + "_" + pattern.PatternName,
 						}
+						// 账簿
+						itemsData["book"] = bookValue(leasecalc.PrimaryBookID)
 						itemsData["index"] = &item.Value{
 							DataType: "number",
 							Value:    strconv.Itoa(index),
@@ -1483,7 +1538,10 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 						return nil, err
 					}
 
-					if fv == 0.0 {
+					// 按顾客设定的端数处理方式确定分录金额
+					amount := p.rounding.RoundFloat(fv)
+
+					if amount == 0 {
 						continue
 					}
 
@@ -1523,7 +1581,7 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 					}
 					itemsData["shiwakekingaku"] = &item.Value{
 						DataType: "number",
-						Value:    result.String(),
+						Value:    amount.String(),
 					}
 					itemsData["shiwakeaggno_parent"] = &item.Value{
 						DataType: "text",
@@ -1541,6 +1599,8 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 						DataType: "text",
 						Value:    keiyakuno + "_" + pattern.PatternName,
 					}
+					// 账簿
+					itemsData["book"] = bookValue(leasecalc.PrimaryBookID)
 					itemsData["index"] = &item.Value{
 						DataType: "number",
 						Value:    strconv.Itoa(index),
@@ -1619,7 +1679,10 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 						return nil, err
 					}
 
-					if fv == 0.0 {
+					// 按顾客设定的端数处理方式确定分录金额
+					amount := p.rounding.RoundFloat(fv)
+
+					if amount == 0 {
 						continue
 					}
 
@@ -1659,7 +1722,7 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 					}
 					itemsData["shiwakekingaku"] = &item.Value{
 						DataType: "number",
-						Value:    result.String(),
+						Value:    amount.String(),
 					}
 					itemsData["shiwakeaggno_parent"] = &item.Value{
 						DataType: "text",
@@ -1677,6 +1740,8 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 						DataType: "text",
 						Value:    keiyakuno + "_" + pattern.PatternName,
 					}
+					// 账簿
+					itemsData["book"] = bookValue(leasecalc.PrimaryBookID)
 					itemsData["index"] = &item.Value{
 						DataType: "number",
 						Value:    strconv.Itoa(index),
@@ -1748,7 +1813,10 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 					return nil, err
 				}
 
-				if fv == 0.0 {
+				// 按顾客设定的端数处理方式确定分录金额
+				amount := p.rounding.RoundFloat(fv)
+
+				if amount == 0 {
 					continue
 				}
 
@@ -1788,7 +1856,7 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 				}
 				itemsData["shiwakekingaku"] = &item.Value{
 					DataType: "number",
-					Value:    result.String(),
+					Value:    amount.String(),
 				}
 				itemsData["shiwakeaggno_parent"] = &item.Value{
 					DataType: "text",
@@ -1806,6 +1874,8 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 					DataType: "text",
 					Value:    keiyakuno + "_" + pattern.PatternName,
 				}
+				// 账簿
+				itemsData["book"] = bookValue(leasecalc.PrimaryBookID)
 				itemsData["index"] = &item.Value{
 					DataType: "number",
 					Value:    strconv.Itoa(index),
@@ -1878,7 +1948,218 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 					return nil, err
 				}
 
-				if fv == 0.0 {
+				// 按顾客设定的端数处理方式确定分录金额
+				amount := p.rounding.RoundFloat(fv)
+
+				if amount == 0 {
+					continue
+				}
+
+				keiyakuno := itemMap["keiyakuno"].GetValue()
+				assetsType := itemMap["bunruicd"].GetValue()
+				subMap := p.asSubMap[assetsType]
+
+				// 创建登录数据
+				itemsData := copyMap(itemMap)
+				itemsData["shiwakeno"] = &item.Value{
+					DataType: "text",
+					Value:    p.shiwakeno,
+				}
+				itemsData["shiwakeymd"] = &item.Value{
+					DataType: "date",
+					Value:    time.Now().Format("2006-01-02"),
+				}
+				itemsData["shiwakeym"] = &item.Value{
+					DataType: "text",
+					Value:    p.handleMonth,
+				}
+				itemsData["partten"] = &item.Value{
+					DataType: "text",
+					Value:    pattern.PatternId,
+				}
+				itemsData["lineno"] = &item.Value{
+					DataType: "number",
+					Value:    strconv.Itoa(line + 1),
+				}
+				itemsData["taishakukubun"] = &item.Value{
+					DataType: "text",
+					Value:    sub.LendingDivision,
+				}
+				itemsData["kanjokamoku"] = &item.Value{
+					DataType: "text",
+					Value:    subMap[sub.GetSubjectKey()],
+				}
+				itemsData["shiwakekingaku"] = &item.Value{
+					DataType: "number",
+					Value:    amount.String(),
+				}
+				itemsData["shiwakeaggno_parent"] = &item.Value{
+					DataType: "text",
+					Value:    strconv.Itoa(count),
+				}
+				itemsData["shiwakeaggno_branch"] = &item.Value{
+					DataType: "text",
+					Value:    strconv.Itoa(branchCount),
+				}
+				itemsData["shiwaketype"] = &item.Value{
+					DataType: "text",
+					Value:    "1",
+				}
+				itemsData["remark"] = &item.Value{
+					DataType: "text",
+					Value:    keiyakuno + "_" + pattern.PatternName,
+				}
+				// 账簿
+				itemsData["book"] = bookValue(leasecalc.PrimaryBookID)
+				itemsData["index"] = &item.Value{
+					DataType: "number",
+					Value:    strconv.Itoa(index),
+				}
+
+				its := &item.ListItems{
+					Items: itemsData,
+				}
+
+				items = append(items, its)
+
+				index++
+				branchCount++
+			}
+			count++
+			continue
+		}
+		// 如果是外币契约的月末换算的场合
+		if actkbn == "fxreval" {
+			pattern, err := getRequiredPattern("01009", p.jouData)
+			if err != nil {
+				loggerx.ErrorLog("insertData", err.Error())
+				return nil, err
+			}
+
+			var itemMap map[string]*item.Value
+
+			branchCount := 1
+			for line, sub := range pattern.GetSubjects() {
+				if sub.ChangeFlag == "old" {
+					itemMap = copyMap(oldItemMap)
+				} else {
+					itemMap = copyMap(newItemMap)
+				}
+
+				// 换算差额已按功能通货计算,按顾客设定的端数处理方式确定分录金额
+				amount, err := evalAmount(sub, newItemMap, p.rounding)
+				if err != nil {
+					loggerx.ErrorLog("insertData", err.Error())
+					return nil, err
+				}
+
+				if amount == 0 {
+					continue
+				}
+
+				keiyakuno := itemMap["keiyakuno"].GetValue()
+				assetsType := itemMap["bunruicd"].GetValue()
+				subMap := p.asSubMap[assetsType]
+
+				// 创建登录数据
+				itemsData := copyMap(itemMap)
+				itemsData["shiwakeno"] = &item.Value{
+					DataType: "text",
+					Value:    p.shiwakeno,
+				}
+				itemsData["shiwakeymd"] = &item.Value{
+					DataType: "date",
+					Value:    time.Now().Format("2006-01-02"),
+				}
+				itemsData["shiwakeym"] = &item.Value{
+					DataType: "text",
+					Value:    p.handleMonth,
+				}
+				itemsData["partten"] = &item.Value{
+					DataType: "text",
+					Value:    pattern.PatternId,
+				}
+				itemsData["lineno"] = &item.Value{
+					DataType: "number",
+					Value:    strconv.Itoa(line + 1),
+				}
+				itemsData["taishakukubun"] = &item.Value{
+					DataType: "text",
+					Value:    sub.LendingDivision,
+				}
+				itemsData["kanjokamoku"] = &item.Value{
+					DataType: "text",
+					Value:    subMap[sub.GetSubjectKey()],
+				}
+				itemsData["shiwakekingaku"] = &item.Value{
+					DataType: "number",
+					Value:    amount.String(),
+				}
+				itemsData["shiwakeaggno_parent"] = &item.Value{
+					DataType: "text",
+					Value:    strconv.Itoa(count),
+				}
+				itemsData["shiwakeaggno_branch"] = &item.Value{
+					DataType: "text",
+					Value:    strconv.Itoa(branchCount),
+				}
+				itemsData["shiwaketype"] = &item.Value{
+					DataType: "text",
+					Value:    "1",
+				}
+				itemsData["remark"] = &item.Value{
+					DataType: "text",
+					Value:    keiyakuno + "_" + pattern.PatternName + "_" + p.handleMonth,
+				}
+				// 账簿
+				itemsData["book"] = bookValue(leasecalc.PrimaryBookID)
+				itemsData["index"] = &item.Value{
+					DataType: "number",
+					Value:    strconv.Itoa(index),
+				}
+
+				its := &item.ListItems{
+					Items: itemsData,
+				}
+
+				items = append(items, its)
+
+				index++
+				branchCount++
+			}
+			count++
+			continue
+		}
+		// 如果是使用権資産减损或减损回转的场合
+		if actkbn == leasex.ActImpairment || actkbn == leasex.ActImpairmentReversal {
+			pid := "01010"
+			if actkbn == leasex.ActImpairmentReversal {
+				pid = "01011"
+			}
+			pattern, err := getRequiredPattern(pid, p.jouData)
+			if err != nil {
+				loggerx.ErrorLog("insertData", err.Error())
+				return nil, err
+			}
+
+			var itemMap map[string]*item.Value
+
+			branchCount := 1
+			for line, sub := range pattern.GetSubjects() {
+				if sub.ChangeFlag == "old" {
+					itemMap = copyMap(oldItemMap)
+				} else {
+					itemMap = copyMap(newItemMap)
+				}
+
+				// 按顾客设定的端数处理方式确定分录金额
+				amount, err := evalAmount(sub, newItemMap, p.rounding)
+				if err != nil {
+					loggerx.ErrorLog("insertData", err.Error())
+					return nil, err
+				}
+
+				if amount == 0 {
 					continue
 				}
 
@@ -1918,7 +2199,7 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 				}
 				itemsData["shiwakekingaku"] = &item.Value{
 					DataType: "number",
-					Value:    result.String(),
+					Value:    amount.String(),
 				}
 				itemsData["shiwakeaggno_parent"] = &item.Value{
 					DataType: "text",
@@ -1936,6 +2217,8 @@ func genShiwakeData(p InsertParam, hsData map[string]ItemData) (it ImportData, e
 					DataType: "text",
 					Value:    keiyakuno + "_" + pattern.PatternName,
 				}
+				// 账簿
+				itemsData["book"] = bookValue(leasecalc.PrimaryBookID)
 				itemsData["index"] = &item.Value{
 					DataType: "number",
 					Value:    strconv.Itoa(index),
@@ -2053,6 +2336,29 @@ func GenPayData(domain, db, appID, userID, lang string, owners []string) (r *ite
 			return
 		}
 		handleMonth := cfg.GetSyoriYm()
+		// 金额端数处理设定
+		rounding := leasecalc.Rounding{
+			Mode: leasecalc.ParseRoundingMode(cfg.GetRoundingMode()),
+		}
+		// 租赁费用计上方式和账簿设定
+		costModel := leasecalc.CostModel(cfg.GetCostModel())
+		books, err := getBookMap(db, appID)
+		if err != nil {
+			path := filex.WriteAndSaveFile(domain, appID, []string{err.Error()})
+			// 发送消息 收集数据情报失败 终止任务
+			jobx.ModifyTask(task.ModifyRequest{
+				JobId:       jobID,
+				Message:     err.Error(),
+				CurrentStep: "collect-data",
+				EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+				ErrorFile: &task.File{
+					Url:  path.MediaLink,
+					Name: path.Name,
+				},
+				Database: db,
+			}, userID)
+			return
+		}
 
 		// 获取分录数据
 		jouData, err := getJournal(db, appID, "03")
@@ -2129,6 +2435,7 @@ func GenPayData(domain, db, appID, userID, lang string, owners []string) (r *ite
 			lang:        lang,
 			shiwakeno:   shiwakeno,
 			handleMonth: handleMonth,
+			rounding:    rounding,
 			appID:       appID,
 			datastoreID: dsMap["paymentInterest"],
 			userID:      userID,
@@ -2136,6 +2443,8 @@ func GenPayData(domain, db, appID, userID, lang string, owners []string) (r *ite
 			dsMap:       dsMap,
 			jouData:     jouData,
 			asSubMap:    asSubMap,
+			costModel:   costModel,
+			books:       books,
 		}
 
 		err = buildPayData(param)
@@ -2156,6 +2465,44 @@ func GenPayData(domain, db, appID, userID, lang string, owners []string) (r *ite
 			return
 		}
 
+		// 贷手契约的受取分录
+		err = buildLessorData(param)
+		if err != nil {
+			path := filex.WriteAndSaveFile(domain, appID, []string{err.Error()})
+			// 发送消息 获取数据失败，终止任务
+			jobx.ModifyTask(task.ModifyRequest{
+				JobId:       jobID,
+				Message:     err.Error(),
+				CurrentStep: "gen-data",
+				EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+				ErrorFile: &task.File{
+					Url:  path.MediaLink,
+					Name: path.Name,
+				},
+				Database: db,
+			}, userID)
+			return
+		}
+
+		// 支付照合确定差额的变动リース料分录
+		err = buildVariablePayData(param)
+		if err != nil {
+			path := filex.WriteAndSaveFile(domain, appID, []string{err.Error()})
+			// 发送消息 获取数据失败，终止任务
+			jobx.ModifyTask(task.ModifyRequest{
+				JobId:       jobID,
+				Message:     err.Error(),
+				CurrentStep: "gen-data",
+				EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+				ErrorFile: &task.File{
+					Url:  path.MediaLink,
+					Name: path.Name,
+				},
+				Database: db,
+			}, userID)
+			return
+		}
+
 		// 发送消息 任务成功结束
 		jobx.ModifyTask(task.ModifyRequest{
 			JobId:       jobID,
@@ -2275,6 +2622,14 @@ func buildPayData(p InsertParam) (e error) {
 				loggerx.ErrorLog("getPayData", err.Error())
 				return err
 			}
+			// 经营租赁的场合,利息已在リース費用分录中计上,支付时只减少租赁负债
+			if p.costModelOf(payItem["book"].GetValue(), itemMap) == leasecalc.CostModelOperating {
+				pattern, err = getRequiredPattern("03002", p.jouData)
+				if err != nil {
+					loggerx.ErrorLog("getPayData", err.Error())
+					return err
+				}
+			}
 
 			branchCount := 1
 			for line, sub := range pattern.GetSubjects() {
@@ -2308,7 +2663,10 @@ func buildPayData(p InsertParam) (e error) {
 					return err
 				}
 
-				if fv == 0.0 {
+				// 按顾客设定的端数处理方式确定分录金额
+				amount := p.rounding.RoundFloat(fv)
+
+				if amount == 0 {
 					continue
 				}
 
@@ -2353,7 +2711,7 @@ func buildPayData(p InsertParam) (e error) {
 				}
 				itemsData["shiwakekingaku"] = &item.Value{
 					DataType: "number",
-					Value:    result.String(),
+					Value:    amount.String(),
 				}
 				itemsData["shiwakeaggno_parent"] = &item.Value{
 					DataType: "text",
@@ -2371,6 +2729,8 @@ func buildPayData(p InsertParam) (e error) {
 					DataType: "text",
 					Value:    "支払_" + p.handleMonth,
 				}
+				// 账簿
+				itemsData["book"] = bookValue(payItem["book"].GetValue())
 				itemsData["index"] = &item.Value{
 					DataType: "number",
 					Value:    strconv.Itoa(index),
@@ -2523,6 +2883,29 @@ func GenRepayData(domain, db, appID, userID, lang string, owners []string) (r *i
 			return
 		}
 		handleMonth := cfg.GetSyoriYm()
+		// 金额端数处理设定
+		rounding := leasecalc.Rounding{
+			Mode: leasecalc.ParseRoundingMode(cfg.GetRoundingMode()),
+		}
+		// 租赁费用计上方式和账簿设定
+		costModel := leasecalc.CostModel(cfg.GetCostModel())
+		books, err := getBookMap(db, appID)
+		if err != nil {
+			path := filex.WriteAndSaveFile(domain, appID, []string{err.Error()})
+			// 发送消息 收集数据情报失败 终止任务
+			jobx.ModifyTask(task.ModifyRequest{
+				JobId:       jobID,
+				Message:     err.Error(),
+				CurrentStep: "collect-data",
+				EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+				ErrorFile: &task.File{
+					Url:  path.MediaLink,
+					Name: path.Name,
+				},
+				Database: db,
+			}, userID)
+			return
+		}
 
 		// 获取分录确认方式
 		appService := app.NewAppService("manage", client.DefaultClient)
@@ -2624,6 +3007,7 @@ func GenRepayData(domain, db, appID, userID, lang string, owners []string) (r *i
 			domain:       domain,
 			shiwakeno:    shiwakeno,
 			handleMonth:  handleMonth,
+			rounding:     rounding,
 			appID:        appID,
 			datastoreID:  dsMap["repayment"],
 			userID:       userID,
@@ -2632,6 +3016,8 @@ func GenRepayData(domain, db, appID, userID, lang string, owners []string) (r *i
 			jouData:      jouData,
 			asSubMap:     asSubMap,
 			confimMethod: confimMethod,
+			costModel:    costModel,
+			books:        books,
 		}
 
 		//  生成数据
@@ -2832,8 +3218,26 @@ func buildRepaymentData(p InsertParam) (e error) {
 				loggerx.ErrorLog("getRepaymentData", err.Error())
 				return err
 			}
+			// 同一账簿的确定済数据
+			setItem := findBookItem(setResp.GetItems(), repayItem.Items["book"].GetValue())
 
 			pattern := getPattern("02001", p.jouData)
+			// 经营租赁的场合,单一リース費用分录
+			if p.hasOperating() {
+				keiyakuAccesskeys := sessionx.GetAccessKeys(p.db, p.userID, p.dsMap["keiyakudaicho"], "R")
+				keiyaku, err := getKeiyakuData(p.db, p.appID, p.dsMap["keiyakudaicho"], repayItem.Items["keiyakuno"].GetValue(), keiyakuAccesskeys)
+				if err != nil {
+					loggerx.ErrorLog("getRepaymentData", err.Error())
+					return err
+				}
+				if p.costModelOf(repayItem.Items["book"].GetValue(), keiyaku) == leasecalc.CostModelOperating {
+					pattern, err = getRequiredPattern("02002", p.jouData)
+					if err != nil {
+						loggerx.ErrorLog("getRepaymentData", err.Error())
+						return err
+					}
+				}
+			}
 			branchCount := 1
 			for line, sub := range pattern.GetSubjects() {
 				expression := formula.NewExpression(sub.AmountField)
@@ -2866,7 +3270,10 @@ func buildRepaymentData(p InsertParam) (e error) {
 					return err
 				}
 
-				if fv == 0.0 {
+				// 按顾客设定的端数处理方式确定分录金额
+				amount := p.rounding.RoundFloat(fv)
+
+				if amount == 0 {
 					continue
 				}
 
@@ -2911,7 +3318,7 @@ func buildRepaymentData(p InsertParam) (e error) {
 				}
 				itemsData["shiwakekingaku"] = &item.Value{
 					DataType: "number",
-					Value:    result.String(),
+					Value:    amount.String(),
 				}
 				itemsData["shiwakeaggno_parent"] = &item.Value{
 					DataType: "text",
@@ -2929,20 +3336,23 @@ func buildRepaymentData(p InsertParam) (e error) {
 					DataType: "text",
 					Value:    "償却_" + p.handleMonth,
 				}
+				// 账簿
+				itemsData["book"] = bookValue(repayItem.Items["book"].GetValue())
 				itemsData["index"] = &item.Value{
 					DataType: "number",
 					Value:    strconv.Itoa(index),
 				}
 
-				if setResp.Total > 0 && p.confimMethod == "sabun" {
-					floatNum, err := strconv.ParseFloat(setResp.GetItems()[0].Items["syokyaku"].GetValue(), 64)
+				if setItem != nil && p.confimMethod == "sabun" {
+					// 确定済数据按同一科目的金额公式计算差额
+					setAmount, err := evalAmount(sub, setItem.Items, p.rounding)
 					if err != nil {
 						loggerx.ErrorLog("getRepaymentData", err.Error())
 					}
 
 					itemsData["shiwakekingaku"] = &item.Value{
 						DataType: "number",
-						Value:    fmt.Sprintf("%f", fv-floatNum),
+						Value:    (amount - setAmount).String(),
 					}
 				}
 
@@ -2956,13 +3366,13 @@ func buildRepaymentData(p InsertParam) (e error) {
 				branchCount++
 			}
 
-			if setResp.Total > 0 && p.confimMethod == "araigae" {
+			if setItem != nil && p.confimMethod == "araigae" {
 				branchCount := 1
 				for line, sub := range pattern.GetSubjects() {
 					expression := formula.NewExpression(sub.AmountField)
 					params := getParam(sub.AmountField)
 					for _, pm := range params {
-						it, ok := setResp.GetItems()[0].Items[pm]
+						it, ok := setItem.Items[pm]
 						if !ok {
 							it = &item.Value{
 								DataType: "number",
@@ -2989,19 +3399,22 @@ func buildRepaymentData(p InsertParam) (e error) {
 						return err
 					}
 
-					if fv == 0.0 {
+					// 按顾客设定的端数处理方式确定分录金额
+					amount := p.rounding.RoundFloat(fv)
+
+					if amount == 0 {
 						continue
 					}
 
-					assetsType := setResp.GetItems()[0].Items["bunruicd"].GetValue()
+					assetsType := setItem.Items["bunruicd"].GetValue()
 					subMap := p.asSubMap[assetsType]
 
 					// 创建登录数据
-					itemsData := copyMap(setResp.GetItems()[0].Items)
+					itemsData := copyMap(setItem.Items)
 
 					itemsData["keiyakuno"] = &item.Value{
 						DataType: "lookup",
-						Value:    setResp.GetItems()[0].Items["leasekaishacd"].GetValue(),
+						Value:    setItem.Items["leasekaishacd"].GetValue(),
 					}
 
 					itemsData["shiwakeno"] = &item.Value{
@@ -3034,7 +3447,7 @@ func buildRepaymentData(p InsertParam) (e error) {
 					}
 					itemsData["shiwakekingaku"] = &item.Value{
 						DataType: "number",
-						Value:    fmt.Sprintf("%f", -fv),
+						Value:    (-amount).String(),
 					}
 					itemsData["shiwakeaggno_parent"] = &item.Value{
 						DataType: "text",
@@ -3052,6 +3465,8 @@ func buildRepaymentData(p InsertParam) (e error) {
 						DataType: "text",
 						Value:    "償却_" + p.handleMonth,
 					}
+					// 账簿
+					itemsData["book"] = bookValue(repayItem.Items["book"].GetValue())
 					itemsData["index"] = &item.Value{
 						DataType: "number",
 						Value:    strconv.Itoa(index),
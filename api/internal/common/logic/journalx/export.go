@@ -0,0 +1,119 @@
+package journalx
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/micro/go-micro/v2/client"
+	"github.com/micro/go-micro/v2/client/grpc"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+	"rxcsoft.cn/pit3/srv/journal/proto/journal"
+)
+
+// FindExporters 获取登录的分录出力适配器
+func FindExporters() ([]*journal.Exporter, error) {
+	journalService := journal.NewJournalService("journal", client.DefaultClient)
+
+	response, err := journalService.FindExporters(context.TODO(), &journal.FindExportersRequest{})
+	if err != nil {
+		loggerx.ErrorLog("findExporters", err.Error())
+		return nil, err
+	}
+
+	return response.GetExporters(), nil
+}
+
+// ExportJournal 分录年月的分录按出力适配器的形式编辑,返回出力行和验证结果
+// preview大于0的场合出力行只返回最初的preview件传票(验证对象为全部传票)
+func ExportJournal(db, appID, userID string, p typesx.JournalExportParam, preview int) (*journal.ExportResponse, error) {
+	if len(p.Exporter) == 0 {
+		return nil, errors.New("出力形式を指定してください")
+	}
+	if _, err := time.Parse("2006-01", p.Shiwakeym); err != nil {
+		return nil, errors.New("仕訳年月が不正です")
+	}
+
+	dsMap, err := getDatastoreMap(db, appID)
+	if err != nil {
+		return nil, err
+	}
+	param := InsertParam{
+		db:     db,
+		appID:  appID,
+		userID: userID,
+		dsMap:  dsMap,
+	}
+
+	conditions := []*item.Condition{
+		{
+			FieldId:     "shiwakeym",
+			FieldType:   "text",
+			SearchValue: p.Shiwakeym,
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+	}
+	if len(p.Book) > 0 {
+		conditions = append(conditions, &item.Condition{
+			FieldId:     "book",
+			FieldType:   "text",
+			SearchValue: p.Book,
+			Operator:    "=",
+			IsDynamic:   true,
+		})
+	}
+	if len(p.Shiwakeno) > 0 {
+		conditions = append(conditions, &item.Condition{
+			FieldId:     "shiwakeno",
+			FieldType:   "text",
+			SearchValue: p.Shiwakeno,
+			Operator:    "=",
+			IsDynamic:   true,
+		})
+	}
+
+	items, err := findAllItems(param, dsMap["shiwake"], conditions, "index")
+	if err != nil {
+		loggerx.ErrorLog("exportJournal", err.Error())
+		return nil, err
+	}
+
+	req := journal.ExportRequest{
+		Exporter:     p.Exporter,
+		AccountCodes: p.AccountCodes,
+		TaxCodes:     p.TaxCodes,
+		CompanyCode:  p.CompanyCode,
+		Currency:     p.Currency,
+		Preview:      int64(preview),
+	}
+	for _, it := range items {
+		values := make(map[string]string)
+		for key, v := range it.GetItems() {
+			values[key] = v.GetValue()
+		}
+		req.Lines = append(req.Lines, &journal.ExportLine{
+			Items: values,
+		})
+	}
+
+	ct := grpc.NewClient(
+		grpc.MaxSendMsgSize(100*1024*1024), grpc.MaxRecvMsgSize(100*1024*1024),
+	)
+	journalService := journal.NewJournalService("journal", ct)
+
+	var opss client.CallOption = func(o *client.CallOptions) {
+		o.RequestTimeout = time.Minute * 10
+		o.DialTimeout = time.Minute * 10
+	}
+
+	response, err := journalService.ExportJournal(context.TODO(), &req, opss)
+	if err != nil {
+		loggerx.ErrorLog("exportJournal", err.Error())
+		return nil, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,260 @@
+package journalx
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/micro/go-micro/v2/client"
+	"github.com/micro/go-micro/v2/client/grpc"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+)
+
+// FxDatastoreKey 为替汇率台账的apikey
+const FxDatastoreKey = "ds_fxrate"
+
+// findAllItems 按条件检索台账的全部数据
+func findAllItems(p InsertParam, datastoreID string, conditions []*item.Condition, sortKey string) ([]*item.Item, error) {
+	ct := grpc.NewClient(
+		grpc.MaxSendMsgSize(100*1024*1024), grpc.MaxRecvMsgSize(100*1024*1024),
+	)
+
+	itemService := item.NewItemService("database", ct)
+
+	var opss client.CallOption = func(o *client.CallOptions) {
+		o.RequestTimeout = time.Minute * 10
+		o.DialTimeout = time.Minute * 10
+	}
+
+	var req item.ItemsRequest
+	req.ConditionList = conditions
+	req.ConditionType = "and"
+	req.Sorts = []*item.SortItem{
+		{
+			SortKey:   sortKey,
+			SortValue: "ascend",
+		},
+	}
+	req.DatastoreId = datastoreID
+	req.AppId = p.appID
+	req.Owners = sessionx.GetAccessKeys(p.db, p.userID, datastoreID, "R")
+	req.IsOrigin = true
+	req.Database = p.db
+
+	response, err := itemService.FindItems(context.TODO(), &req, opss)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetItems(), nil
+}
+
+// getFxRates 取得为替汇率台账的全部汇率
+func getFxRates(p InsertParam) (rates []leasecalc.FxRate, err error) {
+	items, err := findAllItems(p, p.dsMap[FxDatastoreKey], nil, "rateym")
+	if err != nil {
+		loggerx.ErrorLog("getFxRates", err.Error())
+		return nil, err
+	}
+
+	for _, it := range items {
+		ym := it.GetItems()["rateym"].GetValue()
+		if len(ym) < 7 {
+			continue
+		}
+		rate, err := strconv.ParseFloat(it.GetItems()["rate"].GetValue(), 64)
+		if err != nil {
+			loggerx.ErrorLog("getFxRates", err.Error())
+			return nil, err
+		}
+		rates = append(rates, leasecalc.FxRate{
+			Currency: it.GetItems()["currency"].GetValue(),
+			Ym:       ym[:7],
+			Rate:     rate,
+		})
+	}
+
+	return rates, nil
+}
+
+// getLeaseData 取得契约的利息数据(主账簿)
+func getLeaseData(p InsertParam, keiyakuno string) (leases []leasecalc.Lease, err error) {
+	items, err := findAllItems(p, p.dsMap["paymentInterest"], []*item.Condition{
+		{
+			FieldId:     "keiyakuno",
+			FieldType:   "lookup",
+			SearchValue: keiyakuno,
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+	}, "paymentymd")
+	if err != nil {
+		loggerx.ErrorLog("getLeaseData", err.Error())
+		return nil, err
+	}
+
+	for _, it := range items {
+		if leasecalc.BookOf(it.Items["book"].GetValue()) != leasecalc.PrimaryBookID {
+			continue
+		}
+		lease := leasecalc.Lease{
+			Keiyakuno:  keiyakuno,
+			Paymentymd: it.Items["paymentymd"].GetValue(),
+		}
+		if lease.Interest, err = leasecalc.ParseMoney(it.Items["interest"].GetValue()); err != nil {
+			return nil, err
+		}
+		if lease.Repayment, err = leasecalc.ParseMoney(it.Items["repayment"].GetValue()); err != nil {
+			return nil, err
+		}
+		if lease.Balance, err = leasecalc.ParseMoney(it.Items["balance"].GetValue()); err != nil {
+			return nil, err
+		}
+		leases = append(leases, lease)
+	}
+
+	return leases, nil
+}
+
+// numberItem 金额转换为数值项目(负数的场合为0)
+func numberItem(m leasecalc.Money) *item.Value {
+	if m < 0 {
+		m = 0
+	}
+	return &item.Value{
+		DataType: "number",
+		Value:    m.String(),
+	}
+}
+
+// buildFxData 外币契约的租赁负债按处理月度的月末汇率重新换算,生成为替换算差額的分录数据
+// 使用权资产维持取得时汇率,不生成换算差额
+func buildFxData(p InsertParam) (e error) {
+	// 未设定为替汇率台账的场合,没有外币契约
+	if len(p.dsMap[FxDatastoreKey]) == 0 {
+		return nil
+	}
+
+	rates, err := getFxRates(p)
+	if err != nil {
+		return err
+	}
+
+	contracts, err := findAllItems(p, p.dsMap["keiyakudaicho"], []*item.Condition{
+		{
+			FieldId:     "currency",
+			FieldType:   "text",
+			SearchValue: "",
+			Operator:    "<>",
+			IsDynamic:   true,
+		},
+	}, "keiyakuno")
+	if err != nil {
+		loggerx.ErrorLog("buildFxData", err.Error())
+		return err
+	}
+
+	hsData := make(map[string]ItemData)
+	for _, ct := range contracts {
+		items := ct.GetItems()
+		keiyakuno := items["keiyakuno"].GetValue()
+		currency := items["currency"].GetValue()
+		if !leasecalc.IsForeignCurrency(currency, p.functional) {
+			continue
+		}
+		// 处理月度前已解约或满了的契约,以及处理月度后开始的契约不需要换算
+		if cancel := items["kaiyakuymd"].GetValue(); len(cancel) >= 7 && cancel[:7] < p.handleMonth {
+			continue
+		}
+		if expire := items["leaseexpireymd"].GetValue(); len(expire) >= 7 && expire[:7] < p.handleMonth {
+			continue
+		}
+		start := items["leasestymd"].GetValue()
+		if len(start) < 7 || start[:7] > p.handleMonth {
+			continue
+		}
+
+		leases, err := getLeaseData(p, keiyakuno)
+		if err != nil {
+			return err
+		}
+
+		opening, err := leasecalc.OpeningRate(rates, currency, start[:7], p.handleMonth)
+		if err != nil {
+			return fmt.Errorf("契約番号[%s]:%v", keiyakuno, err)
+		}
+		closing, err := leasecalc.RateAt(rates, currency, p.handleMonth)
+		if err != nil {
+			return fmt.Errorf("契約番号[%s]:%v", keiyakuno, err)
+		}
+
+		r, err := leasecalc.RevalueLiability(p.rounding, leases, p.handleMonth, currency, opening, closing)
+		if err != nil {
+			return fmt.Errorf("契約番号[%s]:%v", keiyakuno, err)
+		}
+		if r.PayDiff == 0 && r.InterestDiff == 0 {
+			continue
+		}
+
+		before := copyMap(items)
+		before["zengokbn"] = &item.Value{
+			DataType: "options",
+			Value:    "before",
+		}
+		before["fxrate"] = &item.Value{
+			DataType: "number",
+			Value:    strconv.FormatFloat(opening, 'f', -1, 64),
+		}
+
+		after := copyMap(items)
+		after["zengokbn"] = &item.Value{
+			DataType: "options",
+			Value:    "after",
+		}
+		after["actkbn"] = &item.Value{
+			DataType: "options",
+			Value:    "fxreval",
+		}
+		after["fxrate"] = &item.Value{
+			DataType: "number",
+			Value:    strconv.FormatFloat(closing, 'f', -1, 64),
+		}
+		// 分录计算式用的换算差额(功能通货,按借贷方向分为增加和减少)
+		after["fxloss"] = numberItem(r.FxDiff)
+		after["fxgain"] = numberItem(-r.FxDiff)
+		after["payfxup"] = numberItem(r.PayDiff)
+		after["payfxdown"] = numberItem(-r.PayDiff)
+		after["interestfxup"] = numberItem(r.InterestDiff)
+		after["interestfxdown"] = numberItem(-r.InterestDiff)
+		after["functionalbalance"] = &item.Value{
+			DataType: "number",
+			Value:    r.FunctionalBalance.String(),
+		}
+
+		hsData[keiyakuno] = ItemData{before, after}
+	}
+
+	if len(hsData) == 0 {
+		return nil
+	}
+
+	its, err := genShiwakeData(p, hsData)
+	if err != nil {
+		loggerx.ErrorLog("buildFxData", err.Error())
+		return err
+	}
+
+	result, err := importData(p, its)
+	if err != nil {
+		loggerx.ErrorLog("buildFxData", err.Error())
+		return err
+	}
+
+	loggerx.DebugLog("buildFxData", fmt.Sprintf("result %v", result))
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package journalx
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/leasex"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+	"rxcsoft.cn/pit3/srv/journal/proto/journal"
+)
+
+// shiwakeEntry 按分录模式生成的分录单位(分录模式和金额计算用的数据)
+type shiwakeEntry struct {
+	pattern *journal.Pattern
+	items   map[string]*item.Value
+}
+
+// buildLessorData 生成贷手契约的分录数据
+// 处理月度开始的ファイナンス・リース转租生成使用権資産的认识中止分录(08001),
+// 处理月度的收益数据按分类生成受取分录(ファイナンス:08002,オペレーティング:08003)
+func buildLessorData(p InsertParam) (e error) {
+	// 未设定贷手收益台账的场合,没有贷手契约
+	if len(p.dsMap[leasex.LessorIncomeKey]) == 0 {
+		return nil
+	}
+
+	jouData, err := getJournal(p.db, p.appID, "08")
+	if err != nil {
+		return err
+	}
+
+	var entries []shiwakeEntry
+
+	// 转租开始的分录
+	contracts, err := findAllItems(p, p.dsMap["keiyakudaicho"], []*item.Condition{
+		{
+			FieldId:     "leaseside",
+			FieldType:   "options",
+			SearchValue: leasex.LessorSide,
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+	}, "keiyakuno")
+	if err != nil {
+		loggerx.ErrorLog("buildLessorData", err.Error())
+		return err
+	}
+	for _, ct := range contracts {
+		items := ct.GetItems()
+		start := items["leasestymd"].GetValue()
+		if len(start) < 7 || start[:7] != p.handleMonth {
+			continue
+		}
+		if len(items["headkeiyakuno"].GetValue()) == 0 || leasecalc.ParseLessorClass(items["lessorclass"].GetValue()) != leasecalc.LessorFinance {
+			continue
+		}
+		pattern, err := getRequiredPattern("08001", jouData)
+		if err != nil {
+			return err
+		}
+
+		sonnekigaku, err := leasecalc.ParseMoney(items["sonnekigaku"].GetValue())
+		if err != nil {
+			return fmt.Errorf("契約番号[%s]:%v", items["keiyakuno"].GetValue(), err)
+		}
+		data := copyMap(items)
+		// 分录计算式用的认识中止损益(按借贷方向分为利益和损失)
+		data["subleasegain"] = numberItem(sonnekigaku)
+		data["subleaseloss"] = numberItem(-sonnekigaku)
+
+		entries = append(entries, shiwakeEntry{pattern, data})
+	}
+
+	// 处理月度的受取分录
+	incomes, err := findAllItems(p, p.dsMap[leasex.LessorIncomeKey], []*item.Condition{
+		{
+			FieldId:     "incomeymd",
+			FieldType:   "date",
+			SearchValue: p.handleMonth + "-01",
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+	}, "keiyakuno")
+	if err != nil {
+		loggerx.ErrorLog("buildLessorData", err.Error())
+		return err
+	}
+
+	keiyakuAccesskeys := sessionx.GetAccessKeys(p.db, p.userID, p.dsMap["keiyakudaicho"], "R")
+	for _, in := range incomes {
+		keiyakuno := in.Items["keiyakuno"].GetValue()
+		keiyaku, err := getKeiyakuData(p.db, p.appID, p.dsMap["keiyakudaicho"], keiyakuno, keiyakuAccesskeys)
+		if err != nil {
+			loggerx.ErrorLog("buildLessorData", err.Error())
+			return err
+		}
+
+		pid := "08002"
+		if leasecalc.ParseLessorClass(in.Items["lessorclass"].GetValue()) == leasecalc.LessorOperating {
+			pid = "08003"
+		}
+		pattern, err := getRequiredPattern(pid, jouData)
+		if err != nil {
+			return err
+		}
+
+		data := copyMap(keiyaku)
+		for _, f := range []string{"receipt", "income", "recovery"} {
+			data[f] = in.Items[f]
+		}
+		receipt, err := leasecalc.ParseMoney(in.Items["receipt"].GetValue())
+		if err != nil {
+			return fmt.Errorf("契約番号[%s]:%v", keiyakuno, err)
+		}
+		income, err := leasecalc.ParseMoney(in.Items["income"].GetValue())
+		if err != nil {
+			return fmt.Errorf("契約番号[%s]:%v", keiyakuno, err)
+		}
+		// オペレーティング・リース的受取额与收益额的差额(前受的增加和减少)
+		data["deferredup"] = numberItem(receipt - income)
+		data["deferreddown"] = numberItem(income - receipt)
+
+		entries = append(entries, shiwakeEntry{pattern, data})
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	its, err := genEntryShiwakeData(p, entries, "貸手_"+p.handleMonth)
+	if err != nil {
+		loggerx.ErrorLog("buildLessorData", err.Error())
+		return err
+	}
+
+	result, err := importData(p, its)
+	if err != nil {
+		loggerx.ErrorLog("buildLessorData", err.Error())
+		return err
+	}
+
+	loggerx.DebugLog("buildLessorData", fmt.Sprintf("result %v", result))
+
+	return nil
+}
+
+// genEntryShiwakeData 按分录模式的科目和金额公式编辑分录数据(只有主账簿)
+func genEntryShiwakeData(p InsertParam, entries []shiwakeEntry, remark string) (items ImportData, e error) {
+	index := 1
+	for count, en := range entries {
+		keiyakuno := en.items["keiyakuno"].GetValue()
+		subMap := p.asSubMap[en.items["bunruicd"].GetValue()]
+
+		branchCount := 1
+		for line, sub := range en.pattern.GetSubjects() {
+			amount, err := evalAmount(sub, en.items, p.rounding)
+			if err != nil {
+				return nil, fmt.Errorf("契約番号[%s]:%v", keiyakuno, err)
+			}
+			if amount == 0 {
+				continue
+			}
+
+			// 创建登录数据
+			itemsData := copyMap(en.items)
+
+			itemsData["keiyakuno"] = &item.Value{
+				DataType: "lookup",
+				Value:    keiyakuno,
+			}
+			itemsData["shiwakeno"] = &item.Value{
+				DataType: "text",
+				Value:    p.shiwakeno,
+			}
+			itemsData["shiwakeymd"] = &item.Value{
+				DataType: "date",
+				Value:    time.Now().Format("2006-01-02"),
+			}
+			itemsData["shiwakeym"] = &item.Value{
+				DataType: "text",
+				Value:    p.handleMonth,
+			}
+			itemsData["partten"] = &item.Value{
+				DataType: "text",
+				Value:    en.pattern.PatternId,
+			}
+			itemsData["lineno"] = &item.Value{
+				DataType: "number",
+				Value:    strconv.Itoa(line + 1),
+			}
+			itemsData["taishakukubun"] = &item.Value{
+				DataType: "text",
+				Value:    sub.LendingDivision,
+			}
+			itemsData["kanjokamoku"] = &item.Value{
+				DataType: "text",
+				Value:    subMap[sub.GetSubjectKey()],
+			}
+			itemsData["shiwakekingaku"] = &item.Value{
+				DataType: "number",
+				Value:    amount.String(),
+			}
+			itemsData["shiwakeaggno_parent"] = &item.Value{
+				DataType: "text",
+				Value:    strconv.Itoa(count + 1),
+			}
+			itemsData["shiwakeaggno_branch"] = &item.Value{
+				DataType: "text",
+				Value:    strconv.Itoa(branchCount),
+			}
+			itemsData["shiwaketype"] = &item.Value{
+				DataType: "text",
+				Value:    "3",
+			}
+			itemsData["remark"] = &item.Value{
+				DataType: "text",
+				Value:    remark,
+			}
+			itemsData["book"] = bookValue("")
+			itemsData["index"] = &item.Value{
+				DataType: "number",
+				Value:    strconv.Itoa(index),
+			}
+
+			items = append(items, &item.ListItems{
+				Items: itemsData,
+			})
+
+			index++
+			branchCount++
+		}
+	}
+
+	return items, nil
+}
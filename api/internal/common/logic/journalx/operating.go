@@ -0,0 +1,93 @@
+package journalx
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/yidane/formula"
+	"rxcsoft.cn/pit3/api/internal/common/logic/configx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+	"rxcsoft.cn/pit3/srv/journal/proto/journal"
+)
+
+// getBookMap 取得主账簿以外的账簿设定(账簿ID为key)
+func getBookMap(db, appID string) (books map[string]leasecalc.Book, err error) {
+	list, err := configx.GetBooks(db, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	books = make(map[string]leasecalc.Book, len(list))
+	for _, b := range list {
+		books[b.BookID] = b
+	}
+
+	return books, nil
+}
+
+// hasOperating 是否设定了费用计上方式(未设定的场合全部为ファイナンス・リース,不需要按契约判定)
+func (p InsertParam) hasOperating() bool {
+	if len(p.costModel) > 0 {
+		return true
+	}
+	for _, b := range p.books {
+		if b.OperatingStraightLine {
+			return true
+		}
+	}
+	return false
+}
+
+// costModelOf 数据的费用计上方式
+// 主账簿按契约指定优先,未指定的场合按app设定;其他账簿按账簿设定;所有权移转的契约为ファイナンス・リース
+func (p InsertParam) costModelOf(book string, keiyaku map[string]*item.Value) leasecalc.CostModel {
+	torihikikbn := keiyaku["torihikikbn"].GetValue()
+	if leasecalc.BookOf(book) != leasecalc.PrimaryBookID {
+		return p.books[book].CostModel(torihikikbn)
+	}
+	if torihikikbn == "1" {
+		return leasecalc.CostModelFinance
+	}
+	if model := leasecalc.CostModel(keiyaku["costmodel"].GetValue()); model.Specified() {
+		return leasecalc.ParseCostModel(string(model))
+	}
+	return leasecalc.ParseCostModel(string(p.costModel))
+}
+
+// getRequiredPattern 取得分录模式(经营租赁用的模式未设定的场合返回错误)
+func getRequiredPattern(pid string, j *journal.Journal) (*journal.Pattern, error) {
+	pattern := getPattern(pid, j)
+	if pattern == nil {
+		return nil, fmt.Errorf("仕訳パターン[%s]が設定されていません", pid)
+	}
+	return pattern, nil
+}
+
+// evalAmount 按科目的金额公式计算金额(按顾客设定的端数处理方式取整)
+func evalAmount(sub *journal.Subject, items map[string]*item.Value, rd leasecalc.Rounding) (leasecalc.Money, error) {
+	expression := formula.NewExpression(sub.AmountField)
+	for _, pm := range getParam(sub.AmountField) {
+		val := 0.0
+		if it, ok := items[pm]; ok {
+			v, err := strconv.ParseFloat(it.GetValue(), 64)
+			if err != nil {
+				return 0, err
+			}
+			val = v
+		}
+		expression.AddParameter(pm, val)
+	}
+
+	result, err := expression.Evaluate()
+	if err != nil {
+		return 0, err
+	}
+
+	fv, err := result.Float64()
+	if err != nil {
+		return 0, err
+	}
+
+	return rd.RoundFloat(fv), nil
+}
@@ -0,0 +1,97 @@
+package journalx
+
+import (
+	"fmt"
+
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/leasex"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+)
+
+// buildVariablePayData 支付照合中确定的差额(实际支付-预定支付)作为变动リース料生成分录数据(03003)
+// 对象为计上月度是处理月度的已确定实际支付
+func buildVariablePayData(p InsertParam) (e error) {
+	// 未设定实际支付台账的场合,没有照合数据
+	if len(p.dsMap[leasex.ActualPaymentKey]) == 0 {
+		return nil
+	}
+
+	actuals, err := findAllItems(p, p.dsMap[leasex.ActualPaymentKey], []*item.Condition{
+		{
+			FieldId:     "reconcileym",
+			FieldType:   "text",
+			SearchValue: p.handleMonth,
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+		{
+			FieldId:     "reconcilestatus",
+			FieldType:   "text",
+			SearchValue: leasex.ReconcileConfirmed,
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+	}, "keiyakuno")
+	if err != nil {
+		loggerx.ErrorLog("buildVariablePayData", err.Error())
+		return err
+	}
+	if len(actuals) == 0 {
+		return nil
+	}
+
+	pattern, err := getRequiredPattern("03003", p.jouData)
+	if err != nil {
+		return err
+	}
+
+	var entries []shiwakeEntry
+	keiyakuAccesskeys := sessionx.GetAccessKeys(p.db, p.userID, p.dsMap["keiyakudaicho"], "R")
+	for _, ac := range actuals {
+		keiyakuno := ac.Items["keiyakuno"].GetValue()
+		difference, err := leasecalc.ParseMoney(ac.Items["difference"].GetValue())
+		if err != nil {
+			return fmt.Errorf("契約番号[%s]:%v", keiyakuno, err)
+		}
+		if difference == 0 {
+			continue
+		}
+		keiyaku, err := getKeiyakuData(p.db, p.appID, p.dsMap["keiyakudaicho"], keiyakuno, keiyakuAccesskeys)
+		if err != nil {
+			loggerx.ErrorLog("buildVariablePayData", err.Error())
+			return err
+		}
+
+		data := copyMap(keiyaku)
+		for _, f := range []string{"paymentymd", "paymentamount", "scheduledymd", "difference"} {
+			data[f] = ac.Items[f]
+		}
+		// 实际支付比预定支付多的场合为费用的增加,少的场合为减少
+		data["variableup"] = numberItem(difference)
+		data["variabledown"] = numberItem(-difference)
+
+		entries = append(entries, shiwakeEntry{pattern, data})
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	its, err := genEntryShiwakeData(p, entries, "変動リース料_"+p.handleMonth)
+	if err != nil {
+		loggerx.ErrorLog("buildVariablePayData", err.Error())
+		return err
+	}
+
+	result, err := importData(p, its)
+	if err != nil {
+		loggerx.ErrorLog("buildVariablePayData", err.Error())
+		return err
+	}
+
+	loggerx.DebugLog("buildVariablePayData", fmt.Sprintf("result %v", result))
+
+	return nil
+}
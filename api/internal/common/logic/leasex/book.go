@@ -0,0 +1,140 @@
+package leasex
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/micro/go-micro/v2/client"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/configx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+)
+
+// rishiritsuDateLayout 追加借入利子率检索时的日期格式
+const rishiritsuDateLayout = "Mon Jan 02 2006 15:04:05 MST 0900"
+
+// bookRate 取得账簿使用的割引率(曲线的场合按契约通货、基准日和租赁期间插值)
+func bookRate(db string, b leasecalc.Book, dsMap map[string]string, currency string, baseDate time.Time, leasekikan int, rishiritsu float64) (float64, error) {
+	if b.RateSource != leasecalc.RateCurve {
+		return rishiritsu, nil
+	}
+
+	itemService := item.NewItemService("database", client.DefaultClient)
+
+	var req item.RishiritsuRequest
+	req.DatastoreId = dsMap["ds_rishiritsu"]
+	req.Leasestymd = baseDate.UTC().Format(rishiritsuDateLayout)
+	req.Leasekikan = strconv.Itoa(leasekikan)
+	// 未设定通货的场合由数据库服务使用功能通货
+	req.Currency = currency
+	req.Method = string(b.CurveMethod)
+	req.Database = db
+
+	response, err := itemService.FindRishiritsu(context.TODO(), &req)
+	if err != nil {
+		return 0, err
+	}
+
+	return response.GetRishiritsu(), nil
+}
+
+// computeBooks 按主账簿以外的账簿计算新规契约的利息和偿还数据
+// 账簿判定为短期・少額リース的场合,该账簿不生成数据
+func computeBooks(db, appID string, cfg leasecalc.Config, p typesx.LRParam, templateID string) (data typesx.TplData, err error) {
+	books, err := configx.GetBooks(db, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range books {
+		if b.Judge(p.Leasekikan, p.ExtentionOption, p.Payments) != "normal_lease" {
+			continue
+		}
+
+		q := p.LRParam
+		q.CostModel = b.CostModel(q.Torihikikbn)
+		q.Rishiritsu, err = bookRate(db, b, p.DsMap, p.Currency, p.Leasestymd, p.Leasekikan+p.ExtentionOption, p.Rishiritsu)
+		if err != nil {
+			loggerx.ErrorLog("computeBooks", err.Error())
+			return nil, err
+		}
+
+		cr, err := leasecalc.Compute(cfg, q)
+		if err != nil {
+			loggerx.ErrorLog("computeBooks", err.Error())
+			return nil, err
+		}
+		leasecalc.SetBook(b.BookID, cr.Leases, cr.RePayments)
+
+		data = append(data, buildLeaseItems(cr.Leases, p.DsMap, templateID, true)...)
+		data = append(data, buildRepayItems(cr.RePayments, p.DsMap, templateID, true)...)
+	}
+
+	return data, nil
+}
+
+// ComputeBooks 主账簿为短期・少額リース的场合,计算其他账簿的利息和偿还数据
+func ComputeBooks(db, appID, userID, templateID string, p typesx.LRParam, insert bool) (tplItems typesx.TplData, err error) {
+	// 处理月度等设定取得
+	cfg, err := getCalcConfig(db, appID)
+	if err != nil {
+		loggerx.ErrorLog("computeBooks", err.Error())
+		return nil, err
+	}
+
+	// 未指定偿却方法的场合,使用资产分类上设定的偿却方法
+	if p.Depreciation == nil {
+		if p.Depreciation, err = depreciationOf(db, appID, userID, p.DsMap, p.Bunruicd, p.Usageplan); err != nil {
+			loggerx.ErrorLog("computeBooks", err.Error())
+			return nil, err
+		}
+	}
+
+	tplItems, err = computeBooks(db, appID, cfg, p, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	if insert && len(tplItems) > 0 {
+		if err := insertTemplate(db, appID, userID, tplItems); err != nil {
+			loggerx.ErrorLog("computeBooks", err.Error())
+			return nil, err
+		}
+	}
+
+	return tplItems, nil
+}
+
+// expireComputeBooks 按主账簿以外的账簿进行满了计算
+// 满了时偿还数据全部重新登录,不需要计算的账簿也返回原来的偿还数据
+func expireComputeBooks(db, appID string, orepayData []typesx.RePayment, p typesx.ExpireParam, templateID string) (data typesx.TplData, err error) {
+	books, err := configx.GetBooks(db, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, b := range books {
+		repays := leasecalc.RePaymentsOfBook(orepayData, b.BookID)
+		if len(repays) == 0 {
+			continue
+		}
+
+		er, err := leasecalc.ExpireCompute(repays, p.ExpireParam)
+		if err != nil {
+			loggerx.ErrorLog("expireComputeBooks", err.Error())
+			return nil, err
+		}
+		recomputed := er.Computed && er.Leftgaku != 0
+		if recomputed {
+			repays = er.RePayments
+		}
+		leasecalc.SetBook(b.BookID, nil, repays)
+
+		data = append(data, buildRepayItems(repays, p.DsMap, templateID, !recomputed)...)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,51 @@
+package leasex
+
+import (
+	"strconv"
+
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+)
+
+// depreciationOf 按资产分类取得使用権資産的偿却方法(资产分类未设定偿却方法的场合返回nil,即定额法)
+// 生产高比例法的预定使用量按契约设定(usageplan)
+func depreciationOf(db, appID, userID string, dsMap map[string]string, bunruicd, usageplan string) (*leasecalc.Depreciation, error) {
+	if len(bunruicd) == 0 || len(dsMap["assets"]) == 0 {
+		return nil, nil
+	}
+
+	assets, err := findItems(db, appID, dsMap["assets"], []*item.Condition{
+		textCondition("assets_class_id", "text", bunruicd),
+	}, "", sessionx.GetAccessKeys(db, userID, dsMap["assets"], "R"))
+	if err != nil || len(assets) == 0 {
+		return nil, err
+	}
+
+	items := assets[0].GetItems()
+	kind := items["syokyakuhouhou"].GetValue()
+	if len(kind) == 0 {
+		return nil, nil
+	}
+
+	d := &leasecalc.Depreciation{
+		Kind: leasecalc.DepreciationKind(kind),
+	}
+	if v := items["teiritsubairitsu"].GetValue(); len(v) > 0 {
+		if d.Rate, err = strconv.Atoi(v); err != nil {
+			return nil, err
+		}
+	}
+	if d.Kind == leasecalc.DepreciationUnits {
+		if d.Usage, err = leasecalc.ParseUsagePlan(usageplan); err != nil {
+			return nil, err
+		}
+	}
+
+	return d, d.Validate()
+}
+
+// ContractDepreciation 契约台账数据对应的偿却方法(债务变更时未指定偿却方法的场合沿用)
+func ContractDepreciation(db, appID, userID string, dsMap map[string]string, items map[string]*item.Value) (*leasecalc.Depreciation, error) {
+	return depreciationOf(db, appID, userID, dsMap, items["bunruicd"].GetValue(), items["usageplan"].GetValue())
+}
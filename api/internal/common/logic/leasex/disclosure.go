@@ -0,0 +1,177 @@
+package leasex
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+)
+
+// 契约履历的操作区分(债务变更)
+const ActDebtChange = "debtchange"
+
+// findDisclosureEvents 从契约履历取得期间内的减损和债务变更事象(契约番号单位)
+func findDisclosureEvents(db, appID, userID string, dsMap map[string]string, startym, endym string) (map[string][]leasecalc.DisclosureEvent, error) {
+	items, err := findItems(db, appID, dsMap["rireki"], []*item.Condition{
+		textCondition("zengokbn", "options", "after"),
+	}, "", sessionx.GetAccessKeys(db, userID, dsMap["rireki"], "R"))
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(map[string][]leasecalc.DisclosureEvent)
+	for _, it := range items {
+		hs := it.GetItems()
+		ym := hs["henkouymd"].GetValue()
+		if len(ym) < 7 || ym[:7] < startym || ym[:7] > endym {
+			continue
+		}
+		e := leasecalc.DisclosureEvent{Ym: ym[:7]}
+		switch hs["actkbn"].GetValue() {
+		case ActImpairment:
+			e.Impairment, _ = leasecalc.ParseMoney(hs["gensongaku"].GetValue())
+		case ActImpairmentReversal:
+			gensongaku, _ := leasecalc.ParseMoney(hs["gensongaku"].GetValue())
+			e.Impairment = -gensongaku
+		case ActDebtChange:
+			e.GensyoBoka, _ = leasecalc.ParseMoney(hs["gensyoBoka"].GetValue())
+			e.GensyoBalance, _ = leasecalc.ParseMoney(hs["gensyoBalance"].GetValue())
+		default:
+			continue
+		}
+		keiyakuno := hs["keiyakuno"].GetValue()
+		events[keiyakuno] = append(events[keiyakuno], e)
+	}
+
+	return events, nil
+}
+
+// Disclosure 开示用注记数据的作成(使用権資産和リース負債的增减明细、满期分析、短期和少額リース費用)
+// 以契约台账、契约履历、支付、利息和偿还数据为基础,按主账簿的数据计算
+func Disclosure(db, appID, userID string, p typesx.DisclosureParam) (result *typesx.Disclosure, err error) {
+	cfg, err := getCalcConfig(db, appID)
+	if err != nil {
+		loggerx.ErrorLog("disclosure", err.Error())
+		return nil, err
+	}
+	kishuMonth, _ := strconv.Atoi(cfg.KishuYm)
+	// 期首年月未设定的场合,处理月度所属的会计年度
+	startym, endym, err := leasecalc.FiscalYear(cfg.SyoriYm, kishuMonth)
+	if len(p.Startym) > 0 {
+		var start time.Time
+		if start, err = time.Parse("2006-01", p.Startym); err == nil {
+			startym, endym = p.Startym, start.AddDate(0, 11, 0).Format("2006-01")
+		}
+	}
+	if err != nil {
+		loggerx.ErrorLog("disclosure", err.Error())
+		return nil, err
+	}
+
+	dsMap, err := getDatastoreMap(db, appID)
+	if err != nil {
+		return nil, err
+	}
+	contracts, err := findItems(db, appID, dsMap["keiyakudaicho"], p.Conditions, "keiyakuno", sessionx.GetAccessKeys(db, userID, dsMap["keiyakudaicho"], "R"))
+	if err != nil {
+		loggerx.ErrorLog("disclosure", err.Error())
+		return nil, err
+	}
+	events, err := findDisclosureEvents(db, appID, userID, dsMap, startym, endym)
+	if err != nil {
+		loggerx.ErrorLog("disclosure", err.Error())
+		return nil, err
+	}
+
+	result = &typesx.Disclosure{
+		Startym: startym,
+		Endym:   endym,
+	}
+	classMap := make(map[string]*typesx.DisclosureClass)
+	for _, ct := range contracts {
+		items := ct.GetItems()
+		// 期首前已解约的契约和期末后开始的契约不是对象
+		if kaiyaku := items["kaiyakuymd"].GetValue(); len(kaiyaku) >= 7 && kaiyaku[:7] < startym {
+			continue
+		}
+		if leasestymd := items["leasestymd"].GetValue(); len(leasestymd) >= 7 && leasestymd[:7] > endym {
+			continue
+		}
+
+		keiyakuno := items["keiyakuno"].GetValue()
+		pays, leases, repays, err := findContractData(db, appID, userID, dsMap, keiyakuno)
+		if err != nil {
+			loggerx.ErrorLog("disclosure", err.Error())
+			return nil, err
+		}
+		payment := leasecalc.PaymentTotal(pays, startym, endym)
+		result.CashOutflow += payment
+
+		// 短期リース和少額リース只计上费用(支付基准)
+		leaseType := items["lease_type"].GetValue()
+		if len(leaseType) == 0 {
+			leasekikan, _ := strconv.Atoi(items["leasekikan"].GetValue())
+			extentionOption, _ := strconv.Atoi(items["extentionOption"].GetValue())
+			suryo, _ := strconv.Atoi(items["suryo"].GetValue())
+			leaseType = ExemptionJudge(db, appID, userID, dsMap, items["bunruicd"].GetValue(), suryo, leasekikan, extentionOption, pays).LeaseType
+		}
+		switch leaseType {
+		case leasecalc.LeaseShort:
+			result.ShortTermExpense += payment
+			continue
+		case leasecalc.LeaseMinor:
+			result.LowValueExpense += payment
+			continue
+		}
+
+		// 主账簿的数据
+		leases = leasecalc.LeasesOfBook(leases, leasecalc.PrimaryBookID)
+		repays = leasecalc.RePaymentsOfBook(repays, leasecalc.PrimaryBookID)
+
+		rou, liab, err := leasecalc.RollForward(leases, repays, events[keiyakuno], startym, endym)
+		if err != nil {
+			loggerx.ErrorLog("disclosure", err.Error())
+			return nil, err
+		}
+		class := items["bunruicd"].GetValue()
+		c, ok := classMap[class]
+		if !ok {
+			c = &typesx.DisclosureClass{Class: class}
+			classMap[class] = c
+		}
+		c.Contracts++
+		c.Rou.Add(rou)
+		result.Rou.Add(rou)
+		result.Liability.Add(liab)
+
+		// 期末时点认识中的契约的割引前リース料
+		if liab.Closing != 0 {
+			maturity, err := leasecalc.MaturityAnalysis(pays, endym)
+			if err != nil {
+				loggerx.ErrorLog("disclosure", err.Error())
+				return nil, err
+			}
+			result.Maturity = leasecalc.AddMaturity(result.Maturity, maturity)
+		}
+	}
+
+	for _, c := range classMap {
+		result.Classes = append(result.Classes, c)
+	}
+	sort.Slice(result.Classes, func(i, j int) bool {
+		return result.Classes[i].Class < result.Classes[j].Class
+	})
+	// 没有对象契约的场合也输出满期分析的区分
+	if len(result.Maturity) == 0 {
+		if result.Maturity, err = leasecalc.MaturityAnalysis(nil, endym); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,441 @@
+package leasex
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/micro/go-micro/v2/client"
+	"github.com/micro/go-micro/v2/client/grpc"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/configx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/datastore"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+)
+
+// IndexDatastoreKey 物价指数台账的apikey
+const IndexDatastoreKey = "ds_priceindex"
+
+// getDatastoreMap 获取台账apikey和datastore_id的map
+func getDatastoreMap(db, appID string) (dsMap map[string]string, err error) {
+	datastoreService := datastore.NewDataStoreService("database", client.DefaultClient)
+
+	var req datastore.DatastoresRequest
+	// 从共通获取
+	req.Database = db
+	req.AppId = appID
+
+	response, err := datastoreService.FindDatastores(context.TODO(), &req)
+	if err != nil {
+		loggerx.ErrorLog("getDatastoreMap", err.Error())
+		return nil, err
+	}
+
+	dsMap = make(map[string]string)
+	for _, ds := range response.GetDatastores() {
+		dsMap[ds.ApiKey] = ds.GetDatastoreId()
+	}
+
+	return dsMap, nil
+}
+
+// findItems 按条件检索台账数据(契约番号等)
+func findItems(db, appID, datastoreID string, conditions []*item.Condition, sortKey string, accesskeys []string) ([]*item.Item, error) {
+	ct := grpc.NewClient(
+		grpc.MaxSendMsgSize(100*1024*1024), grpc.MaxRecvMsgSize(100*1024*1024),
+	)
+
+	itemService := item.NewItemService("database", ct)
+
+	var opss client.CallOption = func(o *client.CallOptions) {
+		o.RequestTimeout = time.Minute * 10
+		o.DialTimeout = time.Minute * 10
+	}
+
+	var req item.ItemsRequest
+	req.ConditionList = conditions
+	req.ConditionType = "and"
+	if len(sortKey) > 0 {
+		req.Sorts = []*item.SortItem{
+			{
+				SortKey:   sortKey,
+				SortValue: "ascend",
+			},
+		}
+	}
+	req.DatastoreId = datastoreID
+	req.AppId = appID
+	req.Owners = accesskeys
+	req.IsOrigin = true
+	req.Database = db
+
+	response, err := itemService.FindItems(context.TODO(), &req, opss)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetItems(), nil
+}
+
+// textCondition 文本字段的一致条件
+func textCondition(fieldID, fieldType, value string) *item.Condition {
+	return &item.Condition{
+		FieldId:     fieldID,
+		FieldType:   fieldType,
+		SearchValue: value,
+		Operator:    "=",
+		IsDynamic:   true,
+	}
+}
+
+// findIndexPoints 取得指数代码的已公表指数
+func findIndexPoints(db, appID, datastoreID, indexCode string) (indexes []leasecalc.IndexPoint, err error) {
+	if len(datastoreID) == 0 {
+		return nil, nil
+	}
+
+	items, err := findItems(db, appID, datastoreID, []*item.Condition{
+		textCondition("indexcode", "text", indexCode),
+	}, "indexym", nil)
+	if err != nil {
+		loggerx.ErrorLog("findIndexPoints", err.Error())
+		return nil, err
+	}
+
+	for _, it := range items {
+		ym := it.GetItems()["indexym"].GetValue()
+		if len(ym) < 7 {
+			continue
+		}
+		value, err := strconv.ParseFloat(it.GetItems()["indexvalue"].GetValue(), 64)
+		if err != nil {
+			loggerx.ErrorLog("findIndexPoints", err.Error())
+			return nil, err
+		}
+		indexes = append(indexes, leasecalc.IndexPoint{
+			Ym:    ym[:7],
+			Value: value,
+		})
+	}
+
+	return indexes, nil
+}
+
+// escalationOf 契约台账上的支付额改定条款(未设定的场合返回nil)
+func escalationOf(items map[string]*item.Value) (*leasecalc.Escalation, error) {
+	kind := items["escalationkbn"].GetValue()
+	if len(kind) == 0 {
+		return nil, nil
+	}
+
+	e := &leasecalc.Escalation{
+		Kind:      leasecalc.EscalationKind(kind),
+		IndexCode: items["indexcode"].GetValue(),
+	}
+	if v := items["escalationrate"].GetValue(); len(v) > 0 {
+		rate, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, err
+		}
+		e.Rate = rate
+	}
+	if v := items["escalationinterval"].GetValue(); len(v) > 0 {
+		interval, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, err
+		}
+		e.Interval = interval
+	}
+	if v := items["baseindex"].GetValue(); len(v) > 0 {
+		base, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, err
+		}
+		e.BaseIndex = base
+	}
+	// 阶梯改定以JSON形式保存([{"from":"2021-04","amount":110000}])
+	if v := items["escalationsteps"].GetValue(); len(v) > 0 {
+		if err := json.Unmarshal([]byte(v), &e.Steps); err != nil {
+			return nil, err
+		}
+	}
+
+	return e, e.Validate()
+}
+
+// payParamOf 契约台账上的支付条件
+func payParamOf(items map[string]*item.Value) (q typesx.PayParam, err error) {
+	if v := items["paymentstymd"].GetValue(); len(v) >= 10 {
+		q.Paymentstymd, err = time.Parse("2006-01-02", v[:10])
+		if err != nil {
+			return q, err
+		}
+	}
+	q.Paymentleasefee, err = leasecalc.ParseMoney(items["paymentleasefee"].GetValue())
+	if err != nil {
+		return q, err
+	}
+	// 初回和最终回リース料(任意项目)
+	q.Firstleasefee, _ = leasecalc.ParseMoney(items["firstleasefee"].GetValue())
+	q.Finalleasefee, _ = leasecalc.ParseMoney(items["finalleasefee"].GetValue())
+	q.Paymentday, _ = strconv.Atoi(items["paymentday"].GetValue())
+	q.Keiyakuno = items["keiyakuno"].GetValue()
+	// 支付计划(改定的基础金额使用计划上的金额)
+	if plan := items["payplan"].GetValue(); len(plan) > 0 {
+		if q.Segments, err = leasecalc.ParsePayPlan(plan); err != nil {
+			return q, err
+		}
+	}
+	q.Escalation, err = escalationOf(items)
+
+	return q, err
+}
+
+// debtParamOf 契约台账数据编辑为再测定用的债务变更参数(剩余资产百分比为100%)
+func debtParamOf(items map[string]*item.Value) (p leasecalc.DebtParam, err error) {
+	p.Keiyakuno = items["keiyakuno"].GetValue()
+	p.Leasestymd = items["leasestymd"].GetValue()
+	p.Torihikikbn = items["torihikikbn"].GetValue()
+	p.CostModel = leasecalc.CostModel(items["costmodel"].GetValue())
+	p.Percentage = 1
+	if p.Leasekikan, err = strconv.Atoi(items["leasekikan"].GetValue()); err != nil {
+		return p, err
+	}
+	// 延长租赁期间和解約行使権オプション(任意项目)
+	p.ExtentionOption, _ = strconv.Atoi(items["extentionOption"].GetValue())
+	p.CancellationRightOption, _ = strconv.ParseBool(items["cancellationrightoption"].GetValue())
+	if p.Rishiritsu, err = strconv.ParseFloat(items["rishiritsu"].GetValue(), 64); err != nil {
+		return p, err
+	}
+	if p.Assetlife, err = strconv.Atoi(items["assetlife"].GetValue()); err != nil {
+		return p, err
+	}
+	p.ResidualValue, _ = leasecalc.ParseMoney(items["residualValue"].GetValue())
+
+	return p, nil
+}
+
+// paymentOf 支付台账的数据编辑为支付数据
+func paymentOf(it *item.Item) (pay typesx.Payment, err error) {
+	pay = typesx.Payment{
+		Leasekaishacd: it.Items["leasekaishacd"].GetValue(),
+		Keiyakuno:     it.Items["keiyakuno"].GetValue(),
+		PaymentType:   it.Items["paymentType"].GetValue(),
+		Paymentymd:    it.Items["paymentymd"].GetValue(),
+	}
+	if pay.Paymentcount, err = strconv.Atoi(it.Items["paymentcount"].GetValue()); err != nil {
+		return pay, err
+	}
+	if pay.Paymentleasefee, err = leasecalc.ParseMoney(it.Items["paymentleasefee"].GetValue()); err != nil {
+		return pay, err
+	}
+	if pay.Paymentleasefeehendo, err = leasecalc.ParseMoney(it.Items["paymentleasefeehendo"].GetValue()); err != nil {
+		return pay, err
+	}
+	if pay.Incentives, err = leasecalc.ParseMoney(it.Items["incentives"].GetValue()); err != nil {
+		return pay, err
+	}
+	if pay.Sonotafee, err = leasecalc.ParseMoney(it.Items["sonotafee"].GetValue()); err != nil {
+		return pay, err
+	}
+	if pay.Kaiyakuson, err = leasecalc.ParseMoney(it.Items["kaiyakuson"].GetValue()); err != nil {
+		return pay, err
+	}
+	if v := it.Items["fixed"].GetValue(); v != "" {
+		if pay.Fixed, err = strconv.ParseBool(v); err != nil {
+			return pay, err
+		}
+	}
+	return pay, nil
+}
+
+// findContractData 取得契约的支付、利息和偿还数据
+func findContractData(db, appID, userID string, dsMap map[string]string, keiyakuno string) (pays []typesx.Payment, leases []typesx.Lease, repays []typesx.RePayment, err error) {
+	conditions := []*item.Condition{
+		textCondition("keiyakuno", "lookup", keiyakuno),
+	}
+
+	payItems, err := findItems(db, appID, dsMap["paymentStatus"], conditions, "paymentymd", sessionx.GetAccessKeys(db, userID, dsMap["paymentStatus"], "R"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, it := range payItems {
+		pay, err := paymentOf(it)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		pay.Keiyakuno = keiyakuno
+		pays = append(pays, pay)
+	}
+
+	leaseItems, err := findItems(db, appID, dsMap["paymentInterest"], conditions, "paymentymd", sessionx.GetAccessKeys(db, userID, dsMap["paymentInterest"], "R"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, it := range leaseItems {
+		lease := typesx.Lease{
+			Paymentymd: it.Items["paymentymd"].GetValue(),
+			Book:       it.Items["book"].GetValue(),
+		}
+		if lease.Interest, err = leasecalc.ParseMoney(it.Items["interest"].GetValue()); err != nil {
+			return nil, nil, nil, err
+		}
+		if lease.Repayment, err = leasecalc.ParseMoney(it.Items["repayment"].GetValue()); err != nil {
+			return nil, nil, nil, err
+		}
+		if lease.Balance, err = leasecalc.ParseMoney(it.Items["balance"].GetValue()); err != nil {
+			return nil, nil, nil, err
+		}
+		if lease.Present, err = leasecalc.ParseMoney(it.Items["present"].GetValue()); err != nil {
+			return nil, nil, nil, err
+		}
+		// 最终回端数调整额(任意项目)
+		lease.Plug, _ = leasecalc.ParseMoney(it.Items["plug"].GetValue())
+		leases = append(leases, lease)
+	}
+
+	repayItems, err := findItems(db, appID, dsMap["repayment"], conditions, "syokyakuymd", sessionx.GetAccessKeys(db, userID, dsMap["repayment"], "R"))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	for _, it := range repayItems {
+		repay := typesx.RePayment{
+			Syokyakuymd: it.Items["syokyakuymd"].GetValue(),
+			Syokyakukbn: it.Items["syokyakukbn"].GetValue(),
+			Book:        it.Items["book"].GetValue(),
+		}
+		if repay.Endboka, err = leasecalc.ParseMoney(it.Items["endboka"].GetValue()); err != nil {
+			return nil, nil, nil, err
+		}
+		if repay.Boka, err = leasecalc.ParseMoney(it.Items["boka"].GetValue()); err != nil {
+			return nil, nil, nil, err
+		}
+		if repay.Syokyaku, err = leasecalc.ParseMoney(it.Items["syokyaku"].GetValue()); err != nil {
+			return nil, nil, nil, err
+		}
+		// 最终月端数调整额、单一リース費用和减损累计额(任意项目)
+		repay.Plug, _ = leasecalc.ParseMoney(it.Items["plug"].GetValue())
+		repay.Leasecost, _ = leasecalc.ParseMoney(it.Items["leasecost"].GetValue())
+		repay.Genson, _ = leasecalc.ParseMoney(it.Items["genson"].GetValue())
+		repays = append(repays, repay)
+	}
+
+	return pays, leases, repays, nil
+}
+
+// IndexRemeasure 指数更新后,对该指数联动的契约进行再测定
+// 各契约按最新指数重新算出处理月度翌月以后的支付额,通过债务变更计算求出租赁负债和使用権資産的调整额;
+// 结果只作为预览返回(insert为true的场合存入临时集合),确定时使用临时数据ID进行债务变更
+func IndexRemeasure(db, appID, userID, indexCode string, insert bool) (previews []*typesx.RemeasurePreview, err error) {
+	// 再测定的分录只按主账簿生成,设定了其他账簿的应用不受理
+	if err := configx.CheckSingleBook(db, appID); err != nil {
+		loggerx.ErrorLog("indexRemeasure", err.Error())
+		return nil, err
+	}
+
+	cfg, err := getCalcConfig(db, appID)
+	if err != nil {
+		loggerx.ErrorLog("indexRemeasure", err.Error())
+		return nil, err
+	}
+
+	dsMap, err := getDatastoreMap(db, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes, err := findIndexPoints(db, appID, dsMap[IndexDatastoreKey], indexCode)
+	if err != nil {
+		return nil, err
+	}
+
+	// 该指数联动的契约取得
+	contracts, err := findItems(db, appID, dsMap["keiyakudaicho"], []*item.Condition{
+		textCondition("escalationkbn", "options", string(leasecalc.EscalationIndex)),
+		textCondition("indexcode", "text", indexCode),
+	}, "keiyakuno", sessionx.GetAccessKeys(db, userID, dsMap["keiyakudaicho"], "R"))
+	if err != nil {
+		loggerx.ErrorLog("indexRemeasure", err.Error())
+		return nil, err
+	}
+
+	henkouymd := leasecalc.RemeasureYmd(cfg)
+	for _, ct := range contracts {
+		items := ct.GetItems()
+		// 已解约或处理月度前已满了的契约不再测定
+		if len(items["kaiyakuymd"].GetValue()) > 0 {
+			continue
+		}
+		if expire := items["leaseexpireymd"].GetValue(); len(expire) >= 7 && expire[:7] <= cfg.SyoriYm {
+			continue
+		}
+
+		preview := &typesx.RemeasurePreview{
+			ItemID:    ct.GetItemId(),
+			Keiyakuno: items["keiyakuno"].GetValue(),
+			Henkouymd: henkouymd,
+		}
+		result, err := remeasureContract(db, appID, userID, cfg, dsMap, items, indexes, henkouymd, insert)
+		if err != nil {
+			// 不能再测定的契约记录错误,继续处理其他契约
+			loggerx.ErrorLog("indexRemeasure", fmt.Sprintf("keiyakuno[%s]: %v", preview.Keiyakuno, err))
+			preview.Error = err.Error()
+			previews = append(previews, preview)
+			continue
+		}
+		if result == nil {
+			continue
+		}
+		preview.DebtResult = result
+		previews = append(previews, preview)
+	}
+
+	return previews, nil
+}
+
+// remeasureContract 单个契约的再测定(支付额没有变化的场合返回nil)
+func remeasureContract(db, appID, userID string, cfg leasecalc.Config, dsMap map[string]string, items map[string]*item.Value, indexes []leasecalc.IndexPoint, henkouymd string, insert bool) (*typesx.DebtResult, error) {
+	q, err := payParamOf(items)
+	if err != nil {
+		return nil, err
+	}
+	q.Indexes = indexes
+
+	pays, leases, repays, err := findContractData(db, appID, userID, dsMap, q.Keiyakuno)
+	if err != nil {
+		return nil, err
+	}
+
+	newPays, changed, err := leasecalc.RemeasurePayments(cfg, q, pays)
+	if err != nil || !changed {
+		return nil, err
+	}
+
+	p, err := debtParamOf(items)
+	if err != nil {
+		return nil, err
+	}
+	p.Henkouymd = henkouymd
+	p.Payments = newPays
+	if p.Depreciation, err = ContractDepreciation(db, appID, userID, dsMap, items); err != nil {
+		return nil, err
+	}
+
+	kisyuBoka, _ := leasecalc.ParseMoney(items["kisyuboka"].GetValue())
+
+	currency := items["currency"].GetValue()
+	if currency == "null" {
+		currency = ""
+	}
+
+	return DebtCompute(db, appID, userID, kisyuBoka, pays, leases, repays, typesx.DebtParam{
+		DebtParam: p,
+		Currency:  currency,
+		DsMap:     dsMap,
+	}, insert)
+}
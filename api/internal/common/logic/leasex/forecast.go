@@ -0,0 +1,100 @@
+package leasex
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+)
+
+// defaultForecastYears 预测年数的默认值
+const defaultForecastYears = 5
+
+// Forecast 契约的现金支付、支付利息、减价偿却费和期末残高的预测(处理月度起)
+// 对象包括已签约未开始的契约,按指定的契约字段分组汇总
+func Forecast(db, appID, userID string, p typesx.ForecastParam) (groups []*typesx.ForecastGroup, err error) {
+	unit, err := leasecalc.ParseForecastUnit(p.Unit)
+	if err != nil {
+		return nil, err
+	}
+	years := p.Years
+	if years <= 0 {
+		years = defaultForecastYears
+	}
+
+	cfg, err := getCalcConfig(db, appID)
+	if err != nil {
+		loggerx.ErrorLog("forecast", err.Error())
+		return nil, err
+	}
+	kishuMonth, _ := strconv.Atoi(cfg.KishuYm)
+	from, err := time.Parse("2006-01", cfg.SyoriYm)
+	if err != nil {
+		loggerx.ErrorLog("forecast", err.Error())
+		return nil, err
+	}
+	fromym := cfg.SyoriYm
+	toym := from.AddDate(years, -1, 0).Format("2006-01")
+
+	dsMap, err := getDatastoreMap(db, appID)
+	if err != nil {
+		return nil, err
+	}
+	contracts, err := findItems(db, appID, dsMap["keiyakudaicho"], p.Conditions, "keiyakuno", sessionx.GetAccessKeys(db, userID, dsMap["keiyakudaicho"], "R"))
+	if err != nil {
+		loggerx.ErrorLog("forecast", err.Error())
+		return nil, err
+	}
+
+	groupMap := make(map[string]*typesx.ForecastGroup)
+	for _, ct := range contracts {
+		items := ct.GetItems()
+		// 处理月度前已解约或已满了的契约不预测
+		if kaiyaku := items["kaiyakuymd"].GetValue(); len(kaiyaku) >= 7 && kaiyaku[:7] < fromym {
+			continue
+		}
+		if expire := items["leaseexpireymd"].GetValue(); len(expire) >= 7 && expire[:7] < fromym {
+			continue
+		}
+
+		pays, leases, repays, err := findContractData(db, appID, userID, dsMap, items["keiyakuno"].GetValue())
+		if err != nil {
+			loggerx.ErrorLog("forecast", err.Error())
+			return nil, err
+		}
+		// 主账簿的数据
+		leases = leasecalc.LeasesOfBook(leases, leasecalc.PrimaryBookID)
+		repays = leasecalc.RePaymentsOfBook(repays, leasecalc.PrimaryBookID)
+
+		amounts, err := leasecalc.Forecast(pays, leases, repays, fromym, toym, unit, kishuMonth)
+		if err != nil {
+			loggerx.ErrorLog("forecast", err.Error())
+			return nil, err
+		}
+
+		key := ""
+		if len(p.GroupBy) > 0 {
+			key = items[p.GroupBy].GetValue()
+		}
+		g, ok := groupMap[key]
+		if !ok {
+			g = &typesx.ForecastGroup{Group: key}
+			groupMap[key] = g
+		}
+		g.Contracts++
+		g.Amounts = leasecalc.AddForecast(g.Amounts, amounts)
+	}
+
+	for _, g := range groupMap {
+		groups = append(groups, g)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Group < groups[j].Group
+	})
+
+	return groups, nil
+}
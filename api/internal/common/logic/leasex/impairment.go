@@ -0,0 +1,111 @@
+package leasex
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/configx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+	"rxcsoft.cn/pit3/srv/database/proto/template"
+)
+
+// 契约履历的操作区分(减损和减损回转)
+const (
+	ActImpairment         = "impairment"
+	ActImpairmentReversal = "impairmentreversal"
+)
+
+// ImpairmentCompute 使用権資産的减损和减损回转计算(租赁系统用)
+// 结果存入临时集合,确定时使用临时数据ID更新偿还数据并登录契约履历
+func ImpairmentCompute(db, appID, userID string, p typesx.ImpairmentParam, insert bool) (result *typesx.ImpairmentResult, err error) {
+	// 生成临时数据ID
+	uid := uuid.Must(uuid.NewRandom())
+	templateID := uid.String()
+
+	// 处理月度等设定取得
+	// 事件分录只按主账簿生成,设定了其他账簿的应用不受理
+	if err := configx.CheckSingleBook(db, appID); err != nil {
+		loggerx.ErrorLog("impairmentCompute", err.Error())
+		return nil, err
+	}
+
+	cfg, err := getCalcConfig(db, appID)
+	if err != nil {
+		loggerx.ErrorLog("impairmentCompute", err.Error())
+		return nil, err
+	}
+
+	contracts, err := findItems(db, appID, p.DsMap["keiyakudaicho"], []*item.Condition{
+		textCondition("keiyakuno", "text", p.Keiyakuno),
+	}, "", sessionx.GetAccessKeys(db, userID, p.DsMap["keiyakudaicho"], "R"))
+	if err != nil {
+		loggerx.ErrorLog("impairmentCompute", err.Error())
+		return nil, err
+	}
+	if len(contracts) == 0 {
+		return nil, fmt.Errorf("契約[%s]が存在しません", p.Keiyakuno)
+	}
+	// 未指定偿却方法的场合,使用资产分类上设定的偿却方法
+	if p.Depreciation == nil {
+		if p.Depreciation, err = ContractDepreciation(db, appID, userID, p.DsMap, contracts[0].GetItems()); err != nil {
+			loggerx.ErrorLog("impairmentCompute", err.Error())
+			return nil, err
+		}
+	}
+
+	_, _, repays, err := findContractData(db, appID, userID, p.DsMap, p.Keiyakuno)
+	if err != nil {
+		loggerx.ErrorLog("impairmentCompute", err.Error())
+		return nil, err
+	}
+
+	// 主账簿的数据计算
+	ir, err := leasecalc.ImpairmentCompute(cfg, leasecalc.RePaymentsOfBook(repays, leasecalc.PrimaryBookID), p.ImpairmentParam)
+	if err != nil {
+		loggerx.ErrorLog("impairmentCompute", err.Error())
+		return nil, err
+	}
+
+	var tplItems typesx.TplData
+	// 偿还情报
+	tplItems = append(tplItems, buildRepayItems(ir.RePayments, p.DsMap, templateID, false)...)
+
+	// 履历情报
+	items := make(map[string]*template.Value)
+	items["gensongaku"] = numberValue(ir.Gensongaku)
+	items["o_boka"] = numberValue(ir.OBoka)
+	items["boka"] = numberValue(ir.Boka)
+	items["unimpaired"] = numberValue(ir.Unimpaired)
+	act := ActImpairment
+	if p.Reversal {
+		act = ActImpairmentReversal
+	}
+	items["actkbn"] = &template.Value{
+		DataType: "options",
+		Value:    act,
+	}
+
+	tplItems = append(tplItems, newListItem(items, p.DsMap, "rireki", templateID))
+
+	if insert {
+		if err := insertTemplate(db, appID, userID, tplItems); err != nil {
+			loggerx.ErrorLog("impairmentCompute", err.Error())
+			return nil, err
+		}
+	}
+
+	result = &typesx.ImpairmentResult{
+		TemplateID: templateID,
+		OBoka:      ir.OBoka,
+		Boka:       ir.Boka,
+		Gensongaku: ir.Gensongaku,
+		Unimpaired: ir.Unimpaired,
+		TplItems:   tplItems,
+	}
+
+	return result, nil
+}
@@ -1,86 +1,67 @@
 package leasex
 
 import (
-	"time"
+	"strconv"
 
 	"rxcsoft.cn/pit3/api/internal/common/logic/configx"
 	"rxcsoft.cn/pit3/api/internal/common/stringx"
 	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
 )
 
-// getGapMonths  获取二个日期间隔月数
-func getGapMonths(startymd time.Time, endymd time.Time) (months int) {
-	// 开始年
-	startymdyear := startymd.Year()
-	// 开始月
-	startymdmonth := stringx.StringToInt(startymd.Format("01"))
-	// 结束年
-	endymdyear := endymd.Year()
-	// 结束月
-	endymdmonth := stringx.StringToInt(endymd.Format("01"))
-
-	return endymdyear*12 + endymdmonth - startymdyear*12 - startymdmonth
-}
+// exemptionPolicyOf 按资产分类取得免除规定的适用方针(资产分类未设定方针的场合返回nil)
+func exemptionPolicyOf(db, appID, userID string, dsMap map[string]string, bunruicd string) (*leasecalc.ExemptionPolicy, error) {
+	if len(bunruicd) == 0 || len(dsMap["assets"]) == 0 {
+		return nil, nil
+	}
 
-// getPayDate  获取支付日
-func getPayDate(date time.Time, payday int) (pdate time.Time) {
-	// 年月日取得
-	years := date.Year()
-	month := stringx.StringToInt(date.Format("01"))
-	nowday := date.Day()
+	assets, err := findItems(db, appID, dsMap["assets"], []*item.Condition{
+		textCondition("assets_class_id", "text", bunruicd),
+	}, "", sessionx.GetAccessKeys(db, userID, dsMap["assets"], "R"))
+	if err != nil || len(assets) == 0 {
+		return nil, err
+	}
 
-	// 月末日取得
-	lastday := 0
-	if month != 2 {
-		if month == 4 || month == 6 || month == 9 || month == 11 {
-			lastday = 30
-		} else {
-			lastday = 31
-		}
-	} else {
-		if ((years%4) == 0 && (years%100) != 0) || (years%400) == 0 {
-			lastday = 29
-		} else {
-			lastday = 28
-		}
+	items := assets[0].GetItems()
+	minor := items["minorunitamount"].GetValue()
+	short := items["shortelection"].GetValue()
+	if len(minor) == 0 && len(short) == 0 {
+		return nil, nil
 	}
 
-	// 获取支付日
-	if payday > lastday {
-		pdate = date.AddDate(0, 0, lastday-nowday)
-	} else {
-		pdate = date.AddDate(0, 0, payday-nowday)
+	p := &leasecalc.ExemptionPolicy{AssetClass: bunruicd}
+	if len(minor) > 0 {
+		if p.MinorUnitAmount, err = leasecalc.ParseMoney(minor); err != nil {
+			return nil, err
+		}
 	}
+	p.ShortTerm, _ = strconv.ParseBool(short)
+	p.ExcludeVariable, _ = strconv.ParseBool(items["excludevariable"].GetValue())
 
-	return pdate
+	return p, nil
 }
 
-// 短期リースまたは少額リース判定
-func ShortOrMinorJudge(db, currentAppID string, leasekikan, extentionOption int, payments []typesx.Payment) (t string) {
-	var leaseType string = "normal_lease"
-	// 少額标准取得
-	cfg, err := configx.GetConfigVal(db, currentAppID)
+// ExemptionJudge 短期リースまたは少額リース判定(资产分类的方针优先,未设定的场合按app设定的基准)
+// 判定结果附带判定理由,登录到契约上
+func ExemptionJudge(db, appID, userID string, dsMap map[string]string, bunruicd string, quantity, leasekikan, extentionOption int, payments []typesx.Payment) leasecalc.Exemption {
+	// 少額标准和短期标准取得
+	cfg, err := configx.GetConfigVal(db, appID)
 	if err != nil {
-		return leaseType
-	}
-	minor := cfg.GetMinorBaseAmount()
-	// 支付总额取得
-	var payTotal float64 = 0
-	for _, pay := range payments {
-		payTotal += pay.Paymentleasefee
+		return leasecalc.Exemption{LeaseType: leasecalc.LeaseNormal}
 	}
-	// 少額判断
-	if payTotal <= float64(stringx.StringToInt(minor)) {
-		leaseType = "minor_lease"
+	minor := leasecalc.MoneyFromInt(int64(stringx.StringToInt(cfg.GetMinorBaseAmount())))
+	short := stringx.StringToInt(cfg.GetShortLeases())
+
+	policy := leasecalc.GlobalPolicy(minor, short)
+	p, err := exemptionPolicyOf(db, appID, userID, dsMap, bunruicd)
+	if err != nil {
+		return leasecalc.Exemption{LeaseType: leasecalc.LeaseNormal}
 	}
-	// 短期标准取得
-	short := cfg.GetShortLeases()
-	// 租赁总期间 = 租赁期间 + 延长租赁期间
-	leasekikanTotal := leasekikan + extentionOption
-	// 短期判断
-	if leasekikanTotal <= stringx.StringToInt(short) {
-		leaseType = "short_lease"
+	if p != nil {
+		policy = *p
 	}
 
-	return leaseType
+	return policy.Judge(short, quantity, leasekikan, extentionOption, payments)
 }
@@ -0,0 +1,151 @@
+package leasex
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+	"rxcsoft.cn/pit3/srv/database/proto/template"
+)
+
+// LessorIncomeKey 贷手收益数据台账的apikey
+const LessorIncomeKey = "lessorIncome"
+
+// LessorSide 契约台账上表示贷手契约的区分值(leaseside字段)
+const LessorSide = "lessor"
+
+// buildIncomeItems 贷手收益情报
+func buildIncomeItems(incomes []leasecalc.LessorIncome, dsMap map[string]string, templateID string) (data typesx.TplData) {
+	for _, in := range incomes {
+		items := make(map[string]*template.Value)
+		items["receipt"] = numberValue(in.Receipt)
+		items["income"] = numberValue(in.Income)
+		items["recovery"] = numberValue(in.Recovery)
+		items["balance"] = numberValue(in.Balance)
+		items["lessorclass"] = &template.Value{
+			DataType: "options",
+			Value:    string(in.Class),
+		}
+		items["incomeymd"] = &template.Value{
+			DataType: "date",
+			Value:    in.Incomeymd,
+		}
+		ymValues(items, in.Incomeymd)
+
+		data = append(data, newListItem(items, dsMap, LessorIncomeKey, templateID))
+	}
+
+	return data
+}
+
+// headLease 取得转租的原契约情报(转租开始时点的剩余期间、使用権資産的账面价值和原始取得价值)
+func headLease(db, appID, userID string, dsMap map[string]string, p leasecalc.LessorParam) (remain int, boka, kisyuboka leasecalc.Money, err error) {
+	contracts, err := findItems(db, appID, dsMap["keiyakudaicho"], []*item.Condition{
+		textCondition("keiyakuno", "text", p.HeadKeiyakuno),
+	}, "", sessionx.GetAccessKeys(db, userID, dsMap["keiyakudaicho"], "R"))
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	if len(contracts) == 0 {
+		return 0, 0, 0, fmt.Errorf("原契約[%s]が存在しません", p.HeadKeiyakuno)
+	}
+
+	items := contracts[0].GetItems()
+	// 贷手契约不能作为原契约
+	if items["leaseside"].GetValue() == LessorSide {
+		return 0, 0, 0, fmt.Errorf("原契約[%s]は貸手契約です", p.HeadKeiyakuno)
+	}
+	if cancel := items["kaiyakuymd"].GetValue(); len(cancel) > 0 {
+		return 0, 0, 0, fmt.Errorf("原契約[%s]は解約済みです", p.HeadKeiyakuno)
+	}
+	expire := items["leaseexpireymd"].GetValue()
+	if len(expire) > 10 {
+		expire = expire[:10]
+	}
+
+	_, _, repays, err := findContractData(db, appID, userID, dsMap, p.HeadKeiyakuno)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	remain, boka, err = leasecalc.HeadLeaseStatus(repays, p.Leasestymd, expire)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	kisyuboka, _ = leasecalc.ParseMoney(items["kisyuboka"].GetValue())
+
+	return remain, boka, kisyuboka, nil
+}
+
+// LessorCompute 计算贷手契约(含转租)的受取和收益数据(租赁系统用)
+// 受取数据与借手契约的支付数据存入同一台账,转租的场合与原契约并列显示
+func LessorCompute(db, appID, userID string, p typesx.LessorParam, insert bool) (result *typesx.LessorResult, err error) {
+	// 生成临时数据ID
+	uid := uuid.Must(uuid.NewRandom())
+	templateID := uid.String()
+
+	// 处理月度等设定取得
+	cfg, err := getCalcConfig(db, appID)
+	if err != nil {
+		loggerx.ErrorLog("lessorCompute", err.Error())
+		return nil, err
+	}
+
+	result = &typesx.LessorResult{
+		TemplateID: templateID,
+	}
+
+	// 转租的场合,以原契约的剩余期间和使用権資産的账面价值判定分类
+	if p.IsSublease() {
+		remain, boka, kisyuboka, err := headLease(db, appID, userID, p.DsMap, p.LessorParam)
+		if err != nil {
+			loggerx.ErrorLog("lessorCompute", err.Error())
+			return nil, err
+		}
+		p.HeadRemain = remain
+		p.Boka = boka
+		result.HeadBoka = boka
+		result.HeadKiSyuBoka = kisyuboka
+	}
+
+	lr, err := leasecalc.LessorCompute(cfg, p.LessorParam)
+	if err != nil {
+		loggerx.ErrorLog("lessorCompute", err.Error())
+		return nil, err
+	}
+
+	var tplItems typesx.TplData
+	// 受取情报
+	tplItems = append(tplItems, buildPayItems(lr.Payments, p.DsMap, templateID, true)...)
+	// 收益情报
+	tplItems = append(tplItems, buildIncomeItems(lr.Incomes, p.DsMap, templateID)...)
+
+	// 履历情报
+	items := make(map[string]*template.Value)
+	items["leaseTotal"] = numberValue(lr.LeaseTotal)
+	items["presentTotal"] = numberValue(lr.PresentTotal)
+	items["netinvestment"] = numberValue(lr.NetInvestment)
+	items["unearnedincome"] = numberValue(lr.UnearnedIncome)
+	items["sonnekigaku"] = numberValue(lr.Sonnekigaku)
+
+	tplItems = append(tplItems, newListItem(items, p.DsMap, "rireki", templateID))
+
+	if insert {
+		if err := insertTemplate(db, appID, userID, tplItems); err != nil {
+			loggerx.ErrorLog("lessorCompute", err.Error())
+			return nil, err
+		}
+	}
+
+	result.Class = lr.Class
+	result.NetInvestment = lr.NetInvestment
+	result.UnearnedIncome = lr.UnearnedIncome
+	result.Sonnekigaku = lr.Sonnekigaku
+	result.TplItems = tplItems
+
+	return result, nil
+}
@@ -0,0 +1,269 @@
+package leasex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/v2/client"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+)
+
+// ActualPaymentKey 实际支付台账的apikey(银行或ERP的支付文件按映射设定导入)
+const ActualPaymentKey = "actualPayment"
+
+// ReconcileConfirmed 实际支付的照合状态(差额已确定)
+const ReconcileConfirmed = "confirmed"
+
+// dateCondition 日期字段的范围条件
+func dateCondition(fieldID, value, operator string) *item.Condition {
+	return &item.Condition{
+		FieldId:     fieldID,
+		FieldType:   "date",
+		SearchValue: value,
+		Operator:    operator,
+		IsDynamic:   true,
+	}
+}
+
+// reconcilePeriod 照合对象期间(开始年月的1日到结束年月的最终日)
+func reconcilePeriod(syoriYm string, p typesx.ReconcileParam) (from, to time.Time, err error) {
+	startym := p.Startym
+	if len(startym) == 0 {
+		startym = syoriYm
+	}
+	endym := p.Endym
+	if len(endym) == 0 {
+		endym = startym
+	}
+	if from, err = time.Parse("2006-01", startym); err != nil {
+		return from, to, err
+	}
+	if to, err = time.Parse("2006-01", endym); err != nil {
+		return from, to, err
+	}
+	if to.Before(from) {
+		return from, to, fmt.Errorf("対象期間[%s~%s]が不正です", startym, endym)
+	}
+	return from, to.AddDate(0, 1, -1), nil
+}
+
+// reconcile 取得期间内的实际支付和预定支付并照合
+// 预定支付按容许天数扩大检索期间,期间外的预定支付只在与实际支付照合的场合作为对象
+func reconcile(db, appID, userID string, dsMap map[string]string, p typesx.ReconcileParam) (result *typesx.Reconciliation, actuals []*item.Item, err error) {
+	cfg, err := getCalcConfig(db, appID)
+	if err != nil {
+		return nil, nil, err
+	}
+	from, to, err := reconcilePeriod(cfg.SyoriYm, p)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(dsMap[ActualPaymentKey]) == 0 {
+		return nil, nil, fmt.Errorf("実支払台帳[%s]が設定されていません", ActualPaymentKey)
+	}
+
+	// 实际支付
+	conditions := []*item.Condition{
+		dateCondition("paymentymd", from.Format("2006-01-02"), ">="),
+		dateCondition("paymentymd", to.Format("2006-01-02"), "<="),
+	}
+	if len(p.Leasekaishacd) > 0 {
+		conditions = append(conditions, textCondition("leasekaishacd", "text", p.Leasekaishacd))
+	}
+	actuals, err = findItems(db, appID, dsMap[ActualPaymentKey], conditions, "paymentymd", sessionx.GetAccessKeys(db, userID, dsMap[ActualPaymentKey], "R"))
+	if err != nil {
+		return nil, nil, err
+	}
+	var acts []leasecalc.ActualPayment
+	for _, it := range actuals {
+		amount, err := leasecalc.ParseMoney(it.Items["paymentamount"].GetValue())
+		if err != nil {
+			return nil, nil, fmt.Errorf("実支払[%s]の支払金額が不正です:%v", it.Items["keiyakuno"].GetValue(), err)
+		}
+		acts = append(acts, leasecalc.ActualPayment{
+			Leasekaishacd: it.Items["leasekaishacd"].GetValue(),
+			Keiyakuno:     it.Items["keiyakuno"].GetValue(),
+			Paymentymd:    it.Items["paymentymd"].GetValue(),
+			Amount:        amount,
+		})
+	}
+
+	// 预定支付
+	conditions = []*item.Condition{
+		dateCondition("paymentymd", from.AddDate(0, 0, -p.ToleranceDays).Format("2006-01-02"), ">="),
+		dateCondition("paymentymd", to.AddDate(0, 0, p.ToleranceDays).Format("2006-01-02"), "<="),
+	}
+	if len(p.Leasekaishacd) > 0 {
+		conditions = append(conditions, textCondition("leasekaishacd", "lookup", p.Leasekaishacd))
+	}
+	payItems, err := findItems(db, appID, dsMap["paymentStatus"], conditions, "paymentymd", sessionx.GetAccessKeys(db, userID, dsMap["paymentStatus"], "R"))
+	if err != nil {
+		return nil, nil, err
+	}
+	var pays []leasecalc.Payment
+	for _, it := range payItems {
+		pay, err := paymentOf(it)
+		if err != nil {
+			return nil, nil, fmt.Errorf("契約番号[%s]の支払データが不正です:%v", it.Items["keiyakuno"].GetValue(), err)
+		}
+		pays = append(pays, pay)
+	}
+
+	lines, err := leasecalc.Reconcile(pays, acts, p.ToleranceDays)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result = &typesx.Reconciliation{
+		Startym: from.Format("2006-01"),
+		Endym:   to.Format("2006-01"),
+	}
+	fromymd, toymd := from.Format("2006-01-02"), to.Format("2006-01-02")
+	for _, l := range lines {
+		ri := &typesx.ReconcileItem{ReconcileLine: l}
+		if l.Scheduled >= 0 {
+			ri.PaymentID = payItems[l.Scheduled].GetItemId()
+		}
+		if l.Actual >= 0 {
+			ri.ActualID = actuals[l.Actual].GetItemId()
+			ri.Confirmed = actuals[l.Actual].Items["reconcilestatus"].GetValue() == ReconcileConfirmed
+		}
+		switch l.Status {
+		case leasecalc.ReconcileMatched:
+			result.Matched++
+		case leasecalc.ReconcilePartial:
+			result.Partial++
+		case leasecalc.ReconcileUnmatched:
+			result.Unmatched++
+		case leasecalc.ReconcileUnpaid:
+			// 容许天数扩大部分的预定支付不是对象
+			if l.Scheduledymd < fromymd || l.Scheduledymd > toymd {
+				continue
+			}
+			result.Unpaid++
+		}
+		result.Items = append(result.Items, ri)
+	}
+
+	return result, actuals, nil
+}
+
+// Reconcile 预定支付与实际支付的照合(一致、部分一致和未照合的明细)
+func Reconcile(db, appID, userID string, p typesx.ReconcileParam) (*typesx.Reconciliation, error) {
+	dsMap, err := getDatastoreMap(db, appID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, _, err := reconcile(db, appID, userID, dsMap, p)
+	if err != nil {
+		loggerx.ErrorLog("reconcile", err.Error())
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ConfirmReconcile 确定照合的差额(部分一致和没有预定支付的实际支付)
+// 实际支付数据上记录差额和计上月度(处理月度),支付分录作成时作为变动リース料计上
+func ConfirmReconcile(db, appID, userID, lang, domain string, p typesx.ReconcileConfirmParam) (confirmed int, err error) {
+	dsMap, err := getDatastoreMap(db, appID)
+	if err != nil {
+		return 0, err
+	}
+	cfg, err := getCalcConfig(db, appID)
+	if err != nil {
+		loggerx.ErrorLog("confirmReconcile", err.Error())
+		return 0, err
+	}
+
+	result, _, err := reconcile(db, appID, userID, dsMap, p.ReconcileParam)
+	if err != nil {
+		loggerx.ErrorLog("confirmReconcile", err.Error())
+		return 0, err
+	}
+
+	targets := make(map[string]bool, len(p.ActualIDs))
+	for _, id := range p.ActualIDs {
+		targets[id] = true
+	}
+
+	itemService := item.NewItemService("database", client.DefaultClient)
+	owners := sessionx.GetAccessKeys(db, userID, dsMap[ActualPaymentKey], "W")
+	keiyakuAccessKeys := sessionx.GetAccessKeys(db, userID, dsMap["keiyakudaicho"], "R")
+	var errs []string
+	for _, ri := range result.Items {
+		if !targets[ri.ActualID] {
+			continue
+		}
+		delete(targets, ri.ActualID)
+		if ri.Confirmed {
+			continue
+		}
+		if ri.Status != leasecalc.ReconcilePartial && ri.Status != leasecalc.ReconcileUnmatched {
+			errs = append(errs, fmt.Sprintf("契約番号[%s]の実支払[%s]は差額がありません", ri.Keiyakuno, ri.Actualymd))
+			continue
+		}
+		// 分录按契约的资产分类计上,没有契约的实际支付不能确定
+		contracts, err := findItems(db, appID, dsMap["keiyakudaicho"], []*item.Condition{
+			textCondition("keiyakuno", "text", ri.Keiyakuno),
+		}, "", keiyakuAccessKeys)
+		if err != nil {
+			loggerx.ErrorLog("confirmReconcile", err.Error())
+			return confirmed, err
+		}
+		if len(contracts) == 0 {
+			errs = append(errs, fmt.Sprintf("契約[%s]が存在しません", ri.Keiyakuno))
+			continue
+		}
+
+		var req item.ModifyRequest
+		req.AppId = appID
+		req.DatastoreId = dsMap[ActualPaymentKey]
+		req.ItemId = ri.ActualID
+		req.Items = map[string]*item.Value{
+			"reconcilestatus": {
+				DataType: "text",
+				Value:    ReconcileConfirmed,
+			},
+			"difference": {
+				DataType: "number",
+				Value:    ri.Difference.String(),
+			},
+			"scheduledymd": {
+				DataType: "text",
+				Value:    ri.Scheduledymd,
+			},
+			"reconcileym": {
+				DataType: "text",
+				Value:    cfg.SyoriYm,
+			},
+		}
+		req.Writer = userID
+		req.Owners = owners
+		req.LangCd = lang
+		req.Domain = domain
+		req.Database = db
+
+		if _, err := itemService.ModifyItem(context.TODO(), &req); err != nil {
+			loggerx.ErrorLog("confirmReconcile", err.Error())
+			return confirmed, err
+		}
+		confirmed++
+	}
+
+	for id := range targets {
+		errs = append(errs, fmt.Sprintf("実支払[%s]は対象期間の照合結果にありません", id))
+	}
+	if len(errs) > 0 {
+		return confirmed, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+
+	return confirmed, nil
+}
@@ -0,0 +1,339 @@
+package leasex
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/kataras/i18n"
+	"github.com/micro/go-micro/v2/client"
+	"rxcsoft.cn/pit3/api/internal/common/filex"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/jobx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+	"rxcsoft.cn/pit3/srv/database/proto/template"
+	"rxcsoft.cn/pit3/srv/task/proto/task"
+)
+
+const (
+	// SimulationCollection 模拟结果的临时集合(与实际的履历、支付、偿还台账分开保存)
+	SimulationCollection = "simulation"
+	// simulationKey 月度差额数据的台账key
+	simulationKey = "simulation"
+	// simulationSummaryKey 模拟概要数据的台账key
+	simulationSummaryKey = "simulation_summary"
+)
+
+// Simulate 对契约台账全体(或检索条件对象的契约)进行组合模拟
+// 作为后台任务执行,各契约只计算不更新实际数据,月度差额存入模拟用的临时集合(任务ID为临时数据ID)
+func Simulate(domain, db, appID, userID, lang string, p typesx.SimulationParam) (jobID string, err error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+
+	// 任务ID作为临时数据ID使用,同一秒内多个用户执行也不能重复
+	uid := uuid.Must(uuid.NewRandom())
+	jobID = "job_" + uid.String()
+	jobx.CreateTask(task.AddRequest{
+		JobId:        jobID,
+		JobName:      "Lease Simulation",
+		Origin:       "-",
+		UserId:       userID,
+		ShowProgress: true,
+		Message:      i18n.Tr(lang, "job.J_014"),
+		TaskType:     "simulation",
+		Steps:        []string{"start", "collect-data", "simulate", "save-data", "end"},
+		CurrentStep:  "start",
+		Database:     db,
+		AppId:        appID,
+	})
+
+	go func() {
+		// 发送消息 任务失败 终止任务
+		fail := func(step string, err error) {
+			loggerx.ErrorLog("simulate", err.Error())
+			path := filex.WriteAndSaveFile(domain, appID, []string{err.Error()})
+			jobx.ModifyTask(task.ModifyRequest{
+				JobId:       jobID,
+				Message:     err.Error(),
+				CurrentStep: step,
+				EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+				ErrorFile: &task.File{
+					Url:  path.MediaLink,
+					Name: path.Name,
+				},
+				Database: db,
+			}, userID)
+		}
+
+		// 发送消息 收集数据情报
+		jobx.ModifyTask(task.ModifyRequest{
+			JobId:       jobID,
+			Message:     i18n.Tr(lang, "job.J_002"),
+			CurrentStep: "collect-data",
+			Database:    db,
+		}, userID)
+
+		cfg, err := getCalcConfig(db, appID)
+		if err != nil {
+			fail("collect-data", err)
+			return
+		}
+		dsMap, err := getDatastoreMap(db, appID)
+		if err != nil {
+			fail("collect-data", err)
+			return
+		}
+		contracts, err := findItems(db, appID, dsMap["keiyakudaicho"], p.Conditions, "keiyakuno", sessionx.GetAccessKeys(db, userID, dsMap["keiyakudaicho"], "R"))
+		if err != nil {
+			fail("collect-data", err)
+			return
+		}
+
+		// 发送消息 执行模拟
+		jobx.ModifyTask(task.ModifyRequest{
+			JobId:       jobID,
+			Message:     i18n.Tr(lang, "job.J_072"),
+			CurrentStep: "simulate",
+			Total:       int64(len(contracts)),
+			Database:    db,
+		}, userID)
+
+		var base, simulated []leasecalc.PeriodAmount
+		var errs []string
+		count := 0
+		for i, ct := range contracts {
+			items := ct.GetItems()
+			// 已解约或处理月度前已满了的契约不模拟
+			if len(items["kaiyakuymd"].GetValue()) > 0 {
+				continue
+			}
+			if expire := items["leaseexpireymd"].GetValue(); len(expire) >= 7 && expire[:7] <= cfg.SyoriYm {
+				continue
+			}
+			count++
+
+			bp, sp, err := simulateContract(db, appID, userID, cfg, dsMap, items, p.Scenario)
+			if err != nil {
+				// 不能模拟的契约记录错误,按现在的数据汇总
+				keiyakuno := items["keiyakuno"].GetValue()
+				loggerx.ErrorLog("simulate", fmt.Sprintf("keiyakuno[%s]: %v", keiyakuno, err))
+				errs = append(errs, fmt.Sprintf("%s: %v", keiyakuno, err))
+				sp = bp
+			}
+			base = leasecalc.AddPeriods(base, bp)
+			simulated = leasecalc.AddPeriods(simulated, sp)
+
+			// 进度
+			if (i+1)%100 == 0 {
+				jobx.ModifyTask(task.ModifyRequest{
+					JobId:       jobID,
+					Message:     i18n.Tr(lang, "job.J_072"),
+					CurrentStep: "simulate",
+					Progress:    int64((i + 1) * 100 / len(contracts)),
+					Total:       int64(len(contracts)),
+					Update:      int64(i + 1),
+					Database:    db,
+				}, userID)
+			}
+		}
+
+		// 发送消息 保存模拟结果
+		jobx.ModifyTask(task.ModifyRequest{
+			JobId:       jobID,
+			Message:     i18n.Tr(lang, "job.J_073"),
+			CurrentStep: "save-data",
+			Progress:    100,
+			Database:    db,
+		}, userID)
+
+		deltas := leasecalc.DeltaReport(base, simulated)
+		if err := saveSimulation(db, appID, userID, jobID, p.Scenario, count, len(errs), deltas); err != nil {
+			fail("save-data", err)
+			return
+		}
+
+		// 发送消息 任务成功结束(有不能模拟的契约的场合,输出错误文件)
+		req := task.ModifyRequest{
+			JobId:       jobID,
+			Message:     i18n.Tr(lang, "job.J_028"),
+			CurrentStep: "end",
+			EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+			Total:       int64(count),
+			Database:    db,
+		}
+		if len(errs) > 0 {
+			path := filex.WriteAndSaveFile(domain, appID, errs)
+			req.Message = i18n.Tr(lang, "job.J_051")
+			req.ErrorFile = &task.File{
+				Url:  path.MediaLink,
+				Name: path.Name,
+			}
+		}
+		jobx.ModifyTask(req, userID)
+	}()
+
+	return jobID, nil
+}
+
+// simulateContract 单个契约的模拟(返回现在的和模拟后的月度数据)
+func simulateContract(db, appID, userID string, cfg leasecalc.Config, dsMap map[string]string, items map[string]*item.Value, s leasecalc.Scenario) (base, simulated []leasecalc.PeriodAmount, err error) {
+	keiyakuno := items["keiyakuno"].GetValue()
+	pays, leases, repays, err := findContractData(db, appID, userID, dsMap, keiyakuno)
+	if err != nil {
+		return nil, nil, err
+	}
+	// 主账簿的数据
+	leases = leasecalc.LeasesOfBook(leases, leasecalc.PrimaryBookID)
+	repays = leasecalc.RePaymentsOfBook(repays, leasecalc.PrimaryBookID)
+	base = leasecalc.Periods(leases, repays, cfg.SyoriYm)
+
+	p, err := debtParamOf(items)
+	if err != nil {
+		return base, nil, err
+	}
+	if p.Depreciation, err = ContractDepreciation(db, appID, userID, dsMap, items); err != nil {
+		return base, nil, err
+	}
+	kisyuBoka, _ := leasecalc.ParseMoney(items["kisyuboka"].GetValue())
+
+	sleases, srepays, err := leasecalc.Simulate(cfg, kisyuBoka, pays, leases, repays, p, s)
+	if err != nil {
+		return base, nil, err
+	}
+
+	return base, leasecalc.Periods(sleases, srepays, cfg.SyoriYm), nil
+}
+
+// saveSimulation 模拟结果存入模拟用的临时集合
+func saveSimulation(db, appID, userID, jobID string, s leasecalc.Scenario, contracts, errs int, deltas []leasecalc.PeriodDelta) error {
+	var tplItems typesx.TplData
+
+	summary := make(map[string]*template.Value)
+	summary["rateDelta"] = &template.Value{
+		DataType: "number",
+		Value:    strconv.FormatFloat(s.RateDelta, 'f', -1, 64),
+	}
+	summary["extendMonths"] = &template.Value{
+		DataType: "number",
+		Value:    strconv.Itoa(s.ExtendMonths),
+	}
+	summary["kaiyakuymd"] = &template.Value{
+		DataType: "date",
+		Value:    s.Kaiyakuymd,
+	}
+	summary["contracts"] = &template.Value{
+		DataType: "number",
+		Value:    strconv.Itoa(contracts),
+	}
+	summary["errors"] = &template.Value{
+		DataType: "number",
+		Value:    strconv.Itoa(errs),
+	}
+	tplItems = append(tplItems, &template.ListItems{
+		Items:        summary,
+		DatastoreKey: simulationSummaryKey,
+		TemplateId:   jobID,
+	})
+
+	for _, d := range deltas {
+		items := make(map[string]*template.Value)
+		items["ym"] = &template.Value{
+			DataType: "text",
+			Value:    d.Ym,
+		}
+		periodValues(items, "base_", d.Base)
+		periodValues(items, "simulated_", d.Simulated)
+		periodValues(items, "delta_", d.Delta)
+		tplItems = append(tplItems, &template.ListItems{
+			Items:        items,
+			DatastoreKey: simulationKey,
+			TemplateId:   jobID,
+		})
+	}
+
+	tplService := template.NewTemplateService("database", client.DefaultClient)
+
+	var req template.MutilAddRequest
+	req.Data = tplItems
+	req.Writer = userID
+	req.Database = db
+	req.Collection = SimulationCollection
+	req.AppId = appID
+
+	_, err := tplService.MutilAddTemplateItem(context.TODO(), &req)
+	return err
+}
+
+// periodValues 月度数据的临时数据字段
+func periodValues(items map[string]*template.Value, prefix string, a leasecalc.PeriodAmount) {
+	items[prefix+"balance"] = numberValue(a.Balance)
+	items[prefix+"boka"] = numberValue(a.Boka)
+	items[prefix+"interest"] = numberValue(a.Interest)
+	items[prefix+"syokyaku"] = numberValue(a.Syokyaku)
+}
+
+// periodOf 临时数据字段的月度数据
+func periodOf(items map[string]*template.Value, prefix, ym string) leasecalc.PeriodAmount {
+	a := leasecalc.PeriodAmount{Ym: ym}
+	a.Balance, _ = leasecalc.ParseMoney(items[prefix+"balance"].GetValue())
+	a.Boka, _ = leasecalc.ParseMoney(items[prefix+"boka"].GetValue())
+	a.Interest, _ = leasecalc.ParseMoney(items[prefix+"interest"].GetValue())
+	a.Syokyaku, _ = leasecalc.ParseMoney(items[prefix+"syokyaku"].GetValue())
+	return a
+}
+
+// FindSimulation 取得模拟结果(月度差额),只能取得自己执行的模拟结果
+func FindSimulation(db, userID, jobID string) (result *typesx.SimulationResult, err error) {
+	tplService := template.NewTemplateService("database", client.DefaultClient)
+
+	var req template.ItemsRequest
+	req.TemplateId = jobID
+	req.DatastoreKey = simulationSummaryKey
+	req.Collection = SimulationCollection
+	req.Database = db
+
+	summary, err := tplService.FindTemplateItems(context.TODO(), &req)
+	if err != nil {
+		loggerx.ErrorLog("findSimulation", err.Error())
+		return nil, err
+	}
+	if len(summary.GetItems()) == 0 || summary.GetItems()[0].GetCreatedBy() != userID {
+		return nil, fmt.Errorf("シミュレーション結果[%s]が存在しません", jobID)
+	}
+
+	result = &typesx.SimulationResult{
+		JobID: jobID,
+	}
+	items := summary.GetItems()[0].GetItems()
+	result.Contracts, _ = strconv.Atoi(items["contracts"].GetValue())
+	result.Errors, _ = strconv.Atoi(items["errors"].GetValue())
+
+	req.DatastoreKey = simulationKey
+	response, err := tplService.FindTemplateItems(context.TODO(), &req)
+	if err != nil {
+		loggerx.ErrorLog("findSimulation", err.Error())
+		return nil, err
+	}
+	for _, it := range response.GetItems() {
+		items := it.GetItems()
+		ym := items["ym"].GetValue()
+		result.Deltas = append(result.Deltas, leasecalc.PeriodDelta{
+			Ym:        ym,
+			Base:      periodOf(items, "base_", ym),
+			Simulated: periodOf(items, "simulated_", ym),
+			Delta:     periodOf(items, "delta_", ym),
+		})
+	}
+	sort.Slice(result.Deltas, func(i, j int) bool {
+		return result.Deltas[i].Ym < result.Deltas[j].Ym
+	})
+
+	return result, nil
+}
@@ -0,0 +1,188 @@
+package leasex
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/micro/go-micro/v2/client"
+	"rxcsoft.cn/pit3/api/internal/common/logic/configx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/template"
+)
+
+// getCalcConfig 取得租赁计算用的app设定
+func getCalcConfig(db, appID string) (c leasecalc.Config, err error) {
+	cfg, err := configx.GetConfigVal(db, appID)
+	if err != nil {
+		return c, err
+	}
+
+	c.SyoriYm = cfg.GetSyoriYm()
+	c.KishuYm = cfg.GetKishuYm()
+	c.Rounding = leasecalc.Rounding{
+		Mode: leasecalc.ParseRoundingMode(cfg.GetRoundingMode()),
+	}
+	c.CostModel = leasecalc.ParseCostModel(cfg.GetCostModel())
+
+	return c, nil
+}
+
+// numberValue 数值类型的临时数据字段
+func numberValue(v leasecalc.Money) *template.Value {
+	return &template.Value{
+		DataType: "number",
+		Value:    v.String(),
+	}
+}
+
+// ymValues 在字段中追加年和月
+func ymValues(items map[string]*template.Value, ymd string) {
+	year, _ := strconv.Atoi(ymd[0:4])
+	month, _ := strconv.Atoi(ymd[5:7])
+	items["year"] = &template.Value{
+		DataType: "number",
+		Value:    strconv.Itoa(year),
+	}
+	items["month"] = &template.Value{
+		DataType: "number",
+		Value:    strconv.Itoa(month),
+	}
+}
+
+// newListItem 生成临时数据
+func newListItem(items map[string]*template.Value, dsMap map[string]string, key, templateID string) *template.ListItems {
+	return &template.ListItems{
+		Items:        items,
+		DatastoreId:  dsMap[key],
+		DatastoreKey: key,
+		TemplateId:   templateID,
+	}
+}
+
+// buildPayItems 支付情报(ym为true时追加年和月)
+func buildPayItems(pays []typesx.Payment, dsMap map[string]string, templateID string, ym bool) (data typesx.TplData) {
+	for _, pay := range pays {
+		items := make(map[string]*template.Value)
+		items["paymentcount"] = &template.Value{
+			DataType: "number",
+			Value:    strconv.Itoa(pay.Paymentcount),
+		}
+		items["paymentType"] = &template.Value{
+			DataType: "text",
+			Value:    pay.PaymentType,
+		}
+		items["paymentymd"] = &template.Value{
+			DataType: "date",
+			Value:    pay.Paymentymd,
+		}
+		items["paymentleasefee"] = numberValue(pay.Paymentleasefee)
+		items["paymentleasefeehendo"] = numberValue(pay.Paymentleasefeehendo)
+		items["incentives"] = numberValue(pay.Incentives)
+		items["sonotafee"] = numberValue(pay.Sonotafee)
+		items["kaiyakuson"] = numberValue(pay.Kaiyakuson)
+		if ym {
+			ymValues(items, pay.Paymentymd)
+		}
+
+		data = append(data, newListItem(items, dsMap, "paymentStatus", templateID))
+	}
+
+	return data
+}
+
+// buildLeaseItems 利息情报(ym为true时追加期首元本残高和年月)
+func buildLeaseItems(leases []typesx.Lease, dsMap map[string]string, templateID string, ym bool) (data typesx.TplData) {
+	for _, lease := range leases {
+		items := make(map[string]*template.Value)
+		items["interest"] = numberValue(lease.Interest)
+		items["repayment"] = numberValue(lease.Repayment)
+		items["balance"] = numberValue(lease.Balance)
+		items["present"] = numberValue(lease.Present)
+		// 最终回端数调整额
+		if lease.Plug != 0 {
+			items["plug"] = numberValue(lease.Plug)
+		}
+		items["paymentymd"] = &template.Value{
+			DataType: "date",
+			Value:    lease.Paymentymd,
+		}
+		// 主账簿以外的账簿
+		if len(lease.Book) > 0 {
+			items["book"] = &template.Value{
+				DataType: "text",
+				Value:    lease.Book,
+			}
+		}
+		if ym {
+			items["firstbalance"] = numberValue(lease.Firstbalance)
+			ymValues(items, lease.Paymentymd)
+		}
+
+		data = append(data, newListItem(items, dsMap, "paymentInterest", templateID))
+	}
+
+	return data
+}
+
+// buildRepayItems 偿还情报(ym为true时追加年和月)
+func buildRepayItems(repays []typesx.RePayment, dsMap map[string]string, templateID string, ym bool) (data typesx.TplData) {
+	for _, rp := range repays {
+		items := make(map[string]*template.Value)
+		items["endboka"] = numberValue(rp.Endboka)
+		items["boka"] = numberValue(rp.Boka)
+		items["syokyaku"] = numberValue(rp.Syokyaku)
+		// 最终月端数调整额
+		if rp.Plug != 0 {
+			items["plug"] = numberValue(rp.Plug)
+		}
+		// 单一リース費用(经营租赁)
+		if rp.Leasecost != 0 {
+			items["leasecost"] = numberValue(rp.Leasecost)
+		}
+		// 减损累计额(减损后的契约)
+		if rp.Genson != 0 {
+			items["genson"] = numberValue(rp.Genson)
+		}
+		items["syokyakuymd"] = &template.Value{
+			DataType: "date",
+			Value:    rp.Syokyakuymd,
+		}
+		items["syokyakukbn"] = &template.Value{
+			DataType: "text",
+			Value:    rp.Syokyakukbn,
+		}
+		// 主账簿以外的账簿
+		if len(rp.Book) > 0 {
+			items["book"] = &template.Value{
+				DataType: "text",
+				Value:    rp.Book,
+			}
+		}
+		if ym {
+			ymValues(items, rp.Syokyakuymd)
+		}
+
+		data = append(data, newListItem(items, dsMap, "repayment", templateID))
+	}
+
+	return data
+}
+
+// insertTemplate 临时数据存入临时集合
+func insertTemplate(db, appID, userID string, tplItems typesx.TplData) (err error) {
+	tplService := template.NewTemplateService("database", client.DefaultClient)
+
+	var hsReq template.MutilAddRequest
+
+	// 从body中获取参数
+	hsReq.Data = tplItems
+	// 从共通中获取参数
+	hsReq.Writer = userID
+	hsReq.Database = db
+	hsReq.Collection = userID
+	hsReq.AppId = appID
+
+	_, err = tplService.MutilAddTemplateItem(context.TODO(), &hsReq)
+	return err
+}
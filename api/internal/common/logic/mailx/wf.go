@@ -39,6 +39,8 @@ func SendEmailToApprover(param EmailParam) error {
 		opreate = "中途解約"
 	case "contract-expire":
 		opreate = "契約満了"
+	case "contract-impair":
+		opreate = "減損"
 	}
 	// 查询用户信息
 	userService := user.NewUserService("manage", client.DefaultClient)
@@ -860,6 +860,11 @@ func CopyApp(params CopyParams) error {
 						nReq.Assignees = n.Assignees
 						nReq.ActType = n.ActType
 						nReq.NodeGroupId = n.NodeGroupId
+						nReq.Routes = n.Routes
+						nReq.RemindHours = n.RemindHours
+						nReq.EscalateHours = n.EscalateHours
+						nReq.ExpireHours = n.ExpireHours
+						nReq.ExpireAction = n.ExpireAction
 						nReq.Database = params.DB
 						nReq.Writer = n.CreatedBy
 						_, err := nodeService.AddNode(context.TODO(), &nReq, opss)
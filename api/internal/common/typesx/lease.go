@@ -1,8 +1,8 @@
 package typesx
 
 import (
-	"time"
-
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
 	"rxcsoft.cn/pit3/srv/database/proto/template"
 )
 
@@ -16,11 +16,13 @@ type InsertResult struct {
 
 // ComputeResult 新规契约预算返回
 type ComputeResult struct {
-	TemplateID  string  `json:"template_id" bson:"template_id"`
-	KiSyuBoka   float64 `json:"kisyuboka" bson:"kisyuboka"` // 原始取得价值
-	TplItems    TplData `json:"-"`
-	Hkkjitenzan float64 `json:"hkkjitenzan" bson:"hkkjitenzan"` // 比較開始時点の残存リース料
-	Sonnekigaku float64 `json:"sonnekigaku" bson:"sonnekigaku"` // 利益剰余金
+	TemplateID  string          `json:"template_id" bson:"template_id"`
+	KiSyuBoka   leasecalc.Money `json:"kisyuboka" bson:"kisyuboka"` // 原始取得价值
+	TplItems    TplData         `json:"-"`
+	Hkkjitenzan leasecalc.Money `json:"hkkjitenzan" bson:"hkkjitenzan"` // 比較開始時点の残存リース料
+	Sonnekigaku leasecalc.Money `json:"sonnekigaku" bson:"sonnekigaku"` // 利益剰余金
+	// 比较期间的修正再表示数据(完全遡及適用)
+	Comparatives []leasecalc.Comparative `json:"comparatives,omitempty" bson:"comparatives"`
 }
 
 // ChangeResult 契约情报变更返回
@@ -31,64 +33,182 @@ type ChangeResult struct {
 
 // CancelResult 中途解约预算返回
 type CancelResult struct {
-	TemplateID string  `json:"template_id" bson:"template_id"` // 临时数据ID
-	RemainDebt float64 `json:"remaindebt" bson:"remaindebt"`   // 解約時元本残高
-	Lossgaku   float64 `json:"lossgaku" bson:"lossgaku"`       // 中途解約による除却損金额
-	TplItems   TplData `json:"-"`
+	TemplateID string          `json:"template_id" bson:"template_id"` // 临时数据ID
+	RemainDebt leasecalc.Money `json:"remaindebt" bson:"remaindebt"`   // 解約時元本残高
+	Lossgaku   leasecalc.Money `json:"lossgaku" bson:"lossgaku"`       // 中途解約による除却損金额
+	TplItems   TplData         `json:"-"`
 }
 
 // ExpireResult 满了预算返回
 type ExpireResult struct {
-	TemplateID string  `json:"template_id" bson:"template_id"` // 临时数据ID
-	Leftgaku   float64 `json:"leftgaku" bson:"leftgaku"`       // 满了時剩余价值
-	TplItems   TplData `json:"-"`
+	TemplateID string          `json:"template_id" bson:"template_id"` // 临时数据ID
+	Leftgaku   leasecalc.Money `json:"leftgaku" bson:"leftgaku"`       // 满了時剩余价值
+	TplItems   TplData         `json:"-"`
+}
+
+// ImpairmentResult 使用権資産减损计算结果
+type ImpairmentResult struct {
+	TemplateID string          `json:"template_id" bson:"template_id"` // 临时数据ID
+	OBoka      leasecalc.Money `json:"o_boka" bson:"o_boka"`           // 减损前账面价值
+	Boka       leasecalc.Money `json:"boka" bson:"boka"`               // 减损后账面价值
+	Gensongaku leasecalc.Money `json:"gensongaku" bson:"gensongaku"`   // 减损损失额(减损回转的场合为回转额)
+	Unimpaired leasecalc.Money `json:"unimpaired" bson:"unimpaired"`   // 未减损时的账面价值
+	TplItems   TplData         `json:"-"`
 }
 
 // debtResult 利息偿还情报参数
 type DebtResult struct {
-	TemplateID         string  `json:"template_id" bson:"template_id"`                 // 临时数据ID
-	KiSyuBoka          float64 `json:"kisyuboka" bson:"kisyuboka"`                     // 原始取得价值
-	OShisannsougaku    float64 `json:"o_shisannsougaku" bson:"o_shisannsougaku"`       // 变更前使用権資産額
-	Shisannsougaku     float64 `json:"shisannsougaku" bson:"shisannsougaku"`           // 变更后使用権資産額
-	OLeasesaimusougaku float64 `json:"o_leasesaimusougaku" bson:"o_leasesaimusougaku"` // 变更前租赁负债额
-	Leasesaimusougaku  float64 `json:"leasesaimusougaku" bson:"leasesaimusougaku"`     // 变更后租赁负债额
-	Shisannsagaku      float64 `json:"shisannsagaku" bson:"shisannsagaku"`             // 使用权资产差额
-	Leasesaimusagaku   float64 `json:"leasesaimusagaku" bson:"leasesaimusagaku"`       // 租赁负债差额
-	Sonnekigaku        float64 `json:"sonnekigaku" bson:"sonnekigaku"`                 // 损益额
-	TplItems           TplData `json:"-"`
+	TemplateID         string          `json:"template_id" bson:"template_id"`                 // 临时数据ID
+	KiSyuBoka          leasecalc.Money `json:"kisyuboka" bson:"kisyuboka"`                     // 原始取得价值
+	OShisannsougaku    leasecalc.Money `json:"o_shisannsougaku" bson:"o_shisannsougaku"`       // 变更前使用権資産額
+	Shisannsougaku     leasecalc.Money `json:"shisannsougaku" bson:"shisannsougaku"`           // 变更后使用権資産額
+	OLeasesaimusougaku leasecalc.Money `json:"o_leasesaimusougaku" bson:"o_leasesaimusougaku"` // 变更前租赁负债额
+	Leasesaimusougaku  leasecalc.Money `json:"leasesaimusougaku" bson:"leasesaimusougaku"`     // 变更后租赁负债额
+	Shisannsagaku      leasecalc.Money `json:"shisannsagaku" bson:"shisannsagaku"`             // 使用权资产差额
+	Leasesaimusagaku   leasecalc.Money `json:"leasesaimusagaku" bson:"leasesaimusagaku"`       // 租赁负债差额
+	Sonnekigaku        leasecalc.Money `json:"sonnekigaku" bson:"sonnekigaku"`                 // 损益额
+	TplItems           TplData         `json:"-"`
 }
 
-// PayParam 支付情报参数
-type PayParam struct {
-	Paymentstymd     time.Time `json:"paymentstymd" bson:"paymentstymd"`         // 支付开始日
-	Paymentcycle     int       `json:"paymentcycle" bson:"paymentcycle"`         // 支付周期
-	Paymentday       int       `json:"paymentday" bson:"paymentday"`             // 支付日
-	Paymentcounts    int       `json:"paymentcounts" bson:"paymentcounts"`       // 支付回数
-	ResidualValue    float64   `json:"residualValue" bson:"residualValue"`       // 残价保证额
-	Paymentleasefee  float64   `json:"paymentleasefee" bson:"paymentleasefee"`   // 支付金额
-	OptionToPurchase float64   `json:"optionToPurchase" bson:"optionToPurchase"` // 购入行使权金额
+// RemeasurePreview 指数更新引起的再测定预览(确定时使用临时数据ID进行债务变更)
+type RemeasurePreview struct {
+	ItemID      string `json:"item_id" bson:"item_id"`                 // 契约数据ID
+	Keiyakuno   string `json:"keiyakuno" bson:"keiyakuno"`             // 契约番号
+	Henkouymd   string `json:"henkouymd" bson:"henkouymd"`             // 变更年月
+	Error       string `json:"error,omitempty" bson:"error,omitempty"` // 不能再测定的理由
+	*DebtResult `bson:",inline"`
+}
+
+// SimulationParam 组合模拟参数(对象契约按检索条件筛选,未指定的场合为所有契约)
+type SimulationParam struct {
+	leasecalc.Scenario `bson:",inline"`
+	Conditions         []*item.Condition `json:"conditions" bson:"conditions"` // 对象契约的检索条件
+}
+
+// SimulationResult 组合模拟结果(月度差额)
+type SimulationResult struct {
+	JobID     string                  `json:"job_id" bson:"job_id"`       // 任务ID
+	Contracts int                     `json:"contracts" bson:"contracts"` // 对象契约件数
+	Errors    int                     `json:"errors" bson:"errors"`       // 不能模拟的契约件数
+	Deltas    []leasecalc.PeriodDelta `json:"deltas" bson:"deltas"`       // 月度差额
+}
+
+// ForecastParam 租赁现金流和损益预测参数
+type ForecastParam struct {
+	Unit       string            `json:"unit" bson:"unit"`             // 集计期间单位(month/quarter/year)
+	Years      int               `json:"years" bson:"years"`           // 预测年数(未设定的场合为5年)
+	GroupBy    string            `json:"group_by" bson:"group_by"`     // 分组的契约字段(管理部门、租赁会社、资产分类等)
+	Conditions []*item.Condition `json:"conditions" bson:"conditions"` // 对象契约的检索条件
+}
+
+// ForecastGroup 分组单位的预测结果
+type ForecastGroup struct {
+	Group     string                     `json:"group" bson:"group"`         // 分组的值
+	Contracts int                        `json:"contracts" bson:"contracts"` // 契约件数
+	Amounts   []leasecalc.ForecastAmount `json:"amounts" bson:"amounts"`     // 集计期间单位的金额
+}
+
+// DisclosureParam 开示用注记数据参数
+type DisclosureParam struct {
+	Startym    string            `json:"startym" bson:"startym"`       // 期首年月(未设定的场合为处理月度所属会计年度)
+	Conditions []*item.Condition `json:"conditions" bson:"conditions"` // 对象契约的检索条件
+}
+
+// DisclosureClass 资产分类单位的使用権資産增减明细
+type DisclosureClass struct {
+	Class     string                   `json:"class" bson:"class"`         // 资产分类
+	Contracts int                      `json:"contracts" bson:"contracts"` // 契约件数
+	Rou       leasecalc.RouRollForward `json:"rou" bson:"rou"`             // 使用権資産增减明细
+}
+
+// Disclosure 开示用注记数据(IFRS16/新リース会計基準)
+type Disclosure struct {
+	Startym          string                         `json:"startym" bson:"startym"`                   // 期首年月
+	Endym            string                         `json:"endym" bson:"endym"`                       // 期末年月
+	Classes          []*DisclosureClass             `json:"classes" bson:"classes"`                   // 资产分类单位的使用権資産增减明细
+	Rou              leasecalc.RouRollForward       `json:"rou" bson:"rou"`                           // 使用権資産增减明细合计
+	Liability        leasecalc.LiabilityRollForward `json:"liability" bson:"liability"`               // リース負債增减明细
+	Maturity         []leasecalc.MaturityBucket     `json:"maturity" bson:"maturity"`                 // 割引前リース料的满期分析
+	ShortTermExpense leasecalc.Money                `json:"shortTermExpense" bson:"shortTermExpense"` // 短期リース費用
+	LowValueExpense  leasecalc.Money                `json:"lowValueExpense" bson:"lowValueExpense"`   // 少額リース費用
+	CashOutflow      leasecalc.Money                `json:"cashOutflow" bson:"cashOutflow"`           // リースに係るキャッシュ・アウトフローの合計額
+}
+
+// ReconcileParam 支付照合参数
+type ReconcileParam struct {
+	Startym       string `json:"startym" bson:"startym"`               // 对象开始年月(未设定的场合为处理月度)
+	Endym         string `json:"endym" bson:"endym"`                   // 对象结束年月(未设定的场合与开始年月相同)
+	Leasekaishacd string `json:"leasekaishacd" bson:"leasekaishacd"`   // 租赁会社(未设定的场合为所有租赁会社)
+	ToleranceDays int    `json:"tolerance_days" bson:"tolerance_days"` // 支付日的容许天数
 }
 
+// ReconcileItem 支付照合结果的明细
+type ReconcileItem struct {
+	leasecalc.ReconcileLine `bson:",inline"`
+	PaymentID               string `json:"payment_id" bson:"payment_id"` // 预定支付的数据ID
+	ActualID                string `json:"actual_id" bson:"actual_id"`   // 实际支付的数据ID
+	Confirmed               bool   `json:"confirmed" bson:"confirmed"`   // 差额已确定
+}
+
+// Reconciliation 支付照合结果
+type Reconciliation struct {
+	Startym   string           `json:"startym" bson:"startym"`     // 对象开始年月
+	Endym     string           `json:"endym" bson:"endym"`         // 对象结束年月
+	Matched   int              `json:"matched" bson:"matched"`     // 一致件数
+	Partial   int              `json:"partial" bson:"partial"`     // 部分一致件数
+	Unmatched int              `json:"unmatched" bson:"unmatched"` // 没有预定支付的实际支付件数
+	Unpaid    int              `json:"unpaid" bson:"unpaid"`       // 没有实际支付的预定支付件数
+	Items     []*ReconcileItem `json:"items" bson:"items"`         // 照合结果明细
+}
+
+// ReconcileConfirmParam 支付照合差额确定参数(确定的差额作为变动リース料计上分录)
+type ReconcileConfirmParam struct {
+	ReconcileParam `bson:",inline"`
+	ActualIDs      []string `json:"actual_ids" bson:"actual_ids"` // 确定差额的实际支付数据ID
+}
+
+// ReverseJournalParam 取消分录参数(已确定分录的贷借对调分录作成)
+type ReverseJournalParam struct {
+	Shiwakeno string `json:"shiwakeno" bson:"shiwakeno"` // 取消对象的分录番号
+	Keiyakuno string `json:"keiyakuno" bson:"keiyakuno"` // 契约番号(指定的场合只取消该契约的分录)
+}
+
+// JournalExportParam 分录出力参数(按出力适配器的形式编辑分录)
+type JournalExportParam struct {
+	Exporter     string            `json:"exporter" bson:"exporter"`           // 出力适配器名
+	Shiwakeym    string            `json:"shiwakeym" bson:"shiwakeym"`         // 分录年月
+	Book         string            `json:"book" bson:"book"`                   // 账簿(未指定的场合为全部账簿)
+	Shiwakeno    string            `json:"shiwakeno" bson:"shiwakeno"`         // 分录番号(未指定的场合为全部分录)
+	AccountCodes map[string]string `json:"account_codes" bson:"account_codes"` // 勘定科目对应的科目代码
+	TaxCodes     map[string]string `json:"tax_codes" bson:"tax_codes"`         // 勘定科目对应的税区分
+	CompanyCode  string            `json:"company_code" bson:"company_code"`   // 公司代码
+	Currency     string            `json:"currency" bson:"currency"`           // 货币
+	Preview      int               `json:"preview" bson:"preview"`             // 预览的传票件数
+}
+
+// LessorResult 贷手契约预算返回
+type LessorResult struct {
+	TemplateID     string                `json:"template_id" bson:"template_id"`       // 临时数据ID
+	Class          leasecalc.LessorClass `json:"lessorclass" bson:"lessorclass"`       // 贷手分类
+	NetInvestment  leasecalc.Money       `json:"netinvestment" bson:"netinvestment"`   // 正味リース投資
+	UnearnedIncome leasecalc.Money       `json:"unearnedincome" bson:"unearnedincome"` // 未経過受取利息
+	Sonnekigaku    leasecalc.Money       `json:"sonnekigaku" bson:"sonnekigaku"`       // 標的資産の認識中止による損益額
+	HeadBoka       leasecalc.Money       `json:"headboka" bson:"headboka"`             // 原契约使用権資産的账面价值(转租开始时点)
+	HeadKiSyuBoka  leasecalc.Money       `json:"headkisyuboka" bson:"headkisyuboka"`   // 原契约使用権資産的原始取得价值
+	TplItems       TplData               `json:"-"`
+}
+
+// PayParam 支付情报参数
+type PayParam = leasecalc.PayParam
+
 // LRParam 契约追加情报参数
 type LRParam struct {
-	ResidualValue           float64           `json:"residualValue" bson:"residualValue"`                     // 残价保证额
-	Rishiritsu              float64           `json:"rishiritsu" bson:"rishiritsu"`                           // 割引率
-	Leasestymd              time.Time         `json:"leasestymd" bson:"leasestymd"`                           // 租赁开始日
-	CancellationRightOption bool              `json:"cancellationrightoption" bson:"cancellationrightoption"` // 解約行使権オプション
-	Leasekikan              int               `json:"leasekikan" bson:"leasekikan"`                           // 租赁期间
-	ExtentionOption         int               `json:"extentionOption" bson:"extentionOption"`                 // 延长租赁期间
-	PaymentsAtOrPrior       float64           `json:"paymentsAtOrPrior" bson:"paymentsAtOrPrior"`             // 前払リース料
-	IncentivesAtOrPrior     float64           `json:"incentivesAtOrPrior" bson:"incentivesAtOrPrior"`         // リース・インセンティブ（前払）
-	InitialDirectCosts      float64           `json:"initialDirectCosts" bson:"initialDirectCosts"`           // 当初直接費用
-	RestorationCosts        float64           `json:"restorationCosts" bson:"restorationCosts"`               // 原状回復コスト
-	Assetlife               int               `json:"assetlife" bson:"assetlife"`                             // 耐用年限
-	Torihikikbn             string            `json:"torihikikbn" bson:"torihikikbn"`                         // 取引判定区分
-	Payments                []Payment         `json:"payments" bson:"payments"`                               // 支付情报
-	DsMap                   map[string]string `json:"ds_map" bson:"ds_map"`                                   // 台账情报
-	Sykshisankeisan         string            `json:"sykshisankeisan" bson:"sykshisankeisan"`                 // 使用権資産
-	FirstMonth              string            `json:"firstMonth" bson:"firstMonth"`                           // 比較開始期首月
-	Hkkjitenzan             float64           `json:"hkkjitenzan" bson:"hkkjitenzan"`                         // 比較開始時点の残存リース料
-	Sonnekigaku             float64           `json:"sonnekigaku" bson:"sonnekigaku"`                         // 利益剰余金
+	leasecalc.LRParam `bson:",inline"`
+	Bunruicd          string            `json:"bunruicd" bson:"bunruicd"`   // 资产分类(偿却方法和免除规定方针的选择用)
+	Usageplan         string            `json:"usageplan" bson:"usageplan"` // 预定使用量(生产高比例法)
+	Suryo             int               `json:"suryo" bson:"suryo"`         // 数量(少額リース的单价判定用)
+	Currency          string            `json:"currency" bson:"currency"`   // 契约通货(利率曲线的检索用)
+	DsMap             map[string]string `json:"ds_map" bson:"ds_map"`       // 台账情报
 }
 
 // ChangeParam 契约情报变更参数
@@ -99,72 +219,40 @@ type ChangeParam struct {
 
 // DebtParam 债务变更情报参数
 type DebtParam struct {
-	Kaiyakuymd              string            `json:"kaiyakuymd" bson:"kaiyakuymd"`                           // 解约年月
-	Henkouymd               string            `json:"henkouymd" bson:"henkouymd"`                             // 变更年月
-	Leasestymd              string            `json:"leasestymd" bson:"leasestymd"`                           // 租赁开始日
-	CancellationRightOption bool              `json:"cancellationrightoption" bson:"cancellationrightoption"` // 解約行使権オプション
-	Leasekikan              int               `json:"leasekikan" bson:"leasekikan"`                           // 租赁期间
-	ExtentionOption         int               `json:"extentionOption" bson:"extentionOption"`                 // 延长租赁期间
-	Keiyakuno               string            `json:"keiyakuno" bson:"keiyakuno"`                             // 契约番号
-	Rishiritsu              float64           `json:"rishiritsu" bson:"rishiritsu"`                           // 割引率
-	ResidualValue           float64           `json:"residualValue" bson:"residualValue"`                     // 残价保证额
-	Assetlife               int               `json:"assetlife" bson:"assetlife"`                             // 耐用年限
-	Torihikikbn             string            `json:"torihikikbn" bson:"torihikikbn"`                         // 取引判定区分
-	Percentage              float64           `json:"percentage" bson:"percentage"`                           // 剩余资产百分比
-	Payments                []Payment         `json:"payments" bson:"payments"`                               // 支付情报
-	DsMap                   map[string]string `json:"ds_map" bson:"ds_map"`                                   // 台账情报
+	leasecalc.DebtParam `bson:",inline"`
+	Currency            string            `json:"currency" bson:"currency"` // 契约通货(利率曲线的检索用)
+	DsMap               map[string]string `json:"ds_map" bson:"ds_map"`     // 台账情报
 }
 
 // ExpireParam 契约满了情报参数
 type ExpireParam struct {
-	Henkouymd         string            `json:"henkouymd" bson:"henkouymd"`                 // 变更年月
-	Torihikikbn       string            `json:"torihikikbn" bson:"torihikikbn"`             // 取引判定区分
-	Expiresyokyakukbn string            `json:"expiresyokyakukbn" bson:"expiresyokyakukbn"` // リース満了償却区分
-	Keiyakuno         string            `json:"keiyakuno" bson:"keiyakuno"`                 // 契约番号
-	DsMap             map[string]string `json:"ds_map" bson:"ds_map"`                       // 台账情报
+	leasecalc.ExpireParam `bson:",inline"`
+	DsMap                 map[string]string `json:"ds_map" bson:"ds_map"` // 台账情报
+}
+
+// ImpairmentParam 使用権資産减损情报参数
+type ImpairmentParam struct {
+	leasecalc.ImpairmentParam `bson:",inline"`
+	DsMap                     map[string]string `json:"ds_map" bson:"ds_map"` // 台账情报
 }
 
 // CancelParam 中途解约情报参数
 type CancelParam struct {
-	Kaiyakuymd string            `json:"kaiyakuymd" bson:"kaiyakuymd"` // 解約年月日
-	Keiyakuno  string            `json:"keiyakuno" bson:"keiyakuno"`   // 契约番号
-	DsMap      map[string]string `json:"ds_map" bson:"ds_map"`         // 台账情报
+	leasecalc.CancelParam `bson:",inline"`
+	DsMap                 map[string]string `json:"ds_map" bson:"ds_map"` // 台账情报
 }
 
-// Payment 支付数据
-type Payment struct {
-	Leasekaishacd        string  `json:"leasekaishacd" bson:"leasekaishacd"`               // 租赁会社
-	Keiyakuno            string  `json:"keiyakuno" bson:"keiyakuno"`                       // 契约番号
-	Paymentcount         int     `json:"paymentcount" bson:"paymentcount"`                 // 支付回数
-	PaymentType          string  `json:"paymentType" bson:"paymentType"`                   // 支付类型
-	Paymentymd           string  `json:"paymentymd" bson:"paymentymd"`                     // 支付年月日
-	Paymentleasefee      float64 `json:"paymentleasefee" bson:"paymentleasefee"`           // 支付金额
-	Paymentleasefeehendo float64 `json:"paymentleasefeehendo" bson:"paymentleasefeehendo"` // 变更支付金额
-	Incentives           float64 `json:"incentives" bson:"incentives"`                     // 优惠金额
-	Sonotafee            float64 `json:"sonotafee" bson:"sonotafee"`                       // 其他金额
-	Kaiyakuson           float64 `json:"kaiyakuson" bson:"kaiyakuson"`                     // 解约损失
-	Fixed                bool    `json:"fixed" bson:"fixed"`                               // 修正否
+// LessorParam 贷手契约情报参数
+type LessorParam struct {
+	leasecalc.LessorParam `bson:",inline"`
+	DsMap                 map[string]string `json:"ds_map" bson:"ds_map"` // 台账情报
 }
 
+// Payment 支付数据
+type Payment = leasecalc.Payment
+
 // Lease 利息数据
-type Lease struct {
-	Leasekaishacd string  `json:"leasekaishacd" bson:"leasekaishacd"` // 租赁会社
-	Keiyakuno     string  `json:"keiyakuno" bson:"keiyakuno"`         // 契约番号
-	Interest      float64 `json:"interest" bson:"interest"`           // 支付利息相当额
-	Repayment     float64 `json:"repayment" bson:"repayment"`         // 元本返済相当額
-	Balance       float64 `json:"balance" bson:"balance"`             // 元本残高相当額
-	Firstbalance  float64 `json:"firstbalance" bson:"firstbalance"`   // 期首元本残高
-	Present       float64 `json:"present" bson:"present"`             // 現在価値
-	Paymentymd    string  `json:"paymentymd" bson:"paymentymd"`       // 支付年月
-}
+type Lease = leasecalc.Lease
 
 // RePayment 偿还数据
-type RePayment struct {
-	Leasekaishacd string  `json:"leasekaishacd" bson:"leasekaishacd"` // 租赁会社
-	Keiyakuno     string  `json:"keiyakuno" bson:"keiyakuno"`         // 契约番号
-	Syokyakukbn   string  `json:"syokyakukbn" bson:"syokyakukbn"`     // 償却区分
-	Endboka       float64 `json:"endboka" bson:"endboka"`             // 月末薄价
-	Boka          float64 `json:"boka" bson:"boka"`                   // 期首薄价
-	Syokyaku      float64 `json:"syokyaku" bson:"syokyaku"`           // 偿却额
-	Syokyakuymd   string  `json:"syokyakuymd" bson:"syokyakuymd"`     // 偿却年月
-}
+type RePayment = leasecalc.RePayment
@@ -13,12 +13,16 @@ import (
 	"rxcsoft.cn/pit3/api/internal/common/filex"
 	"rxcsoft.cn/pit3/api/internal/common/httpx"
 	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/configx"
 	"rxcsoft.cn/pit3/api/internal/common/logic/langx"
 	"rxcsoft.cn/pit3/api/internal/common/logic/tplx"
 	"rxcsoft.cn/pit3/api/internal/system/initx"
 	"rxcsoft.cn/pit3/api/internal/system/jobx"
 	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
 	"rxcsoft.cn/pit3/lib/msg"
+	"rxcsoft.cn/pit3/srv/database/proto/datastore"
+	"rxcsoft.cn/pit3/srv/database/proto/field"
 	"rxcsoft.cn/pit3/srv/global/proto/language"
 	"rxcsoft.cn/pit3/srv/manage/proto/app"
 	"rxcsoft.cn/pit3/srv/manage/proto/customer"
@@ -54,8 +58,14 @@ const (
 	ActionAddGroup            = "AddGroup"
 	defaultPasswordEnv        = "DEFAULT_PASSWORD"
 	ActionNextMonth           = "NextMonth"
+	ActionReopenMonth         = "ReopenMonth"
+	ActionFindMonthCloses     = "FindMonthCloses"
+	ActionModifyAppBooks      = "ModifyAppBooks"
 )
 
+// bookDatastores 按账簿保存数据的台账(利息,偿还,分录)
+var bookDatastores = []string{"paymentInterest", "repayment", "shiwake"}
+
 // FindApps 查找多个APP记录
 // @Router /apps [get]
 func (a *App) FindApps(c *gin.Context) {
@@ -797,6 +807,7 @@ func (a *App) NextMonth(c *gin.Context) {
 		req.AppId = appID
 	}
 	req.Value = c.Query("value")
+	req.Writer = sessionx.GetAuthUserID(c)
 	req.Database = sessionx.GetUserCustomer(c)
 
 	response, err := appService.NextMonth(context.TODO(), &req)
@@ -812,3 +823,208 @@ func (a *App) NextMonth(c *gin.Context) {
 		Data:    response,
 	})
 }
+
+// ReopenMonth 重开最近关闭的月度
+// @Router app/apps/reopenMonth [put]
+func (a *App) ReopenMonth(c *gin.Context) {
+	loggerx.InfoLog(c, ActionReopenMonth, loggerx.MsgProcessStarted)
+
+	appService := app.NewAppService("manage", client.DefaultClient)
+
+	var req app.ReopenMonthRequest
+	req.AppId = sessionx.GetCurrentApp(c)
+	appID := c.Query("app_id")
+	if len(appID) > 0 {
+		req.AppId = appID
+	}
+	req.Writer = sessionx.GetAuthUserID(c)
+	req.Database = sessionx.GetUserCustomer(c)
+	// 关闭后有变更的数据时需要确认后强制重开
+	req.Force = c.Query("force") == "true"
+
+	response, err := appService.ReopenMonth(context.TODO(), &req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionReopenMonth, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionReopenMonth, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I003, fmt.Sprintf(httpx.Temp, AppProcessName, ActionReopenMonth)),
+		Data:    response.GetMonthClose(),
+	})
+}
+
+// FindMonthCloses 获取月度关闭记录
+// @Router app/apps/monthCloses [get]
+func (a *App) FindMonthCloses(c *gin.Context) {
+	loggerx.InfoLog(c, ActionFindMonthCloses, loggerx.MsgProcessStarted)
+
+	appService := app.NewAppService("manage", client.DefaultClient)
+
+	var req app.FindMonthClosesRequest
+	req.AppId = sessionx.GetCurrentApp(c)
+	appID := c.Query("app_id")
+	if len(appID) > 0 {
+		req.AppId = appID
+	}
+	req.Database = sessionx.GetUserCustomer(c)
+
+	response, err := appService.FindMonthCloses(context.TODO(), &req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionFindMonthCloses, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionFindMonthCloses, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I003, fmt.Sprintf(httpx.Temp, AppProcessName, ActionFindMonthCloses)),
+		Data:    response.GetMonthCloses(),
+	})
+}
+
+// ModifyAppBooks 更新会计账簿
+// @Router app/apps/{a_id}/books [put]
+func (a *App) ModifyAppBooks(c *gin.Context) {
+	loggerx.InfoLog(c, ActionModifyAppBooks, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	var req app.ModifyAppBooksRequest
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionModifyAppBooks, err)
+		return
+	}
+	req.AppId = c.Param("a_id")
+	req.Writer = userID
+	req.Database = db
+
+	// 账簿设定检查
+	var books []leasecalc.Book
+	for _, b := range req.GetBooks() {
+		book, err := configx.ToBook(b)
+		if err != nil {
+			httpx.GinHTTPError(c, ActionModifyAppBooks, err)
+			return
+		}
+		books = append(books, book)
+	}
+	if err := leasecalc.ValidateBooks(books); err != nil {
+		httpx.GinHTTPError(c, ActionModifyAppBooks, err)
+		return
+	}
+
+	appService := app.NewAppService("manage", client.DefaultClient)
+	_, err := appService.ModifyAppBooks(context.TODO(), &req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionModifyAppBooks, err)
+		return
+	}
+
+	// 报表和分录下载中按账簿区分,台账中追加账簿字段
+	if len(books) > 0 {
+		if err := addBookFields(db, req.GetAppId(), userID, sessionx.GetCurrentLanguage(c), sessionx.GetUserDomain(c)); err != nil {
+			httpx.GinHTTPError(c, ActionModifyAppBooks, err)
+			return
+		}
+	}
+	loggerx.SuccessLog(c, ActionModifyAppBooks, fmt.Sprintf(loggerx.MsgProcesSucceed, ActionModifyAppBooks))
+
+	loggerx.InfoLog(c, ActionModifyAppBooks, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I005, fmt.Sprintf(httpx.Temp, AppProcessName, ActionModifyAppBooks)),
+	})
+}
+
+// addBookFields 利息,偿还,分录台账中没有账簿字段的场合追加
+func addBookFields(db, appID, userID, lang, domain string) error {
+	datastoreService := datastore.NewDataStoreService("database", client.DefaultClient)
+
+	var dsReq datastore.DatastoresRequest
+	dsReq.AppId = appID
+	dsReq.Database = db
+
+	dsResp, err := datastoreService.FindDatastores(context.TODO(), &dsReq)
+	if err != nil {
+		loggerx.ErrorLog("addBookFields", err.Error())
+		return err
+	}
+
+	fieldService := field.NewFieldService("database", client.DefaultClient)
+	langService := language.NewLanguageService("global", client.DefaultClient)
+
+	for _, ds := range dsResp.GetDatastores() {
+		target := false
+		for _, key := range bookDatastores {
+			if ds.GetApiKey() == key {
+				target = true
+				break
+			}
+		}
+		if !target {
+			continue
+		}
+
+		var fReq field.FieldsRequest
+		fReq.AppId = appID
+		fReq.DatastoreId = ds.GetDatastoreId()
+		fReq.Database = db
+
+		fResp, err := fieldService.FindFields(context.TODO(), &fReq)
+		if err != nil {
+			loggerx.ErrorLog("addBookFields", err.Error())
+			return err
+		}
+
+		exist := false
+		for _, f := range fResp.GetFields() {
+			if f.GetFieldId() == "book" {
+				exist = true
+				break
+			}
+		}
+		if exist {
+			continue
+		}
+
+		var req field.AddRequest
+		req.AppId = appID
+		req.DatastoreId = ds.GetDatastoreId()
+		req.FieldName = "会計帳簿"
+		req.FieldType = "text"
+		req.FieldId = "book"
+		req.IsFixed = true
+		req.Writer = userID
+		req.Database = db
+
+		response, err := fieldService.AddField(context.TODO(), &req)
+		if err != nil {
+			loggerx.ErrorLog("addBookFields", err.Error())
+			return err
+		}
+
+		// 添加多语言数据
+		languageReq := language.AddAppLanguageDataRequest{
+			Domain:   domain,
+			LangCd:   lang,
+			AppId:    appID,
+			Type:     "fields",
+			Key:      req.GetDatastoreId() + "_" + response.GetFieldId(),
+			Value:    req.GetFieldName(),
+			Writer:   userID,
+			Database: db,
+		}
+
+		_, err = langService.AddAppLanguageData(context.TODO(), &languageReq)
+		if err != nil {
+			loggerx.ErrorLog("addBookFields", err.Error())
+			return err
+		}
+	}
+
+	return nil
+}
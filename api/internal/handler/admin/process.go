@@ -10,6 +10,7 @@ import (
 	"rxcsoft.cn/pit3/api/internal/common/httpx"
 	"rxcsoft.cn/pit3/api/internal/common/loggerx"
 	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/api/internal/system/wfx"
 	"rxcsoft.cn/pit3/lib/msg"
 	"rxcsoft.cn/pit3/srv/workflow/proto/node"
 	"rxcsoft.cn/pit3/srv/workflow/proto/process"
@@ -22,6 +23,7 @@ type Process struct{}
 const (
 	ProcessName        = "Process"
 	ActionFindsProcess = "FindsProcess"
+	ActionReassignAll  = "ReassignAll"
 	NodeName           = "Node"
 	ActionFindsNode    = "FindsNode"
 	FormName           = "Form"
@@ -54,6 +56,43 @@ func (f *Process) FindsProcess(c *gin.Context) {
 	})
 }
 
+// ReassignAll 批量转交用户的待审批进程(休假等场合)
+// @Router /process/reassign [post]
+func (f *Process) ReassignAll(c *gin.Context) {
+	loggerx.InfoLog(c, ActionReassignAll, loggerx.MsgProcessStarted)
+
+	type Request struct {
+		FromUser   string   `json:"from_user"`
+		ToUser     string   `json:"to_user"`
+		ExampleIDs []string `json:"ex_ids"`
+		Comment    string   `json:"comment"`
+	}
+
+	var req Request
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionReassignAll, err)
+		return
+	}
+
+	db := sessionx.GetUserCustomer(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	approve := new(wfx.Approve)
+	result, err := approve.ReassignAll(db, req.FromUser, req.ToUser, userID, req.Comment, req.ExampleIDs)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionReassignAll, err)
+		return
+	}
+	loggerx.SuccessLog(c, ActionReassignAll, fmt.Sprintf("Processes of [%s] reassign to [%s]: %d success, %d failed", req.FromUser, req.ToUser, len(result.Reassigned), len(result.Errors)))
+
+	loggerx.InfoLog(c, ActionReassignAll, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I005, fmt.Sprintf(httpx.Temp, ProcessName, ActionReassignAll)),
+		Data:    result,
+	})
+}
+
 // FindNodes 获取所有节点
 // @Router /Node [get]
 func (f *Process) FindNodes(c *gin.Context) {
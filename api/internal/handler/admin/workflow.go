@@ -125,6 +125,24 @@ func (t *Workflow) AddWorkflow(c *gin.Context) {
 	wReq.AppId = appId
 	wReq.Writer = user
 	wReq.Database = db
+	// 流程服务检查流程图(无法到达的节点、循环、条件式)
+	for _, n := range req.Nodes {
+		if len(n.NodeType) == 0 {
+			n.NodeType = "1"
+		}
+		gn := &workflow.GraphNode{
+			NodeId:   n.GetNodeId(),
+			NodeType: n.GetNodeType(),
+			NextNode: n.GetNextNode(),
+		}
+		for _, r := range n.GetRoutes() {
+			gn.Routes = append(gn.Routes, &workflow.GraphRoute{
+				Guard:    r.GetGuard(),
+				NextNode: r.GetNextNode(),
+			})
+		}
+		wReq.Nodes = append(wReq.Nodes, gn)
+	}
 
 	response, err := workflowService.AddWorkflow(context.TODO(), &wReq)
 	if err != nil {
@@ -177,7 +195,9 @@ func (t *Workflow) AddWorkflow(c *gin.Context) {
 	nodeService := node.NewNodeService("workflow", client.DefaultClient)
 	for _, nReq := range req.Nodes {
 		nReq.WfId = response.GetWfId()
-		nReq.NodeType = "1"
+		if len(nReq.NodeType) == 0 {
+			nReq.NodeType = "1"
+		}
 		nReq.Writer = user
 		nReq.Database = db
 
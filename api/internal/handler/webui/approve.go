@@ -829,6 +829,34 @@ func (i *Approve) FindApproveItem(c *gin.Context) {
 	res["fields"] = fields
 	res["process"] = pResp.GetProcesses()
 	res["nodes"] = nResp.GetNodes()
+	res["revision"] = exResp.GetExample().GetRevision()
+	res["version"] = exResp.GetExample().GetVersion()
+
+	// 每次申请的数据(退回修改后再申请的历史),各次的审批意见按进程的revision区分
+	var rReq approve.RevisionsRequest
+	rReq.ExampleId = response.GetItem().GetExampleId()
+	rReq.Database = db
+
+	rResp, err := tplService.FindRevisions(context.TODO(), &rReq)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionFindApproveItem, err)
+		return
+	}
+
+	var revisions []map[string]interface{}
+	for _, r := range rResp.GetRevisions() {
+		items := make(map[string]interface{})
+		for key, value := range r.GetCurrent() {
+			items[key] = transferx.TransferApprove(value)
+		}
+		revisions = append(revisions, map[string]interface{}{
+			"revision":     r.GetRevision(),
+			"items":        items,
+			"submitted_at": r.GetSubmittedAt(),
+			"submitted_by": r.GetSubmittedBy(),
+		})
+	}
+	res["revisions"] = revisions
 
 	itemMap := make(map[string]interface{})
 	for key, value := range response.GetItem().GetCurrent() {
@@ -981,6 +1009,11 @@ func getStatus(db, exID, userID string) (s *typesx.Status, e error) {
 			result.CurrentNode = p.CurrentNode
 			result.Approver = p.UserId
 		}
+		// 退回修改的场合为最后一次申请中退回的审批者
+		if p.Status == 5 && p.GetRevision() == exResp.GetExample().GetRevision() && p.GetComment() != "Returned by other approvers" {
+			result.CurrentNode = p.CurrentNode
+			result.Approver = p.UserId
+		}
 	}
 
 	return result, nil
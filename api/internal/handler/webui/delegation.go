@@ -0,0 +1,117 @@
+package webui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/micro/go-micro/v2/client"
+
+	"rxcsoft.cn/pit3/api/internal/common/httpx"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/msg"
+	"rxcsoft.cn/pit3/srv/workflow/proto/delegation"
+)
+
+// Delegation 审批代理
+type Delegation struct{}
+
+// log出力
+const (
+	DelegationProcessName   = "Delegation"
+	ActionFindDelegations   = "FindDelegations"
+	ActionAddDelegation     = "AddDelegation"
+	ActionDeleteDelegations = "DeleteDelegations"
+)
+
+// FindDelegations 获取当前用户的审批代理(设定的和被委托的)
+// @Router /delegations [get]
+func (t *Delegation) FindDelegations(c *gin.Context) {
+	loggerx.InfoLog(c, ActionFindDelegations, loggerx.MsgProcessStarted)
+
+	delegationService := delegation.NewDelegationService("workflow", client.DefaultClient)
+
+	var req delegation.DelegationsRequest
+	// 被委托的代理
+	if c.Query("delegated") == "true" {
+		req.Delegate = sessionx.GetAuthUserID(c)
+	} else {
+		req.UserId = sessionx.GetAuthUserID(c)
+	}
+	req.WfId = c.Query("wf_id")
+	req.ActiveOn = c.Query("active_on")
+	req.Database = sessionx.GetUserCustomer(c)
+
+	response, err := delegationService.FindDelegations(context.TODO(), &req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionFindDelegations, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionFindDelegations, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I003, fmt.Sprintf(httpx.Temp, DelegationProcessName, ActionFindDelegations)),
+		Data:    response.GetDelegations(),
+	})
+}
+
+// AddDelegation 添加当前用户的审批代理
+// @Router /delegations [post]
+func (t *Delegation) AddDelegation(c *gin.Context) {
+	loggerx.InfoLog(c, ActionAddDelegation, loggerx.MsgProcessStarted)
+
+	delegationService := delegation.NewDelegationService("workflow", client.DefaultClient)
+
+	var req delegation.AddRequest
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionAddDelegation, err)
+		return
+	}
+
+	req.UserId = sessionx.GetAuthUserID(c)
+	req.Writer = sessionx.GetAuthUserID(c)
+	req.Database = sessionx.GetUserCustomer(c)
+
+	response, err := delegationService.AddDelegation(context.TODO(), &req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionAddDelegation, err)
+		return
+	}
+	loggerx.SuccessLog(c, ActionAddDelegation, fmt.Sprintf("Delegation[%s] create success", response.GetDelegationId()))
+
+	loggerx.InfoLog(c, ActionAddDelegation, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, DelegationProcessName, ActionAddDelegation)),
+		Data:    response,
+	})
+}
+
+// DeleteDelegations 删除当前用户的审批代理
+// @Router /delegations [delete]
+func (t *Delegation) DeleteDelegations(c *gin.Context) {
+	loggerx.InfoLog(c, ActionDeleteDelegations, loggerx.MsgProcessStarted)
+
+	delegationService := delegation.NewDelegationService("workflow", client.DefaultClient)
+
+	var req delegation.DeleteRequest
+	req.DelegationIds = c.QueryArray("delegations")
+	req.UserId = sessionx.GetAuthUserID(c)
+	req.Database = sessionx.GetUserCustomer(c)
+
+	_, err := delegationService.DeleteDelegations(context.TODO(), &req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionDeleteDelegations, err)
+		return
+	}
+	loggerx.SuccessLog(c, ActionDeleteDelegations, fmt.Sprintf("Delegation[%v] delete success", req.GetDelegationIds()))
+
+	loggerx.InfoLog(c, ActionDeleteDelegations, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I006, fmt.Sprintf(httpx.Temp, DelegationProcessName, ActionDeleteDelegations)),
+		Data:    nil,
+	})
+}
@@ -0,0 +1,150 @@
+package webui
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"rxcsoft.cn/pit3/api/internal/common/httpx"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/leasex"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/lib/msg"
+)
+
+// log出力
+const (
+	ActionDisclosure         = "Disclosure"
+	ActionDownloadDisclosure = "DownloadDisclosure"
+)
+
+// Disclosure 开示用注记数据(增减明细、满期分析、短期和少額リース費用、现金支出)
+// @Router /disclosure [POST]
+func (r *Report) Disclosure(c *gin.Context) {
+	loggerx.InfoLog(c, ActionDisclosure, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	var req typesx.DisclosureParam
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionDisclosure, err)
+		return
+	}
+
+	result, err := leasex.Disclosure(db, appID, userID, req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionDisclosure, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionDisclosure, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I003, fmt.Sprintf(httpx.Temp, ReportProcessName, ActionDisclosure)),
+		Data:    result,
+	})
+}
+
+// DownloadDisclosure 开示用注记数据,以csv或xlsx文件的方式下载
+// @Router /disclosure/download [POST]
+func (r *Report) DownloadDisclosure(c *gin.Context) {
+	loggerx.InfoLog(c, ActionDownloadDisclosure, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	// 从body中获取参数
+	var req typesx.DisclosureParam
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionDownloadDisclosure, err)
+		return
+	}
+
+	// 金额列(第3列起)按数值写入
+	downloadRows(c, "disclosure", 2, func() ([][]string, error) {
+		result, err := leasex.Disclosure(db, appID, userID, req)
+		if err != nil {
+			return nil, err
+		}
+		return disclosureRows(result), nil
+	})
+
+	loggerx.InfoLog(c, ActionDownloadDisclosure, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, ReportProcessName, ActionDownloadDisclosure)),
+		Data:    gin.H{},
+	})
+}
+
+// disclosureRows 开示用注记数据编辑为文件的行(各注记之间空一行)
+func disclosureRows(d *typesx.Disclosure) [][]string {
+	rows := [][]string{
+		{"開示期間", d.Startym + "~" + d.Endym},
+		{},
+		// 使用権資産的增减明细(资产分类单位)
+		{"使用権資産", "資産分類", "契約件数", "期首残高", "新規取得", "減価償却費", "再測定", "減損損失", "解約・終了", "期末残高"},
+	}
+	rou := func(class string, contracts int, r leasecalc.RouRollForward) []string {
+		return []string{
+			"使用権資産",
+			class,
+			strconv.Itoa(contracts),
+			r.Opening.String(),
+			r.Additions.String(),
+			r.Depreciation.String(),
+			r.Remeasurements.String(),
+			r.Impairments.String(),
+			r.Terminations.String(),
+			r.Closing.String(),
+		}
+	}
+	contracts := 0
+	for _, c := range d.Classes {
+		rows = append(rows, rou(c.Class, c.Contracts, c.Rou))
+		contracts += c.Contracts
+	}
+	rows = append(rows, rou("合計", contracts, d.Rou))
+
+	// リース負債的增减明细
+	l := d.Liability
+	rows = append(rows,
+		[]string{},
+		[]string{"リース負債", "", "契約件数", "期首残高", "新規計上", "支払利息", "支払額", "再測定", "解約・終了", "期末残高"},
+		[]string{
+			"リース負債",
+			"合計",
+			strconv.Itoa(contracts),
+			l.Opening.String(),
+			l.Additions.String(),
+			l.Interest.String(),
+			l.Payments.String(),
+			l.Remeasurements.String(),
+			l.Terminations.String(),
+			l.Closing.String(),
+		},
+	)
+
+	// 割引前リース料的满期分析
+	rows = append(rows, []string{}, []string{"満期分析(割引前)", "区分", "金額"})
+	for _, m := range d.Maturity {
+		rows = append(rows, []string{"満期分析(割引前)", m.Label, m.Amount.String()})
+	}
+
+	// 短期和少額リース費用、现金支出
+	rows = append(rows,
+		[]string{},
+		[]string{"費用及びキャッシュ・フロー", "項目", "金額"},
+		[]string{"費用及びキャッシュ・フロー", "短期リース費用", d.ShortTermExpense.String()},
+		[]string{"費用及びキャッシュ・フロー", "少額リース費用", d.LowValueExpense.String()},
+		[]string{"費用及びキャッシュ・フロー", "リースに係るキャッシュ・アウトフローの合計額", d.CashOutflow.String()},
+	)
+
+	return rows
+}
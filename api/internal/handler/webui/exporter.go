@@ -0,0 +1,154 @@
+package webui
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"rxcsoft.cn/pit3/api/internal/common/httpx"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/journalx"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/msg"
+	"rxcsoft.cn/pit3/srv/journal/proto/journal"
+)
+
+// log出力
+const (
+	ActionFindExporters  = "FindExporters"
+	ActionPreviewExport  = "PreviewExport"
+	ActionDownloadExport = "DownloadExport"
+)
+
+// 预览默认的传票件数
+const defaultExportPreview = 20
+
+// FindExporters 获取分录出力适配器(弥生会計、freee、マネーフォワード、SAP等)
+// @Router /exporters [get]
+func (f *Journal) FindExporters(c *gin.Context) {
+	loggerx.InfoLog(c, ActionFindExporters, loggerx.MsgProcessStarted)
+
+	exporters, err := journalx.FindExporters()
+	if err != nil {
+		httpx.GinHTTPError(c, ActionFindExporters, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionFindExporters, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I003, fmt.Sprintf(httpx.Temp, JournalProcessName, ActionFindExporters)),
+		Data:    exporters,
+	})
+}
+
+// PreviewExport 分录按出力适配器的形式编辑,返回最初的传票的出力行和全部传票的验证结果
+// @Router /export/preview [post]
+func (f *Journal) PreviewExport(c *gin.Context) {
+	loggerx.InfoLog(c, ActionPreviewExport, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	var req typesx.JournalExportParam
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionPreviewExport, err)
+		return
+	}
+	preview := req.Preview
+	if preview <= 0 {
+		preview = defaultExportPreview
+	}
+
+	result, err := journalx.ExportJournal(db, appID, userID, req, preview)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionPreviewExport, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionPreviewExport, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I003, fmt.Sprintf(httpx.Temp, JournalProcessName, ActionPreviewExport)),
+		Data:    result,
+	})
+}
+
+// DownloadExport 分录按出力适配器的形式下载(验证结果有错误的场合不出力,错误文件中出力验证结果)
+// @Router /export [post]
+func (f *Journal) DownloadExport(c *gin.Context) {
+	loggerx.InfoLog(c, ActionDownloadExport, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	var req typesx.JournalExportParam
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionDownloadExport, err)
+		return
+	}
+
+	// 出力文件的形式由适配器决定
+	exporters, err := journalx.FindExporters()
+	if err != nil {
+		httpx.GinHTTPError(c, ActionDownloadExport, err)
+		return
+	}
+	var format *journal.Exporter
+	for _, e := range exporters {
+		if e.GetName() == req.Exporter {
+			format = e
+			break
+		}
+	}
+	if format == nil {
+		httpx.GinHTTPError(c, ActionDownloadExport, fmt.Errorf("出力形式[%s]は登録されていません", req.Exporter))
+		return
+	}
+
+	file := rowsFile{
+		ext:   format.GetExtension(),
+		comma: []rune(format.GetSeparatorChar())[0],
+	}
+	if format.GetCharEncoding() == "Shift-JIS" {
+		file.encoding = "sjis"
+	}
+
+	downloadRowsFile(c, "journal_"+format.GetName(), file, func() ([][]string, error) {
+		result, err := journalx.ExportJournal(db, appID, userID, req, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		var report []string
+		errors := 0
+		for _, i := range result.GetIssues() {
+			report = append(report, i.GetMessage())
+			if i.GetLevel() == "error" {
+				errors++
+			}
+		}
+		if errors > 0 {
+			return nil, &rowsError{
+				message: fmt.Sprintf("仕訳の出力チェックでエラーが%d件あります", errors),
+				lines:   report,
+			}
+		}
+
+		var rows [][]string
+		for _, r := range result.GetRows() {
+			rows = append(rows, r.GetCells())
+		}
+		return rows, nil
+	})
+
+	loggerx.InfoLog(c, ActionDownloadExport, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, JournalProcessName, ActionDownloadExport)),
+		Data:    gin.H{},
+	})
+}
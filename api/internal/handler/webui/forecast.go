@@ -0,0 +1,106 @@
+package webui
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"rxcsoft.cn/pit3/api/internal/common/httpx"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/leasex"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/msg"
+)
+
+// log出力
+const (
+	ActionForecast         = "Forecast"
+	ActionDownloadForecast = "DownloadForecast"
+)
+
+// Forecast 租赁现金流和损益的预测(集计期间单位,按契约字段分组)
+// @Router /forecast [POST]
+func (r *Prs) Forecast(c *gin.Context) {
+	loggerx.InfoLog(c, ActionForecast, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	var req typesx.ForecastParam
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionForecast, err)
+		return
+	}
+
+	groups, err := leasex.Forecast(db, appID, userID, req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionForecast, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionForecast, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I003, fmt.Sprintf(httpx.Temp, ReportProcessName, ActionForecast)),
+		Data:    groups,
+	})
+}
+
+// DownloadForecast 租赁现金流和损益的预测,以csv或xlsx文件的方式下载
+// @Router /forecast/download [POST]
+func (r *Prs) DownloadForecast(c *gin.Context) {
+	loggerx.InfoLog(c, ActionDownloadForecast, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	// 从body中获取参数
+	var req typesx.ForecastParam
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionDownloadForecast, err)
+		return
+	}
+
+	// 金额列(第5列起)按数值写入
+	downloadRows(c, "forecast", 4, func() ([][]string, error) {
+		groups, err := leasex.Forecast(db, appID, userID, req)
+		if err != nil {
+			return nil, err
+		}
+		return forecastRows(groups), nil
+	})
+
+	loggerx.InfoLog(c, ActionDownloadForecast, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, ReportProcessName, ActionDownloadForecast)),
+		Data:    gin.H{},
+	})
+}
+
+// forecastRows 预测结果编辑为文件的行(第一行为标题)
+func forecastRows(groups []*typesx.ForecastGroup) [][]string {
+	rows := [][]string{
+		{"グループ", "契約件数", "期間開始", "期間終了", "支払リース料", "支払利息", "減価償却費", "期末リース負債", "期末使用権資産"},
+	}
+	for _, g := range groups {
+		for _, a := range g.Amounts {
+			rows = append(rows, []string{
+				g.Group,
+				strconv.Itoa(g.Contracts),
+				a.Startym,
+				a.Endym,
+				a.Payment.String(),
+				a.Interest.String(),
+				a.Syokyaku.String(),
+				a.Balance.String(),
+				a.Boka.String(),
+			})
+		}
+	}
+	return rows
+}
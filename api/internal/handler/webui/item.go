@@ -67,6 +67,7 @@ const (
 	ActionMutilModifyItem      = "MutilModifyItem"
 	ActionChangeDebt           = "ChangeDebt"
 	ActionContractExpire       = "ContractExpire"
+	ActionImpairContract       = "ImpairContract"
 	ActionTerminateContract    = "TerminateContract"
 	ActionInventoryItem        = "InventoryItem"
 	ActionMutilInventoryItem   = "MutilInventoryItem"
@@ -216,7 +217,7 @@ func (i *Item) FindItem(c *gin.Context) {
 	})
 }
 
-// FindRishiritsu 通过database_Id获取数据
+// FindRishiritsu 通过database_Id获取追加借入利子率(返回利率曲线、期间点和插值方式)
 // @Router /database/{d_id}/rishiritsu [get]
 func (i *Item) FindRishiritsu(c *gin.Context) {
 	loggerx.InfoLog(c, ActionFindRishiritsu, loggerx.MsgProcessStarted)
@@ -227,6 +228,8 @@ func (i *Item) FindRishiritsu(c *gin.Context) {
 	req.DatastoreId = c.Param("d_id")
 	req.Leasekikan = c.Query("lease_kikan")
 	req.Leasestymd = c.Query("lease_stymd")
+	req.Currency = c.Query("currency")
+	req.Method = c.Query("method")
 	req.Database = sessionx.GetUserCustomer(c)
 
 	response, err := itemService.FindRishiritsu(context.TODO(), &req)
@@ -235,18 +238,17 @@ func (i *Item) FindRishiritsu(c *gin.Context) {
 		return
 	}
 
-	var rishiritsu interface{}
-	for key, value := range response.GetItem().GetItems() {
-		if key == "rishiritsu" {
-			rishiritsu = transferx.TransferData(value)
-		}
-	}
-
 	loggerx.InfoLog(c, ActionFindRishiritsu, loggerx.MsgProcessEnded)
 	c.JSON(200, httpx.Response{
 		Status:  0,
 		Message: msg.GetMsg("ja-JP", msg.Info, msg.I003, fmt.Sprintf(httpx.Temp, ItemProcessName, ActionFindRishiritsu)),
-		Data:    rishiritsu,
+		Data: gin.H{
+			"rishiritsu":  response.GetRishiritsu(),
+			"curve":       response.GetCurve(),
+			"method":      response.GetMethod(),
+			"term":        response.GetTerm(),
+			"used_points": response.GetUsedPoints(),
+		},
 	})
 }
 
@@ -364,6 +366,9 @@ func (i *Item) AddItem(c *gin.Context) {
 	}
 	loggerx.SuccessLog(c, ActionAddItem, fmt.Sprintf("Item[%s] Add Success", response.GetItemId()))
 
+	// 物价指数登录的场合,联动契约的再测定试算
+	notifyIndexRemeasure(c, datastore, nReq.GetItems())
+
 	code := "I_014"
 	param := wsx.MessageParam{
 		Sender:  "SYSTEM",
@@ -50,6 +50,7 @@ const (
 	ActionImportJournal       = "ImportJournal"
 	ActionModifyJournal       = "ModifyJournal"
 	ActionJournalConfim       = "JournalConfim"
+	ActionReverseJournal      = "ReverseJournal"
 	ActionFindSakuseiData     = "FindSakuseiData"
 	ActionAddDownloadSetting  = "AddDownloadSetting"
 	ActionFindDownloadSetting = "FindDownloadSetting"
@@ -500,6 +501,46 @@ func (f *Journal) JournalConfim(c *gin.Context) {
 	}
 	lastDay := getMonthLastDay(handleDate)
 
+	// 确定前验证贷借平衡,不一致的场合不确定
+	imbalances, err := journalx.CheckBalance(db, appID, userID, handleMonth)
+	if err == nil && len(imbalances) > 0 {
+		var errs []string
+		for _, im := range imbalances {
+			errs = append(errs, im.Error())
+		}
+		err = errors.New(i18n.Tr(lang, "job.J_074"))
+		path := filex.WriteAndSaveFile(domain, appID, errs)
+		// 发送消息 贷借不一致，终止任务
+		jobx.ModifyTask(task.ModifyRequest{
+			JobId:       jobID,
+			Message:     err.Error(),
+			CurrentStep: "journal-confim",
+			EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+			ErrorFile: &task.File{
+				Url:  path.MediaLink,
+				Name: path.Name,
+			},
+			Database: db,
+		}, userID)
+		return
+	}
+	if err != nil {
+		path := filex.WriteAndSaveFile(domain, appID, []string{err.Error()})
+		// 发送消息 收集数据情报失败 终止任务
+		jobx.ModifyTask(task.ModifyRequest{
+			JobId:       jobID,
+			Message:     err.Error(),
+			CurrentStep: "collect-data",
+			EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+			ErrorFile: &task.File{
+				Url:  path.MediaLink,
+				Name: path.Name,
+			},
+			Database: db,
+		}, userID)
+		return
+	}
+
 	itemService := item.NewItemService("database", client.DefaultClient)
 
 	for _, datastoreID := range datastoreIDs {
@@ -545,6 +586,46 @@ func (f *Journal) JournalConfim(c *gin.Context) {
 	})
 }
 
+// ReverseJournal 已确定分录的取消(在处理月度作成贷借对调的分录,不删除已确定数据)
+// @Router /journals/reverse [post]
+func (f *Journal) ReverseJournal(c *gin.Context) {
+	loggerx.InfoLog(c, ActionReverseJournal, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+	lang := sessionx.GetCurrentLanguage(c)
+	domain := sessionx.GetUserDomain(c)
+	owners := sessionx.GetUserOwner(c)
+
+	var req typesx.ReverseJournalParam
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionReverseJournal, err)
+		return
+	}
+	if len(req.Shiwakeno) == 0 {
+		httpx.GinHTTPError(c, ActionReverseJournal, errors.New("仕訳番号を指定してください"))
+		return
+	}
+
+	shiwakeno, count, err := journalx.ReverseJournal(domain, db, appID, userID, lang, owners, req.Shiwakeno, req.Keiyakuno)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionReverseJournal, err)
+		return
+	}
+	loggerx.SuccessLog(c, ActionReverseJournal, fmt.Sprintf("Journal %s reversed by %s", req.Shiwakeno, shiwakeno))
+
+	loggerx.InfoLog(c, ActionReverseJournal, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, JournalProcessName, ActionReverseJournal)),
+		Data: gin.H{
+			"shiwakeno": shiwakeno,
+			"total":     count,
+		},
+	})
+}
+
 // AddDatastoreMapping 添加分录下载设置
 // @Router /download/setting[post]
 func (f *Journal) AddDownloadSetting(c *gin.Context) {
@@ -620,6 +701,8 @@ func (f *Journal) SwkDownload(c *gin.Context) {
 	domain := sessionx.GetUserDomain(c)
 	db := sessionx.GetUserCustomer(c)
 	appRoot := "app_" + appID
+	// 账簿(未指定的场合下载全部账簿的分录)
+	book := c.Query("book")
 
 	go func() {
 
@@ -671,9 +754,21 @@ func (f *Journal) SwkDownload(c *gin.Context) {
 			Database:    db,
 		}, userID)
 
+		var conditions []*item.Condition
+		if len(book) > 0 {
+			conditions = append(conditions, &item.Condition{
+				FieldId:     "book",
+				FieldType:   "text",
+				SearchValue: book,
+				Operator:    "=",
+				IsDynamic:   true,
+			})
+		}
+
 		cReq := item.CountRequest{
 			AppId:         appID,
 			DatastoreId:   datastoreID,
+			ConditionList: conditions,
 			ConditionType: "and",
 			Owners:        owners,
 			Database:      db,
@@ -730,6 +825,7 @@ func (f *Journal) SwkDownload(c *gin.Context) {
 		dReq := item.DownloadRequest{
 			AppId:         appID,
 			DatastoreId:   datastoreID,
+			ConditionList: conditions,
 			ConditionType: "and",
 			Owners:        owners,
 			Database:      db,
@@ -18,6 +18,7 @@ import (
 	"rxcsoft.cn/pit3/api/internal/system/sessionx"
 	"rxcsoft.cn/pit3/api/internal/system/wfx"
 	"rxcsoft.cn/pit3/api/internal/system/wsx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
 	"rxcsoft.cn/pit3/lib/msg"
 	"rxcsoft.cn/pit3/srv/database/proto/approve"
 	"rxcsoft.cn/pit3/srv/database/proto/datastore"
@@ -29,6 +30,9 @@ const (
 	LeaseProcessName        = "Lease"
 	ActionGeneratePay       = "GeneratePay"
 	ActionComputeLeaserepay = "ComputeLeaserepay"
+	ActionRemeasureIndex    = "RemeasureIndex"
+	ActionSimulate          = "Simulate"
+	ActionFindSimulation    = "FindSimulation"
 )
 
 // ModifyContract 契约情报变更
@@ -142,27 +146,27 @@ func (i *Item) ModifyContract(c *gin.Context) {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
-		paymentleasefee, err := strconv.ParseFloat(it.Items["paymentleasefee"].GetValue(), 64)
+		paymentleasefee, err := leasecalc.ParseMoney(it.Items["paymentleasefee"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
-		paymentleasefeehendo, err := strconv.ParseFloat(it.Items["paymentleasefeehendo"].GetValue(), 64)
+		paymentleasefeehendo, err := leasecalc.ParseMoney(it.Items["paymentleasefeehendo"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
-		incentives, err := strconv.ParseFloat(it.Items["incentives"].GetValue(), 64)
+		incentives, err := leasecalc.ParseMoney(it.Items["incentives"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
-		sonotafee, err := strconv.ParseFloat(it.Items["sonotafee"].GetValue(), 64)
+		sonotafee, err := leasecalc.ParseMoney(it.Items["sonotafee"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
-		kaiyakuson, err := strconv.ParseFloat(it.Items["kaiyakuson"].GetValue(), 64)
+		kaiyakuson, err := leasecalc.ParseMoney(it.Items["kaiyakuson"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
@@ -212,33 +216,39 @@ func (i *Item) ModifyContract(c *gin.Context) {
 	}
 	// 数据编辑到leaseData
 	for _, it := range lResp.GetItems() {
-		interest, err := strconv.ParseFloat(it.Items["interest"].GetValue(), 64)
+		interest, err := leasecalc.ParseMoney(it.Items["interest"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
-		repayment, err := strconv.ParseFloat(it.Items["repayment"].GetValue(), 64)
+		repayment, err := leasecalc.ParseMoney(it.Items["repayment"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
-		balance, err := strconv.ParseFloat(it.Items["balance"].GetValue(), 64)
+		balance, err := leasecalc.ParseMoney(it.Items["balance"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
-		present, err := strconv.ParseFloat(it.Items["present"].GetValue(), 64)
+		present, err := leasecalc.ParseMoney(it.Items["present"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
+		// 最终回端数调整额(任意项目)
+		plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+		// 账簿(主账簿为空)
+		book := it.Items["book"].GetValue()
 		paymentymd := it.Items["paymentymd"].GetValue()
 		lease := typesx.Lease{
 			Interest:   interest,
 			Repayment:  repayment,
 			Balance:    balance,
 			Present:    present,
+			Plug:       plug,
 			Paymentymd: paymentymd,
+			Book:       book,
 		}
 		leaseData = append(leaseData, lease)
 	}
@@ -265,29 +275,38 @@ func (i *Item) ModifyContract(c *gin.Context) {
 	}
 	// 数据编辑到repayData
 	for _, it := range rResp.GetItems() {
-		endboka, err := strconv.ParseFloat(it.Items["endboka"].GetValue(), 64)
+		endboka, err := leasecalc.ParseMoney(it.Items["endboka"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
-		boka, err := strconv.ParseFloat(it.Items["boka"].GetValue(), 64)
+		boka, err := leasecalc.ParseMoney(it.Items["boka"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
-		syokyaku, err := strconv.ParseFloat(it.Items["syokyaku"].GetValue(), 64)
+		syokyaku, err := leasecalc.ParseMoney(it.Items["syokyaku"].GetValue())
 		if err != nil {
 			httpx.GinHTTPError(c, ActionModifyContract, err)
 			return
 		}
+		// 最终月端数调整额(任意项目)
+		plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+		// 单一リース費用(经营租赁,任意项目)
+		leasecost, _ := leasecalc.ParseMoney(it.Items["leasecost"].GetValue())
 		syokyakuymd := it.Items["syokyakuymd"].GetValue()
 		syokyakukbn := it.Items["syokyakukbn"].GetValue()
+		// 账簿(主账簿为空)
+		book := it.Items["book"].GetValue()
 		rePayment := typesx.RePayment{
 			Endboka:     endboka,
 			Boka:        boka,
 			Syokyaku:    syokyaku,
+			Plug:        plug,
+			Leasecost:   leasecost,
 			Syokyakuymd: syokyakuymd,
 			Syokyakukbn: syokyakukbn,
+			Book:        book,
 		}
 		repayData = append(repayData, rePayment)
 	}
@@ -1024,6 +1043,188 @@ func (i *Item) ContractExpire(c *gin.Context) {
 	})
 }
 
+// ImpairContract 使用権資産减损(含减损回转)
+// @Router /datastores/{d_id}/items/{i_id}/impairment [put]
+func (i *Item) ImpairContract(c *gin.Context) {
+	loggerx.InfoLog(c, ActionImpairContract, loggerx.MsgProcessStarted)
+
+	datastore := c.Param("d_id")
+	itemID := c.Param("i_id")
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+	domain := sessionx.GetUserDomain(c)
+	groupID := sessionx.GetUserGroup(c)
+	owners := sessionx.GetUserOwner(c)
+
+	wks := wfx.GetUserWorkflow(db, groupID, appID, datastore, "contract-impair")
+	if len(wks) > 0 {
+		itemService := item.NewItemService("database", client.DefaultClient)
+
+		var iReq item.ItemRequest
+		iReq.DatastoreId = datastore
+		iReq.ItemId = itemID
+		iReq.Database = db
+		iReq.IsOrigin = true
+		iReq.Owners = sessionx.GetUserAccessKeys(c, datastore, "W")
+
+		iResp, err := itemService.FindItem(context.TODO(), &iReq)
+		if err != nil {
+			httpx.GinHTTPError(c, ActionImpairContract, err)
+			return
+		}
+
+		itemMap := map[string]*approve.Value{}
+		items := iResp.GetItem().GetItems()
+
+		for key, it := range items {
+			if it.GetDataType() == "user" {
+				var uList []string
+				err := json.Unmarshal([]byte(it.GetValue()), &uList)
+				if err != nil {
+					itemMap[key] = &approve.Value{
+						DataType: it.GetDataType(),
+						Value:    "",
+					}
+				} else {
+					itemMap[key] = &approve.Value{
+						DataType: it.GetDataType(),
+						Value:    strings.Join(uList, ","),
+					}
+				}
+			} else if it.GetDataType() == "lookup" {
+				if len(it.GetValue()) > 0 {
+					result := strings.Split(it.GetValue(), " : ")
+					itemMap[key] = &approve.Value{
+						DataType: it.GetDataType(),
+						Value:    result[0],
+					}
+				} else {
+					itemMap[key] = &approve.Value{
+						DataType: it.GetDataType(),
+						Value:    "",
+					}
+				}
+			} else {
+				itemMap[key] = &approve.Value{
+					DataType: it.GetDataType(),
+					Value:    it.GetValue(),
+				}
+			}
+		}
+
+		wfID := wks[0].GetWfId()
+
+		approveService := approve.NewApproveService("database", client.DefaultClient)
+
+		var req approve.AddRequest
+		if err := c.BindJSON(&req); err != nil {
+			httpx.GinHTTPError(c, ActionImpairContract, err)
+			return
+		}
+		req.ItemId = itemID
+		req.Current = req.Items
+		req.History = itemMap
+		req.DatastoreId = datastore
+		req.AppId = appID
+		req.Writer = userID
+		req.Database = db
+		req.Domain = domain
+		req.LangCd = sessionx.GetCurrentLanguage(c)
+		// 开启流程
+		approve := new(wfx.Approve)
+		// 添加流程实例
+		exID, err := approve.AddExample(db, wfID, userID)
+		if err != nil {
+			httpx.GinHTTPError(c, ActionImpairContract, err)
+			return
+		}
+		req.ExampleId = exID
+		response, err := approveService.AddItem(context.TODO(), &req)
+		if err != nil {
+			httpx.GinHTTPError(c, ActionImpairContract, err)
+			return
+		}
+		// 流程开始启动
+		err = approve.StartExampleInstance(db, wfID, userID, exID, domain)
+		if err != nil {
+			httpx.GinHTTPError(c, ActionImpairContract, err)
+			return
+		}
+
+		loggerx.SuccessLog(c, ActionImpairContract, fmt.Sprintf("Item[%s] Add Success", response.GetItemId()))
+
+		var statusReq item.StatusRequest
+		statusReq.AppId = appID
+		statusReq.DatastoreId = datastore
+		statusReq.ItemId = itemID
+		statusReq.Database = db
+		statusReq.Writer = userID
+		statusReq.Status = "2"
+
+		_, err = itemService.ChangeStatus(context.TODO(), &statusReq)
+		if err != nil {
+			httpx.GinHTTPError(c, ActionImpairContract, err)
+			return
+		}
+
+		loggerx.InfoLog(c, ActionImpairContract, loggerx.MsgProcessEnded)
+		c.JSON(200, httpx.Response{
+			Status:  0,
+			Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, LeaseProcessName, ActionImpairContract)),
+			Data:    response,
+		})
+		c.Abort()
+		return
+	}
+
+	itemService := item.NewItemService("database", client.DefaultClient)
+
+	var req item.ImpairContractRequest
+	// 从body中获取参数
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionImpairContract, err)
+		return
+	}
+	// 从path中获取参数
+	req.DatastoreId = datastore
+	req.ItemId = itemID
+	// 从共通中获取参数
+	req.AppId = appID
+	req.Writer = userID
+	req.Owners = owners
+	req.LangCd = sessionx.GetCurrentLanguage(c)
+	req.Domain = domain
+	req.Database = db
+
+	response, err := itemService.ImpairContract(context.TODO(), &req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionImpairContract, err)
+		return
+	}
+	loggerx.SuccessLog(c, ActionImpairContract, fmt.Sprintf("item[%s] update success", req.GetItemId()))
+
+	code := "I_016"
+	param := wsx.MessageParam{
+		Sender:  "SYSTEM",
+		Domain:  sessionx.GetUserDomain(c),
+		MsgType: "normal",
+		Code:    code,
+		Link:    "/datastores/" + req.GetDatastoreId() + "/list",
+		Content: "更新数据成功，请刷新浏览器获取最新数据！",
+		Object:  "apps." + sessionx.GetCurrentApp(c) + ".datastores." + req.GetDatastoreId(),
+		Status:  "unread",
+	}
+	wsx.SendToCurrentAndParentGroup(param, sessionx.GetUserCustomer(c), sessionx.GetUserGroup(c))
+
+	loggerx.InfoLog(c, ActionImpairContract, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I005, fmt.Sprintf(httpx.Temp, LeaseProcessName, ActionImpairContract)),
+		Data:    response,
+	})
+}
+
 // GeneratePay 生成支付数据(租赁系统用)
 // @Router /generate/pay [post]
 func (i *Item) GeneratePay(c *gin.Context) {
@@ -1037,7 +1238,7 @@ func (i *Item) GeneratePay(c *gin.Context) {
 	}
 
 	// 生成支付数据(租赁系统用)
-	payData, err := leasex.GeneratePay(req)
+	payData, err := leasex.GeneratePay(sessionx.GetUserCustomer(c), sessionx.GetCurrentApp(c), req)
 	if err != nil {
 		httpx.GinHTTPError(c, ActionGeneratePay, err)
 		return
@@ -1097,10 +1298,13 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 		req.DsMap = dsMap
 
 		var tID string
-		var kisyuBoka float64
-		var hkkjitenzan float64
-		var sonnekigaku float64
-		leaseType := leasex.ShortOrMinorJudge(db, appID, req.Leasekikan, req.ExtentionOption, req.Payments)
+		var kisyuBoka leasecalc.Money
+		var hkkjitenzan leasecalc.Money
+		var sonnekigaku leasecalc.Money
+		var comparatives []leasecalc.Comparative
+		// 按资产分类的方针判定,判定理由返回给契约登录
+		exemption := leasex.ExemptionJudge(db, appID, userID, dsMap, req.Bunruicd, req.Suryo, req.Leasekikan, req.ExtentionOption, req.Payments)
+		leaseType := exemption.LeaseType
 		if leaseType != "normal_lease" {
 			result, err := leasex.InsertPay(db, appID, userID, dsMap, req.Payments, true)
 			if err != nil {
@@ -1108,6 +1312,11 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 				return
 			}
 			tID = result.TemplateID
+			// 其他账簿判定为通常リース的场合,计算该账簿的利息和偿还数据
+			if _, err := leasex.ComputeBooks(db, appID, userID, tID, req, true); err != nil {
+				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
+				return
+			}
 		} else {
 			// 计算利息和偿还数据后返回临时数据ID(租赁系统用)
 			result, err := leasex.Compute(db, appID, userID, req, true)
@@ -1119,6 +1328,7 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 			kisyuBoka = result.KiSyuBoka
 			hkkjitenzan = result.Hkkjitenzan
 			sonnekigaku = result.Sonnekigaku
+			comparatives = result.Comparatives
 		}
 
 		loggerx.InfoLog(c, ActionComputeLeaserepay, loggerx.MsgProcessEnded)
@@ -1126,16 +1336,110 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 			Status:  0,
 			Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, LeaseProcessName, ActionComputeLeaserepay)),
 			Data: gin.H{
-				"template_id": tID,
-				"lease_type":  leaseType,
-				"kisyuboka":   kisyuBoka,
-				"hkkjitenzan": hkkjitenzan,
-				"sonnekigaku": sonnekigaku,
+				"template_id":      tID,
+				"lease_type":       leaseType,
+				"exemption_reason": exemption.Reason,
+				"kisyuboka":        kisyuBoka,
+				"hkkjitenzan":      hkkjitenzan,
+				"sonnekigaku":      sonnekigaku,
+				"comparatives":     comparatives,
 			},
 		})
 		return
 	}
 
+	// 贷手契约(含转租)新规的情形
+	if section == "lessor" {
+		// 从body中获取契约情报
+		var req typesx.LessorParam
+		if err := c.BindJSON(&req); err != nil {
+			httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
+			return
+		}
+
+		datastoreService := datastore.NewDataStoreService("database", client.DefaultClient)
+
+		var dsreq datastore.DatastoresRequest
+		// 从共通获取
+		dsreq.Database = db
+		dsreq.AppId = appID
+
+		response, err := datastoreService.FindDatastores(context.TODO(), &dsreq)
+		if err != nil {
+			httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
+			return
+		}
+
+		dsMap := make(map[string]string)
+
+		for _, ds := range response.GetDatastores() {
+			dsMap[ds.ApiKey] = ds.GetDatastoreId()
+		}
+
+		req.DsMap = dsMap
+
+		// 计算受取和收益数据后返回临时数据ID(租赁系统用)
+		result, err := leasex.LessorCompute(db, appID, userID, req, true)
+		if err != nil {
+			httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
+			return
+		}
+
+		loggerx.InfoLog(c, ActionComputeLeaserepay, loggerx.MsgProcessEnded)
+		c.JSON(200, httpx.Response{
+			Status:  0,
+			Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, LeaseProcessName, ActionComputeLeaserepay)),
+			Data:    result,
+		})
+		return
+	}
+
+	// 使用権資産减损(含减损回转)的情形
+	if section == "impairment" {
+		// 从body中获取减损情报
+		var req typesx.ImpairmentParam
+		if err := c.BindJSON(&req); err != nil {
+			httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
+			return
+		}
+
+		datastoreService := datastore.NewDataStoreService("database", client.DefaultClient)
+
+		var dsreq datastore.DatastoresRequest
+		// 从共通获取
+		dsreq.Database = db
+		dsreq.AppId = appID
+
+		response, err := datastoreService.FindDatastores(context.TODO(), &dsreq)
+		if err != nil {
+			httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
+			return
+		}
+
+		dsMap := make(map[string]string)
+
+		for _, ds := range response.GetDatastores() {
+			dsMap[ds.ApiKey] = ds.GetDatastoreId()
+		}
+
+		req.DsMap = dsMap
+
+		// 减损计算后返回临时数据ID和减损前后的账面价值(租赁系统用)
+		result, err := leasex.ImpairmentCompute(db, appID, userID, req, true)
+		if err != nil {
+			httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
+			return
+		}
+
+		loggerx.InfoLog(c, ActionComputeLeaserepay, loggerx.MsgProcessEnded)
+		c.JSON(200, httpx.Response{
+			Status:  0,
+			Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, LeaseProcessName, ActionComputeLeaserepay)),
+			Data:    result,
+		})
+		return
+	}
+
 	// 债务变更的情形
 	if section == "debt" {
 		// 从body中获取契约情报
@@ -1167,7 +1471,7 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 		req.DsMap = dsMap
 
 		// 利息台账债务变更前数据和偿还台账债务变更前数据取得
-		var kisyuBoka float64
+		var kisyuBoka leasecalc.Money
 		var payData []typesx.Payment
 		var leaseData []typesx.Lease
 		var repayData []typesx.RePayment
@@ -1237,9 +1541,21 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 		keiyaItem := kres.Items[0]
 
 		if value, exist := keiyaItem.GetItems()["kisyuboka"]; exist {
-			val, _ := strconv.ParseFloat(value.GetValue(), 64)
+			val, _ := leasecalc.ParseMoney(value.GetValue())
 			kisyuBoka = val
 		}
+		// 租赁费用计上方式未指定的场合,沿用契约登录时的计上方式
+		if len(req.CostModel) == 0 {
+			req.CostModel = leasecalc.CostModel(keiyaItem.GetItems()["costmodel"].GetValue())
+		}
+		// 偿却方法未指定的场合,沿用资产分类上设定的偿却方法(指定的场合从变更年月起变更偿却方法)
+		if req.Depreciation == nil {
+			req.Depreciation, err = leasex.ContractDepreciation(db, appID, userID, dsMap, keiyaItem.GetItems())
+			if err != nil {
+				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
+				return
+			}
+		}
 
 		payAccessKeys := sessionx.GetAccessKeys(db, userID, dsMap["paymentStatus"], "R")
 
@@ -1267,27 +1583,27 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			paymentleasefee, err := strconv.ParseFloat(it.Items["paymentleasefee"].GetValue(), 64)
+			paymentleasefee, err := leasecalc.ParseMoney(it.Items["paymentleasefee"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			paymentleasefeehendo, err := strconv.ParseFloat(it.Items["paymentleasefeehendo"].GetValue(), 64)
+			paymentleasefeehendo, err := leasecalc.ParseMoney(it.Items["paymentleasefeehendo"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			incentives, err := strconv.ParseFloat(it.Items["incentives"].GetValue(), 64)
+			incentives, err := leasecalc.ParseMoney(it.Items["incentives"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			sonotafee, err := strconv.ParseFloat(it.Items["sonotafee"].GetValue(), 64)
+			sonotafee, err := leasecalc.ParseMoney(it.Items["sonotafee"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			kaiyakuson, err := strconv.ParseFloat(it.Items["kaiyakuson"].GetValue(), 64)
+			kaiyakuson, err := leasecalc.ParseMoney(it.Items["kaiyakuson"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
@@ -1337,33 +1653,39 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 		}
 		// 数据编辑到leaseData
 		for _, it := range lResp.GetItems() {
-			interest, err := strconv.ParseFloat(it.Items["interest"].GetValue(), 64)
+			interest, err := leasecalc.ParseMoney(it.Items["interest"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			repayment, err := strconv.ParseFloat(it.Items["repayment"].GetValue(), 64)
+			repayment, err := leasecalc.ParseMoney(it.Items["repayment"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			balance, err := strconv.ParseFloat(it.Items["balance"].GetValue(), 64)
+			balance, err := leasecalc.ParseMoney(it.Items["balance"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			present, err := strconv.ParseFloat(it.Items["present"].GetValue(), 64)
+			present, err := leasecalc.ParseMoney(it.Items["present"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
+			// 最终回端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+			// 账簿(主账簿为空)
+			book := it.Items["book"].GetValue()
 			paymentymd := it.Items["paymentymd"].GetValue()
 			lease := typesx.Lease{
 				Interest:   interest,
 				Repayment:  repayment,
 				Balance:    balance,
 				Present:    present,
+				Plug:       plug,
 				Paymentymd: paymentymd,
+				Book:       book,
 			}
 			leaseData = append(leaseData, lease)
 		}
@@ -1390,29 +1712,41 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 		}
 		// 数据编辑到repayData
 		for _, it := range rResp.GetItems() {
-			endboka, err := strconv.ParseFloat(it.Items["endboka"].GetValue(), 64)
+			endboka, err := leasecalc.ParseMoney(it.Items["endboka"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			boka, err := strconv.ParseFloat(it.Items["boka"].GetValue(), 64)
+			boka, err := leasecalc.ParseMoney(it.Items["boka"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			syokyaku, err := strconv.ParseFloat(it.Items["syokyaku"].GetValue(), 64)
+			syokyaku, err := leasecalc.ParseMoney(it.Items["syokyaku"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
+			// 最终月端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+			// 单一リース費用(经营租赁,任意项目)
+			leasecost, _ := leasecalc.ParseMoney(it.Items["leasecost"].GetValue())
+			// 减损累计额(任意项目)
+			genson, _ := leasecalc.ParseMoney(it.Items["genson"].GetValue())
 			syokyakuymd := it.Items["syokyakuymd"].GetValue()
 			syokyakukbn := it.Items["syokyakukbn"].GetValue()
+			// 账簿(主账簿为空)
+			book := it.Items["book"].GetValue()
 			RePayment := typesx.RePayment{
 				Endboka:     endboka,
 				Boka:        boka,
 				Syokyaku:    syokyaku,
+				Plug:        plug,
+				Leasecost:   leasecost,
+				Genson:      genson,
 				Syokyakuymd: syokyakuymd,
 				Syokyakukbn: syokyakukbn,
+				Book:        book,
 			}
 			repayData = append(repayData, RePayment)
 		}
@@ -1527,27 +1861,27 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			paymentleasefee, err := strconv.ParseFloat(it.Items["paymentleasefee"].GetValue(), 64)
+			paymentleasefee, err := leasecalc.ParseMoney(it.Items["paymentleasefee"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			paymentleasefeehendo, err := strconv.ParseFloat(it.Items["paymentleasefeehendo"].GetValue(), 64)
+			paymentleasefeehendo, err := leasecalc.ParseMoney(it.Items["paymentleasefeehendo"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			incentives, err := strconv.ParseFloat(it.Items["incentives"].GetValue(), 64)
+			incentives, err := leasecalc.ParseMoney(it.Items["incentives"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			sonotafee, err := strconv.ParseFloat(it.Items["sonotafee"].GetValue(), 64)
+			sonotafee, err := leasecalc.ParseMoney(it.Items["sonotafee"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			kaiyakuson, err := strconv.ParseFloat(it.Items["kaiyakuson"].GetValue(), 64)
+			kaiyakuson, err := leasecalc.ParseMoney(it.Items["kaiyakuson"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
@@ -1597,33 +1931,39 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 		}
 		// 数据编辑到leaseData
 		for _, it := range lResp.GetItems() {
-			interest, err := strconv.ParseFloat(it.Items["interest"].GetValue(), 64)
+			interest, err := leasecalc.ParseMoney(it.Items["interest"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			repayment, err := strconv.ParseFloat(it.Items["repayment"].GetValue(), 64)
+			repayment, err := leasecalc.ParseMoney(it.Items["repayment"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			balance, err := strconv.ParseFloat(it.Items["balance"].GetValue(), 64)
+			balance, err := leasecalc.ParseMoney(it.Items["balance"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			present, err := strconv.ParseFloat(it.Items["present"].GetValue(), 64)
+			present, err := leasecalc.ParseMoney(it.Items["present"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
+			// 最终回端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+			// 账簿(主账簿为空)
+			book := it.Items["book"].GetValue()
 			paymentymd := it.Items["paymentymd"].GetValue()
 			lease := typesx.Lease{
 				Interest:   interest,
 				Repayment:  repayment,
 				Balance:    balance,
 				Present:    present,
+				Plug:       plug,
 				Paymentymd: paymentymd,
+				Book:       book,
 			}
 			leaseData = append(leaseData, lease)
 		}
@@ -1650,29 +1990,38 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 		}
 		// 数据编辑到repayData
 		for _, it := range rResp.GetItems() {
-			endboka, err := strconv.ParseFloat(it.Items["endboka"].GetValue(), 64)
+			endboka, err := leasecalc.ParseMoney(it.Items["endboka"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			boka, err := strconv.ParseFloat(it.Items["boka"].GetValue(), 64)
+			boka, err := leasecalc.ParseMoney(it.Items["boka"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			syokyaku, err := strconv.ParseFloat(it.Items["syokyaku"].GetValue(), 64)
+			syokyaku, err := leasecalc.ParseMoney(it.Items["syokyaku"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
+			// 最终月端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+			// 单一リース費用(经营租赁,任意项目)
+			leasecost, _ := leasecalc.ParseMoney(it.Items["leasecost"].GetValue())
 			syokyakuymd := it.Items["syokyakuymd"].GetValue()
 			syokyakukbn := it.Items["syokyakukbn"].GetValue()
+			// 账簿(主账簿为空)
+			book := it.Items["book"].GetValue()
 			RePayment := typesx.RePayment{
 				Endboka:     endboka,
 				Boka:        boka,
 				Syokyaku:    syokyaku,
+				Plug:        plug,
+				Leasecost:   leasecost,
 				Syokyakuymd: syokyakuymd,
 				Syokyakukbn: syokyakukbn,
+				Book:        book,
 			}
 			repayData = append(repayData, RePayment)
 		}
@@ -1774,29 +2123,38 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 		}
 		// 数据编辑到repayData
 		for _, it := range rResp.GetItems() {
-			endboka, err := strconv.ParseFloat(it.Items["endboka"].GetValue(), 64)
+			endboka, err := leasecalc.ParseMoney(it.Items["endboka"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			boka, err := strconv.ParseFloat(it.Items["boka"].GetValue(), 64)
+			boka, err := leasecalc.ParseMoney(it.Items["boka"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
-			syokyaku, err := strconv.ParseFloat(it.Items["syokyaku"].GetValue(), 64)
+			syokyaku, err := leasecalc.ParseMoney(it.Items["syokyaku"].GetValue())
 			if err != nil {
 				httpx.GinHTTPError(c, ActionComputeLeaserepay, err)
 				return
 			}
+			// 最终月端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+			// 单一リース費用(经营租赁,任意项目)
+			leasecost, _ := leasecalc.ParseMoney(it.Items["leasecost"].GetValue())
 			syokyakuymd := it.Items["syokyakuymd"].GetValue()
 			syokyakukbn := it.Items["syokyakukbn"].GetValue()
+			// 账簿(主账簿为空)
+			book := it.Items["book"].GetValue()
 			RePayment := typesx.RePayment{
 				Endboka:     endboka,
 				Boka:        boka,
 				Syokyaku:    syokyaku,
+				Plug:        plug,
+				Leasecost:   leasecost,
 				Syokyakuymd: syokyakuymd,
 				Syokyakukbn: syokyakukbn,
+				Book:        book,
 			}
 			repayData = append(repayData, RePayment)
 		}
@@ -1817,3 +2175,136 @@ func (i *Item) ComputeLeaserepay(c *gin.Context) {
 		return
 	}
 }
+
+// RemeasureIndex 指数联动契约的再测定预览(租赁系统用)
+// 返回各契约的租赁负债和使用権資産的调整额及临时数据ID,确定时使用临时数据ID进行债务变更
+// @Router /remeasure/index [post]
+func (i *Item) RemeasureIndex(c *gin.Context) {
+	loggerx.InfoLog(c, ActionRemeasureIndex, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+	indexCode := c.Query("index_code")
+
+	previews, err := leasex.IndexRemeasure(db, appID, userID, indexCode, true)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionRemeasureIndex, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionRemeasureIndex, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, LeaseProcessName, ActionRemeasureIndex)),
+		Data:    previews,
+	})
+}
+
+// Simulate 契约台账全体的组合模拟(租赁系统用)
+// 作为后台任务执行,返回任务ID;模拟结果不更新实际的履历、支付和偿还数据
+// @Router /simulations [post]
+func (i *Item) Simulate(c *gin.Context) {
+	loggerx.InfoLog(c, ActionSimulate, loggerx.MsgProcessStarted)
+
+	domain := sessionx.GetUserDomain(c)
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+	lang := sessionx.GetCurrentLanguage(c)
+
+	var req typesx.SimulationParam
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionSimulate, err)
+		return
+	}
+
+	jobID, err := leasex.Simulate(domain, db, appID, userID, lang, req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionSimulate, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionSimulate, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, LeaseProcessName, ActionSimulate)),
+		Data: gin.H{
+			"job_id": jobID,
+		},
+	})
+}
+
+// FindSimulation 取得组合模拟的月度差额报告
+// @Router /simulations/{job_id} [get]
+func (i *Item) FindSimulation(c *gin.Context) {
+	loggerx.InfoLog(c, ActionFindSimulation, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	result, err := leasex.FindSimulation(db, userID, c.Param("job_id"))
+	if err != nil {
+		httpx.GinHTTPError(c, ActionFindSimulation, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionFindSimulation, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I003, fmt.Sprintf(httpx.Temp, LeaseProcessName, ActionFindSimulation)),
+		Data:    result,
+	})
+}
+
+// notifyIndexRemeasure 物价指数台账登录数据后,对联动契约进行再测定试算,有需要调整的契约的场合通知用户确认预览
+func notifyIndexRemeasure(c *gin.Context, datastoreID string, items map[string]*item.Value) {
+	indexCode := items["indexcode"].GetValue()
+	if len(indexCode) == 0 {
+		return
+	}
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+	domain := sessionx.GetUserDomain(c)
+	groupID := sessionx.GetUserGroup(c)
+
+	go func() {
+		datastoreService := datastore.NewDataStoreService("database", client.DefaultClient)
+
+		var dReq datastore.DatastoreRequest
+		dReq.DatastoreId = datastoreID
+		dReq.Database = db
+
+		dResponse, err := datastoreService.FindDatastore(context.TODO(), &dReq)
+		if err != nil {
+			loggerx.ErrorLog("notifyIndexRemeasure", err.Error())
+			return
+		}
+		if dResponse.GetDatastore().GetApiKey() != leasex.IndexDatastoreKey {
+			return
+		}
+
+		previews, err := leasex.IndexRemeasure(db, appID, userID, indexCode, false)
+		if err != nil {
+			loggerx.ErrorLog("notifyIndexRemeasure", err.Error())
+			return
+		}
+		if len(previews) == 0 {
+			return
+		}
+
+		param := wsx.MessageParam{
+			Sender:  "SYSTEM",
+			Domain:  domain,
+			MsgType: "normal",
+			Code:    "I_019",
+			Link:    "/remeasure/index?index_code=" + indexCode,
+			Content: fmt.Sprintf("指数[%s]已更新,%d件联动契约需要再测定,请确认预览后进行债务变更！", indexCode, len(previews)),
+			Object:  "apps." + appID + ".datastores." + datastoreID,
+			Status:  "unread",
+		}
+		wsx.SendToCurrentAndParentGroup(param, db, groupID)
+	}()
+}
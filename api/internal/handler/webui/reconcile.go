@@ -0,0 +1,83 @@
+package webui
+
+import (
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+
+	"rxcsoft.cn/pit3/api/internal/common/httpx"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/leasex"
+	"rxcsoft.cn/pit3/api/internal/common/typesx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/msg"
+)
+
+// log出力
+const (
+	ActionReconcile        = "Reconcile"
+	ActionConfirmReconcile = "ConfirmReconcile"
+)
+
+// Reconcile 预定支付与导入的实际支付的照合(一致、部分一致和未照合的明细)
+// @Router /reconcile [POST]
+func (f *Journal) Reconcile(c *gin.Context) {
+	loggerx.InfoLog(c, ActionReconcile, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	var req typesx.ReconcileParam
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionReconcile, err)
+		return
+	}
+
+	result, err := leasex.Reconcile(db, appID, userID, req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionReconcile, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionReconcile, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I003, fmt.Sprintf(httpx.Temp, JournalProcessName, ActionReconcile)),
+		Data:    result,
+	})
+}
+
+// ConfirmReconcile 确定照合的差额(支付分录作成时作为变动リース料计上)
+// @Router /reconcile/confirm [POST]
+func (f *Journal) ConfirmReconcile(c *gin.Context) {
+	loggerx.InfoLog(c, ActionConfirmReconcile, loggerx.MsgProcessStarted)
+
+	db := sessionx.GetUserCustomer(c)
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+	lang := sessionx.GetCurrentLanguage(c)
+	domain := sessionx.GetUserDomain(c)
+
+	var req typesx.ReconcileConfirmParam
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionConfirmReconcile, err)
+		return
+	}
+
+	confirmed, err := leasex.ConfirmReconcile(db, appID, userID, lang, domain, req)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionConfirmReconcile, err)
+		return
+	}
+	loggerx.SuccessLog(c, ActionConfirmReconcile, fmt.Sprintf("%d actual payments confirmed", confirmed))
+
+	loggerx.InfoLog(c, ActionConfirmReconcile, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I004, fmt.Sprintf(httpx.Temp, JournalProcessName, ActionConfirmReconcile)),
+		Data: gin.H{
+			"confirmed": confirmed,
+		},
+	})
+}
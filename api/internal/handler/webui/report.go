@@ -1648,6 +1648,8 @@ func (u *Report) ColDataDownload(c *gin.Context) {
 		//使用権資産の計算方法
 		if resp.ColDatas.GetSykshisankeisan() == "1" {
 			row = append(row, "適用開始時点から計算")
+		} else if resp.ColDatas.GetSykshisankeisan() == "3" {
+			row = append(row, "完全遡及適用")
 		} else {
 			row = append(row, "取得時点に遡って計算")
 		}
@@ -0,0 +1,269 @@
+package webui
+
+import (
+	"encoding/csv"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/360EntSecGroup-Skylar/excelize/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/kataras/i18n"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/transform"
+
+	"rxcsoft.cn/pit3/api/internal/common/excelx"
+	"rxcsoft.cn/pit3/api/internal/common/filex"
+	"rxcsoft.cn/pit3/api/internal/system/jobx"
+	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/srv/task/proto/task"
+	storagecli "rxcsoft.cn/utils/storage/client"
+)
+
+// rowsFile 行数据文件的形式
+type rowsFile struct {
+	ext      string // 扩展名(csv, txt, xlsx)
+	encoding string // 文字编码(sjis的场合为Shift-JIS,其他为UTF-8)
+	comma    rune   // 分隔符
+	bom      bool   // UTF-8的场合是否写入BOM
+	numCol   int    // xlsx中numCol以后的列按数值写入
+}
+
+// rowsError 编辑行数据时的错误(错误文件中按行出力明细)
+type rowsError struct {
+	message string
+	lines   []string
+}
+
+// Error 错误信息
+func (e *rowsError) Error() string {
+	return e.message
+}
+
+// downloadRows 以后台任务的方式编辑文件的行,写入csv或xlsx文件后保存到文件服务器
+// name为任务和文件的名称,numCol以后的列在xlsx中按数值写入
+func downloadRows(c *gin.Context, name string, numCol int, build func() ([][]string, error)) {
+	file := rowsFile{
+		ext:      "csv",
+		encoding: c.Query("encoding"),
+		comma:    ',',
+		bom:      true,
+		numCol:   numCol,
+	}
+	if c.Query("file_type") == "xlsx" {
+		file.ext = "xlsx"
+	}
+	downloadRowsFile(c, name, file, build)
+}
+
+// downloadRowsFile 以后台任务的方式编辑文件的行,按指定的形式写入文件后保存到文件服务器
+func downloadRowsFile(c *gin.Context, name string, file rowsFile, build func() ([][]string, error)) {
+	// 参数取得
+	jobID := c.Query("job_id")
+	appID := sessionx.GetCurrentApp(c)
+	userID := sessionx.GetAuthUserID(c)
+	domain := sessionx.GetUserDomain(c)
+	db := sessionx.GetUserCustomer(c)
+	lang := sessionx.GetCurrentLanguage(c)
+	appRoot := "app_" + appID
+
+	// 创建任务
+	jobx.CreateTask(task.AddRequest{
+		JobId:        jobID,
+		JobName:      "lease " + name + " download",
+		Origin:       "apps." + appID + "." + name,
+		UserId:       userID,
+		ShowProgress: false,
+		Message:      i18n.Tr(lang, "job.J_014"),
+		TaskType:     "ds-csv-download",
+		Steps:        []string{"start", "build-data", "write-to-file", "save-file", "end"},
+		CurrentStep:  "start",
+		Database:     db,
+		AppId:        appID,
+	})
+
+	// 正式处理开始
+	go func() {
+		// 发送消息 处理失败，终止任务
+		fail := func(step string, err error) {
+			lines := []string{err.Error()}
+			if re, ok := err.(*rowsError); ok {
+				lines = re.lines
+			}
+			path := filex.WriteAndSaveFile(domain, appID, lines)
+			jobx.ModifyTask(task.ModifyRequest{
+				JobId:       jobID,
+				Message:     err.Error(),
+				CurrentStep: step,
+				EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+				ErrorFile: &task.File{
+					Url:  path.MediaLink,
+					Name: path.Name,
+				},
+				Database: db,
+			}, userID)
+		}
+
+		// 发送消息 开始编辑数据
+		jobx.ModifyTask(task.ModifyRequest{
+			JobId:       jobID,
+			Message:     i18n.Tr(lang, "job.J_012"),
+			CurrentStep: "build-data",
+			Database:    db,
+		}, userID)
+
+		rows, err := build()
+		if err != nil {
+			fail("build-data", err)
+			return
+		}
+
+		// 发送消息 写入文件
+		jobx.ModifyTask(task.ModifyRequest{
+			JobId:       jobID,
+			Message:     i18n.Tr(lang, "job.J_033"),
+			CurrentStep: "write-to-file",
+			Database:    db,
+		}, userID)
+
+		timestamp := time.Now().Format("20060102150405")
+		filename := "temp/tmp_" + timestamp + "_" + name + "." + file.ext
+		filePath := path.Join(appRoot, "csv", name+"_"+timestamp+"."+file.ext)
+		contentType := "text/csv"
+		if file.ext == "xlsx" {
+			filePath = path.Join(appRoot, "excel", name+"_"+timestamp+".xlsx")
+			contentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+			err = writeRowsExcel(filename, file.numCol, rows)
+		} else {
+			if file.ext == "txt" {
+				contentType = "text/plain"
+			}
+			err = writeRowsCsv(filename, file, rows)
+		}
+		if err != nil {
+			fail("write-to-file", err)
+			return
+		}
+		defer os.Remove(filename)
+
+		// 发送消息 写入文件成功，开始保存文档到文件服务器
+		jobx.ModifyTask(task.ModifyRequest{
+			JobId:       jobID,
+			Message:     i18n.Tr(lang, "job.J_043"),
+			CurrentStep: "save-file",
+			Database:    db,
+		}, userID)
+
+		fo, err := os.Open(filename)
+		if err != nil {
+			fail("save-file", err)
+			return
+		}
+		defer fo.Close()
+
+		// 写入文件到 minio
+		minioClient, err := storagecli.NewClient(domain)
+		if err != nil {
+			fail("save-file", err)
+			return
+		}
+		path, err := minioClient.SavePublicObject(fo, filePath, contentType)
+		if err != nil {
+			fail("save-file", err)
+			return
+		}
+		// 判断顾客上传文件是否在设置的最大存储空间以内
+		if !filex.CheckCanUpload(domain, float64(path.Size)) {
+			// 如果已达上限，则删除刚才上传的文件
+			minioClient.DeleteObject(path.Name)
+			errPath := filex.WriteAndSaveFile(domain, appID, []string{"最大ストレージ容量に達しました。ファイルのアップロードに失敗しました"})
+			// 发送消息 保存文件失败，终止任务
+			jobx.ModifyTask(task.ModifyRequest{
+				JobId:       jobID,
+				Message:     i18n.Tr(lang, "job.J_007"),
+				CurrentStep: "save-file",
+				EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+				ErrorFile: &task.File{
+					Url:  errPath.MediaLink,
+					Name: errPath.Name,
+				},
+				Database: db,
+			}, userID)
+			return
+		}
+		// 如果没有超出最大值，就对顾客的已使用大小进行累加
+		if err := filex.ModifyUsedSize(domain, float64(path.Size)); err != nil {
+			fail("save-file", err)
+			return
+		}
+
+		// 发送消息 写入保存文件成功，返回下载路径，任务结束
+		jobx.ModifyTask(task.ModifyRequest{
+			JobId:       jobID,
+			Message:     i18n.Tr(lang, "job.J_028"),
+			CurrentStep: "end",
+			File: &task.File{
+				Url:  path.MediaLink,
+				Name: path.Name,
+			},
+			EndTime:  time.Now().UTC().Format("2006-01-02 15:04:05"),
+			Database: db,
+		}, userID)
+	}()
+}
+
+// writeRowsCsv 行数据按分隔符写入文件
+func writeRowsCsv(filename string, file rowsFile, rows [][]string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var writer *csv.Writer
+	if file.encoding == "sjis" {
+		converter := transform.NewWriter(f, japanese.ShiftJIS.NewEncoder())
+		writer = csv.NewWriter(converter)
+	} else {
+		writer = csv.NewWriter(f)
+		// 写入UTF-8 BOM，避免使用Microsoft Excel打开乱码
+		if file.bom {
+			if _, err := f.WriteString("\xEF\xBB\xBF"); err != nil {
+				return err
+			}
+		}
+	}
+	writer.Comma = file.comma
+	if err := writer.WriteAll(rows); err != nil {
+		return err
+	}
+	writer.Flush()
+
+	return writer.Error()
+}
+
+// writeRowsExcel 行数据写入xlsx文件(第一行为标题,numCol以后的列按数值写入)
+func writeRowsExcel(filename string, numCol int, rows [][]string) error {
+	excelFile := excelize.NewFile()
+	// 创建一个工作表
+	index := excelFile.NewSheet("Sheet1")
+	// 设置工作簿的默认工作表
+	excelFile.SetActiveSheet(index)
+
+	for i, row := range rows {
+		for j, v := range row {
+			y := excelx.GetAxisY(j+1) + strconv.Itoa(i+1)
+			// 金额列按数值写入
+			if i > 0 && j >= numCol {
+				if f, err := strconv.ParseFloat(v, 64); err == nil {
+					excelFile.SetCellValue("Sheet1", y, f)
+					continue
+				}
+			}
+			excelFile.SetCellValue("Sheet1", y, v)
+		}
+	}
+
+	return excelFile.SaveAs(filename)
+}
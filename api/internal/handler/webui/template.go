@@ -12,6 +12,7 @@ import (
 	"rxcsoft.cn/pit3/api/internal/common/loggerx"
 	"rxcsoft.cn/pit3/api/internal/common/typesx"
 	"rxcsoft.cn/pit3/api/internal/system/sessionx"
+	"rxcsoft.cn/pit3/lib/leasecalc"
 	"rxcsoft.cn/pit3/lib/msg"
 	"rxcsoft.cn/pit3/srv/database/proto/template"
 )
@@ -116,22 +117,26 @@ func (i *Template) FindTemplateItems(c *gin.Context) {
 
 // map转Lease对象
 func toLease(itemMap map[string]*template.Value) (lease typesx.Lease) {
-	interest := 0.0
-	repayment := 0.0
-	balance := 0.0
-	present := 0.0
+	var interest leasecalc.Money
+	var repayment leasecalc.Money
+	var balance leasecalc.Money
+	var present leasecalc.Money
+	var plug leasecalc.Money
 	paymentymd := ""
 	if interestvalue, ok := itemMap["interest"]; ok {
-		interest, _ = strconv.ParseFloat(interestvalue.Value, 64)
+		interest, _ = leasecalc.ParseMoney(interestvalue.Value)
 	}
 	if repaymentvalue, ok := itemMap["repayment"]; ok {
-		repayment, _ = strconv.ParseFloat(repaymentvalue.Value, 64)
+		repayment, _ = leasecalc.ParseMoney(repaymentvalue.Value)
 	}
 	if balancevalue, ok := itemMap["balance"]; ok {
-		balance, _ = strconv.ParseFloat(balancevalue.Value, 64)
+		balance, _ = leasecalc.ParseMoney(balancevalue.Value)
 	}
 	if presentvalue, ok := itemMap["present"]; ok {
-		present, _ = strconv.ParseFloat(presentvalue.Value, 64)
+		present, _ = leasecalc.ParseMoney(presentvalue.Value)
+	}
+	if plugvalue, ok := itemMap["plug"]; ok {
+		plug, _ = leasecalc.ParseMoney(plugvalue.Value)
 	}
 	if paymentymdvalue, ok := itemMap["paymentymd"]; ok {
 		paymentymd = paymentymdvalue.Value
@@ -142,6 +147,7 @@ func toLease(itemMap map[string]*template.Value) (lease typesx.Lease) {
 		Repayment:  repayment,
 		Balance:    balance,
 		Present:    present,
+		Plug:       plug,
 		Paymentymd: paymentymd,
 	}
 	return lease
@@ -149,19 +155,23 @@ func toLease(itemMap map[string]*template.Value) (lease typesx.Lease) {
 
 // map转RePayment对象
 func toRePayment(itemMap map[string]*template.Value) (rePayment typesx.RePayment) {
-	endboka := 0.0
-	boka := 0.0
-	syokyaku := 0.0
+	var endboka leasecalc.Money
+	var boka leasecalc.Money
+	var syokyaku leasecalc.Money
+	var plug leasecalc.Money
 	syokyakuymd := ""
 	syokyakukbn := ""
 	if endbokavalue, ok := itemMap["endboka"]; ok {
-		endboka, _ = strconv.ParseFloat(endbokavalue.Value, 64)
+		endboka, _ = leasecalc.ParseMoney(endbokavalue.Value)
 	}
 	if bokavalue, ok := itemMap["boka"]; ok {
-		boka, _ = strconv.ParseFloat(bokavalue.Value, 64)
+		boka, _ = leasecalc.ParseMoney(bokavalue.Value)
 	}
 	if syokyakuvalue, ok := itemMap["syokyaku"]; ok {
-		syokyaku, _ = strconv.ParseFloat(syokyakuvalue.Value, 64)
+		syokyaku, _ = leasecalc.ParseMoney(syokyakuvalue.Value)
+	}
+	if plugvalue, ok := itemMap["plug"]; ok {
+		plug, _ = leasecalc.ParseMoney(plugvalue.Value)
 	}
 	if syokyakuymdvalue, ok := itemMap["syokyakuymd"]; ok {
 		syokyakuymd = syokyakuymdvalue.Value
@@ -173,6 +183,7 @@ func toRePayment(itemMap map[string]*template.Value) (rePayment typesx.RePayment
 		Endboka:     endboka,
 		Boka:        boka,
 		Syokyaku:    syokyaku,
+		Plug:        plug,
 		Syokyakuymd: syokyakuymd,
 		Syokyakukbn: syokyakukbn,
 	}
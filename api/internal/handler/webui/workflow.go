@@ -12,6 +12,7 @@ import (
 	"rxcsoft.cn/pit3/api/internal/system/sessionx"
 	"rxcsoft.cn/pit3/api/internal/system/wfx"
 	"rxcsoft.cn/pit3/lib/msg"
+	"rxcsoft.cn/pit3/srv/database/proto/approve"
 	"rxcsoft.cn/pit3/srv/workflow/proto/node"
 	"rxcsoft.cn/pit3/srv/workflow/proto/workflow"
 )
@@ -30,6 +31,10 @@ const (
 	ActionFindActions       = "FindActions"
 	ActionDismiss           = "Dismiss"
 	ActionAdmit             = "Admit"
+	ActionWithdraw          = "Withdraw"
+	ActionReassign          = "Reassign"
+	ActionReturn            = "Return"
+	ActionResubmit          = "Resubmit"
 	ActionFindUserWorkflows = "FindUserWorkflows"
 )
 
@@ -110,6 +115,7 @@ func (t *Workflow) Admit(c *gin.Context) {
 	type Request struct {
 		ExampleID string `json:"ex_id"`
 		Comment   string `json:"comment"`
+		Version   int64  `json:"version"`
 	}
 
 	var req Request
@@ -123,7 +129,7 @@ func (t *Workflow) Admit(c *gin.Context) {
 	userID := sessionx.GetAuthUserID(c)
 
 	approve := new(wfx.Approve)
-	err := approve.Admit(db, req.ExampleID, userID, domain, req.Comment)
+	err := approve.Admit(db, req.ExampleID, userID, domain, req.Comment, req.Version)
 	if err != nil {
 		httpx.GinHTTPError(c, ActionAdmit, err)
 		return
@@ -146,6 +152,7 @@ func (t *Workflow) Dismiss(c *gin.Context) {
 	type Request struct {
 		ExampleID string `json:"ex_id"`
 		Comment   string `json:"comment"`
+		Version   int64  `json:"version"`
 	}
 
 	var req Request
@@ -159,7 +166,7 @@ func (t *Workflow) Dismiss(c *gin.Context) {
 	userID := sessionx.GetAuthUserID(c)
 
 	approve := new(wfx.Approve)
-	err := approve.Dismiss(db, req.ExampleID, userID, req.Comment)
+	err := approve.Dismiss(db, req.ExampleID, userID, req.Comment, req.Version)
 	if err != nil {
 		httpx.GinHTTPError(c, ActionDismiss, err)
 		return
@@ -173,6 +180,147 @@ func (t *Workflow) Dismiss(c *gin.Context) {
 	})
 }
 
+// Withdraw 申请者取消
+// @Router /withdraw [post]
+func (t *Workflow) Withdraw(c *gin.Context) {
+	loggerx.InfoLog(c, ActionWithdraw, loggerx.MsgProcessStarted)
+
+	type Request struct {
+		ExampleID string `json:"ex_id"`
+		Comment   string `json:"comment"`
+	}
+
+	var req Request
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionWithdraw, err)
+		return
+	}
+
+	db := sessionx.GetUserCustomer(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	approve := new(wfx.Approve)
+	err := approve.Withdraw(db, req.ExampleID, userID, req.Comment)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionWithdraw, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionWithdraw, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I006, fmt.Sprintf(httpx.Temp, WorkflowProcessName, ActionWithdraw)),
+		Data:    nil,
+	})
+}
+
+// Reassign 当前用户的审批转交给其他用户
+// @Router /reassign [post]
+func (t *Workflow) Reassign(c *gin.Context) {
+	loggerx.InfoLog(c, ActionReassign, loggerx.MsgProcessStarted)
+
+	type Request struct {
+		ExampleID string `json:"ex_id"`
+		ToUser    string `json:"to_user"`
+		Comment   string `json:"comment"`
+	}
+
+	var req Request
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionReassign, err)
+		return
+	}
+
+	db := sessionx.GetUserCustomer(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	approve := new(wfx.Approve)
+	err := approve.Reassign(db, req.ExampleID, userID, req.ToUser, userID, req.Comment)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionReassign, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionReassign, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I006, fmt.Sprintf(httpx.Temp, WorkflowProcessName, ActionReassign)),
+		Data:    nil,
+	})
+}
+
+// Return 退回给申请者修改
+// @Router /return [post]
+func (t *Workflow) Return(c *gin.Context) {
+	loggerx.InfoLog(c, ActionReturn, loggerx.MsgProcessStarted)
+
+	type Request struct {
+		ExampleID string `json:"ex_id"`
+		Comment   string `json:"comment"`
+		Version   int64  `json:"version"`
+	}
+
+	var req Request
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionReturn, err)
+		return
+	}
+
+	db := sessionx.GetUserCustomer(c)
+	userID := sessionx.GetAuthUserID(c)
+
+	wa := new(wfx.Approve)
+	err := wa.Return(db, req.ExampleID, userID, req.Comment, req.Version)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionReturn, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionReturn, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I005, fmt.Sprintf(httpx.Temp, WorkflowProcessName, ActionReturn)),
+		Data:    nil,
+	})
+}
+
+// Resubmit 申请者修改退回的审批数据后再申请
+// @Router /resubmit [post]
+func (t *Workflow) Resubmit(c *gin.Context) {
+	loggerx.InfoLog(c, ActionResubmit, loggerx.MsgProcessStarted)
+
+	type Request struct {
+		ExampleID string                    `json:"ex_id"`
+		Items     map[string]*approve.Value `json:"items"`
+		Current   map[string]*approve.Value `json:"current"`
+	}
+
+	var req Request
+	if err := c.BindJSON(&req); err != nil {
+		httpx.GinHTTPError(c, ActionResubmit, err)
+		return
+	}
+
+	db := sessionx.GetUserCustomer(c)
+	userID := sessionx.GetAuthUserID(c)
+	domain := sessionx.GetUserDomain(c)
+	lang := sessionx.GetCurrentLanguage(c)
+
+	wa := new(wfx.Approve)
+	err := wa.Resubmit(db, req.ExampleID, userID, domain, lang, req.Items, req.Current)
+	if err != nil {
+		httpx.GinHTTPError(c, ActionResubmit, err)
+		return
+	}
+
+	loggerx.InfoLog(c, ActionResubmit, loggerx.MsgProcessEnded)
+	c.JSON(200, httpx.Response{
+		Status:  0,
+		Message: msg.GetMsg("ja-JP", msg.Info, msg.I005, fmt.Sprintf(httpx.Temp, WorkflowProcessName, ActionResubmit)),
+		Data:    nil,
+	})
+}
+
 // FindUserWorkflows 查找当前台账需要流程的操作
 // @Router /workflows/{workflow_id} [delete]
 func (t *Workflow) FindUserWorkflows(c *gin.Context) {
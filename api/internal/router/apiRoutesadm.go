@@ -68,8 +68,14 @@ func initAuthRouterAdm(router *gin.Engine) {
 		appRoute.PUT("/apps/:a_id", app.ModifyApp)
 		// 修改单个APP的config记录
 		appRoute.PUT("/apps/:a_id/configs", app.ModifyAppConfigs)
+		// 修改单个APP的会计账簿
+		appRoute.PUT("/apps/:a_id/books", app.ModifyAppBooks)
 		// 下一月度处理
 		appRoute.PUT("/apps/nextMonth", app.NextMonth)
+		// 重开最近关闭的月度
+		appRoute.PUT("/apps/reopenMonth", app.ReopenMonth)
+		// 月度关闭记录
+		appRoute.GET("/apps/monthCloses", app.FindMonthCloses)
 		// 删除选中的APP记录
 		appRoute.DELETE("/apps", app.DeleteSelectApps)
 		// 物理删除APP记录
@@ -430,6 +436,8 @@ func initAuthRouterAdm(router *gin.Engine) {
 		processRoute := v1.Group("/process")
 		// 获取所有进程
 		processRoute.GET("/processes/:user_id", process.FindsProcess)
+		// 批量转交用户的待审批进程
+		processRoute.POST("/reassign", process.ReassignAll)
 		// 获取所有节点
 		processRoute.GET("/node", process.FindNodes)
 	}
@@ -71,6 +71,8 @@ func initAuthRouterWeb(router *gin.Engine) {
 		journalRoute.GET("/journals/findSakuseiData", journal.FindSakuseiData)
 		// 分录确定
 		journalRoute.GET("/journals/confim", journal.JournalConfim)
+		// 已确定分录的取消(贷借对调分录)
+		journalRoute.POST("/journals/reverse", journal.ReverseJournal)
 		// 修改分录记录
 		journalRoute.PUT("/journals/:j_id", journal.ModifyJournal)
 		// 添加分录下载设置
@@ -79,6 +81,16 @@ func initAuthRouterWeb(router *gin.Engine) {
 		journalRoute.GET("/download/find", journal.FindDownloadSetting)
 		// 分录下载
 		journalRoute.GET("/download", journal.SwkDownload)
+		// 查找分录出力适配器
+		journalRoute.GET("/exporters", journal.FindExporters)
+		// 按出力适配器的形式预览分录
+		journalRoute.POST("/export/preview", journal.PreviewExport)
+		// 按出力适配器的形式下载分录
+		journalRoute.POST("/export", journal.DownloadExport)
+		// 预定支付与实际支付的照合
+		journalRoute.POST("/reconcile", journal.Reconcile)
+		// 照合差额的确定(变动リース料分录)
+		journalRoute.POST("/reconcile/confirm", journal.ConfirmReconcile)
 	}
 
 	subject := new(webui.Subject)
@@ -282,6 +294,10 @@ func initAuthRouterWeb(router *gin.Engine) {
 		reportRoute.POST("/:rp_id/create", reports.CreateColData)
 		// 根据条件CSV下载总表数据
 		reportRoute.GET("/downloadColData", reports.ColDataDownload)
+		// 开示用注记数据(增减明细、满期分析、短期和少額リース費用等)
+		reportRoute.POST("/disclosure", reports.Disclosure)
+		// 开示用注记数据,以csv或xlsx文件下载
+		reportRoute.POST("/disclosure/download", reports.DownloadDisclosure)
 	}
 
 	// report 特殊报表的下载
@@ -290,6 +306,10 @@ func initAuthRouterWeb(router *gin.Engine) {
 		reportRoute := v1.Group("/item")
 		// 作成租赁物件本金返还预计表(明细表)数据,以csv文件下载
 		reportRoute.POST("/datastores/:d_id/prs/download", prs.DownloadPrs)
+		// 租赁现金流和损益的预测
+		reportRoute.POST("/forecast", prs.Forecast)
+		// 租赁现金流和损益的预测,以csv或xlsx文件下载
+		reportRoute.POST("/forecast/download", prs.DownloadForecast)
 	}
 
 	// dashboard
@@ -346,10 +366,17 @@ func initAuthRouterWeb(router *gin.Engine) {
 		itemRoute.POST("/generate/pay", items.GeneratePay)
 		// 计算利息和偿还数据(租赁系统用)
 		itemRoute.POST("/compute/leaserepay", items.ComputeLeaserepay)
+		// 指数联动契约的再测定预览
+		itemRoute.POST("/remeasure/index", items.RemeasureIndex)
+		// 组合模拟(后台任务)和模拟结果
+		itemRoute.POST("/simulations", items.Simulate)
+		itemRoute.GET("/simulations/:job_id", items.FindSimulation)
 		// 债务变更
 		itemRoute.PUT("/datastores/:d_id/items/:i_id/debt", items.ChangeDebt)
 		// 契约满了
 		itemRoute.PUT("/datastores/:d_id/items/:i_id/contractExpire", items.ContractExpire)
+		// 使用権資産减损(含减损回转)
+		itemRoute.PUT("/datastores/:d_id/items/:i_id/impairment", items.ImpairContract)
 		// 契约情报变更
 		itemRoute.PUT("/datastores/:d_id/items/:i_id/contract", items.ModifyContract)
 		// 中途解约
@@ -439,6 +466,26 @@ func initAuthRouterWeb(router *gin.Engine) {
 		workflowRoute.POST("/admit", workflow.Admit)
 		// 拒绝
 		workflowRoute.POST("/dismiss", workflow.Dismiss)
+		// 申请者取消
+		workflowRoute.POST("/withdraw", workflow.Withdraw)
+		// 审批转交
+		workflowRoute.POST("/reassign", workflow.Reassign)
+		// 退回修改
+		workflowRoute.POST("/return", workflow.Return)
+		// 退回后再申请
+		workflowRoute.POST("/resubmit", workflow.Resubmit)
+	}
+
+	// delegation
+	delegation := new(webui.Delegation)
+	{
+		delegationRoute := v1.Group("/workflow")
+		// 获取审批代理
+		delegationRoute.GET("/delegations", delegation.FindDelegations)
+		// 添加审批代理
+		delegationRoute.POST("/delegations", delegation.AddDelegation)
+		// 删除审批代理
+		delegationRoute.DELETE("/delegations", delegation.DeleteDelegations)
 	}
 
 	// allow
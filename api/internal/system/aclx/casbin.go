@@ -70,6 +70,7 @@ func GetCasbin_request(app string, roleid []string, userid string, path string,
 		"/internal/api/v1/web/item/datastores/:d_id/items/:i_id/terminate#PUT":      "midway_cancel",
 		"/internal/api/v1/web/item/datastores/:d_id/items/:i_id/debt#PUT":           "estimate_update",
 		"/internal/api/v1/web/item/datastores/:d_id/items/:i_id/contractExpire#PUT": "contract_expire",
+		"/internal/api/v1/web/item/datastores/:d_id/items/:i_id/impairment#PUT":     "contract_impair",
 		"/internal/api/v1/web/item/datastores/:d_id/items/print#POST":               "pdf",
 		"/internal/api/v1/web/item/clear/datastores/:d_id/items#DELETE":             "clear",
 		"/internal/api/v1/web/item/datastores/:d_id/items#PATCH":                    "group",
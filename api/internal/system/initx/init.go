@@ -10,6 +10,7 @@ import (
 	"rxcsoft.cn/pit3/api/internal/common/cryptox"
 	"rxcsoft.cn/pit3/api/internal/common/loggerx"
 	"rxcsoft.cn/pit3/srv/global/proto/logger"
+	"rxcsoft.cn/pit3/srv/manage/proto/customer"
 	"rxcsoft.cn/pit3/srv/manage/proto/role"
 	"rxcsoft.cn/pit3/srv/manage/proto/user"
 	"rxcsoft.cn/pit3/srv/task/proto/schedule"
@@ -59,6 +60,8 @@ func addDefaultSchedule() {
 	addBackupSchedule(userId)
 	// 添加备份清理任务
 	addBackupClearSchedule(userId)
+	// 添加审批期限检查任务
+	addWorkflowDeadlineSchedule(userId)
 }
 
 // 添加备份任务
@@ -183,6 +186,87 @@ func addBackupClearSchedule(userId string) {
 	}
 }
 
+// 添加审批期限检查任务
+func addWorkflowDeadlineSchedule(userId string) {
+	// 默认值
+	db := "system"
+	scheduleType := "workflow-deadline"
+
+	scheduleService := schedule.NewScheduleService("task", client.DefaultClient)
+
+	var freq schedule.SchedulesRequest
+	freq.PageIndex = 1
+	freq.PageSize = 1
+	freq.Database = db
+	freq.UserId = userId
+	freq.ScheduleType = scheduleType
+	freq.RunNow = false
+
+	response, err := scheduleService.FindSchedules(context.TODO(), &freq)
+	if err != nil {
+		loggerx.ErrorLog("addWorkflowDeadlineSchedule", err.Error())
+		return
+	}
+	// 如果已经存在，则直接返回
+	if response.GetTotal() == 1 {
+		return
+	}
+
+	// 不存在的场合，添加
+	domain := os.Getenv(defaultDomainEnv)
+	if len(domain) == 0 {
+		domain = defaultDomain
+	}
+
+	// 添加审批期限检查任务,每小时执行,按顾客设定的时区判断开始和结束日期（不存在的情况）
+	scheduleSpec := "TZ=" + defaultTimezone(domain) + " 0 * * * ?"
+
+	var req schedule.AddRequest
+	req.Writer = userId
+	req.Database = db
+	req.Params = make(map[string]string)
+	req.Params["db"] = db
+	req.Params["domain"] = domain
+	req.Params["app_id"] = "system"
+	req.Params["client_ip"] = "127.0.0.1"
+	req.ScheduleName = "workflow deadline"
+	req.Spec = scheduleSpec
+	req.Multi = 0
+	req.RetryTimes = 1
+	req.RetryInterval = 1000
+	req.StartTime = time.Now().Format("2006-01-02")
+	req.EndTime = "3000-01-01"
+	req.ScheduleType = scheduleType
+	req.RunNow = false
+	req.Status = "1"
+
+	_, err = scheduleService.AddSchedule(context.TODO(), &req)
+	if err != nil {
+		loggerx.ErrorLog("addWorkflowDeadlineSchedule", err.Error())
+		return
+	}
+}
+
+// defaultTimezone 获取顾客设定的默认时区(获取失败或未设定的场合为Asia/Tokyo)
+func defaultTimezone(domain string) string {
+	customerService := customer.NewCustomerService("manage", client.DefaultClient)
+	customerReq := customer.FindCustomerByDomainRequest{
+		Domain: domain,
+	}
+	clientele, err := customerService.FindCustomerByDomain(context.TODO(), &customerReq)
+	if err != nil {
+		loggerx.ErrorLog("defaultTimezone", err.Error())
+		return "Asia/Tokyo"
+	}
+
+	timezone := clientele.GetCustomer().GetDefaultTimezone()
+	if _, err := time.LoadLocation(timezone); len(timezone) == 0 || err != nil {
+		return "Asia/Tokyo"
+	}
+
+	return timezone
+}
+
 // 判断默认的超级管理员用户是否存在，不存在则添加
 func existSuperAdmin() bool {
 	loggerx.SystemLog(false, false, actionInitApp, fmt.Sprintf("Process FindDefaultUser:%s", loggerx.MsgProcessStarted))
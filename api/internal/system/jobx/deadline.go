@@ -0,0 +1,92 @@
+package jobx
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/micro/go-micro/v2/broker"
+	"github.com/micro/go-micro/v2/client"
+	"github.com/micro/go-plugins/broker/rabbitmq/v2"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/system/wfx"
+	"rxcsoft.cn/pit3/srv/manage/proto/customer"
+	"rxcsoft.cn/pit3/srv/task/proto/schedule"
+)
+
+// DeadlineHandler 处理超过审批期限的流程(提醒、转交给上级、自动承认或却下)
+type DeadlineHandler struct {
+}
+
+// Run 执行审批期限的检查
+func (b *DeadlineHandler) Run(schedule *schedule.Schedule) (result string, err error) {
+	var now string
+	if schedule.Spec != "" {
+		// 提取计划时区名称
+		scheduleTimezoneName := schedule.Spec[strings.Index(schedule.Spec, "=")+1 : strings.Index(schedule.Spec, " ")]
+		// 通过时区名称获取时区
+		scheduleTimezone, err := time.LoadLocation(scheduleTimezoneName)
+		if err != nil {
+			loggerx.SystemLog(true, true, "run", err.Error())
+			return "", err
+		}
+		// 获取指定时区的时间
+		now = time.Now().In(scheduleTimezone).Format("2006-01-02")
+	} else {
+		// 获取本地时区的时间
+		now = time.Now().Local().Format("2006-01-02")
+	}
+	if schedule.StartTime > now {
+		loggerx.SystemLog(true, true, "run", errNotExecutionTime.Error())
+		return "", errNotExecutionTime
+	}
+	if schedule.EndTime < now {
+		// 过期了，删除该任务
+		body, err := json.Marshal(schedule)
+		if err != nil {
+			return "", err
+		}
+
+		br := rabbitmq.NewBroker()
+
+		br.Publish("job.delete", &broker.Message{
+			Body: body,
+		})
+		// 提示任务已过期
+		loggerx.SystemLog(true, true, "run", errExpired.Error())
+		return "", errExpired
+	}
+
+	count, err := sweepDeadlines()
+	if err != nil {
+		loggerx.SystemLog(true, true, "run", err.Error())
+		return "", err
+	}
+
+	return fmt.Sprintf("%d processes handled", count), nil
+}
+
+// sweepDeadlines 检查所有顾客的待审批进程,一个顾客失败时继续处理其他顾客
+func sweepDeadlines() (int, error) {
+	customerService := customer.NewCustomerService("manage", client.DefaultClient)
+
+	var req customer.FindCustomersRequest
+	response, err := customerService.FindCustomers(context.TODO(), &req)
+	if err != nil {
+		return 0, err
+	}
+
+	total := 0
+	for _, cs := range response.GetCustomers() {
+		count, err := wfx.SweepDeadlines(cs.GetCustomerId(), cs.GetDomain())
+		if err != nil {
+			loggerx.SystemLog(true, true, "sweepDeadlines", fmt.Sprintf("customer[%s] has error: %v", cs.GetCustomerId(), err))
+			continue
+		}
+		total += count
+	}
+
+	return total, nil
+}
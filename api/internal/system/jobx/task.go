@@ -253,6 +253,8 @@ func createHandler(schedule *schedule.Schedule) Handler {
 		handler = new(RestoreHandler)
 	case "db-backup-clean":
 		handler = new(ClearHandler)
+	case "workflow-deadline":
+		handler = new(DeadlineHandler)
 	}
 
 	return handler
@@ -279,6 +281,11 @@ func afterExecJob(schedule *schedule.Schedule, taskResult Result) {
 
 	loggerx.SystemLog(false, false, "createJob", fmt.Sprintf("the [%s] job has end", schedule.ScheduleName))
 
+	// 每小时执行的审批期限检查不发送邮件
+	if schedule.ScheduleType == "workflow-deadline" {
+		return
+	}
+
 	// 发送邮件
 	go SendNotification(schedule, taskResult)
 }
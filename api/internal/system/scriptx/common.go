@@ -32,6 +32,12 @@ var datastoreActionMap = map[string][]*ActionData{
 			Method: http.MethodPut,
 		},
 	},
+	"contract_impair": {
+		{
+			Path:   "/internal/api/v1/web/item/datastores/:d_id/items/:i_id/impairment",
+			Method: http.MethodPut,
+		},
+	},
 	"pdf": {
 		{
 			Path:   "/internal/api/v1/web/item/datastores/:d_id/items/print",
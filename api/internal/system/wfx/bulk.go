@@ -0,0 +1,56 @@
+package wfx
+
+import (
+	"context"
+
+	"github.com/micro/go-micro/v2/client"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/srv/workflow/proto/process"
+)
+
+// ReassignResult 批量转交的结果
+type ReassignResult struct {
+	Reassigned []string          `json:"reassigned"`
+	Errors     map[string]string `json:"errors"`
+}
+
+// ReassignAll 将用户的待审批进程批量转交给其他用户,exIDs为空时转交全部;
+// 每个实例单独转交,失败的实例记录错误后继续处理
+func (a *Approve) ReassignAll(db, fromUser, toUser, writer, comment string, exIDs []string) (*ReassignResult, error) {
+	proceeService := process.NewProcessService("workflow", client.DefaultClient)
+
+	var req process.FindsProcessesRequest
+	req.UserId = fromUser
+	req.Database = db
+
+	response, err := proceeService.FindsProcesses(context.TODO(), &req)
+	if err != nil {
+		loggerx.ErrorLog("ReassignAll", err.Error())
+		return nil, err
+	}
+
+	target := make(map[string]bool, len(exIDs))
+	for _, id := range exIDs {
+		target[id] = true
+	}
+
+	result := &ReassignResult{
+		Errors: make(map[string]string),
+	}
+	seen := make(map[string]bool)
+	for _, p := range response.GetProcesses() {
+		exID := p.GetExId()
+		if seen[exID] || (len(target) > 0 && !target[exID]) {
+			continue
+		}
+		seen[exID] = true
+
+		if err := a.Reassign(db, exID, fromUser, toUser, writer, comment); err != nil {
+			result.Errors[exID] = err.Error()
+			continue
+		}
+		result.Reassigned = append(result.Reassigned, exID)
+	}
+
+	return result, nil
+}
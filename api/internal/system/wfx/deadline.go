@@ -0,0 +1,267 @@
+package wfx
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/micro/go-micro/v2/client"
+	"rxcsoft.cn/pit3/api/internal/common/loggerx"
+	"rxcsoft.cn/pit3/api/internal/common/logic/mailx"
+	"rxcsoft.cn/pit3/api/internal/system/wsx"
+	"rxcsoft.cn/pit3/srv/manage/proto/group"
+	"rxcsoft.cn/pit3/srv/manage/proto/user"
+	"rxcsoft.cn/pit3/srv/workflow/proto/example"
+	"rxcsoft.cn/pit3/srv/workflow/proto/node"
+	"rxcsoft.cn/pit3/srv/workflow/proto/process"
+	"rxcsoft.cn/pit3/srv/workflow/wfclient"
+)
+
+// 流程服务返回的超过处理期限时的处理
+const (
+	overdueRemind   = "remind"
+	overdueEscalate = "escalate"
+	overdueApprove  = "approve"
+	overdueReject   = "reject"
+)
+
+// SweepDeadlines 处理超过期限的待审批进程(提醒审批者、转交给上级组织的审批者、自动承认或却下),
+// 返回处理的进程数,单个进程的处理失败只记录日志
+func SweepDeadlines(db, domain string) (int, error) {
+	proceeService := process.NewProcessService("workflow", client.DefaultClient)
+
+	var req process.OverdueRequest
+	req.Database = db
+
+	response, err := proceeService.FindOverdueProcesses(context.TODO(), &req)
+	if err != nil {
+		loggerx.ErrorLog("SweepDeadlines", err.Error())
+		return 0, err
+	}
+
+	wfs := make(map[string]*WfInfo)
+	var groups []*group.Group
+	var reminded []string
+	var unescalated []string
+	count := 0
+
+	for _, o := range response.GetProcesses() {
+		wf, ok := wfs[o.GetWfId()]
+		if !ok {
+			wf, err = findWfInfo(db, o.GetWfId())
+			if err != nil {
+				continue
+			}
+			wfs[o.GetWfId()] = wf
+		}
+
+		switch o.GetAction() {
+		case overdueRemind:
+			remindApprover(db, wf, o)
+			reminded = append(reminded, o.GetProId())
+		case overdueEscalate:
+			if groups == nil {
+				groups = findGroups(db, domain)
+			}
+			manager := findManager(db, domain, wf, o, groups)
+			if len(manager) == 0 {
+				// 没有可以转交的上级审批者的场合记录为已转交,不再重复转交
+				loggerx.ErrorLog("SweepDeadlines", fmt.Sprintf("process[%s] has no manager to escalate", o.GetProId()))
+				unescalated = append(unescalated, o.GetProId())
+				continue
+			}
+			err = escalate(db, wf, o, manager)
+		case overdueApprove, overdueReject:
+			err = expire(db, wf, o)
+		default:
+			continue
+		}
+		if wfclient.IsConflict(err) {
+			// 检查后审批者已经处理的场合不处理该进程
+			loggerx.DebugLog("SweepDeadlines", fmt.Sprintf("example[%s] has been updated, skip process[%s]", o.GetExId(), o.GetProId()))
+			continue
+		}
+		if err != nil {
+			loggerx.ErrorLog("SweepDeadlines", err.Error())
+			continue
+		}
+		count++
+	}
+
+	if len(reminded) > 0 {
+		var mReq process.RemindedRequest
+		mReq.ProIds = reminded
+		mReq.Database = db
+
+		_, err = proceeService.MarkReminded(context.TODO(), &mReq)
+		if err != nil {
+			loggerx.ErrorLog("SweepDeadlines", err.Error())
+			return count, err
+		}
+	}
+
+	if len(unescalated) > 0 {
+		var eReq process.EscalatedRequest
+		eReq.ProIds = unescalated
+		eReq.Database = db
+
+		_, err = proceeService.MarkEscalated(context.TODO(), &eReq)
+		if err != nil {
+			loggerx.ErrorLog("SweepDeadlines", err.Error())
+			return count, err
+		}
+	}
+
+	return count, nil
+}
+
+// remindApprover 提醒审批者处理期限将到(通知失败只记录日志)
+func remindApprover(db string, wf *WfInfo, o *process.Overdue) {
+	// 获取申请者信息
+	userService := user.NewUserService("manage", client.DefaultClient)
+
+	var ureq user.FindUserRequest
+	ureq.UserId = o.GetApplicant()
+	ureq.Database = db
+
+	uResp, err := userService.FindUser(context.TODO(), &ureq)
+	if err != nil {
+		loggerx.ErrorLog("remindApprover", err.Error())
+		return
+	}
+
+	params := mailx.EmailParam{
+		Database:       db,
+		UserID:         o.GetUserId(),
+		AppID:          wf.Workflow.GetAppId(),
+		WorkflowID:     o.GetWfId(),
+		DatastoreID:    wf.Workflow.GetParams()["datastore"],
+		Language:       "ja-JP",
+		CreateUserName: uResp.GetUser().GetUserName(),
+		Opreate:        wf.Workflow.GetParams()["action"],
+	}
+
+	err = mailx.SendEmailToApprover(params)
+	if err != nil {
+		loggerx.ErrorLog("remindApprover", err.Error())
+	}
+
+	param := wsx.MessageParam{
+		Sender:    "SYSTEM",
+		Recipient: o.GetUserId(),
+		MsgType:   "approve",
+		Code:      "I_019",
+		Content:   "承認期限が近づいている申請がありますので、確認してください。",
+		Status:    "unread",
+	}
+	wsx.SendToUser(param)
+}
+
+// escalate 转交给上级组织的审批者(检查后实例已被更新的场合返回冲突)
+func escalate(db string, wf *WfInfo, o *process.Overdue, manager string) error {
+	comment := "承認期限を過ぎたため、上位組織の承認者に転送しました。"
+	response, err := wfclient.Reassign(db, o.GetExId(), o.GetUserId(), manager, wfclient.SystemWriter, comment, true, o.GetVersion())
+	if err != nil {
+		loggerx.ErrorLog("escalate", err.Error())
+		return err
+	}
+
+	return afterTransition(db, wf, response, wfclient.SystemWriter)
+}
+
+// expire 过期时按节点的设定由系统代为承认或却下(检查后实例已被更新的场合返回冲突)
+func expire(db string, wf *WfInfo, o *process.Overdue) error {
+	var response *example.TransitionResponse
+	var err error
+	if o.GetAction() == overdueApprove {
+		comment := "承認期限を過ぎたため、システムは承認プロセスを実行しました。"
+		response, err = wfclient.Approve(db, o.GetExId(), o.GetUserId(), wfclient.SystemWriter, comment, o.GetVersion())
+	} else {
+		comment := "承認期限を過ぎたため、システムは却下プロセスを実行しました。"
+		response, err = wfclient.Reject(db, o.GetExId(), o.GetUserId(), wfclient.SystemWriter, comment, o.GetVersion())
+	}
+	if err != nil {
+		loggerx.ErrorLog("expire", err.Error())
+		return err
+	}
+
+	return afterTransition(db, wf, response, o.GetUserId())
+}
+
+// findManager 查找审批者的上级组织中的审批者(从直接上级开始逐级查找);
+// 节点按角色指定审批者的场合查找具有该角色的用户,按用户指定的场合查找具有审批者的角色的用户,
+// 都没有的场合使用上级组织中的任意用户
+func findManager(db, domain string, wf *WfInfo, o *process.Overdue, groups []*group.Group) string {
+	var n *node.Node
+	for _, item := range wf.Nodes {
+		if item.GetNodeId() == o.GetCurrentNode() {
+			n = item
+			break
+		}
+	}
+	if n == nil {
+		return ""
+	}
+
+	// 节点的审批角色
+	var roles []string
+	for _, ap := range n.GetAssignees() {
+		as := strings.Split(ap, "_")
+		if as[0] == "r" && len(as) > 1 {
+			roles = append(roles, as[1])
+		}
+	}
+
+	// 获取审批者信息
+	userService := user.NewUserService("manage", client.DefaultClient)
+
+	var ureq user.FindUserRequest
+	ureq.UserId = o.GetUserId()
+	ureq.Database = db
+
+	uResp, err := userService.FindUser(context.TODO(), &ureq)
+	if err != nil {
+		loggerx.ErrorLog("findManager", err.Error())
+		return ""
+	}
+
+	// 按用户指定审批者的场合,按审批者的角色查找,最后查找组织中的任意用户
+	if len(roles) == 0 {
+		roles = append(roles, uResp.GetUser().GetRoles()...)
+		roles = append(roles, "")
+	}
+
+	current := findGroup(uResp.GetUser().GetGroup(), groups)
+	for current != nil {
+		current = findGroup(current.GetParentGroupId(), groups)
+		if current == nil {
+			break
+		}
+		for _, role := range roles {
+			for _, u := range findUsers(db, domain, current.GetGroupId(), role) {
+				if u != o.GetUserId() && u != o.GetApplicant() {
+					return u
+				}
+			}
+		}
+	}
+
+	return ""
+}
+
+// findGroups 获取所有group数据
+func findGroups(db, domain string) []*group.Group {
+	groupService := group.NewGroupService("manage", client.DefaultClient)
+
+	var req group.FindGroupsRequest
+	req.Domain = domain
+	req.Database = db
+
+	response, err := groupService.FindGroups(context.TODO(), &req)
+	if err != nil {
+		loggerx.ErrorLog("findGroups", err.Error())
+		return nil
+	}
+
+	return response.GetGroups()
+}
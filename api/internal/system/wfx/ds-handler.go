@@ -490,6 +490,83 @@ func (b *DsHandler) Admit(w *Work) (result string, err error) {
 		return "ok", nil
 	}
 
+	if action == "contract-impair" {
+
+		itemService := item.NewItemService("database", client.DefaultClient)
+
+		owners := sessionx.GetAccessKeys(db, userID, params["datastore"], "W")
+
+		// 从body中获取参数
+		items := map[string]*item.Value{}
+		// 项目变更后数据
+		for key, it := range tResp.GetItem().GetItems() {
+			if it.GetDataType() == "user" {
+				var uList []string
+				err := json.Unmarshal([]byte(it.GetValue()), &uList)
+				if err != nil {
+					items[key] = &item.Value{
+						DataType: it.GetDataType(),
+						Value:    "",
+					}
+				} else {
+					items[key] = &item.Value{
+						DataType: it.GetDataType(),
+						Value:    strings.Join(uList, ","),
+					}
+				}
+			} else if it.GetDataType() == "lookup" {
+				if len(it.GetValue()) > 0 {
+					result := strings.Split(it.GetValue(), " : ")
+					items[key] = &item.Value{
+						DataType: it.GetDataType(),
+						Value:    result[0],
+					}
+				} else {
+					items[key] = &item.Value{
+						DataType: it.GetDataType(),
+						Value:    "",
+					}
+				}
+			} else {
+				items[key] = &item.Value{
+					DataType: it.GetDataType(),
+					Value:    it.GetValue(),
+				}
+			}
+		}
+
+		var mReq item.ImpairContractRequest
+		// 从path中获取参数
+		mReq.DatastoreId = tResp.GetItem().GetDatastoreId()
+		mReq.ItemId = tResp.GetItem().GetItemId()
+		mReq.Items = items
+		// 从共通中获取参数
+		mReq.AppId = tResp.GetItem().GetAppId()
+		mReq.Writer = userID
+		mReq.Owners = owners
+		mReq.Database = db
+
+		_, err := itemService.ImpairContract(context.TODO(), &mReq)
+		if err != nil {
+			return "fail", err
+		}
+
+		var statusReq item.StatusRequest
+		statusReq.AppId = tResp.GetItem().GetAppId()
+		statusReq.DatastoreId = tResp.GetItem().GetDatastoreId()
+		statusReq.ItemId = tResp.GetItem().GetItemId()
+		statusReq.Database = db
+		statusReq.Writer = userID
+		statusReq.Status = "1"
+
+		_, err = itemService.ChangeStatus(context.TODO(), &statusReq)
+		if err != nil {
+			return "fail", err
+		}
+
+		return "ok", nil
+	}
+
 	if action == "delete" {
 
 		itemService := item.NewItemService("database", client.DefaultClient)
@@ -167,6 +167,24 @@ func (t *Workflow) AddWorkflow(c *gin.Context) {
 	wReq.AppId = appId
 	wReq.Writer = user
 	wReq.Database = db
+	// 流程服务检查流程图(无法到达的节点、循环、条件式)
+	for _, n := range req.Nodes {
+		if len(n.NodeType) == 0 {
+			n.NodeType = "1"
+		}
+		gn := &workflow.GraphNode{
+			NodeId:   n.GetNodeId(),
+			NodeType: n.GetNodeType(),
+			NextNode: n.GetNextNode(),
+		}
+		for _, r := range n.GetRoutes() {
+			gn.Routes = append(gn.Routes, &workflow.GraphRoute{
+				Guard:    r.GetGuard(),
+				NextNode: r.GetNextNode(),
+			})
+		}
+		wReq.Nodes = append(wReq.Nodes, gn)
+	}
 
 	response, err := workflowService.AddWorkflow(context.TODO(), &wReq)
 	if err != nil {
@@ -216,7 +234,9 @@ func (t *Workflow) AddWorkflow(c *gin.Context) {
 	nodeService := node.NewNodeService("workflow", client.DefaultClient)
 	for _, nReq := range req.Nodes {
 		nReq.WfId = response.GetWfId()
-		nReq.NodeType = "1"
+		if len(nReq.NodeType) == 0 {
+			nReq.NodeType = "1"
+		}
 		nReq.Writer = user
 		nReq.Database = db
 
@@ -508,6 +528,7 @@ func (t *Workflow) Admit(c *gin.Context) {
 	type Request struct {
 		ExampleID string `json:"ex_id"`
 		Comment   string `json:"comment"`
+		Version   int64  `json:"version"`
 	}
 
 	var req Request
@@ -521,7 +542,7 @@ func (t *Workflow) Admit(c *gin.Context) {
 	userID := sessionx.GetAuthUserID(c)
 
 	approve := new(wfx.Approve)
-	err := approve.Admit(db, req.ExampleID, userID, domain, req.Comment)
+	err := approve.Admit(db, req.ExampleID, userID, domain, req.Comment, req.Version)
 	if err != nil {
 		httpx.GinHTTPError(c, ActionAdmit, err)
 		return
@@ -555,6 +576,7 @@ func (t *Workflow) Dismiss(c *gin.Context) {
 	type Request struct {
 		ExampleID string `json:"ex_id"`
 		Comment   string `json:"comment"`
+		Version   int64  `json:"version"`
 	}
 
 	var req Request
@@ -568,7 +590,7 @@ func (t *Workflow) Dismiss(c *gin.Context) {
 	userID := sessionx.GetAuthUserID(c)
 
 	approve := new(wfx.Approve)
-	err := approve.Dismiss(db, req.ExampleID, userID, req.Comment)
+	err := approve.Dismiss(db, req.ExampleID, userID, req.Comment, req.Version)
 	if err != nil {
 		httpx.GinHTTPError(c, ActionDismiss, err)
 		return
@@ -0,0 +1,138 @@
+package leasecalc
+
+import (
+	"fmt"
+)
+
+// Standard 会计基准
+type Standard string
+
+const (
+	// StandardIFRS16 IFRS第16号
+	StandardIFRS16 Standard = "ifrs16"
+	// StandardJGAAP 日本基准(リース会計基準)
+	StandardJGAAP Standard = "jgaap"
+	// StandardASC842 米国基准ASC 842
+	StandardASC842 Standard = "asc842"
+)
+
+// RateSource 账簿使用的割引率来源
+type RateSource string
+
+const (
+	// RateContract 使用契约登录的割引率
+	RateContract RateSource = "contract"
+	// RateCurve 使用追加借入利子率曲线插值的利率
+	RateCurve RateSource = "curve"
+)
+
+// PrimaryBookID 主账簿ID(app设定的账簿,利息和偿还数据上不记录账簿)
+const PrimaryBookID = "primary"
+
+// Book 会计账簿(同一契约按账簿各自计算利息和偿还数据,各自生成分录)
+type Book struct {
+	BookID                string              `json:"book_id" bson:"book_id"`                                 // 账簿ID
+	BookName              string              `json:"book_name" bson:"book_name"`                             // 账簿名
+	Standard              Standard            `json:"standard" bson:"standard"`                               // 会计基准
+	ShortLeases           int                 `json:"short_leases" bson:"short_leases"`                       // 短期リース判定期间(月)
+	MinorBaseAmount       Money               `json:"minor_base_amount" bson:"minor_base_amount"`             // 少額リース基准额
+	RateSource            RateSource          `json:"rate_source" bson:"rate_source"`                         // 割引率来源
+	CurveMethod           InterpolationMethod `json:"curve_method" bson:"curve_method"`                       // 利率曲线插值方式
+	OperatingStraightLine bool                `json:"operating_straight_line" bson:"operating_straight_line"` // 经营租赁按定额计上费用(ASC 842)
+}
+
+// Validate 账簿设定检查
+func (b Book) Validate() error {
+	if len(b.BookID) == 0 {
+		return fmt.Errorf("leasecalc: book id is empty")
+	}
+	if b.BookID == PrimaryBookID {
+		return fmt.Errorf("leasecalc: book id %q is reserved for the primary book", b.BookID)
+	}
+	switch b.Standard {
+	case StandardIFRS16, StandardJGAAP, StandardASC842:
+	default:
+		return fmt.Errorf("leasecalc: book %s has unknown standard %q", b.BookID, b.Standard)
+	}
+	switch b.RateSource {
+	case RateContract, RateCurve:
+	default:
+		return fmt.Errorf("leasecalc: book %s has unknown rate source %q", b.BookID, b.RateSource)
+	}
+	if b.OperatingStraightLine && b.Standard != StandardASC842 {
+		return fmt.Errorf("leasecalc: book %s: straight-line operating lease cost applies to ASC 842 only", b.BookID)
+	}
+	if b.ShortLeases < 0 || b.MinorBaseAmount < 0 {
+		return fmt.Errorf("leasecalc: book %s has negative thresholds", b.BookID)
+	}
+	return nil
+}
+
+// ValidateBooks 账簿一览检查(账簿ID不能重复)
+func ValidateBooks(books []Book) error {
+	ids := make(map[string]bool, len(books))
+	for _, b := range books {
+		if err := b.Validate(); err != nil {
+			return err
+		}
+		if ids[b.BookID] {
+			return fmt.Errorf("leasecalc: duplicate book id %s", b.BookID)
+		}
+		ids[b.BookID] = true
+	}
+	return nil
+}
+
+// Judge 按账簿的判定基准进行短期リースまたは少額リース判定
+func (b Book) Judge(leasekikan, extentionOption int, payments []Payment) string {
+	return ShortOrMinorJudge(b.MinorBaseAmount, b.ShortLeases, leasekikan, extentionOption, payments)
+}
+
+// BookOf 数据上记录的账簿(未记录的场合为主账簿)
+func BookOf(book string) string {
+	if len(book) == 0 {
+		return PrimaryBookID
+	}
+	return book
+}
+
+// LeasesOfBook 取得指定账簿的利息数据
+func LeasesOfBook(leases []Lease, bookID string) (ls []Lease) {
+	for _, l := range leases {
+		if BookOf(l.Book) == BookOf(bookID) {
+			ls = append(ls, l)
+		}
+	}
+	return ls
+}
+
+// RePaymentsOfBook 取得指定账簿的偿还数据
+func RePaymentsOfBook(repays []RePayment, bookID string) (rs []RePayment) {
+	for _, r := range repays {
+		if BookOf(r.Book) == BookOf(bookID) {
+			rs = append(rs, r)
+		}
+	}
+	return rs
+}
+
+// InitialBoka 账簿的原始取得价值(偿还数据第一条的期首薄价)
+func InitialBoka(repays []RePayment) Money {
+	if len(repays) == 0 {
+		return 0
+	}
+	return repays[0].Boka
+}
+
+// SetBook 在计算结果的利息和偿还数据上记录账簿
+func SetBook(bookID string, leases []Lease, repays []RePayment) {
+	if bookID == PrimaryBookID {
+		bookID = ""
+	}
+	for i := range leases {
+		leases[i].Book = bookID
+	}
+	for i := range repays {
+		repays[i].Book = bookID
+	}
+}
@@ -0,0 +1,71 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestValidateBooks(t *testing.T) {
+	jgaap := Book{BookID: "jgaap", Standard: StandardJGAAP, RateSource: RateContract}
+	asc := Book{BookID: "us", Standard: StandardASC842, RateSource: RateCurve, OperatingStraightLine: true}
+	if err := ValidateBooks([]Book{jgaap, asc}); err != nil {
+		t.Fatalf("ValidateBooks() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		books []Book
+	}{
+		{name: "empty_id", books: []Book{{Standard: StandardIFRS16, RateSource: RateContract}}},
+		{name: "primary_id", books: []Book{{BookID: PrimaryBookID, Standard: StandardIFRS16, RateSource: RateContract}}},
+		{name: "unknown_standard", books: []Book{{BookID: "x", Standard: "gaap", RateSource: RateContract}}},
+		{name: "unknown_rate_source", books: []Book{{BookID: "x", Standard: StandardIFRS16}}},
+		{name: "straight_line_not_asc842", books: []Book{{BookID: "x", Standard: StandardIFRS16, RateSource: RateContract, OperatingStraightLine: true}}},
+		{name: "duplicate", books: []Book{jgaap, jgaap}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateBooks(tt.books); err == nil {
+				t.Errorf("ValidateBooks() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestBookJudge(t *testing.T) {
+	pays := []Payment{
+		{Paymentleasefee: MoneyFromInt(100000)},
+		{Paymentleasefee: MoneyFromInt(100000)},
+	}
+	ifrs := Book{BookID: "ifrs", ShortLeases: 12, MinorBaseAmount: MoneyFromInt(100000)}
+	jgaap := Book{BookID: "jgaap", ShortLeases: 12, MinorBaseAmount: MoneyFromInt(3000000)}
+
+	if got := ifrs.Judge(24, 0, pays); got != "normal_lease" {
+		t.Errorf("ifrs.Judge() = %v, want normal_lease", got)
+	}
+	if got := jgaap.Judge(24, 0, pays); got != "minor_lease" {
+		t.Errorf("jgaap.Judge() = %v, want minor_lease", got)
+	}
+	if got := ifrs.Judge(6, 6, pays); got != "short_lease" {
+		t.Errorf("ifrs.Judge() = %v, want short_lease", got)
+	}
+}
+
+func TestBookFilter(t *testing.T) {
+	leases := []Lease{{Paymentymd: "2020-04-30"}, {Paymentymd: "2020-04-30", Book: "us"}}
+	repays := []RePayment{{Boka: MoneyFromInt(100)}, {Boka: MoneyFromInt(90), Book: "us"}}
+
+	if ls := LeasesOfBook(leases, PrimaryBookID); len(ls) != 1 || ls[0].Book != "" {
+		t.Errorf("LeasesOfBook(primary) = %v", ls)
+	}
+	if ls := LeasesOfBook(leases, "us"); len(ls) != 1 || ls[0].Book != "us" {
+		t.Errorf("LeasesOfBook(us) = %v", ls)
+	}
+	if got := InitialBoka(RePaymentsOfBook(repays, "us")); got != MoneyFromInt(90) {
+		t.Errorf("InitialBoka(us) = %v, want 90", got)
+	}
+
+	SetBook(PrimaryBookID, leases, repays)
+	if leases[1].Book != "" || repays[1].Book != "" {
+		t.Errorf("SetBook(primary) left book %q/%q, want empty", leases[1].Book, repays[1].Book)
+	}
+}
@@ -1,26 +1,33 @@
-package leasex
+package leasecalc
 
 import (
 	"errors"
 	"reflect"
 	"time"
-
-	"rxcsoft.cn/pit3/api/internal/common/typesx"
 )
 
 // 処理月度の翌月からリース料変更可能 and 変更年月の翌月から変動リース料編集可能
-func payChangeableCheck(hym, sym string, oldpays []typesx.Payment, newpays []typesx.Payment) (err error) {
+func payChangeableCheck(hym, sym string, oldpays []Payment, newpays []Payment) (err error) {
 	// 変更年月
-	henkouym, _ := time.Parse("2006-01", hym)
+	henkouym, err := time.Parse("2006-01", hym)
+	if err != nil {
+		return err
+	}
 	// 处理月度
-	syoriym, _ := time.Parse("2006-01", sym)
+	syoriym, err := time.Parse("2006-01", sym)
+	if err != nil {
+		return err
+	}
 
 	// 旧支払データから変更年月前（変更年月も含む）の支払データと処理月度前（処理月度も含む）の支払データの支払リース料を取得
-	var oldpayfee []float64
-	var oldPrePays []typesx.Payment
+	var oldpayfee []Money
+	var oldPrePays []Payment
 	for _, pay := range oldpays {
 		// 支付年月
-		paymentym, _ := time.Parse("2006-01", pay.Paymentymd[0:7])
+		paymentym, err := time.Parse("2006-01", pay.Paymentymd[0:7])
+		if err != nil {
+			return err
+		}
 		// 支払データ
 		if paymentym.Before(henkouym) || paymentym.Equal(henkouym) {
 			pay.Fixed = true
@@ -33,11 +40,14 @@ func payChangeableCheck(hym, sym string, oldpays []typesx.Payment, newpays []typ
 	}
 
 	// 新旧支払データから変更年月前（変更年月も含む）の支払データと処理月度前（処理月度も含む）の支払データの支払リース料を取得
-	var newpayfee []float64
-	var newPrePays []typesx.Payment
+	var newpayfee []Money
+	var newPrePays []Payment
 	for _, pay := range newpays {
 		// 支付年月
-		paymentym, _ := time.Parse("2006-01", pay.Paymentymd[0:7])
+		paymentym, err := time.Parse("2006-01", pay.Paymentymd[0:7])
+		if err != nil {
+			return err
+		}
 		// 支払データ
 		if paymentym.Before(henkouym) || paymentym.Equal(henkouym) {
 			pay.Fixed = true
@@ -62,17 +72,27 @@ func payChangeableCheck(hym, sym string, oldpays []typesx.Payment, newpays []typ
 	return nil
 }
 
-func kaiyakuPayDataCheck(syoriYmStr, kaiyakuymd string, oldpays, newpays []typesx.Payment) (err error) {
+// 预定解约的场合,支付数据检查
+func kaiyakuPayDataCheck(syoriYmStr, kaiyakuymd string, oldpays, newpays []Payment) (err error) {
 	// 处理月度
-	syoriym, _ := time.Parse("2006-01", syoriYmStr)
+	syoriym, err := time.Parse("2006-01", syoriYmStr)
+	if err != nil {
+		return err
+	}
 	// 解约年月
-	kaiyakuym, _ := time.Parse("2006-01", kaiyakuymd[0:7])
+	kaiyakuym, err := time.Parse("2006-01", kaiyakuymd[0:7])
+	if err != nil {
+		return err
+	}
 
-	var oldPastPays []typesx.Payment
-	var oldLeftPays []typesx.Payment
+	var oldPastPays []Payment
+	var oldLeftPays []Payment
 	for _, pay := range oldpays {
 		// 支付年月
-		paymentym, _ := time.Parse("2006-01", pay.Paymentymd[0:7])
+		paymentym, err := time.Parse("2006-01", pay.Paymentymd[0:7])
+		if err != nil {
+			return err
+		}
 		// 已经完成支払データ
 		if paymentym.Before(syoriym) {
 			pay.Fixed = true
@@ -86,11 +106,14 @@ func kaiyakuPayDataCheck(syoriYmStr, kaiyakuymd string, oldpays, newpays []types
 		}
 	}
 
-	var newPastPays []typesx.Payment
-	var newLeftPays []typesx.Payment
+	var newPastPays []Payment
+	var newLeftPays []Payment
 	for _, pay := range newpays {
 		// 支付年月
-		paymentym, _ := time.Parse("2006-01", pay.Paymentymd[0:7])
+		paymentym, err := time.Parse("2006-01", pay.Paymentymd[0:7])
+		if err != nil {
+			return err
+		}
 		// 已经完成支払データ
 		if paymentym.Before(syoriym) {
 			pay.Fixed = true
@@ -118,16 +141,19 @@ func kaiyakuPayDataCheck(syoriYmStr, kaiyakuymd string, oldpays, newpays []types
 }
 
 // 支付数据合法性检查
-func payDataValidCheck(cancellationRightOption bool, pays []typesx.Payment) (err error) {
+func payDataValidCheck(cancellationRightOption bool, pays []Payment) (err error) {
 	// 前回支付日保存用
 	var prevPaymentymd time.Time
 	// 前回支付回数保存用
 	var prevPaymentCount int
 	// 解约损失检查用
-	var cancelLostCount float64
+	var cancelLostCount Money
 	for index, pay := range pays {
 		// 支付年月日
-		paymentymd, _ := time.Parse("2006-01-02", pay.Paymentymd[0:10])
+		paymentymd, err := time.Parse("2006-01-02", pay.Paymentymd[0:10])
+		if err != nil {
+			return err
+		}
 		if index == 0 {
 			// 初轮赋值
 			prevPaymentymd = paymentymd
@@ -177,20 +203,25 @@ func expireymdCheck(leasestymd, lastpayymd string, leasekikan, extentionOption i
 	// 租赁开始日转换
 	stymd, err := time.Parse("2006-01-02", leasestymd)
 	if err != nil {
-		return errors.New("リース満了年月日は最終支払日以降（最終支払日含め）でなければなりません")
+		return errors.New("リース開始日付（" + leasestymd + "）が不正です")
 	}
 	// 最终支付日转换
 	payymd, err := time.Parse("2006-01-02", lastpayymd)
 	if err != nil {
-		return errors.New("リース満了年月日は最終支払日以降（最終支払日含め）でなければなりません")
+		return errors.New("最終支払日付（" + lastpayymd + "）が不正です")
 	}
 	// 租赁满了日算出
 	expireymd := stymd.AddDate(0, leasekikan+extentionOption, 0)
 
 	// 判断
 	if expireymd.Before(payymd) {
-		return errors.New("リース満了年月日は最終支払日以降（最終支払日含め）でなければなりません")
+		return errors.New("リース満了年月日（" + expireymd.Format("2006/01/02") + "）は最終支払日（" + lastpayymd + "）以降（最終支払日含め）でなければなりません")
 	}
 
 	return nil
 }
+
+// ShortOrMinorJudge 短期リースまたは少額リース判定(app设定的基准,按支付额合计判定)
+func ShortOrMinorJudge(minorBaseAmount Money, shortLeases int, leasekikan, extentionOption int, payments []Payment) (t string) {
+	return GlobalPolicy(minorBaseAmount, shortLeases).Judge(shortLeases, 1, leasekikan, extentionOption, payments).LeaseType
+}
@@ -0,0 +1,627 @@
+package leasecalc
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// Compute 计算利息和偿还数据(新规契约)
+func Compute(cfg Config, p LRParam) (result *ComputeResult, err error) {
+	result = &ComputeResult{}
+
+	// 支付数据合法性检查
+	if err := payDataValidCheck(p.CancellationRightOption, p.Payments); err != nil {
+		return nil, err
+	}
+	// 端数处理设定
+	rd := cfg.Rounding
+	// 残价保证额
+	residualValue := p.ResidualValue
+	// 割引率
+	rishiritsu := p.Rishiritsu
+	// 比較開始期首月
+	firstMonthB, err := time.Parse("2006-1-02", p.FirstMonth+"-01")
+	if err != nil {
+		return nil, err
+	}
+	// 租赁总期间算出(租赁期间 + 延长租赁期间) => 減価償却期間
+	genkakikan := p.Leasekikan + p.ExtentionOption
+	// 租赁费用计上方式
+	costModel, err := costModelOf(cfg, p.CostModel, p.Torihikikbn)
+	if err != nil {
+		return nil, err
+	}
+	// 使用権資産的偿却方法
+	method, err := p.Depreciation.Method()
+	if err != nil {
+		return nil, err
+	}
+
+	// 比較開始時点から計算
+	hkkjitenzan, presentTotalRemain := getLeaseDebt(rd, p.Payments, rishiritsu, p.FirstMonth)
+
+	// 根据支付年月支付周期等情报对支付情报再整理
+	payments, err := getArrangedPays(p.Payments)
+	if err != nil {
+		return nil, err
+	}
+
+	// **********利息情报算出**********
+	leases, err := getLeaseDataStart(rd, payments, firstMonthB, firstMonthB, presentTotalRemain, rishiritsu)
+	if err != nil {
+		return nil, err
+	}
+
+	var repays []RePayment
+	if p.Sykshisankeisan == TransitionFull {
+		// 完全遡及適用(利息和偿还数据均从租赁开始日计算)
+		full, err := fullRetrospective(rd, method, costModel, p, payments, genkakikan, firstMonthB)
+		if err != nil {
+			return nil, err
+		}
+		leases = full.Leases
+		repays = full.RePayments
+
+		result.KiSyuBoka = full.KiSyuBoka
+		result.LeaseTotal = full.LeaseTotal
+		result.PresentTotal = full.PresentTotal
+		result.Sonnekigaku = full.Sonnekigaku
+		// 比较期间的修正再表示
+		result.Comparatives = comparatives(leases, repays, full.PresentTotal, full.KiSyuBoka, firstDayOfMonth(p.Leasestymd), firstMonthB, cfg.SyoriYm)
+	} else if p.Sykshisankeisan == TransitionStart {
+		// 開始時点から計算
+		// 初期期首簿価 = 比較開始時点の现在价值合计
+		boka := presentTotalRemain
+
+		// **********偿还情报算出**********
+		repays, err = getRepayDataStart(rd, method, firstMonthB, genkakikan, residualValue, boka, p.Leasestymd)
+		if err != nil {
+			return nil, err
+		}
+		// 经营租赁的场合,单一リース費用按定额计上
+		if costModel == CostModelOperating {
+			repays = straightLineCost(rd, repays, leases, residualValue)
+		}
+
+		result.KiSyuBoka = boka
+		result.LeaseTotal = hkkjitenzan
+		result.PresentTotal = presentTotalRemain
+		result.Sonnekigaku = 0
+	} else {
+		// 取得時点に遡って計算
+		// 租赁开始日(月初)
+		leasestymd := firstDayOfMonth(p.Leasestymd)
+		// 根据参数传入的支付情报算出现在价值合计
+		presentTotal, leaseTotal := getLeaseTotal(rd, p.Payments, leasestymd, rishiritsu)
+		// 初期期首簿価 = 现在价值合计+ 当初直接費用 + 原状回復コスト
+		boka := presentTotal + p.InitialDirectCosts + p.RestorationCosts
+		// 使用権資産簿価 = 取得价值 - (取得价值-残价保证额)/租赁期间 * (租赁开始日到比較開始期首月的月数)
+		useBoka := boka
+		if p.Leasekikan != 0 {
+			useBoka = boka - rd.Mul(boka-residualValue, big.NewRat(1, int64(p.Leasekikan)))*Money(getGapMonths(leasestymd, firstMonthB))
+		}
+
+		// **********偿还情报算出**********
+		repays, err = getRepayDataObtain(rd, method, leasestymd, genkakikan, residualValue, boka, firstMonthB)
+		if err != nil {
+			return nil, err
+		}
+		// 经营租赁的场合,按租赁开始日起的利息算出单一リース費用
+		if costModel == CostModelOperating {
+			fullLeases, err := getLeaseData(rd, payments, leasestymd, leasestymd, presentTotal, rishiritsu)
+			if err != nil {
+				return nil, err
+			}
+			repays = straightLineCost(rd, repays, fullLeases, residualValue)
+		}
+
+		result.KiSyuBoka = boka
+		result.LeaseTotal = leaseTotal
+		result.PresentTotal = presentTotal
+		// 利益剰余金
+		result.Sonnekigaku = presentTotalRemain - useBoka
+	}
+
+	// 処理月度の先月までの償却費の累計額
+	for _, rp := range repays {
+		if rp.Syokyakuymd[:7] < cfg.SyoriYm {
+			result.PreDepreciationTotal += rp.Syokyaku
+		}
+	}
+
+	result.Hkkjitenzan = hkkjitenzan
+	result.Payments = p.Payments
+	result.Leases = leases
+	result.RePayments = repays
+
+	return result, nil
+}
+
+// ChangeCompute 计算情报变更数据
+func ChangeCompute(henkouymd string, leaseData []Lease, repayData []RePayment) (result *ChangeResult, err error) {
+	result = &ChangeResult{}
+
+	// 变更年月
+	henkouym, err := time.Parse("2006-01", henkouymd[0:7])
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lease := range leaseData {
+		// 当前利息支付年月
+		paymentym, err := time.Parse("2006-01", lease.Paymentymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		// 翌月から最終回まで
+		if paymentym.After(henkouym) {
+			result.PayTotalRemain += lease.Interest + lease.Repayment
+			result.InterestTotalRemain += lease.Interest
+		}
+	}
+
+	// リース開始日から変更年月日までの償却費の累計額
+	for _, repay := range repayData {
+		if repay.Syokyakuymd[:7] <= henkouymd[:7] {
+			result.OldDepreciationTotal += repay.Syokyaku
+		}
+	}
+
+	return result, nil
+}
+
+// DebtCompute 计算债务变更
+func DebtCompute(cfg Config, kisyuBoka Money, opayData []Payment, oleaseData []Lease, orepayData []RePayment, p DebtParam) (result *DebtResult, err error) {
+	result = &DebtResult{}
+
+	// 支付数据合法性检查
+	if err := payDataValidCheck(p.CancellationRightOption, p.Payments); err != nil {
+		return nil, err
+	}
+	if len(p.Payments) == 0 {
+		return nil, errors.New("支払データが存在しません")
+	}
+	// 最终支付日取得(不含残价保证金和购入选项行使金)
+	lastPayYmd := p.Payments[len(p.Payments)-1].Paymentymd
+	if p.Payments[len(p.Payments)-1].PaymentType != "支払" && len(p.Payments) > 1 {
+		lastPayYmd = p.Payments[len(p.Payments)-2].Paymentymd
+	}
+	// 租赁满了年月日检查
+	if err := expireymdCheck(p.Leasestymd[:10], lastPayYmd, p.Leasekikan, p.ExtentionOption); err != nil {
+		return nil, err
+	}
+	// 预定解约的场合,支付数据检查
+	if p.Kaiyakuymd != "" {
+		if err := kaiyakuPayDataCheck(cfg.SyoriYm, p.Kaiyakuymd, opayData, p.Payments); err != nil {
+			return nil, err
+		}
+	}
+
+	// 処理月度の翌月からリース料変更可能 and 変更年月の翌月から変動リース料編集可能
+	if err := payChangeableCheck(p.Henkouymd[0:7], cfg.SyoriYm, opayData, p.Payments); err != nil {
+		return nil, err
+	}
+	// 租赁费用计上方式
+	costModel, err := costModelOf(cfg, p.CostModel, p.Torihikikbn)
+	if err != nil {
+		return nil, err
+	}
+	// 变更后的偿却方法(可以在租赁期间中途变更)
+	method, err := p.Depreciation.Method()
+	if err != nil {
+		return nil, err
+	}
+	// 处理月度转换
+	syoriym, err := time.Parse("2006-01", cfg.SyoriYm)
+	if err != nil {
+		return nil, err
+	}
+	// 端数处理设定
+	rd := cfg.Rounding
+	// 割引率
+	rishiritsu := p.Rishiritsu
+	// 剩余资产百分比&减少比例
+	percentage := ratOf(p.Percentage)
+	gensyoRate := new(big.Rat).Sub(big.NewRat(1, 1), percentage)
+	// 变更年月
+	henkouym, err := time.Parse("2006-01", p.Henkouymd[0:7])
+	if err != nil {
+		return nil, err
+	}
+	// 新的基准日（租赁开始日）
+	leasestymd := henkouym.AddDate(0, 1, 0)
+	// 1--变更时点剩余元本残高
+	var leftBalanceAfter Money = 0
+	// 2--变更时点剩余使用权资产
+	var leftBokaAfter Money = 0
+	// 债务变更前数据处理
+	var leaseData []Lease
+	var repayData []RePayment
+	// 债务变更调整区调整前数据
+	var repayDataAdjBefore []RePayment
+	// 循环旧利息数据集合,保存债务变更前利息数据,变更时点剩余元本残高取得
+	for _, lease := range oleaseData {
+		// 支付年月
+		paymentym, err := time.Parse("2006-01", lease.Paymentymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		// 变更时点剩余元本残高取得
+		if !paymentym.After(henkouym) {
+			leftBalanceAfter = lease.Balance
+			leaseData = append(leaseData, lease)
+		}
+	}
+
+	// 循环旧偿还数据集合,保存债务变更前偿还数据,变更时点剩余使用权资产取得,债务变更调整区调整前偿还数据取得
+	for _, repay := range orepayData {
+		// 偿还年月
+		syokyakuym, err := time.Parse("2006-01", repay.Syokyakuymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		// 变更时点剩余使用权资产取得
+		if !syokyakuym.After(henkouym) {
+			leftBokaAfter = repay.Endboka
+			repayData = append(repayData, repay)
+		}
+		// 债务变更调整区调整前数据取得
+		if syokyakuym.After(henkouym) && !syokyakuym.After(syoriym) {
+			repayDataAdjBefore = append(repayDataAdjBefore, repay)
+		}
+	}
+	if len(repayData) == 0 {
+		return nil, errors.New("変更年月前（変更年月も含む）の償却データが存在しません")
+	}
+
+	// 变更后支付数据取得
+	var henkouPayments []Payment
+	// 现支付额合计(剩余支付期间现支払額的合计额)
+	var payTotalAfter Money = 0
+	// 循环新支付情报,保存变更后支付数据
+	for _, pay := range p.Payments {
+		// 支付年月
+		paymentym, err := time.Parse("2006-01", pay.Paymentymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		// 债务变更年月后(不包含变更当月,当月按变更前处理,次月生效)
+		if paymentym.After(henkouym) {
+			henkouPayments = append(henkouPayments, pay)
+			payTotalAfter += currentPayOf(pay)
+		}
+	}
+	// 3--減少した支払総額(剩余支付期间元支払額×减少比例(1-剩余资产百分比)的合计额)
+	var gensyoPayTotal Money = 0
+	// 原支付额比例减少后支付合计(剩余支付期间元支払額×剩余资产百分比的合计额)
+	var payTotalRemain Money = 0
+	// 原支付额合计
+	var payTotal Money = 0
+
+	// 循环旧支付情报,減少した支払総額取得
+	for _, pay := range opayData {
+		// 支付年月
+		paymentym, err := time.Parse("2006-01", pay.Paymentymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		// 债务变更年月后(不包含变更当月,当月按变更前处理,次月生效)
+		if paymentym.After(henkouym) {
+			gensyoPayTotal += rd.Mul(currentPayOf(pay), gensyoRate)
+			payTotalRemain += rd.Mul(currentPayOf(pay), percentage)
+			payTotal += currentPayOf(pay)
+		}
+	}
+
+	// 根据变更后支付数据,算出现在价值合计
+	presentTotal, _ := getLeaseTotal(rd, henkouPayments, leasestymd, rishiritsu)
+	// 9--变更后剩余リース負債 = (现在价值合计)
+	var leaseTotal Money = presentTotal
+
+	// ===========================================================================
+	// =======================损益额等相关统计情报算出==============================
+	// 比例减少
+	if p.Percentage < 1 {
+		// 4--リース範囲縮小の割合で減少分のリース債務 =变更前租赁负债额*減少比例(1-剩余资产百分比)
+		result.GensyoBalance = rd.Mul(leftBalanceAfter, gensyoRate)
+		// 6--リース範囲縮小の割合で減少分の使用権資産 = 变更时点剩余使用权资产*減少比例(1-剩余资产百分比)
+		result.GensyoBoka = rd.Mul(leftBokaAfter, gensyoRate)
+		// 7--比例減少によって発生する損益 = 減少分のリース債務 - 減少分の使用権資産
+		result.Sonnekigaku = result.GensyoBalance - result.GensyoBoka
+		// 8--リース範囲縮小の割合で減少し、残ったリース債務 = 变更前租赁负债额 = 变更时点剩余元本残高 * 剩余资产百分比
+		result.OLeasesaimusougaku = rd.Mul(leftBalanceAfter, percentage)
+		// 9--变更后租赁负债额 = 变更后剩余リース負債
+		result.Leasesaimusougaku = leaseTotal
+		// 10--リース債務の変動額 = 租赁负债差额 = 变更后租赁负债额 - 变更前租赁负债额
+		result.Leasesaimusagaku = result.Leasesaimusougaku - result.OLeasesaimusougaku
+		// 11--リース範囲縮小の割合で減少し、残った使用権資産簿価 = 变更前使用権資産額 = 变更时点剩余使用权资产 * 剩余资产百分比
+		result.OShisannsougaku = rd.Mul(leftBokaAfter, percentage)
+		// 12--使用権資産簿価を調整 = 变更后使用権資産額 = 变更前使用権資産額 + 租赁负债差额（作为使用权調整額）
+		result.Shisannsougaku = result.OShisannsougaku + result.Leasesaimusagaku
+		// 使用权资产差额
+		result.Shisannsagaku = result.Shisannsougaku - result.OShisannsougaku
+
+		// 再見積変更後現在価値
+		result.LeaseTotalAfter = leaseTotal
+		// 変更時点の元本残高に対して、比例残の金額
+		result.LeaseTotalRemain = rd.Mul(leftBalanceAfter, percentage)
+	}
+
+	// 非比例减少
+	if p.Percentage == 1 {
+		// 变更前租赁负债额 = 变更时点剩余元本残高
+		result.OLeasesaimusougaku = leftBalanceAfter
+		// 变更后租赁负债额 = 变更后剩余リース負債
+		result.Leasesaimusougaku = leaseTotal
+		// 租赁负债差额
+		result.Leasesaimusagaku = result.Leasesaimusougaku - result.OLeasesaimusougaku
+
+		// 变更前使用権資産額 = 变更时点剩余使用权资产
+		result.OShisannsougaku = leftBokaAfter
+		// 变更后使用権資産額 = 变更前使用権資産額 + 租赁负债差额（作为使用权調整額）
+		result.Shisannsougaku = result.OShisannsougaku + result.Leasesaimusagaku
+		// 使用权资产差额
+		result.Shisannsagaku = result.Shisannsougaku - result.OShisannsougaku
+
+		// 损益额 = 租赁负债差额 - 使用权资产差额
+		result.Sonnekigaku = result.Leasesaimusagaku - result.Shisannsagaku
+	}
+
+	// 原始取得价值 = 原始值 + 使用权资产差额
+	result.KiSyuBoka = kisyuBoka + result.Shisannsagaku
+	// 分录使用
+	result.GensyoPayTotal = gensyoPayTotal
+	result.PayTotalAfter = payTotalAfter
+	result.PayTotalRemain = payTotalRemain
+	// 支付额变动额 = 再見積後リース料総額-再見積前リース料総額
+	result.PayTotalChange = payTotalAfter - payTotal
+
+	// ==================================================================================
+	// =======================变更年月后的利息和偿还情报再计算==============================
+	// 变更后支付数据再整理
+	payments, err := getArrangedPays(henkouPayments)
+	if err != nil {
+		return nil, err
+	}
+
+	// **********利息情报算出**********
+	// 元本残高相当额 = 变更后租赁负债额,前回支付年月 = 变更年月的次月
+	leases, err := getLeaseData(rd, payments, leasestymd, leasestymd, result.Leasesaimusougaku, rishiritsu)
+	if err != nil {
+		return nil, err
+	}
+	leaseData = append(leaseData, leases...)
+
+	// **********偿还情报算出**********
+	// 租赁总期间
+	leasekikanTotal := p.Leasekikan + p.ExtentionOption
+	// 減価償却期間算出
+	genkakikan := p.Assetlife * 12
+	if p.Torihikikbn != "1" {
+		// 移転外
+		if genkakikan > leasekikanTotal {
+			genkakikan = leasekikanTotal
+		}
+	}
+	genkakikan = genkakikan - len(repayData)
+	// 新減価开始日算出
+	leasestsyoymd, err := time.Parse("2006-01-02", repayData[len(repayData)-1].Syokyakuymd)
+	if err != nil {
+		return nil, err
+	}
+	leasestsyoymd = leasestsyoymd.AddDate(0, 1, 0)
+	// 期首月取得
+	kishuMonth, _ := strconv.Atoi(cfg.KishuYm)
+
+	// 偿还情报算出处理(剩余期首簿価 = 变更后使用権資産額,按变更后的偿却方法计算)
+	repays, err := getRepayData(rd, method, leasestsyoymd, genkakikan, p.ResidualValue, result.Shisannsougaku, kishuMonth)
+	if err != nil {
+		return nil, err
+	}
+	// 减损后的契约,继续记录减损累计额(减损回转的上限)
+	if genson := repayData[len(repayData)-1].Genson; genson > 0 {
+		unimpaired, err := getRepayData(rd, method, leasestsyoymd, genkakikan, p.ResidualValue, result.Shisannsougaku+genson, kishuMonth)
+		if err != nil {
+			return nil, err
+		}
+		trackGenson(repays, unimpaired)
+	}
+	// 经营租赁的场合,变更后的单一リース費用按剩余期间定额计上
+	if costModel == CostModelOperating {
+		repays = straightLineCost(rd, repays, leases, p.ResidualValue)
+	}
+	// 调整区调整前偿还总额&リース費用总额
+	var adjSyoTotalBefore Money = 0
+	var adjCostTotalBefore Money = 0
+	// 添加调整区调整前数据,记录调整月和调整区调整前偿还总额
+	for _, repay := range repayDataAdjBefore {
+		adjSyoTotalBefore += repay.Syokyaku
+		adjCostTotalBefore += repay.Leasecost
+		repayData = append(repayData, repay)
+	}
+	// 调整区调整后偿还总额&リース費用总额
+	var adjSyoTotalAfter Money = 0
+	var adjCostTotalAfter Money = 0
+	// 债务变更调整区后数据
+	var repayDataAdjAfter []RePayment
+	// 债务变更调整区后数据取得和调整区调整后偿还总额取得
+	for _, repay := range repays {
+		// 偿还年月
+		syokyakuym, err := time.Parse("2006-01", repay.Syokyakuymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		if syokyakuym.After(henkouym) && !syokyakuym.After(syoriym) {
+			// 调整区调整后偿还总额取得
+			adjSyoTotalAfter += repay.Syokyaku
+			adjCostTotalAfter += repay.Leasecost
+		} else {
+			// 债务变更调整区后数据取得
+			repayDataAdjAfter = append(repayDataAdjAfter, repay)
+		}
+	}
+
+	syokyaku := adjSyoTotalAfter - adjSyoTotalBefore
+	leasecost := adjCostTotalAfter - adjCostTotalBefore
+	if syokyaku != 0 || leasecost != 0 {
+		// 插入調整額数据
+		repayData = append(repayData, RePayment{
+			Syokyakuymd: cfg.SyoriYm + "-01",
+			Syokyaku:    syokyaku,
+			Syokyakukbn: "調整",
+			Leasecost:   leasecost,
+		})
+	}
+
+	// 添加调整区后数据
+	repayData = append(repayData, repayDataAdjAfter...)
+
+	result.Payments = p.Payments
+	result.Leases = leaseData
+	result.RePayments = repayData
+
+	return result, nil
+}
+
+// CancelCompute 中途解约处理
+func CancelCompute(cfg Config, opayData []Payment, oleaseData []Lease, orepayData []RePayment, p CancelParam) (result *CancelResult, err error) {
+	result = &CancelResult{}
+
+	// 解约年月转换
+	kaiyakuym, err := time.Parse("2006-01", p.Kaiyakuymd[0:7])
+	if err != nil {
+		return nil, err
+	}
+	// 处理月度转换
+	syoriym, err := time.Parse("2006-01", cfg.SyoriYm)
+	if err != nil {
+		return nil, err
+	}
+
+	// 解约后支付数据整理
+	for _, pay := range opayData {
+		// 当前支付年月
+		paymentym, err := time.Parse("2006-01", pay.Paymentymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		// 解约年月和解约年月前的支付数据放入支付集合
+		if paymentym.After(kaiyakuym) {
+			break
+		}
+		result.Payments = append(result.Payments, pay)
+	}
+
+	// 解约后利息数据整理&解約時元本残高算出
+	for _, lease := range oleaseData {
+		// 当前利息支付年月
+		paymentym, err := time.Parse("2006-01", lease.Paymentymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		if paymentym.After(kaiyakuym) {
+			// 中途解約時点の支払リース料残額&利息残
+			result.PayTotalRemain += lease.Interest + lease.Repayment
+			result.InterestTotalRemain += lease.Interest
+			continue
+		}
+		// 解约年月和解约年月前的利息数据放入利息集合
+		result.RemainDebt = lease.Balance
+		result.Leases = append(result.Leases, lease)
+	}
+
+	// 解约后偿还数据整理&中途解約による除却損算出
+	// 中途解約による調整額
+	var tyoseigaku Money = 0
+	// 中途解約によるリース費用調整額(经营租赁)
+	var tyoseiCost Money = 0
+	for index, repay := range orepayData {
+		// 当前偿还年月
+		syokyakuym, err := time.Parse("2006-01", repay.Syokyakuymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		// 除却損的计算-首次
+		if index == 0 && syokyakuym.Equal(kaiyakuym) {
+			// 解約年月日前月の月末簿価を除却損とする
+			result.Lossgaku = repay.Boka
+		}
+		// 中途解約時点の償却費の累計額
+		if !syokyakuym.After(kaiyakuym) {
+			result.SyokyakuTotal += repay.Syokyaku
+		}
+		// 处理月度前的偿还数据放入偿还集合
+		if !syokyakuym.After(syoriym) {
+			result.RePayments = append(result.RePayments, repay)
+		}
+		// 解約年月日から処理月度の前月までの償却費は処理月度の調整償却費とする
+		if syokyakuym.After(kaiyakuym) && !syokyakuym.After(syoriym) {
+			tyoseigaku += repay.Syokyaku
+			tyoseiCost += repay.Leasecost
+		}
+		// 解約年月日前月の月末簿価を除却損とする
+		if syokyakuym.Before(kaiyakuym) {
+			result.Lossgaku = repay.Endboka
+		}
+	}
+
+	// 中途解約による調整額の添付
+	if tyoseigaku != 0 || tyoseiCost != 0 {
+		result.RePayments = append(result.RePayments, RePayment{
+			Syokyakuymd: cfg.SyoriYm + "-01",
+			Syokyaku:    0 - tyoseigaku,
+			Syokyakukbn: "調整",
+			Leasecost:   0 - tyoseiCost,
+		})
+	}
+
+	return result, nil
+}
+
+// ExpireCompute 满了处理
+func ExpireCompute(orepayData []RePayment, p ExpireParam) (result *ExpireResult, err error) {
+	result = &ExpireResult{}
+
+	// 移転外の場合（期間短い方なので、償却完了しているはず、計算無し）
+	if p.Torihikikbn != "1" {
+		return result, nil
+	}
+	// 移転且つ償却持続の場合、計算無し
+	if p.Expiresyokyakukbn == "keeppayoff" {
+		return result, nil
+	}
+
+	// 以下は移転且つ償却停止で場合、残りの償却データを削除、残り価値を計算する
+	result.Computed = true
+	// 変更年月转换
+	henkouym, err := time.Parse("2006-01", p.Henkouymd[0:7])
+	if err != nil {
+		return nil, err
+	}
+
+	// 满了后偿却数据整理和剩余价值算出
+	for index, repay := range orepayData {
+		// 当前偿还年月
+		syokyakuym, err := time.Parse("2006-01", repay.Syokyakuymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+
+		if index == 0 && syokyakuym.Equal(henkouym) {
+			// 解約年月日前月の月末簿価を除却損とする
+			result.Leftgaku = repay.Boka
+		}
+
+		// 変更年月(含)前的偿还数据放入偿还集合和剩余价值算出
+		if syokyakuym.After(henkouym) {
+			break
+		}
+		result.RePayments = append(result.RePayments, repay)
+		// 剩余价值记录
+		result.Leftgaku = repay.Endboka
+	}
+
+	return result, nil
+}
@@ -0,0 +1,267 @@
+package leasecalc
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// 使用 go test -update 重新生成 testdata 下的 golden 文件
+var update = flag.Bool("update", false, "update golden files")
+
+func checkGolden(t *testing.T, name string, got interface{}) {
+	t.Helper()
+	actual, err := json.MarshalIndent(got, "", "  ")
+	if err != nil {
+		t.Fatalf("json.MarshalIndent() error = %v", err)
+	}
+	actual = append(actual, '\n')
+
+	golden := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := ioutil.WriteFile(golden, actual, 0644); err != nil {
+			t.Fatalf("ioutil.WriteFile() error = %v", err)
+		}
+		return
+	}
+
+	expected, err := ioutil.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("ioutil.ReadFile() error = %v", err)
+	}
+	if !bytes.Equal(actual, expected) {
+		t.Errorf("%s result differs from golden file %s\n got: %s", name, golden, actual)
+	}
+}
+
+func date(s string) time.Time {
+	d, _ := time.Parse("2006-01-02", s)
+	return d
+}
+
+func mustPays(t *testing.T, q PayParam) []Payment {
+	t.Helper()
+	pays, err := GeneratePay(q)
+	if err != nil {
+		t.Fatalf("GeneratePay() error = %v", err)
+	}
+	return pays
+}
+
+func mustCompute(t *testing.T, cfg Config, p LRParam) *ComputeResult {
+	t.Helper()
+	got, err := Compute(cfg, p)
+	if err != nil {
+		t.Fatalf("Compute() error = %v", err)
+	}
+	return got
+}
+
+var testConfig = Config{
+	SyoriYm: "2021-04",
+	KishuYm: "4",
+}
+
+// 5年契约,月额10万,残价保证额50万
+func baseParam(t *testing.T) LRParam {
+	return LRParam{
+		ResidualValue:      MoneyFromInt(500000),
+		Rishiritsu:         0.03,
+		Leasestymd:         date("2020-04-01"),
+		Leasekikan:         60,
+		InitialDirectCosts: MoneyFromInt(120000),
+		RestorationCosts:   MoneyFromInt(80000),
+		Assetlife:          6,
+		Torihikikbn:        "2",
+		Sykshisankeisan:    "2",
+		FirstMonth:         "2020-04",
+		Payments: mustPays(t, PayParam{
+			Paymentstymd:    date("2020-04-25"),
+			Paymentcycle:    1,
+			Paymentday:      25,
+			Paymentcounts:   60,
+			Paymentleasefee: MoneyFromInt(100000),
+			ResidualValue:   MoneyFromInt(500000),
+			Keiyakuno:       "K0001",
+		}),
+	}
+}
+
+func TestGeneratePay(t *testing.T) {
+	tests := []struct {
+		name string
+		q    PayParam
+	}{
+		{
+			name: "generate_pay_monthly",
+			q: PayParam{
+				Paymentstymd:    date("2020-01-31"),
+				Paymentcycle:    1,
+				Paymentday:      31,
+				Paymentcounts:   6,
+				Paymentleasefee: MoneyFromInt(50000),
+				Keiyakuno:       "K0002",
+			},
+		},
+		{
+			name: "generate_pay_quarterly_option",
+			q: PayParam{
+				Paymentstymd:     date("2020-04-10"),
+				Paymentcycle:     3,
+				Paymentday:       10,
+				Paymentcounts:    4,
+				Paymentleasefee:  MoneyFromInt(300000),
+				Firstleasefee:    MoneyFromInt(350000),
+				Finalleasefee:    MoneyFromInt(250000),
+				OptionToPurchase: MoneyFromInt(100000),
+				Keiyakuno:        "K0003",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGolden(t, tt.name, mustPays(t, tt.q))
+		})
+	}
+}
+
+func TestCompute(t *testing.T) {
+	obtain := baseParam(t)
+	obtain.FirstMonth = "2021-04"
+
+	start := baseParam(t)
+	start.Sykshisankeisan = "1"
+	start.FirstMonth = "2021-04"
+
+	tests := []struct {
+		name string
+		p    LRParam
+	}{
+		{name: "compute_new", p: baseParam(t)},
+		{name: "compute_obtain", p: obtain},
+		{name: "compute_start", p: start},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGolden(t, tt.name, mustCompute(t, testConfig, tt.p))
+		})
+	}
+}
+
+func TestChangeCompute(t *testing.T) {
+	base := mustCompute(t, testConfig, baseParam(t))
+
+	got, err := ChangeCompute("2021-03-15", base.Leases, base.RePayments)
+	if err != nil {
+		t.Fatalf("ChangeCompute() error = %v", err)
+	}
+	checkGolden(t, "change", got)
+}
+
+func TestDebtCompute(t *testing.T) {
+	tests := []struct {
+		name       string
+		percentage float64
+		fee        int64
+	}{
+		{name: "debt_increase", percentage: 1, fee: 120000},
+		{name: "debt_decrease", percentage: 0.6, fee: 60000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bp := baseParam(t)
+			base := mustCompute(t, testConfig, bp)
+
+			// 2021-06以后的支付额变更
+			var pays []Payment
+			for _, pay := range base.Payments {
+				if pay.PaymentType == "支払" && pay.Paymentymd >= "2021-06" {
+					pay.Paymentleasefee = MoneyFromInt(tt.fee)
+				}
+				pays = append(pays, pay)
+			}
+
+			got, err := DebtCompute(testConfig, base.KiSyuBoka, base.Payments, base.Leases, base.RePayments, DebtParam{
+				Henkouymd:     "2021-05-01",
+				Leasestymd:    "2020-04-01",
+				Leasekikan:    bp.Leasekikan,
+				Keiyakuno:     "K0001",
+				Rishiritsu:    bp.Rishiritsu,
+				ResidualValue: bp.ResidualValue,
+				Assetlife:     bp.Assetlife,
+				Torihikikbn:   bp.Torihikikbn,
+				Percentage:    tt.percentage,
+				Payments:      pays,
+			})
+			if err != nil {
+				t.Fatalf("DebtCompute() error = %v", err)
+			}
+			checkGolden(t, tt.name, got)
+		})
+	}
+}
+
+func TestCancelCompute(t *testing.T) {
+	base := mustCompute(t, testConfig, baseParam(t))
+
+	got, err := CancelCompute(testConfig, base.Payments, base.Leases, base.RePayments, CancelParam{
+		Kaiyakuymd: "2021-01-20",
+		Keiyakuno:  "K0001",
+	})
+	if err != nil {
+		t.Fatalf("CancelCompute() error = %v", err)
+	}
+	checkGolden(t, "cancel", got)
+}
+
+func TestExpireCompute(t *testing.T) {
+	base := mustCompute(t, testConfig, baseParam(t))
+
+	tests := []struct {
+		name string
+		p    ExpireParam
+	}{
+		{
+			name: "expire_stop",
+			p:    ExpireParam{Henkouymd: "2024-12-01", Torihikikbn: "1", Expiresyokyakukbn: "stoppayoff"},
+		},
+		{
+			name: "expire_keep",
+			p:    ExpireParam{Henkouymd: "2024-12-01", Torihikikbn: "1", Expiresyokyakukbn: "keeppayoff"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExpireCompute(base.RePayments, tt.p)
+			if err != nil {
+				t.Fatalf("ExpireCompute() error = %v", err)
+			}
+			checkGolden(t, tt.name, got)
+		})
+	}
+}
+
+func TestShortOrMinorJudge(t *testing.T) {
+	pays := []Payment{{Paymentleasefee: MoneyFromInt(100000)}, {Paymentleasefee: MoneyFromInt(100000)}}
+	tests := []struct {
+		name       string
+		leasekikan int
+		payments   []Payment
+		want       string
+	}{
+		{name: "short", leasekikan: 12, payments: pays, want: "short_lease"},
+		{name: "minor", leasekikan: 24, payments: pays, want: "minor_lease"},
+		{name: "normal", leasekikan: 24, payments: append(pays, Payment{Paymentleasefee: MoneyFromInt(3000000)}), want: "normal_lease"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ShortOrMinorJudge(MoneyFromInt(3000000), 12, tt.leasekikan, 0, tt.payments); got != tt.want {
+				t.Errorf("ShortOrMinorJudge() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package leasecalc
+
+import (
+	"time"
+)
+
+// getGapMonths  获取二个日期间隔月数
+func getGapMonths(startymd time.Time, endymd time.Time) (months int) {
+	return endymd.Year()*12 + int(endymd.Month()) - startymd.Year()*12 - int(startymd.Month())
+}
+
+// getPayDate  获取支付日
+func getPayDate(date time.Time, payday int) (pdate time.Time) {
+	// 年月日取得
+	years := date.Year()
+	month := int(date.Month())
+	nowday := date.Day()
+
+	// 月末日取得
+	lastday := 0
+	if month != 2 {
+		if month == 4 || month == 6 || month == 9 || month == 11 {
+			lastday = 30
+		} else {
+			lastday = 31
+		}
+	} else {
+		if ((years%4) == 0 && (years%100) != 0) || (years%400) == 0 {
+			lastday = 29
+		} else {
+			lastday = 28
+		}
+	}
+
+	// 获取支付日
+	if payday > lastday {
+		pdate = date.AddDate(0, 0, lastday-nowday)
+	} else {
+		pdate = date.AddDate(0, 0, payday-nowday)
+	}
+
+	return pdate
+}
+
+// firstDayOfMonth 获取当月1日
+func firstDayOfMonth(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
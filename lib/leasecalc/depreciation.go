@@ -0,0 +1,264 @@
+package leasecalc
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DepreciationKind 使用権資産的偿却方法
+type DepreciationKind string
+
+const (
+	// DepreciationStraightLine 定额法(默认)
+	DepreciationStraightLine DepreciationKind = "straight"
+	// DepreciationDeclining 定率法(200%/250%,调整前偿却额低于保证额后按改定取得价额均等偿却)
+	DepreciationDeclining DepreciationKind = "declining"
+	// DepreciationUnits 生产高比例法(按月别预定使用量按分)
+	DepreciationUnits DepreciationKind = "units"
+	// DepreciationNone 不偿却(土地等)
+	DepreciationNone DepreciationKind = "none"
+)
+
+// UsagePoint 月别预定使用量(生产高比例法)
+type UsagePoint struct {
+	Ym    string  `json:"ym" bson:"ym"`       // 使用年月(2006-01)
+	Units float64 `json:"units" bson:"units"` // 使用量
+}
+
+// ParseUsagePlan 预定使用量文字列解析
+// 区间以";"分隔,各区间的书式为"<开始年月>[x<月数>] <月使用量>",例: 2020-04x12 200; 2021-04x48 100
+func ParseUsagePlan(plan string) (usage []UsagePoint, err error) {
+	for i, text := range strings.Split(plan, ";") {
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		line := i + 1
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("予定使用量%d行目:書式が不正です", line)
+		}
+
+		parts := strings.SplitN(strings.ToLower(fields[0]), "x", 2)
+		start, err := time.Parse("2006-01", parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("予定使用量%d行目:開始年月が不正です", line)
+		}
+		months := 1
+		if len(parts) == 2 {
+			if months, err = strconv.Atoi(parts[1]); err != nil || months <= 0 {
+				return nil, fmt.Errorf("予定使用量%d行目:月数が不正です", line)
+			}
+		}
+		units, err := strconv.ParseFloat(fields[1], 64)
+		if err != nil || units < 0 {
+			return nil, fmt.Errorf("予定使用量%d行目:使用量が不正です", line)
+		}
+
+		for m := 0; m < months; m++ {
+			usage = append(usage, UsagePoint{
+				Ym:    start.AddDate(0, m, 0).Format("2006-01"),
+				Units: units,
+			})
+		}
+	}
+	return usage, nil
+}
+
+// Depreciation 偿却方法设定(偿却方法按资产分类设定,预定使用量按契约设定)
+type Depreciation struct {
+	Kind  DepreciationKind `json:"kind" bson:"kind"`                       // 偿却方法
+	Rate  int              `json:"rate,omitempty" bson:"rate"`             // 定率法的倍率(200或250)
+	Usage []UsagePoint     `json:"usage,omitempty" bson:"usage,omitempty"` // 月别预定使用量(units)
+}
+
+// Validate 偿却方法设定检查
+func (d *Depreciation) Validate() error {
+	if d == nil {
+		return nil
+	}
+	switch d.Kind {
+	case DepreciationDeclining:
+		if d.Rate != 200 && d.Rate != 250 {
+			return errors.New("定率法の償却率は200%または250%を指定してください")
+		}
+	case DepreciationUnits:
+		if len(d.Usage) == 0 {
+			return errors.New("生産高比例法の予定使用量が設定されていません")
+		}
+		for _, u := range d.Usage {
+			if _, err := time.Parse("2006-01", u.Ym); err != nil {
+				return errors.New("予定使用量の年月が不正です")
+			}
+			if u.Units < 0 {
+				return errors.New("予定使用量がマイナスです")
+			}
+		}
+	case DepreciationStraightLine, DepreciationNone, "":
+	default:
+		return errors.New("償却方法が不正です")
+	}
+	return nil
+}
+
+// Method 取得偿却方法的实现(未设定的场合为定额法)
+func (d *Depreciation) Method() (DepreciationMethod, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	if d == nil {
+		return StraightLine{}, nil
+	}
+	switch d.Kind {
+	case DepreciationDeclining:
+		return DecliningBalance{Rate: d.Rate}, nil
+	case DepreciationUnits:
+		return UnitsOfUse{Usage: d.Usage}, nil
+	case DepreciationNone:
+		return NoDepreciation{}, nil
+	}
+	return StraightLine{}, nil
+}
+
+// DepreciationBase 偿还数据的生成条件
+type DepreciationBase struct {
+	Start         time.Time // 偿却开始月
+	Months        int       // 计算月数
+	Limit         int       // 下期计算的上限月数(偿却期间)
+	CalMonths     int       // 首期偿还月数
+	LeftMonths    int       // 剩余月数
+	ResidualValue Money     // 残价保证额
+	Boka          Money     // 期首簿価
+}
+
+// DepreciationMethod 偿却方法
+type DepreciationMethod interface {
+	// Kbn 偿还数据上记录的偿却区分
+	Kbn() string
+	// Schedule 生成月别偿还数据
+	Schedule(rd Rounding, b DepreciationBase) []RePayment
+}
+
+// StraightLine 定额法(期首簿価-残价保证额按剩余月数均等偿却)
+type StraightLine struct{}
+
+// Kbn 偿却区分
+func (StraightLine) Kbn() string { return "通常" }
+
+// Schedule 生成月别偿还数据
+func (m StraightLine) Schedule(rd Rounding, b DepreciationBase) []RePayment {
+	return periodic(rd, b, m.Kbn(), func(boka Money, offset, calMonths, leftMonths int) Money {
+		return straightAmount(rd, boka-b.ResidualValue, calMonths, leftMonths)
+	}, evenWeight)
+}
+
+// DecliningBalance 定率法
+// 偿却率 = 1/耐用年数 × 倍率(小数点以下3位四舍五入),调整前偿却额 = 期首簿価 × 偿却率;
+// 调整前偿却额低于按剩余月数均等偿却的金额(保证额)以后,按改定取得价额(切换时的期首簿価)均等偿却
+type DecliningBalance struct {
+	Rate int // 倍率(200或250)
+}
+
+// Kbn 偿却区分
+func (DecliningBalance) Kbn() string { return "定率" }
+
+// rate 年偿却率(耐用月数换算为年数)
+func (m DecliningBalance) rate(months int) *big.Rat {
+	if months <= 0 {
+		return new(big.Rat)
+	}
+	// 倍率/耐用年数,四舍五入至小数点以下3位
+	x := big.NewRat(int64(m.Rate)*12*1000, int64(months)*100)
+	x.Add(x, big.NewRat(1, 2))
+	return big.NewRat(new(big.Int).Quo(x.Num(), x.Denom()).Int64(), 1000)
+}
+
+// Schedule 生成月别偿还数据
+func (m DecliningBalance) Schedule(rd Rounding, b DepreciationBase) []RePayment {
+	rate := m.rate(b.Limit)
+	return periodic(rd, b, m.Kbn(), func(boka Money, offset, calMonths, leftMonths int) Money {
+		// 调整前偿却额(当期月数按分)
+		amount := rd.Mul(boka, new(big.Rat).Mul(rate, big.NewRat(int64(calMonths), 12)))
+		// 保证额(改定取得价额的均等偿却额)以上
+		if guaranteed := straightAmount(rd, boka-b.ResidualValue, calMonths, leftMonths); amount < guaranteed {
+			amount = guaranteed
+		}
+		return amount
+	}, evenWeight)
+}
+
+// UnitsOfUse 生产高比例法(期首簿価-残价保证额按预定使用量的比例偿却)
+type UnitsOfUse struct {
+	Usage []UsagePoint // 月别预定使用量
+}
+
+// Kbn 偿却区分
+func (UnitsOfUse) Kbn() string { return "生産高" }
+
+// Schedule 生成月别偿还数据
+func (m UnitsOfUse) Schedule(rd Rounding, b DepreciationBase) []RePayment {
+	units := make(map[string]*big.Rat, len(m.Usage))
+	for _, u := range m.Usage {
+		units[u.Ym] = ratOf(u.Units)
+	}
+	weight := func(offset int) *big.Rat {
+		if u, ok := units[b.Start.AddDate(0, offset, 0).Format("2006-01")]; ok {
+			return u
+		}
+		return new(big.Rat)
+	}
+	// offset起n个月的使用量合计
+	sum := func(offset, n int) *big.Rat {
+		total := new(big.Rat)
+		for i := 0; i < n; i++ {
+			total.Add(total, weight(offset+i))
+		}
+		return total
+	}
+
+	return periodic(rd, b, m.Kbn(), func(boka Money, offset, calMonths, leftMonths int) Money {
+		remain := sum(offset, b.Months-offset)
+		if remain.Sign() == 0 {
+			return 0
+		}
+		// (期首簿価-残价保证额) × 当期使用量 / 剩余使用量
+		return rd.Mul(boka-b.ResidualValue, new(big.Rat).Quo(sum(offset, calMonths), remain))
+	}, weight)
+}
+
+// NoDepreciation 不偿却(期末薄价维持期首簿価)
+type NoDepreciation struct{}
+
+// Kbn 偿却区分
+func (NoDepreciation) Kbn() string { return "非償却" }
+
+// Schedule 生成月别偿还数据
+func (m NoDepreciation) Schedule(rd Rounding, b DepreciationBase) (repayData []RePayment) {
+	syokyakuymd := b.Start
+	for i := 0; i < b.Months; i++ {
+		repayData = append(repayData, RePayment{
+			Boka:        b.Boka,
+			Endboka:     b.Boka,
+			Syokyakuymd: syokyakuymd.Format("2006-01-02"),
+			Syokyakukbn: m.Kbn(),
+		})
+		syokyakuymd = firstDayOfMonth(syokyakuymd).AddDate(0, 1, 0)
+	}
+	return repayData
+}
+
+// straightAmount 当期的均等偿却额 = (期首簿価-残价保证额) / 剩余月数 × 当期偿还月数
+func straightAmount(rd Rounding, present Money, calMonths, leftMonths int) Money {
+	if present == 0 || calMonths == 0 || leftMonths == 0 {
+		return 0
+	}
+	return rd.Mul(present, big.NewRat(int64(calMonths), int64(leftMonths)))
+}
+
+// evenWeight 月别均等按分
+func evenWeight(offset int) *big.Rat {
+	return big.NewRat(1, 1)
+}
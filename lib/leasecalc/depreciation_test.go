@@ -0,0 +1,132 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+// annualTotals 按会计年度(4月期首)合计偿却额
+func annualTotals(repays []RePayment) (totals []Money) {
+	for i, rp := range repays {
+		if i == 0 || rp.Syokyakuymd[5:7] == "04" {
+			totals = append(totals, 0)
+		}
+		totals[len(totals)-1] += rp.Syokyaku
+	}
+	return totals
+}
+
+func checkDepreciated(t *testing.T, got *ComputeResult, residualValue Money, kbn string) {
+	t.Helper()
+	var total Money
+	for _, rp := range got.RePayments {
+		total += rp.Syokyaku
+		if rp.Syokyakukbn != kbn {
+			t.Fatalf("Syokyakukbn = %v, want %v", rp.Syokyakukbn, kbn)
+		}
+	}
+	if want := got.KiSyuBoka - residualValue; total != want {
+		t.Errorf("total Syokyaku = %v, want %v", total, want)
+	}
+	if last := got.RePayments[len(got.RePayments)-1]; last.Endboka != residualValue {
+		t.Errorf("last Endboka = %v, want %v", last.Endboka, residualValue)
+	}
+}
+
+func TestDecliningBalance(t *testing.T) {
+	p := baseParam(t)
+	p.ResidualValue = 0
+	p.Depreciation = &Depreciation{Kind: DepreciationDeclining, Rate: 200}
+	got := mustCompute(t, testConfig, p)
+	checkGolden(t, "depreciation_declining", got.RePayments)
+	checkDepreciated(t, got, p.ResidualValue, "定率")
+
+	// 5年的200%定率法偿却率为0.400
+	totals := annualTotals(got.RePayments)
+	if want := (Rounding{}).Mul(got.KiSyuBoka, ratOf(0.4)); totals[0] != want {
+		t.Errorf("first year = %v, want %v", totals[0], want)
+	}
+	// 切换为保证额后按改定取得价额均等偿却(最终年的端数调整1円以内)
+	if diff := totals[4] - totals[3]; diff > MoneyFromInt(1) || diff < -MoneyFromInt(1) {
+		t.Errorf("annual after switch-over = %v, %v, want equal", totals[3], totals[4])
+	}
+	for i := 1; i < len(totals)-1; i++ {
+		if totals[i] > totals[i-1] {
+			t.Errorf("annual[%d] = %v, want <= %v", i, totals[i], totals[i-1])
+		}
+	}
+
+	// 250%的场合首年偿却额更大
+	p.Depreciation.Rate = 250
+	if got250 := annualTotals(mustCompute(t, testConfig, p).RePayments); got250[0] <= totals[0] {
+		t.Errorf("first year (250%%) = %v, want > %v", got250[0], totals[0])
+	}
+
+	p.Depreciation.Rate = 150
+	if _, err := Compute(testConfig, p); err == nil {
+		t.Errorf("Compute() error = nil, want rate error")
+	}
+}
+
+func TestUnitsOfUse(t *testing.T) {
+	p := baseParam(t)
+	// 首年使用量为2倍
+	usage, err := ParseUsagePlan("2020-04x12 200; 2021-04x48 100")
+	if err != nil || len(usage) != 60 {
+		t.Fatalf("ParseUsagePlan() = %v, %v, want 60 months", len(usage), err)
+	}
+	p.Depreciation = &Depreciation{Kind: DepreciationUnits, Usage: usage}
+	got := mustCompute(t, testConfig, p)
+	checkDepreciated(t, got, p.ResidualValue, "生産高")
+
+	if first, second := got.RePayments[0].Syokyaku, got.RePayments[12].Syokyaku; first != second*2 {
+		t.Errorf("Syokyaku = %v, %v, want ratio 2:1", first, second)
+	}
+}
+
+func TestNoDepreciation(t *testing.T) {
+	p := baseParam(t)
+	p.Depreciation = &Depreciation{Kind: DepreciationNone}
+	got := mustCompute(t, testConfig, p)
+
+	for _, rp := range got.RePayments {
+		if rp.Syokyaku != 0 || rp.Endboka != got.KiSyuBoka || rp.Syokyakukbn != "非償却" {
+			t.Fatalf("RePayment = %+v, want no depreciation", rp)
+		}
+	}
+}
+
+func TestDebtComputeMethodChange(t *testing.T) {
+	bp := baseParam(t)
+	base := mustCompute(t, testConfig, bp)
+
+	// 2021-05变更为定率法(支付条件不变)
+	got, err := DebtCompute(testConfig, base.KiSyuBoka, base.Payments, base.Leases, base.RePayments, DebtParam{
+		Henkouymd:     "2021-05-01",
+		Leasestymd:    "2020-04-01",
+		Leasekikan:    bp.Leasekikan,
+		Keiyakuno:     "K0001",
+		Rishiritsu:    bp.Rishiritsu,
+		ResidualValue: bp.ResidualValue,
+		Assetlife:     bp.Assetlife,
+		Torihikikbn:   bp.Torihikikbn,
+		Percentage:    1,
+		Payments:      base.Payments,
+		Depreciation:  &Depreciation{Kind: DepreciationDeclining, Rate: 200},
+	})
+	if err != nil {
+		t.Fatalf("DebtCompute() error = %v", err)
+	}
+
+	for _, rp := range got.RePayments {
+		want := "通常"
+		if rp.Syokyakuymd[:7] > "2021-05" {
+			want = "定率"
+		}
+		if rp.Syokyakukbn != want {
+			t.Fatalf("RePayments[%s].Syokyakukbn = %v, want %v", rp.Syokyakuymd, rp.Syokyakukbn, want)
+		}
+	}
+	if last := got.RePayments[len(got.RePayments)-1]; last.Endboka != bp.ResidualValue {
+		t.Errorf("last Endboka = %v, want %v", last.Endboka, bp.ResidualValue)
+	}
+}
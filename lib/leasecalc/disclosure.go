@@ -0,0 +1,205 @@
+package leasecalc
+
+import (
+	"errors"
+	"time"
+)
+
+// RouRollForward 使用権資産的增减明细(开示用)
+type RouRollForward struct {
+	Opening        Money `json:"opening" bson:"opening"`               // 期首残高
+	Additions      Money `json:"additions" bson:"additions"`           // 新規取得
+	Depreciation   Money `json:"depreciation" bson:"depreciation"`     // 減価償却費
+	Remeasurements Money `json:"remeasurements" bson:"remeasurements"` // 再測定
+	Impairments    Money `json:"impairments" bson:"impairments"`       // 減損損失(戻入の場合は負数)
+	Terminations   Money `json:"terminations" bson:"terminations"`     // 解約・終了
+	Closing        Money `json:"closing" bson:"closing"`               // 期末残高
+}
+
+// Add 合计
+func (r *RouRollForward) Add(o RouRollForward) {
+	r.Opening += o.Opening
+	r.Additions += o.Additions
+	r.Depreciation += o.Depreciation
+	r.Remeasurements += o.Remeasurements
+	r.Impairments += o.Impairments
+	r.Terminations += o.Terminations
+	r.Closing += o.Closing
+}
+
+// LiabilityRollForward リース負債的增减明细(开示用)
+type LiabilityRollForward struct {
+	Opening        Money `json:"opening" bson:"opening"`               // 期首残高
+	Additions      Money `json:"additions" bson:"additions"`           // 新規計上
+	Interest       Money `json:"interest" bson:"interest"`             // 支払利息
+	Payments       Money `json:"payments" bson:"payments"`             // 支払額(元本返済+利息)
+	Remeasurements Money `json:"remeasurements" bson:"remeasurements"` // 再測定
+	Terminations   Money `json:"terminations" bson:"terminations"`     // 解約・終了
+	Closing        Money `json:"closing" bson:"closing"`               // 期末残高
+}
+
+// Add 合计
+func (l *LiabilityRollForward) Add(o LiabilityRollForward) {
+	l.Opening += o.Opening
+	l.Additions += o.Additions
+	l.Interest += o.Interest
+	l.Payments += o.Payments
+	l.Remeasurements += o.Remeasurements
+	l.Terminations += o.Terminations
+	l.Closing += o.Closing
+}
+
+// DisclosureEvent 契约履历中影响增减明细区分的事象(减损和范围缩小)
+type DisclosureEvent struct {
+	Ym            string `json:"ym" bson:"ym"`                       // 事象年月
+	Impairment    Money  `json:"impairment" bson:"impairment"`       // 减损损失额(减损回转的场合为负数)
+	GensyoBoka    Money  `json:"gensyoBoka" bson:"gensyoBoka"`       // 范围缩小导致比例减少的使用権資産
+	GensyoBalance Money  `json:"gensyoBalance" bson:"gensyoBalance"` // 范围缩小导致比例减少的リース負債
+}
+
+// MaturityBucket 割引前リース料的满期分析区分
+type MaturityBucket struct {
+	Label  string `json:"label" bson:"label"`   // 区分
+	Amount Money  `json:"amount" bson:"amount"` // 割引前リース料
+}
+
+// maturityLabels 满期分析的标准区分(期末日起1年以内至5年以内按年,5年超)
+var maturityLabels = []string{"1年以内", "1年超2年以内", "2年超3年以内", "3年超4年以内", "4年超5年以内", "5年超"}
+
+// FiscalYear 年月所属的会计年度的期首年月和期末年月
+func FiscalYear(ym string, kishuMonth int) (startym, endym string, err error) {
+	t, err := time.Parse("2006-01", ym)
+	if err != nil {
+		return "", "", err
+	}
+	start := periodStart(t, ForecastYear, kishuMonth)
+	return start.Format("2006-01"), start.AddDate(0, 11, 0).Format("2006-01"), nil
+}
+
+// RollForward 单个契约startym至endym期间的使用権資産和リース負債的增减明细
+// 期首、期末残高和费用按偿还表计算;减损和范围缩小按契约履历区分,其余的差额为再测定
+func RollForward(leases []Lease, repays []RePayment, events []DisclosureEvent, startym, endym string) (rou RouRollForward, liab LiabilityRollForward, err error) {
+	start, err := time.Parse("2006-01", startym)
+	if err != nil {
+		return rou, liab, err
+	}
+	if endym < startym {
+		return rou, liab, errors.New("開示期間が不正です")
+	}
+
+	// 期首残高为前月末的残高,期末残高为endym的月末残高(数据最终月以后为0)
+	for _, a := range Periods(leases, repays, start.AddDate(0, -1, 0).Format("2006-01")) {
+		switch {
+		case a.Ym < startym:
+			rou.Opening, liab.Opening = a.Boka, a.Balance
+		case a.Ym <= endym:
+			rou.Depreciation += a.Syokyaku
+			liab.Interest += a.Interest
+			rou.Closing, liab.Closing = a.Boka, a.Balance
+		}
+	}
+
+	// 新规取得(租赁开始月在期间内)
+	if len(repays) > 0 {
+		if ym := repays[0].Syokyakuymd[0:7]; ym >= startym && ym <= endym {
+			rou.Additions = repays[0].Boka
+		}
+	}
+	if len(leases) > 0 {
+		if ym := leases[0].Paymentymd[0:7]; ym >= startym && ym <= endym {
+			liab.Additions = leases[0].Balance + leases[0].Repayment
+		}
+	}
+	for _, l := range leases {
+		if ym := l.Paymentymd[0:7]; ym >= startym && ym <= endym {
+			liab.Payments += l.Interest + l.Repayment
+		}
+	}
+
+	// 终止认识(满了或解约的场合,数据最终月的月末残高)
+	var lastRepay *RePayment
+	for i := range repays {
+		if repays[i].Syokyakukbn != "調整" {
+			lastRepay = &repays[i]
+		}
+	}
+	if lastRepay != nil {
+		if ym := lastRepay.Syokyakuymd[0:7]; ym >= startym && ym <= endym {
+			rou.Terminations = lastRepay.Endboka
+		}
+	}
+	if len(leases) > 0 {
+		last := leases[len(leases)-1]
+		if ym := last.Paymentymd[0:7]; ym >= startym && ym <= endym {
+			liab.Terminations = last.Balance
+		}
+	}
+
+	// 契约履历的减损和范围缩小(部分终止认识)
+	for _, e := range events {
+		if e.Ym < startym || e.Ym > endym {
+			continue
+		}
+		rou.Impairments += e.Impairment
+		rou.Terminations += e.GensyoBoka
+		liab.Terminations += e.GensyoBalance
+	}
+
+	// 再测定 = 期末残高 - (期首残高 + 增加 - 减少)
+	rou.Remeasurements = rou.Closing - (rou.Opening + rou.Additions - rou.Depreciation - rou.Impairments - rou.Terminations)
+	liab.Remeasurements = liab.Closing - (liab.Opening + liab.Additions + liab.Interest - liab.Payments - liab.Terminations)
+
+	return rou, liab, nil
+}
+
+// MaturityAnalysis endym以后的割引前リース料按标准区分的满期分析
+func MaturityAnalysis(pays []Payment, endym string) ([]MaturityBucket, error) {
+	end, err := time.Parse("2006-01", endym)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]MaturityBucket, len(maturityLabels))
+	for i, label := range maturityLabels {
+		buckets[i].Label = label
+	}
+	for _, pay := range pays {
+		ym, err := time.Parse("2006-01", pay.Paymentymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		months := (ym.Year()-end.Year())*12 + int(ym.Month()-end.Month())
+		if months <= 0 {
+			continue
+		}
+		i := (months - 1) / 12
+		if i >= len(buckets) {
+			i = len(buckets) - 1
+		}
+		buckets[i].Amount += pay.Paymentleasefee
+	}
+	return buckets, nil
+}
+
+// AddMaturity 满期分析合计(同一区分的金额合计)
+func AddMaturity(total, ms []MaturityBucket) []MaturityBucket {
+	if len(total) == 0 {
+		return append([]MaturityBucket(nil), ms...)
+	}
+	for i := range total {
+		if i < len(ms) {
+			total[i].Amount += ms[i].Amount
+		}
+	}
+	return total
+}
+
+// PaymentTotal startym至endym期间的支付额合计(现金支出)
+func PaymentTotal(pays []Payment, startym, endym string) (total Money) {
+	for _, pay := range pays {
+		if ym := pay.Paymentymd[0:7]; ym >= startym && ym <= endym {
+			total += pay.Paymentleasefee
+		}
+	}
+	return total
+}
@@ -0,0 +1,108 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestFiscalYear(t *testing.T) {
+	start, end, err := FiscalYear("2021-02", 4)
+	if err != nil {
+		t.Fatalf("FiscalYear() error = %v", err)
+	}
+	if start != "2020-04" || end != "2021-03" {
+		t.Errorf("FiscalYear() = %v~%v, want 2020-04~2021-03", start, end)
+	}
+}
+
+func TestRollForward(t *testing.T) {
+	bp := baseParam(t)
+	base := mustCompute(t, testConfig, bp)
+
+	// 租赁开始年度:新规取得,无再测定
+	rou, liab, err := RollForward(base.Leases, base.RePayments, nil, "2020-04", "2021-03")
+	if err != nil {
+		t.Fatalf("RollForward() error = %v", err)
+	}
+	checkGolden(t, "disclosure_rollforward", map[string]interface{}{"rou": rou, "liability": liab})
+	if rou.Opening != 0 || rou.Additions != base.KiSyuBoka {
+		t.Errorf("rou Opening, Additions = %v, %v, want 0, %v", rou.Opening, rou.Additions, base.KiSyuBoka)
+	}
+	if rou.Remeasurements != 0 || liab.Remeasurements != 0 {
+		t.Errorf("Remeasurements = %v, %v, want 0, 0", rou.Remeasurements, liab.Remeasurements)
+	}
+	if liab.Closing != base.Leases[11].Balance {
+		t.Errorf("liability Closing = %v, want %v", liab.Closing, base.Leases[11].Balance)
+	}
+
+	// 翌年度的期首残高为前年度的期末残高
+	next, nextLiab, err := RollForward(base.Leases, base.RePayments, nil, "2021-04", "2022-03")
+	if err != nil {
+		t.Fatalf("RollForward() error = %v", err)
+	}
+	if next.Opening != rou.Closing || nextLiab.Opening != liab.Closing || next.Additions != 0 {
+		t.Errorf("Opening = %v, %v, want %v, %v", next.Opening, nextLiab.Opening, rou.Closing, liab.Closing)
+	}
+
+	// 减损按契约履历区分,不计入再测定
+	ir, err := ImpairmentCompute(testConfig, base.RePayments, impairmentParam(bp, "2021-06-01", MoneyFromInt(1000000), false))
+	if err != nil {
+		t.Fatalf("ImpairmentCompute() error = %v", err)
+	}
+	events := []DisclosureEvent{{Ym: "2021-06", Impairment: ir.Gensongaku}}
+	impaired, _, err := RollForward(base.Leases, ir.RePayments, events, "2021-04", "2022-03")
+	if err != nil {
+		t.Fatalf("RollForward() error = %v", err)
+	}
+	if impaired.Impairments != MoneyFromInt(1000000) || impaired.Remeasurements != 0 {
+		t.Errorf("Impairments, Remeasurements = %v, %v, want 1000000, 0", impaired.Impairments, impaired.Remeasurements)
+	}
+
+	// 满了年度:期末残高为0
+	last, lastLiab, err := RollForward(base.Leases, base.RePayments, nil, "2025-04", "2026-03")
+	if err != nil {
+		t.Fatalf("RollForward() error = %v", err)
+	}
+	if last.Closing != 0 || lastLiab.Closing != 0 || lastLiab.Remeasurements != 0 || last.Remeasurements != 0 {
+		t.Errorf("last = %v, %v, want closing 0 without remeasurements", last, lastLiab)
+	}
+
+	if _, _, err := RollForward(base.Leases, base.RePayments, nil, "2021-04", "2021-03"); err == nil {
+		t.Error("RollForward() error = nil, want invalid period")
+	}
+}
+
+func TestMaturityAnalysis(t *testing.T) {
+	bp := baseParam(t)
+
+	got, err := MaturityAnalysis(bp.Payments, "2021-03")
+	if err != nil {
+		t.Fatalf("MaturityAnalysis() error = %v", err)
+	}
+	// 2021-04~2025-03为月额10万×12,2025-04为最终回的残价保证额
+	want := []Money{
+		MoneyFromInt(1200000),
+		MoneyFromInt(1200000),
+		MoneyFromInt(1200000),
+		MoneyFromInt(1200000),
+		MoneyFromInt(500000),
+		0,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("len(MaturityAnalysis()) = %v, want %v", len(got), len(want))
+	}
+	for i, b := range got {
+		if b.Amount != want[i] {
+			t.Errorf("MaturityAnalysis()[%s] = %v, want %v", b.Label, b.Amount, want[i])
+		}
+	}
+
+	total := AddMaturity(nil, got)
+	total = AddMaturity(total, got)
+	if total[0].Amount != MoneyFromInt(2400000) || got[0].Amount != MoneyFromInt(1200000) {
+		t.Errorf("AddMaturity()[0] = %v, want 2400000", total[0].Amount)
+	}
+
+	if p := PaymentTotal(bp.Payments, "2020-04", "2021-03"); p != MoneyFromInt(1200000) {
+		t.Errorf("PaymentTotal() = %v, want 1200000", p)
+	}
+}
@@ -0,0 +1,205 @@
+package leasecalc
+
+import (
+	"errors"
+	"math/big"
+	"time"
+)
+
+// EscalationKind 支付额改定方式
+type EscalationKind string
+
+const (
+	// EscalationPercent 按固定比例定期改定(复利)
+	EscalationPercent EscalationKind = "percent"
+	// EscalationStepped 按日期阶梯改定为指定金额
+	EscalationStepped EscalationKind = "step"
+	// EscalationIndex 按物价指数(CPI等)联动改定
+	EscalationIndex EscalationKind = "index"
+)
+
+// defaultEscalationInterval 改定间隔未设定的场合的月数(每年改定)
+const defaultEscalationInterval = 12
+
+// ErrBaseIndex 指数联动契约的基准指数未设定
+var ErrBaseIndex = errors.New("指数連動リースの基準指数が設定されていません")
+
+// EscalationStep 阶梯改定
+type EscalationStep struct {
+	From   string `json:"from" bson:"from"`     // 适用开始年月(2006-01)
+	Amount Money  `json:"amount" bson:"amount"` // 改定后支付金额
+}
+
+// IndexPoint 指数值
+type IndexPoint struct {
+	Ym    string  `json:"ym" bson:"ym"`       // 指数年月(2006-01)
+	Value float64 `json:"value" bson:"value"` // 指数值
+}
+
+// Escalation 支付额改定条款
+type Escalation struct {
+	Kind      EscalationKind   `json:"kind" bson:"kind"`           // 改定方式
+	Rate      float64          `json:"rate" bson:"rate"`           // 每次改定的比例(percent)
+	Interval  int              `json:"interval" bson:"interval"`   // 改定间隔月数(percent/index)
+	Steps     []EscalationStep `json:"steps" bson:"steps"`         // 阶梯改定(step)
+	IndexCode string           `json:"indexCode" bson:"indexCode"` // 指数代码(index)
+	BaseIndex float64          `json:"baseIndex" bson:"baseIndex"` // 基准指数(index)
+}
+
+// Validate 改定条款检查
+func (e *Escalation) Validate() error {
+	if e == nil {
+		return nil
+	}
+	switch e.Kind {
+	case EscalationPercent:
+		if e.Rate <= -1 {
+			return errors.New("改定率が不正です")
+		}
+	case EscalationStepped:
+		for _, s := range e.Steps {
+			if _, err := time.Parse("2006-01", s.From); err != nil {
+				return errors.New("段階改定の適用開始年月が不正です")
+			}
+		}
+	case EscalationIndex:
+		if e.BaseIndex <= 0 {
+			return ErrBaseIndex
+		}
+	case "":
+	default:
+		return errors.New("改定方式が不正です")
+	}
+	if e.Interval < 0 {
+		return errors.New("改定間隔が不正です")
+	}
+	return nil
+}
+
+// interval 改定间隔月数
+func (e *Escalation) interval() int {
+	if e.Interval > 0 {
+		return e.Interval
+	}
+	return defaultEscalationInterval
+}
+
+// feeAt 支付年月的改定后支付金额
+// 改定回数 = 支付开始月到支付年月的月数 / 改定间隔;
+// 指数联动的场合,使用改定时点已公表的最新指数(之后的支付也按该指数,不预测将来的指数)
+func (e *Escalation) feeAt(rd Rounding, base Money, stym, payym time.Time, indexes []IndexPoint) Money {
+	n := getGapMonths(stym, payym) / e.interval()
+	switch e.Kind {
+	case EscalationPercent:
+		if n <= 0 {
+			return base
+		}
+		return rd.Mul(base, powRat(new(big.Rat).Add(big.NewRat(1, 1), ratOf(e.Rate)), n))
+	case EscalationStepped:
+		fee := base
+		ym := payym.Format("2006-01")
+		for _, s := range e.Steps {
+			if s.From <= ym {
+				fee = s.Amount
+			}
+		}
+		return fee
+	case EscalationIndex:
+		if n <= 0 {
+			return base
+		}
+		reviewym := stym.AddDate(0, n*e.interval(), 0).Format("2006-01")
+		value, ok := latestIndex(indexes, reviewym)
+		if !ok {
+			return base
+		}
+		return rd.Mul(base, new(big.Rat).Quo(ratOf(value), ratOf(e.BaseIndex)))
+	}
+	return base
+}
+
+// latestIndex 指定年月(包含)为止已公表的最新指数
+func latestIndex(indexes []IndexPoint, ym string) (value float64, ok bool) {
+	latest := ""
+	for _, p := range indexes {
+		if p.Ym <= ym && p.Ym >= latest {
+			latest = p.Ym
+			value = p.Value
+			ok = true
+		}
+	}
+	return value, ok
+}
+
+// escalate 按改定条款改写支付金额(只改写from年月之后的支付,from为空的场合全部改写)
+// 改定的基础金额为契约上的支付金额(初回和最终回リース料有输入的场合优先使用,有支付计划的场合为计划上的金额)
+func escalate(q PayParam, pays []Payment, from string) (result []Payment, changed bool) {
+	stym := firstDayOfMonth(q.Paymentstymd)
+	last := -1
+	for i, pay := range pays {
+		if pay.PaymentType == "支払" {
+			last = i
+		}
+	}
+
+	// 有支付计划的场合,各回的基础金额为计划上的金额
+	var planned map[int]Money
+	if len(q.Segments) > 0 {
+		planned = plannedFees(q)
+	}
+
+	result = make([]Payment, 0, len(pays))
+	for i, pay := range pays {
+		if pay.PaymentType != "支払" || pay.Paymentymd[:7] <= from {
+			result = append(result, pay)
+			continue
+		}
+		base := q.Paymentleasefee
+		if pay.Paymentcount == 1 && q.Firstleasefee != 0 {
+			base = q.Firstleasefee
+		}
+		if i == last && q.Finalleasefee != 0 {
+			base = q.Finalleasefee
+		}
+		if fee, ok := planned[pay.Paymentcount]; ok {
+			base = fee
+		}
+		payymd, err := time.Parse("2006-01-02", pay.Paymentymd)
+		if err != nil {
+			result = append(result, pay)
+			continue
+		}
+		fee := q.Escalation.feeAt(q.Rounding, base, stym, payymd, q.Indexes)
+		if fee != pay.Paymentleasefee {
+			pay.Paymentleasefee = fee
+			changed = true
+		}
+		result = append(result, pay)
+	}
+
+	return result, changed
+}
+
+// RemeasureYmd 指数更新等引起的再测定的变更年月日(处理月度,翌月的支付开始变更)
+func RemeasureYmd(cfg Config) string {
+	return cfg.SyoriYm + "-01"
+}
+
+// RemeasurePayments 按最新指数重新算出处理月度翌月以后的支付金额
+// 返回的支付数据作为债务变更的变更后支付数据使用(变更年月为RemeasureYmd),
+// 支付额没有变化的场合changed为false,不需要再测定
+func RemeasurePayments(cfg Config, q PayParam, opayData []Payment) (pays []Payment, changed bool, err error) {
+	if q.Escalation == nil || q.Escalation.Kind == "" {
+		return opayData, false, nil
+	}
+	if err := q.Escalation.Validate(); err != nil {
+		return nil, false, err
+	}
+	if _, err := time.Parse("2006-01", cfg.SyoriYm); err != nil {
+		return nil, false, err
+	}
+	q.Rounding = cfg.Rounding
+
+	pays, changed = escalate(q, opayData, cfg.SyoriYm)
+	return pays, changed, nil
+}
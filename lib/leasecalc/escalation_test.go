@@ -0,0 +1,132 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestGeneratePayEscalation(t *testing.T) {
+	base := PayParam{
+		Paymentstymd:    date("2020-04-25"),
+		Paymentcycle:    1,
+		Paymentday:      25,
+		Paymentcounts:   36,
+		Paymentleasefee: MoneyFromInt(100000),
+		Keiyakuno:       "K0004",
+	}
+	tests := []struct {
+		name       string
+		escalation *Escalation
+		indexes    []IndexPoint
+		want       map[string]Money // 支付年月 => 支付金额
+	}{
+		{
+			name:       "percent",
+			escalation: &Escalation{Kind: EscalationPercent, Rate: 0.03},
+			want: map[string]Money{
+				"2021-03": MoneyFromInt(100000),
+				"2021-04": MoneyFromInt(103000),
+				"2022-04": MoneyFromInt(106090),
+			},
+		},
+		{
+			name: "step",
+			escalation: &Escalation{Kind: EscalationStepped, Steps: []EscalationStep{
+				{From: "2020-10", Amount: MoneyFromInt(110000)},
+				{From: "2022-01", Amount: MoneyFromInt(120000)},
+			}},
+			want: map[string]Money{
+				"2020-09": MoneyFromInt(100000),
+				"2020-10": MoneyFromInt(110000),
+				"2022-01": MoneyFromInt(120000),
+			},
+		},
+		{
+			name:       "index",
+			escalation: &Escalation{Kind: EscalationIndex, Interval: 12, IndexCode: "CPI", BaseIndex: 100},
+			indexes:    []IndexPoint{{Ym: "2020-04", Value: 100}, {Ym: "2021-03", Value: 102.5}},
+			want: map[string]Money{
+				"2021-03": MoneyFromInt(100000),
+				"2021-04": MoneyFromInt(102500),
+				// 将来的改定也按已公表的最新指数
+				"2022-04": MoneyFromInt(102500),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := base
+			q.Escalation = tt.escalation
+			q.Indexes = tt.indexes
+			for _, pay := range mustPays(t, q) {
+				if want, ok := tt.want[pay.Paymentymd[:7]]; ok && pay.Paymentleasefee != want {
+					t.Errorf("Paymentleasefee(%s) = %v, want %v", pay.Paymentymd, pay.Paymentleasefee, want)
+				}
+			}
+		})
+	}
+
+	q := base
+	q.Escalation = &Escalation{Kind: EscalationIndex}
+	if _, err := GeneratePay(q); err != ErrBaseIndex {
+		t.Errorf("GeneratePay() error = %v, want %v", err, ErrBaseIndex)
+	}
+}
+
+func TestIndexRemeasure(t *testing.T) {
+	q := PayParam{
+		Paymentstymd:    date("2020-04-25"),
+		Paymentcycle:    1,
+		Paymentday:      25,
+		Paymentcounts:   60,
+		Paymentleasefee: MoneyFromInt(100000),
+		ResidualValue:   MoneyFromInt(500000),
+		Keiyakuno:       "K0001",
+		Escalation:      &Escalation{Kind: EscalationIndex, IndexCode: "CPI", BaseIndex: 100},
+		Indexes:         []IndexPoint{{Ym: "2020-04", Value: 100}},
+	}
+	bp := baseParam(t)
+	bp.Payments = mustPays(t, q)
+	base := mustCompute(t, testConfig, bp)
+
+	// 指数未更新的场合不需要再测定
+	if _, changed, err := RemeasurePayments(testConfig, q, base.Payments); err != nil || changed {
+		t.Fatalf("RemeasurePayments() changed = %v, err = %v, want false", changed, err)
+	}
+
+	// 2021-03的指数公表后,处理月度(2021-04)翌月以后的支付额按新指数改定
+	q.Indexes = append(q.Indexes, IndexPoint{Ym: "2021-03", Value: 104})
+	pays, changed, err := RemeasurePayments(testConfig, q, base.Payments)
+	if err != nil || !changed {
+		t.Fatalf("RemeasurePayments() changed = %v, err = %v, want true", changed, err)
+	}
+	for i, pay := range pays {
+		want := base.Payments[i].Paymentleasefee
+		if pay.PaymentType == "支払" && pay.Paymentymd[:7] > testConfig.SyoriYm {
+			want = MoneyFromInt(104000)
+		}
+		if pay.Paymentleasefee != want {
+			t.Errorf("Paymentleasefee(%s) = %v, want %v", pay.Paymentymd, pay.Paymentleasefee, want)
+		}
+	}
+
+	got, err := DebtCompute(testConfig, base.KiSyuBoka, base.Payments, base.Leases, base.RePayments, DebtParam{
+		Henkouymd:     RemeasureYmd(testConfig),
+		Leasestymd:    "2020-04-01",
+		Leasekikan:    bp.Leasekikan,
+		Keiyakuno:     "K0001",
+		Rishiritsu:    bp.Rishiritsu,
+		ResidualValue: bp.ResidualValue,
+		Assetlife:     bp.Assetlife,
+		Torihikikbn:   bp.Torihikikbn,
+		Percentage:    1,
+		Payments:      pays,
+	})
+	if err != nil {
+		t.Fatalf("DebtCompute() error = %v", err)
+	}
+	checkGolden(t, "remeasure_index", got)
+
+	if got.Leasesaimusagaku <= 0 || got.Shisannsagaku != got.Leasesaimusagaku {
+		t.Errorf("Leasesaimusagaku = %v, Shisannsagaku = %v, want equal positive adjustment", got.Leasesaimusagaku, got.Shisannsagaku)
+	}
+}
@@ -0,0 +1,116 @@
+package leasecalc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 短期リースまたは少額リース判定结果的租赁类型
+const (
+	LeaseNormal = "normal_lease"
+	LeaseShort  = "short_lease"
+	LeaseMinor  = "minor_lease"
+)
+
+// ExemptionPolicy 资产分类单位的免除规定适用方针(短期リース和少額リース)
+// 少額リース按标的资产的单价判定(リース料総额÷数量)
+type ExemptionPolicy struct {
+	AssetClass      string `json:"assets_class_id" bson:"assets_class_id"`     // 资产分类
+	MinorUnitAmount Money  `json:"minor_unit_amount" bson:"minor_unit_amount"` // 少額リース判定的单价基准额(0的场合不适用少額リース)
+	ShortTerm       bool   `json:"short_term" bson:"short_term"`               // 适用短期リース的免除
+	ExcludeVariable bool   `json:"exclude_variable" bson:"exclude_variable"`   // 判定时不包括变动リース料
+}
+
+// Exemption 短期リースまたは少額リース判定结果
+type Exemption struct {
+	LeaseType string `json:"lease_type" bson:"lease_type"` // 租赁类型(normal_lease/short_lease/minor_lease)
+	Reason    string `json:"reason" bson:"reason"`         // 判定理由
+}
+
+// GlobalPolicy app设定的少額基准额和短期基准对应的方针(资产分类未设定方针的场合使用)
+// 与以往的判定相同,按支付额合计判定,不包括变动リース料
+func GlobalPolicy(minorBaseAmount Money, shortLeases int) ExemptionPolicy {
+	return ExemptionPolicy{
+		MinorUnitAmount: minorBaseAmount,
+		ShortTerm:       shortLeases > 0,
+		ExcludeVariable: true,
+	}
+}
+
+// Judge 按方针进行短期リースまたは少額リース判定(短期リース优先)
+// shortLeases为短期リース判定期间(月),quantity为标的资产的数量(未设定的场合为1)
+func (p ExemptionPolicy) Judge(shortLeases, quantity, leasekikan, extentionOption int, payments []Payment) Exemption {
+	if quantity <= 0 {
+		quantity = 1
+	}
+	// 支付总额取得
+	var payTotal, variable Money
+	for _, pay := range payments {
+		payTotal += pay.Paymentleasefee
+		variable += pay.Paymentleasefeehendo
+	}
+	if !p.ExcludeVariable {
+		payTotal += variable
+	}
+	unit := payTotal / Money(quantity)
+	// 租赁总期间 = 租赁期间 + 延长租赁期间
+	leasekikanTotal := leasekikan + extentionOption
+
+	// 短期判断
+	if p.ShortTerm && leasekikanTotal <= shortLeases {
+		return Exemption{
+			LeaseType: LeaseShort,
+			Reason:    fmt.Sprintf("リース期間%dヶ月が短期リースの基準%dヶ月以下のため短期リースに分類", leasekikanTotal, shortLeases),
+		}
+	}
+
+	// 单价的说明(数量和变动リース料的扱い)
+	price := "単価" + formatAmount(unit)
+	if quantity > 1 {
+		price += fmt.Sprintf("(リース料総額%s÷数量%d)", formatAmount(payTotal), quantity)
+	}
+	if p.ExcludeVariable && variable != 0 {
+		price += fmt.Sprintf("(変動リース料%sを除く)", formatAmount(variable))
+	}
+
+	// 少額判断
+	if p.MinorUnitAmount > 0 && unit <= p.MinorUnitAmount {
+		return Exemption{
+			LeaseType: LeaseMinor,
+			Reason:    fmt.Sprintf("%sが少額リースの基準額%s以下のため少額リースに分類", price, formatAmount(p.MinorUnitAmount)),
+		}
+	}
+
+	var reasons []string
+	if p.ShortTerm {
+		reasons = append(reasons, fmt.Sprintf("リース期間%dヶ月が短期リースの基準%dヶ月を超え", leasekikanTotal, shortLeases))
+	} else {
+		reasons = append(reasons, "短期リースの免除を適用せず")
+	}
+	if p.MinorUnitAmount > 0 {
+		reasons = append(reasons, fmt.Sprintf("%sが少額リースの基準額%sを超える", price, formatAmount(p.MinorUnitAmount)))
+	} else {
+		reasons = append(reasons, "少額リースの免除を適用しない")
+	}
+	return Exemption{
+		LeaseType: LeaseNormal,
+		Reason:    strings.Join(reasons, "、") + "ため通常リースに分類",
+	}
+}
+
+// formatAmount 金额的显示(整数部分按3位加逗号)
+func formatAmount(m Money) string {
+	s := m.String()
+	sign := ""
+	if strings.HasPrefix(s, "-") {
+		sign, s = "-", s[1:]
+	}
+	frac := ""
+	if i := strings.Index(s, "."); i >= 0 {
+		s, frac = s[:i], s[i:]
+	}
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return sign + s + frac
+}
@@ -0,0 +1,70 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestExemptionPolicyJudge(t *testing.T) {
+	// 月额35000×24回 = 840000,变动リース料24000
+	var pays []Payment
+	for i := 0; i < 24; i++ {
+		pays = append(pays, Payment{Paymentleasefee: MoneyFromInt(35000), Paymentleasefeehendo: MoneyFromInt(1000)})
+	}
+	pc := ExemptionPolicy{AssetClass: "PC", MinorUnitAmount: MoneyFromInt(500000), ShortTerm: true, ExcludeVariable: true}
+
+	tests := []struct {
+		name       string
+		policy     ExemptionPolicy
+		quantity   int
+		leasekikan int
+		want       Exemption
+	}{
+		{
+			name:       "minor_by_unit",
+			policy:     pc,
+			quantity:   2,
+			leasekikan: 24,
+			want: Exemption{
+				LeaseType: LeaseMinor,
+				Reason:    "単価420,000(リース料総額840,000÷数量2)(変動リース料24,000を除く)が少額リースの基準額500,000以下のため少額リースに分類",
+			},
+		},
+		{
+			name:       "normal_with_variable",
+			policy:     ExemptionPolicy{AssetClass: "PC", MinorUnitAmount: MoneyFromInt(500000), ShortTerm: true},
+			quantity:   1,
+			leasekikan: 24,
+			want: Exemption{
+				LeaseType: LeaseNormal,
+				Reason:    "リース期間24ヶ月が短期リースの基準12ヶ月を超え、単価864,000が少額リースの基準額500,000を超えるため通常リースに分類",
+			},
+		},
+		{
+			name:       "short",
+			policy:     pc,
+			quantity:   1,
+			leasekikan: 12,
+			want: Exemption{
+				LeaseType: LeaseShort,
+				Reason:    "リース期間12ヶ月が短期リースの基準12ヶ月以下のため短期リースに分類",
+			},
+		},
+		{
+			name:       "no_election",
+			policy:     ExemptionPolicy{AssetClass: "BLD"},
+			quantity:   1,
+			leasekikan: 12,
+			want: Exemption{
+				LeaseType: LeaseNormal,
+				Reason:    "短期リースの免除を適用せず、少額リースの免除を適用しないため通常リースに分類",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.Judge(12, tt.quantity, tt.leasekikan, 0, pays); got != tt.want {
+				t.Errorf("Judge() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package leasecalc
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// ForecastUnit 预测的集计期间单位
+type ForecastUnit string
+
+// 集计期间单位(四半期和年度按期首月(KishuYm)划分)
+const (
+	ForecastMonth   ForecastUnit = "month"
+	ForecastQuarter ForecastUnit = "quarter"
+	ForecastYear    ForecastUnit = "year"
+)
+
+// ParseForecastUnit 集计期间单位的转换(未设定的场合为月度)
+func ParseForecastUnit(s string) (ForecastUnit, error) {
+	switch ForecastUnit(s) {
+	case "", ForecastMonth:
+		return ForecastMonth, nil
+	case ForecastQuarter, ForecastYear:
+		return ForecastUnit(s), nil
+	}
+	return "", errors.New("集計単位が不正です")
+}
+
+// ForecastAmount 集计期间单位的现金支付、支付利息、减价偿却费和期末残高
+type ForecastAmount struct {
+	Startym  string `json:"startym" bson:"startym"`   // 期间开始年月
+	Endym    string `json:"endym" bson:"endym"`       // 期间结束年月
+	Payment  Money  `json:"payment" bson:"payment"`   // 支払リース料
+	Interest Money  `json:"interest" bson:"interest"` // 支払利息
+	Syokyaku Money  `json:"syokyaku" bson:"syokyaku"` // 減価償却費
+	Balance  Money  `json:"balance" bson:"balance"`   // 期末リース負債
+	Boka     Money  `json:"boka" bson:"boka"`         // 期末使用権資産
+}
+
+// periodStart 年月所属的集计期间的开始年月
+func periodStart(ym time.Time, unit ForecastUnit, kishuMonth int) time.Time {
+	// 会计年度内的月数(期首月为0)
+	offset := (int(ym.Month()) - kishuMonth + 12) % 12
+	switch unit {
+	case ForecastQuarter:
+		return ym.AddDate(0, -(offset % 3), 0)
+	case ForecastYear:
+		return ym.AddDate(0, -offset, 0)
+	}
+	return ym
+}
+
+// ForecastPeriods 预测对象的集计期间(fromym至toym,最后的期间截止到toym)
+func ForecastPeriods(fromym, toym string, unit ForecastUnit, kishuMonth int) ([]ForecastAmount, error) {
+	from, err := time.Parse("2006-01", fromym)
+	if err != nil {
+		return nil, err
+	}
+	to, err := time.Parse("2006-01", toym)
+	if err != nil {
+		return nil, err
+	}
+	if to.Before(from) {
+		return nil, errors.New("予測期間が不正です")
+	}
+
+	var periods []ForecastAmount
+	for t := from; !t.After(to); {
+		end := periodStart(t, unit, kishuMonth)
+		switch unit {
+		case ForecastQuarter:
+			end = end.AddDate(0, 2, 0)
+		case ForecastYear:
+			end = end.AddDate(0, 11, 0)
+		}
+		if end.After(to) {
+			end = to
+		}
+		periods = append(periods, ForecastAmount{
+			Startym: t.Format("2006-01"),
+			Endym:   end.Format("2006-01"),
+		})
+		t = end.AddDate(0, 1, 0)
+	}
+	return periods, nil
+}
+
+// Forecast 单个契约的预测(按集计期间汇总支付、利息和偿还数据)
+// 租赁开始前的契约(已签约未开始)开始前的期间为0,开始后按偿还表计上
+func Forecast(pays []Payment, leases []Lease, repays []RePayment, fromym, toym string, unit ForecastUnit, kishuMonth int) ([]ForecastAmount, error) {
+	periods, err := ForecastPeriods(fromym, toym, unit, kishuMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	// 月度的期末残高和费用
+	months := make(map[string]PeriodAmount)
+	for _, a := range Periods(leases, repays, fromym) {
+		months[a.Ym] = a
+	}
+	// 月度的支付额
+	payments := make(map[string]Money)
+	for _, pay := range pays {
+		payments[pay.Paymentymd[0:7]] += pay.Paymentleasefee
+	}
+
+	for i := range periods {
+		p := &periods[i]
+		start, _ := time.Parse("2006-01", p.Startym)
+		for t := start; t.Format("2006-01") <= p.Endym; t = t.AddDate(0, 1, 0) {
+			ym := t.Format("2006-01")
+			a := months[ym]
+			p.Payment += payments[ym]
+			p.Interest += a.Interest
+			p.Syokyaku += a.Syokyaku
+			// 期末残高为期间最终月的月末残高(数据最终月以后为0)
+			p.Balance = a.Balance
+			p.Boka = a.Boka
+		}
+	}
+	return periods, nil
+}
+
+// AddForecast 预测数据合计(同一集计期间的金额合计)
+func AddForecast(total, fs []ForecastAmount) []ForecastAmount {
+	amounts := make(map[string]ForecastAmount, len(total))
+	for _, list := range [][]ForecastAmount{total, fs} {
+		for _, f := range list {
+			a := amounts[f.Startym]
+			a.Startym, a.Endym = f.Startym, f.Endym
+			a.Payment += f.Payment
+			a.Interest += f.Interest
+			a.Syokyaku += f.Syokyaku
+			a.Balance += f.Balance
+			a.Boka += f.Boka
+			amounts[f.Startym] = a
+		}
+	}
+	result := make([]ForecastAmount, 0, len(amounts))
+	for _, a := range amounts {
+		result = append(result, a)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Startym < result[j].Startym
+	})
+	return result
+}
@@ -0,0 +1,92 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestForecastPeriods(t *testing.T) {
+	tests := []struct {
+		unit   ForecastUnit
+		fromym string
+		toym   string
+		want   []string
+	}{
+		{ForecastMonth, "2021-04", "2021-06", []string{"2021-04~2021-04", "2021-05~2021-05", "2021-06~2021-06"}},
+		{ForecastQuarter, "2021-05", "2021-12", []string{"2021-05~2021-06", "2021-07~2021-09", "2021-10~2021-12"}},
+		{ForecastYear, "2021-06", "2023-05", []string{"2021-06~2022-03", "2022-04~2023-03", "2023-04~2023-05"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.unit), func(t *testing.T) {
+			got, err := ForecastPeriods(tt.fromym, tt.toym, tt.unit, 4)
+			if err != nil {
+				t.Fatalf("ForecastPeriods() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("len(ForecastPeriods()) = %v, want %v", len(got), len(tt.want))
+			}
+			for i, p := range got {
+				if s := p.Startym + "~" + p.Endym; s != tt.want[i] {
+					t.Errorf("ForecastPeriods()[%d] = %v, want %v", i, s, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestForecast(t *testing.T) {
+	bp := baseParam(t)
+	base := mustCompute(t, testConfig, bp)
+
+	got, err := Forecast(base.Payments, base.Leases, base.RePayments, "2021-04", "2026-03", ForecastYear, 4)
+	if err != nil {
+		t.Fatalf("Forecast() error = %v", err)
+	}
+	checkGolden(t, "forecast_year", got)
+
+	months, err := Forecast(base.Payments, base.Leases, base.RePayments, "2021-04", "2026-03", ForecastMonth, 4)
+	if err != nil {
+		t.Fatalf("Forecast() error = %v", err)
+	}
+	// 年度合计与月度合计一致,期末残高为年度最终月的残高
+	var payment, interest Money
+	for _, m := range months[:12] {
+		payment += m.Payment
+		interest += m.Interest
+	}
+	if got[0].Payment != payment || got[0].Interest != interest || got[0].Balance != months[11].Balance {
+		t.Errorf("Forecast()[0] = %v, want payment %v interest %v balance %v", got[0], payment, interest, months[11].Balance)
+	}
+	if last := got[len(got)-1]; last.Balance != 0 || last.Boka != 0 {
+		t.Errorf("last Balance, Boka = %v, %v, want 0, 0", last.Balance, last.Boka)
+	}
+
+	// 已签约未开始的契约,开始前的期间为0
+	future := baseParam(t)
+	future.Leasestymd = date("2022-04-01")
+	future.FirstMonth = "2022-04"
+	future.Payments = mustPays(t, PayParam{
+		Paymentstymd:    date("2022-04-25"),
+		Paymentcycle:    1,
+		Paymentday:      25,
+		Paymentcounts:   60,
+		Paymentleasefee: MoneyFromInt(100000),
+		Keiyakuno:       "K0002",
+	})
+	fr := mustCompute(t, testConfig, future)
+	fs, err := Forecast(fr.Payments, fr.Leases, fr.RePayments, "2021-04", "2026-03", ForecastYear, 4)
+	if err != nil {
+		t.Fatalf("Forecast() error = %v", err)
+	}
+	if fs[0].Payment != 0 || fs[0].Balance != 0 || fs[0].Boka != 0 {
+		t.Errorf("Forecast()[0] = %v, want zero before commencement", fs[0])
+	}
+	if fs[1].Payment != MoneyFromInt(1200000) || fs[1].Balance == 0 {
+		t.Errorf("Forecast()[1] = %v, want payments after commencement", fs[1])
+	}
+
+	// 合计
+	total := AddForecast(got, fs)
+	if total[1].Payment != got[1].Payment+fs[1].Payment {
+		t.Errorf("AddForecast()[1].Payment = %v, want %v", total[1].Payment, got[1].Payment+fs[1].Payment)
+	}
+}
@@ -0,0 +1,119 @@
+package leasecalc
+
+import (
+	"fmt"
+	"time"
+)
+
+// FxRate 为替汇率(外币1单位的功能通货金额)
+type FxRate struct {
+	Currency string  `json:"currency" bson:"currency"` // 通货代码(USD等)
+	Ym       string  `json:"ym" bson:"ym"`             // 汇率年月(2006-01,月末汇率)
+	Rate     float64 `json:"rate" bson:"rate"`         // 汇率
+}
+
+// FxRevaluation 外币租赁负债的月末换算结果
+// 租赁负债按月末汇率换算,使用权资产维持取得时汇率,不再换算
+type FxRevaluation struct {
+	Keiyakuno           string  `json:"keiyakuno" bson:"keiyakuno"`                     // 契约番号
+	Currency            string  `json:"currency" bson:"currency"`                       // 交易通货
+	Ym                  string  `json:"ym" bson:"ym"`                                   // 换算年月
+	OpeningRate         float64 `json:"openingRate" bson:"openingRate"`                 // 前月末汇率(租赁开始月为取得时汇率)
+	ClosingRate         float64 `json:"closingRate" bson:"closingRate"`                 // 当月末汇率
+	PayTotalRemain      Money   `json:"payTotalRemain" bson:"payTotalRemain"`           // 当月以后的支付残额(交易通货)
+	InterestTotalRemain Money   `json:"interestTotalRemain" bson:"interestTotalRemain"` // 当月以后的利息残额(交易通货)
+	Balance             Money   `json:"balance" bson:"balance"`                         // 当月末元本残高(交易通货)
+	FunctionalBalance   Money   `json:"functionalBalance" bson:"functionalBalance"`     // 当月末元本残高(功能通货)
+	PayDiff             Money   `json:"payDiff" bson:"payDiff"`                         // 支付残额的换算差额(功能通货)
+	InterestDiff        Money   `json:"interestDiff" bson:"interestDiff"`               // 利息残额的换算差额(功能通货)
+	FxDiff              Money   `json:"fxDiff" bson:"fxDiff"`                           // 为替换算差額(正:差損,负:差益)
+}
+
+// IsForeignCurrency 是否为外币契约(未设定或与功能通货相同的场合为否)
+func IsForeignCurrency(currency, functional string) bool {
+	return len(currency) > 0 && currency != functional
+}
+
+// RateAt 取得年月时点的汇率(该年月以前最新的汇率)
+func RateAt(rates []FxRate, currency, ym string) (rate float64, err error) {
+	found := ""
+	for _, r := range rates {
+		if r.Currency != currency || r.Ym > ym || r.Ym <= found {
+			continue
+		}
+		found = r.Ym
+		rate = r.Rate
+	}
+	if len(found) == 0 || rate <= 0 {
+		return 0, fmt.Errorf("通貨[%s]の%s時点の為替レートが登録されていません", currency, ym)
+	}
+	return rate, nil
+}
+
+// Translate 交易通货金额按汇率换算为功能通货
+func (r Rounding) Translate(m Money, rate float64) Money {
+	return r.Mul(m, ratOf(rate))
+}
+
+// RevalueLiability 按月末汇率重新换算租赁负债
+// 换算差额为月初(当月以后)的支付残额和利息残额按前月末汇率和当月末汇率换算的差额,
+// 当月的支付按当月末汇率换算,所以不产生差额
+func RevalueLiability(rd Rounding, leases []Lease, ym, currency string, openingRate, closingRate float64) (r FxRevaluation, err error) {
+	if _, err := time.Parse("2006-01", ym); err != nil {
+		return r, fmt.Errorf("換算年月[%s]が不正です", ym)
+	}
+	if openingRate <= 0 || closingRate <= 0 {
+		return r, fmt.Errorf("通貨[%s]の為替レートが不正です", currency)
+	}
+
+	r = FxRevaluation{
+		Currency:    currency,
+		Ym:          ym,
+		OpeningRate: openingRate,
+		ClosingRate: closingRate,
+	}
+	started := false
+	for _, l := range leases {
+		if len(l.Paymentymd) < 7 {
+			continue
+		}
+		if len(r.Keiyakuno) == 0 {
+			r.Keiyakuno = l.Keiyakuno
+		}
+		lym := l.Paymentymd[:7]
+		if lym <= ym {
+			// 当月末的元本残高
+			r.Balance = l.Balance
+			started = true
+		}
+		if lym >= ym {
+			r.PayTotalRemain += l.Interest + l.Repayment
+			r.InterestTotalRemain += l.Interest
+		}
+	}
+
+	// 支付开始前的场合,元本残高为当初的现在价值
+	if !started {
+		r.Balance = r.PayTotalRemain - r.InterestTotalRemain
+	}
+
+	r.FunctionalBalance = rd.Translate(r.Balance, closingRate)
+	r.PayDiff = rd.Translate(r.PayTotalRemain, closingRate) - rd.Translate(r.PayTotalRemain, openingRate)
+	r.InterestDiff = rd.Translate(r.InterestTotalRemain, closingRate) - rd.Translate(r.InterestTotalRemain, openingRate)
+	r.FxDiff = r.PayDiff - r.InterestDiff
+
+	return r, nil
+}
+
+// OpeningRate 换算年月的月初汇率(前月末汇率,租赁开始月为取得时汇率)
+func OpeningRate(rates []FxRate, currency, leasestym, ym string) (float64, error) {
+	t, err := time.Parse("2006-01", ym)
+	if err != nil {
+		return 0, fmt.Errorf("換算年月[%s]が不正です", ym)
+	}
+	prev := t.AddDate(0, -1, 0).Format("2006-01")
+	if prev < leasestym {
+		return RateAt(rates, currency, leasestym)
+	}
+	return RateAt(rates, currency, prev)
+}
@@ -0,0 +1,58 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestRevalueLiability(t *testing.T) {
+	rates := []FxRate{
+		{Currency: "USD", Ym: "2020-04", Rate: 107.5},
+		{Currency: "USD", Ym: "2021-03", Rate: 110},
+		{Currency: "EUR", Ym: "2021-04", Rate: 130},
+		{Currency: "USD", Ym: "2021-04", Rate: 109},
+	}
+	got := mustCompute(t, testConfig, baseParam(t))
+
+	// 租赁开始月按取得时汇率,不产生差额
+	opening, err := OpeningRate(rates, "USD", "2020-04", "2020-04")
+	if err != nil || opening != 107.5 {
+		t.Fatalf("OpeningRate() = %v, %v, want 107.5", opening, err)
+	}
+	if r, err := RevalueLiability(Rounding{}, got.Leases, "2020-04", "USD", opening, 107.5); err != nil || r.FxDiff != 0 {
+		t.Errorf("RevalueLiability() FxDiff = %v, err = %v, want 0", r.FxDiff, err)
+	}
+
+	// 中间月份未登录汇率的场合,沿用最新的汇率
+	if rate, err := RateAt(rates, "USD", "2020-12"); err != nil || rate != 107.5 {
+		t.Errorf("RateAt() = %v, %v, want 107.5", rate, err)
+	}
+	if _, err := RateAt(rates, "GBP", "2021-04"); err == nil {
+		t.Errorf("RateAt() error = nil, want missing rate error")
+	}
+
+	opening, err = OpeningRate(rates, "USD", "2020-04", "2021-04")
+	if err != nil || opening != 110 {
+		t.Fatalf("OpeningRate() = %v, %v, want 110", opening, err)
+	}
+	r, err := RevalueLiability(Rounding{}, got.Leases, "2021-04", "USD", opening, 109)
+	if err != nil {
+		t.Fatalf("RevalueLiability() error = %v", err)
+	}
+
+	// 月初元本残高(支付残额-利息残额)按汇率变动额换算,円高的场合为差益
+	var opened Money
+	for _, l := range got.Leases {
+		if l.Paymentymd[:7] == "2021-03" {
+			opened = l.Balance
+		}
+	}
+	if opened != r.PayTotalRemain-r.InterestTotalRemain {
+		t.Errorf("opening balance = %v, want %v", r.PayTotalRemain-r.InterestTotalRemain, opened)
+	}
+	if diff := r.FxDiff - (Rounding{}).Translate(opened, -1); diff > MoneyFromInt(1) || diff < -MoneyFromInt(1) {
+		t.Errorf("FxDiff = %v, want about %v", r.FxDiff, (Rounding{}).Translate(opened, -1))
+	}
+	if r.FunctionalBalance != (Rounding{}).Translate(r.Balance, 109) {
+		t.Errorf("FunctionalBalance = %v, want %v", r.FunctionalBalance, (Rounding{}).Translate(r.Balance, 109))
+	}
+}
@@ -0,0 +1,3 @@
+module rxcsoft.cn/pit3/lib/leasecalc
+
+go 1.13
@@ -0,0 +1,155 @@
+package leasecalc
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// InterpolationMethod 追加借入利子率曲线的插值方式
+type InterpolationMethod string
+
+const (
+	// InterpolateLinear 线性插值(利率按期间线性插值)
+	InterpolateLinear InterpolationMethod = "linear"
+	// InterpolateLogLinear 对数线性插值(割引係数的对数按期间线性插值)
+	InterpolateLogLinear InterpolationMethod = "log_linear"
+)
+
+// ErrTenorOutOfRange 租赁期间超过曲线的最长期间点
+var ErrTenorOutOfRange = errors.New("leasecalc: lease term exceeds the longest tenor of the curve")
+
+// ParseInterpolationMethod 插值方式转换(未设定或不正的场合,默认线性插值)
+func ParseInterpolationMethod(s string) InterpolationMethod {
+	if InterpolationMethod(s) == InterpolateLogLinear {
+		return InterpolateLogLinear
+	}
+	return InterpolateLinear
+}
+
+// TenorPoint 利率曲线的期间点
+type TenorPoint struct {
+	Months int     `json:"months" bson:"months"` // 期间(月)
+	Rate   float64 `json:"rate" bson:"rate"`     // 年利率
+	ItemID string  `json:"item_id,omitempty" bson:"item_id"`
+}
+
+// Curve 追加借入利子率曲线(每个基准年月和通货一条)
+type Curve struct {
+	BaseYm   string       `json:"base_ym" bson:"base_ym"`   // 基准年月
+	Currency string       `json:"currency" bson:"currency"` // 通货
+	Points   []TenorPoint `json:"points" bson:"points"`     // 期间点(按期间升序)
+}
+
+// CurveRate 曲线插值结果(审计用,记录利率的来源)
+type CurveRate struct {
+	Rate       float64             `json:"rate"`        // 插值后的年利率
+	Term       int                 `json:"term"`        // 租赁期间(月)
+	Method     InterpolationMethod `json:"method"`      // 插值方式
+	UsedPoints []TenorPoint        `json:"used_points"` // 插值使用的期间点
+}
+
+// NewCurve 生成利率曲线(期间点按期间排序并检查)
+func NewCurve(baseYm, currency string, points []TenorPoint) (*Curve, error) {
+	if len(points) == 0 {
+		return nil, errors.New("leasecalc: curve has no tenor points")
+	}
+	ps := make([]TenorPoint, len(points))
+	copy(ps, points)
+	sort.SliceStable(ps, func(i, j int) bool {
+		return ps[i].Months < ps[j].Months
+	})
+	for i, p := range ps {
+		if p.Months <= 0 {
+			return nil, fmt.Errorf("leasecalc: invalid tenor %d months", p.Months)
+		}
+		if p.Rate <= -12 {
+			return nil, fmt.Errorf("leasecalc: invalid rate %v at tenor %d months", p.Rate, p.Months)
+		}
+		if i > 0 && ps[i-1].Months == p.Months {
+			return nil, fmt.Errorf("leasecalc: duplicate tenor %d months", p.Months)
+		}
+	}
+	return &Curve{BaseYm: baseYm, Currency: currency, Points: ps}, nil
+}
+
+// RatePoint 利率表的一行(通货和期间点)
+type RatePoint struct {
+	Currency string
+	TenorPoint
+}
+
+// CurveCurrency 检索利率曲线使用的通货(未指定的场合使用功能通货)
+func CurveCurrency(currency, functional string) string {
+	if len(currency) == 0 || currency == "null" {
+		return functional
+	}
+	return currency
+}
+
+// MatchCurrency 利率行是否属于该通货的曲线(没有通货的行视为功能通货)
+func MatchCurrency(rowCurrency, currency, functional string) bool {
+	if len(rowCurrency) == 0 || rowCurrency == "null" {
+		return currency == functional
+	}
+	return rowCurrency == currency
+}
+
+// NewCurrencyCurve 从同一基准年月的利率行中取出该通货的期间点生成利率曲线,
+// currency未指定的场合使用功能通货
+func NewCurrencyCurve(baseYm, currency, functional string, rows []RatePoint) (*Curve, error) {
+	currency = CurveCurrency(currency, functional)
+
+	var points []TenorPoint
+	for _, r := range rows {
+		if MatchCurrency(r.Currency, currency, functional) {
+			points = append(points, r.TenorPoint)
+		}
+	}
+	return NewCurve(baseYm, currency, points)
+}
+
+// Interpolate 根据租赁期间从曲线上求利率
+// 短于最短期间点的场合使用最短期间点的利率,超过最长期间点的场合返回错误
+func (c *Curve) Interpolate(term int, method InterpolationMethod) (CurveRate, error) {
+	method = ParseInterpolationMethod(string(method))
+	result := CurveRate{Term: term, Method: method}
+	if term <= 0 {
+		return result, fmt.Errorf("leasecalc: invalid lease term %d months", term)
+	}
+
+	last := c.Points[len(c.Points)-1]
+	if term > last.Months {
+		return result, ErrTenorOutOfRange
+	}
+
+	// 第一个期间 >= 租赁期间的期间点
+	i := sort.Search(len(c.Points), func(i int) bool {
+		return c.Points[i].Months >= term
+	})
+	upper := c.Points[i]
+	if upper.Months == term || i == 0 {
+		result.Rate = upper.Rate
+		result.UsedPoints = []TenorPoint{upper}
+		return result, nil
+	}
+
+	lower := c.Points[i-1]
+	w := float64(term-lower.Months) / float64(upper.Months-lower.Months)
+	switch method {
+	case InterpolateLogLinear:
+		// 割引係数 DF(t) = (1 + r/12)^-t,对ln(DF)按期间线性插值后换算回年利率
+		lnLower := -float64(lower.Months) * math.Log1p(lower.Rate/12)
+		lnUpper := -float64(upper.Months) * math.Log1p(upper.Rate/12)
+		ln := lnLower + w*(lnUpper-lnLower)
+		result.Rate = 12 * math.Expm1(-ln/float64(term))
+	default:
+		result.Rate = lower.Rate + w*(upper.Rate-lower.Rate)
+	}
+	// 消除浮点误差(利率保留10位小数)
+	result.Rate = math.Round(result.Rate*1e10) / 1e10
+	result.UsedPoints = []TenorPoint{lower, upper}
+
+	return result, nil
+}
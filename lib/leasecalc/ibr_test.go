@@ -0,0 +1,144 @@
+package leasecalc
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func testCurve(t *testing.T) *Curve {
+	t.Helper()
+	c, err := NewCurve("2020-04", "JPY", []TenorPoint{
+		{Months: 60, Rate: 0.02},
+		{Months: 12, Rate: 0.005},
+		{Months: 36, Rate: 0.014},
+		{Months: 24, Rate: 0.01},
+	})
+	if err != nil {
+		t.Fatalf("NewCurve() error = %v", err)
+	}
+	return c
+}
+
+func TestCurveInterpolate(t *testing.T) {
+	c := testCurve(t)
+	tests := []struct {
+		name   string
+		term   int
+		method InterpolationMethod
+		want   float64
+		used   []int
+	}{
+		{name: "exact_tenor", term: 36, method: InterpolateLinear, want: 0.014, used: []int{36}},
+		{name: "shorter_than_first", term: 6, method: InterpolateLinear, want: 0.005, used: []int{12}},
+		{name: "linear", term: 37, method: InterpolateLinear, want: 0.01425, used: []int{36, 60}},
+		{name: "linear_mid", term: 18, method: InterpolateLinear, want: 0.0075, used: []int{12, 24}},
+		{name: "default_is_linear", term: 48, method: "", want: 0.017, used: []int{36, 60}},
+		{name: "log_linear", term: 37, method: InterpolateLogLinear, want: 0.0144053, used: []int{36, 60}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := c.Interpolate(tt.term, tt.method)
+			if err != nil {
+				t.Fatalf("Interpolate() error = %v", err)
+			}
+			if math.Abs(got.Rate-tt.want) > 1e-7 {
+				t.Errorf("Interpolate() rate = %v, want %v", got.Rate, tt.want)
+			}
+			if len(got.UsedPoints) != len(tt.used) {
+				t.Fatalf("Interpolate() used points = %v, want tenors %v", got.UsedPoints, tt.used)
+			}
+			for i, p := range got.UsedPoints {
+				if p.Months != tt.used[i] {
+					t.Errorf("Interpolate() used points = %v, want tenors %v", got.UsedPoints, tt.used)
+				}
+			}
+		})
+	}
+
+	if _, err := c.Interpolate(61, InterpolateLinear); err != ErrTenorOutOfRange {
+		t.Errorf("Interpolate() beyond last tenor error = %v, want %v", err, ErrTenorOutOfRange)
+	}
+}
+
+// 对数线性插值时,插值点的割引係数位于两端期间点割引係数的几何插值上
+func TestCurveInterpolateLogLinearDiscountFactor(t *testing.T) {
+	c := testCurve(t)
+	got, err := c.Interpolate(48, InterpolateLogLinear)
+	if err != nil {
+		t.Fatalf("Interpolate() error = %v", err)
+	}
+	df := func(rate float64, months int) float64 {
+		return math.Pow(1+rate/12, -float64(months))
+	}
+	want := math.Sqrt(df(0.014, 36) * df(0.02, 60))
+	if math.Abs(df(got.Rate, 48)-want) > 1e-9 {
+		t.Errorf("discount factor = %v, want %v", df(got.Rate, 48), want)
+	}
+}
+
+func TestNewCurveInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []TenorPoint
+	}{
+		{name: "empty"},
+		{name: "duplicate", points: []TenorPoint{{Months: 12, Rate: 0.01}, {Months: 12, Rate: 0.02}}},
+		{name: "zero_tenor", points: []TenorPoint{{Months: 0, Rate: 0.01}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewCurve("2020-04", "JPY", tt.points); err == nil {
+				t.Errorf("NewCurve() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestNewCurrencyCurve(t *testing.T) {
+	// 同一基准年月有JPY和USD的利率,以及没有通货的旧数据
+	rows := []RatePoint{
+		{Currency: "JPY", TenorPoint: TenorPoint{Months: 12, Rate: 0.005}},
+		{Currency: "JPY", TenorPoint: TenorPoint{Months: 60, Rate: 0.02}},
+		{Currency: "USD", TenorPoint: TenorPoint{Months: 12, Rate: 0.04}},
+		{Currency: "USD", TenorPoint: TenorPoint{Months: 60, Rate: 0.05}},
+		{Currency: "null", TenorPoint: TenorPoint{Months: 36, Rate: 0.014}},
+	}
+
+	tests := []struct {
+		name         string
+		currency     string
+		functional   string
+		wantCurrency string
+		wantMonths   []int
+		wantErr      bool
+	}{
+		{name: "foreign", currency: "USD", functional: "JPY", wantCurrency: "USD", wantMonths: []int{12, 60}},
+		{name: "functional", currency: "JPY", functional: "JPY", wantCurrency: "JPY", wantMonths: []int{12, 36, 60}},
+		{name: "blank uses functional", currency: "", functional: "JPY", wantCurrency: "JPY", wantMonths: []int{12, 36, 60}},
+		{name: "null uses functional", currency: "null", functional: "USD", wantCurrency: "USD", wantMonths: []int{12, 36, 60}},
+		{name: "blank without functional", currency: "", functional: "", wantCurrency: "", wantMonths: []int{36}},
+		{name: "no rows", currency: "EUR", functional: "JPY", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewCurrencyCurve("2020-04", tt.currency, tt.functional, rows)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewCurrencyCurve() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if c.Currency != tt.wantCurrency {
+				t.Errorf("Currency = %q, want %q", c.Currency, tt.wantCurrency)
+			}
+			var months []int
+			for _, p := range c.Points {
+				months = append(months, p.Months)
+			}
+			if fmt.Sprint(months) != fmt.Sprint(tt.wantMonths) {
+				t.Errorf("Points = %v, want %v", months, tt.wantMonths)
+			}
+		})
+	}
+}
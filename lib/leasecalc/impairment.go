@@ -0,0 +1,160 @@
+package leasecalc
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// ImpairmentCompute 使用権資産的减损和减损回转处理
+// 减损年月的月末薄价按减损损失额调整后,次月起以调整后的账面价值为基础再生成剩余的偿还数据;
+// 减损回转以未减损时的账面价值(月末薄价+减损累计额)为上限
+func ImpairmentCompute(cfg Config, orepayData []RePayment, p ImpairmentParam) (result *ImpairmentResult, err error) {
+	result = &ImpairmentResult{}
+
+	if p.Amount <= 0 {
+		return nil, errors.New("減損額は0より大きい金額を入力してください")
+	}
+	// 偿却方法
+	method, err := p.Depreciation.Method()
+	if err != nil {
+		return nil, err
+	}
+	// 处理月度转换
+	syoriym, err := time.Parse("2006-01", cfg.SyoriYm)
+	if err != nil {
+		return nil, err
+	}
+	// 减损年月
+	if len(p.Henkouymd) < 7 {
+		return nil, errors.New("減損年月が不正です")
+	}
+	henkouym, err := time.Parse("2006-01", p.Henkouymd[0:7])
+	if err != nil {
+		return nil, err
+	}
+	// 处理月度前的偿还数据已计上分录,不能遡及减损
+	if henkouym.Before(syoriym) {
+		return nil, errors.New("減損年月は処理月度以降を指定してください")
+	}
+	// 端数处理设定
+	rd := cfg.Rounding
+
+	// 减损年月(含)前的偿还数据保存,减损前账面价值和减损累计额取得
+	var repayData []RePayment
+	// 经营租赁的单一リース費用不因减损变化(按偿却年月沿用)
+	leasecosts := make(map[string]Money)
+	var genson Money
+	// 减损年月的偿还数据位置和已偿却月数
+	last, months := -1, 0
+	for _, repay := range orepayData {
+		syokyakuym, err := time.Parse("2006-01", repay.Syokyakuymd[0:7])
+		if err != nil {
+			return nil, err
+		}
+		if syokyakuym.After(henkouym) {
+			leasecosts[repay.Syokyakuymd] = repay.Leasecost
+			continue
+		}
+		// 调整数据不计入偿却月数
+		if repay.Syokyakukbn == "調整" {
+			repayData = append(repayData, repay)
+			continue
+		}
+		result.OBoka = repay.Endboka
+		genson = repay.Genson
+		last = len(repayData)
+		months++
+		repayData = append(repayData, repay)
+	}
+	if last < 0 {
+		return nil, errors.New("減損年月前（減損年月も含む）の償却データが存在しません")
+	}
+	result.Unimpaired = result.OBoka + genson
+
+	if p.Reversal {
+		// 减损回转(以减损累计额为上限)
+		if genson <= 0 {
+			return nil, errors.New("戻入可能な減損損失が存在しません")
+		}
+		result.Gensongaku = p.Amount
+		if result.Gensongaku > genson {
+			result.Gensongaku = genson
+		}
+		result.Boka = result.OBoka + result.Gensongaku
+		genson -= result.Gensongaku
+	} else {
+		if p.Amount > result.OBoka {
+			return nil, errors.New("減損額が帳簿価額を超えています")
+		}
+		result.Gensongaku = p.Amount
+		result.Boka = result.OBoka - result.Gensongaku
+		genson += result.Gensongaku
+	}
+
+	// 减损年月的月末薄价调整
+	repayData[last].Endboka = result.Boka
+	repayData[last].Genson = genson
+
+	// **********剩余偿还情报再生成**********
+	// 租赁总期间
+	leasekikanTotal := p.Leasekikan + p.ExtentionOption
+	// 減価償却期間算出
+	genkakikan := p.Assetlife * 12
+	if p.Torihikikbn != "1" {
+		// 移転外
+		if genkakikan > leasekikanTotal {
+			genkakikan = leasekikanTotal
+		}
+	}
+	genkakikan = genkakikan - months
+	if genkakikan <= 0 {
+		result.RePayments = repayData
+		return result, nil
+	}
+	// 新減価开始日算出
+	leasestsyoymd, err := time.Parse("2006-01-02", repayData[last].Syokyakuymd)
+	if err != nil {
+		return nil, err
+	}
+	leasestsyoymd = leasestsyoymd.AddDate(0, 1, 0)
+	// 期首月取得
+	kishuMonth, _ := strconv.Atoi(cfg.KishuYm)
+
+	repays, err := getRepayData(rd, method, leasestsyoymd, genkakikan, impairedResidual(p.ResidualValue, result.Boka), result.Boka, kishuMonth)
+	if err != nil {
+		return nil, err
+	}
+	if genson > 0 {
+		// 未减损时的偿还数据(减损累计额的算出用)
+		unimpaired, err := getRepayData(rd, method, leasestsyoymd, genkakikan, impairedResidual(p.ResidualValue, result.Boka+genson), result.Boka+genson, kishuMonth)
+		if err != nil {
+			return nil, err
+		}
+		trackGenson(repays, unimpaired)
+	}
+	for i := range repays {
+		repays[i].Leasecost = leasecosts[repays[i].Syokyakuymd]
+	}
+
+	result.RePayments = append(repayData, repays...)
+
+	return result, nil
+}
+
+// impairedResidual 减损后的账面价值低于残价保证额的场合,以账面价值为偿却后的残额
+func impairedResidual(residualValue, boka Money) Money {
+	if boka < residualValue {
+		return boka
+	}
+	return residualValue
+}
+
+// trackGenson 偿还数据上记录减损累计额(未减损时的月末薄价与月末薄价的差额)
+func trackGenson(repays, unimpaired []RePayment) {
+	for i := range repays {
+		if i < len(unimpaired) {
+			repays[i].Genson = unimpaired[i].Endboka - repays[i].Endboka
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func impairmentParam(bp LRParam, henkouymd string, amount Money, reversal bool) ImpairmentParam {
+	return ImpairmentParam{
+		Henkouymd:     henkouymd,
+		Keiyakuno:     "K0001",
+		Leasekikan:    bp.Leasekikan,
+		Assetlife:     bp.Assetlife,
+		Torihikikbn:   bp.Torihikikbn,
+		ResidualValue: bp.ResidualValue,
+		Amount:        amount,
+		Reversal:      reversal,
+	}
+}
+
+// repayAt 指定年月的偿还数据
+func repayAt(t *testing.T, repays []RePayment, ym string) RePayment {
+	t.Helper()
+	for _, rp := range repays {
+		if rp.Syokyakuymd[:7] == ym && rp.Syokyakukbn != "調整" {
+			return rp
+		}
+	}
+	t.Fatalf("RePayment[%s] not found", ym)
+	return RePayment{}
+}
+
+func TestImpairmentCompute(t *testing.T) {
+	bp := baseParam(t)
+	base := mustCompute(t, testConfig, bp)
+
+	// 2021-06减损100万
+	got, err := ImpairmentCompute(testConfig, base.RePayments, impairmentParam(bp, "2021-06-01", MoneyFromInt(1000000), false))
+	if err != nil {
+		t.Fatalf("ImpairmentCompute() error = %v", err)
+	}
+	checkGolden(t, "impairment", got)
+
+	before := repayAt(t, base.RePayments, "2021-06")
+	if got.OBoka != before.Endboka || got.Boka != before.Endboka-MoneyFromInt(1000000) {
+		t.Errorf("OBoka, Boka = %v, %v, want %v, %v", got.OBoka, got.Boka, before.Endboka, before.Endboka-MoneyFromInt(1000000))
+	}
+	if next := repayAt(t, got.RePayments, "2021-07"); next.Boka != got.Boka {
+		t.Errorf("next Boka = %v, want %v", next.Boka, got.Boka)
+	}
+	if len(got.RePayments) != len(base.RePayments) {
+		t.Errorf("len(RePayments) = %v, want %v", len(got.RePayments), len(base.RePayments))
+	}
+	if last := got.RePayments[len(got.RePayments)-1]; last.Endboka != bp.ResidualValue || last.Genson != 0 {
+		t.Errorf("last Endboka, Genson = %v, %v, want %v, 0", last.Endboka, last.Genson, bp.ResidualValue)
+	}
+
+	// 2022-04回转,以未减损时的账面价值为上限
+	at := repayAt(t, got.RePayments, "2022-04")
+	rev, err := ImpairmentCompute(testConfig, got.RePayments, impairmentParam(bp, "2022-04-01", MoneyFromInt(5000000), true))
+	if err != nil {
+		t.Fatalf("ImpairmentCompute() error = %v", err)
+	}
+	if rev.Gensongaku != at.Genson || rev.Boka != repayAt(t, base.RePayments, "2022-04").Endboka {
+		t.Errorf("Gensongaku, Boka = %v, %v, want %v, unimpaired", rev.Gensongaku, rev.Boka, at.Genson)
+	}
+	for _, rp := range rev.RePayments {
+		if rp.Syokyakuymd[:7] > "2022-04" && rp.Genson != 0 {
+			t.Fatalf("RePayments[%s].Genson = %v, want 0", rp.Syokyakuymd, rp.Genson)
+		}
+	}
+
+	// 没有减损累计额的场合不能回转
+	if _, err := ImpairmentCompute(testConfig, base.RePayments, impairmentParam(bp, "2021-06-01", MoneyFromInt(1), true)); err == nil {
+		t.Errorf("ImpairmentCompute() error = nil, want reversal error")
+	}
+	// 处理月度前不能减损
+	if _, err := ImpairmentCompute(testConfig, base.RePayments, impairmentParam(bp, "2021-03-01", MoneyFromInt(1), false)); err == nil {
+		t.Errorf("ImpairmentCompute() error = nil, want syoriym error")
+	}
+	// 减损额不能超过账面价值
+	if _, err := ImpairmentCompute(testConfig, base.RePayments, impairmentParam(bp, "2021-06-01", before.Endboka+1, false)); err == nil {
+		t.Errorf("ImpairmentCompute() error = nil, want amount error")
+	}
+}
@@ -0,0 +1,106 @@
+package leasecalc
+
+import (
+	"time"
+)
+
+// 利息情报算出
+func getLeaseData(rd Rounding, payments []Payment, leasestymd time.Time, prevymd time.Time, principalAmount Money, rishiritsu float64) (ls []Lease, err error) {
+	return buildLeaseData(rd, payments, leasestymd, prevymd, principalAmount, rishiritsu, false)
+}
+
+// 利息情报算出(開始時点から計算)
+func getLeaseDataStart(rd Rounding, payments []Payment, leasestymd time.Time, prevymd time.Time, principalAmount Money, rishiritsu float64) (ls []Lease, err error) {
+	return buildLeaseData(rd, payments, leasestymd, prevymd, principalAmount, rishiritsu, true)
+}
+
+// buildLeaseData 利息情报算出共通处理
+// start为true的场合,基准月(比較開始期首月)之前的支付不计算,并记录期首元本残高
+// 最终回的利息由残高倒推,与按利率算出的利息之差作为端数调整额(Plug)记录
+func buildLeaseData(rd Rounding, payments []Payment, leasestymd time.Time, prevymd time.Time, principalAmount Money, rishiritsu float64, start bool) (ls []Lease, err error) {
+	var leaseData []Lease
+	// 月利率
+	rate := monthlyRate(rishiritsu)
+	// 首条数据判断用
+	var first = true
+	// 期首元本残高
+	var firstbalance Money = 0
+	// 前回支付日保存用
+	var prevPaymentymd time.Time
+	// 循环整理生成的新支付情报生成利息相关情报
+	for i, pay := range payments {
+		var lease Lease
+		// 当前月份的支付年月日
+		paymentymd, err := time.Parse("2006-01-02", pay.Paymentymd)
+		if err != nil {
+			return leaseData, err
+		}
+		// 基准月之前的支付不计算
+		if start && paymentymd.Before(leasestymd) {
+			continue
+		}
+		// 利息情报-年月日
+		lease.Paymentymd = paymentymd.Format("2006-01") + "-01"
+		// 累加未支付月份产生的利息用保存用
+		var interestcount Money = 0
+		// 临时计算原本保存用(计算利息用,不做下回支付退避)
+		var principalAmountcal Money = principalAmount
+		// 未支付月数取得
+		var gap int
+		if first {
+			// 获取首回支付与基准月间隔月数
+			gap = getGapMonths(prevymd, paymentymd)
+			if !leasestymd.Equal(prevymd) {
+				// 获取首回支付与前回支付年月间隔月数
+				gap = gap - 1
+			}
+		} else {
+			// 获取前回支付与本回支付间隔月数
+			gap = getGapMonths(prevPaymentymd, paymentymd) - 1
+		}
+		// 累加计算未支付月利息
+		for j := 0; j < gap; j++ {
+			// 单月利息
+			interestsingle := rd.Mul(principalAmountcal, rate)
+			// 临时计算原本 = 临时计算原本 + 未支付利息
+			principalAmountcal = principalAmountcal + interestsingle
+			// 未支付利息累计
+			interestcount += interestsingle
+		}
+		// 利息情报-支払利息相当額 = 当前支付月利息 + 累加的未支付月份产生的利息
+		lease.Interest = rd.Mul(principalAmountcal, rate) + interestcount
+		// 当回实际支付额编辑 = 支付额 - 优惠 + 变动额
+		currentPay := currentPayOf(pay)
+		// 支付最终回的场合
+		if i == len(payments)-1 {
+			// 利息情报-支払利息相当額 = 当回实际支付额 - 元本残高相当額
+			interest := currentPay - principalAmount
+			// 端数调整额 = 倒推利息 - 按利率算出的利息
+			lease.Plug = interest - lease.Interest
+			lease.Interest = interest
+		}
+		// 利息情报-元本返済相当額
+		lease.Repayment = currentPay - lease.Interest
+		// 利息情报-元本残高相当額
+		lease.Balance = principalAmount - lease.Repayment
+		// 期首元本残高
+		if start {
+			if paymentymd.Month() == prevymd.Month() {
+				firstbalance = lease.Repayment + lease.Balance
+			}
+			lease.Firstbalance = firstbalance
+		}
+		// k幂数取得(k=基准月到支付年月日的月数)
+		k := getGapMonths(leasestymd, paymentymd) + 1
+		// 利息情报-現在価値
+		lease.Present = discount(rd, currentPay, rishiritsu, k)
+		// 前回元本残高相当額变化--下回计算用
+		principalAmount = lease.Balance
+		// 添加利息情报
+		leaseData = append(leaseData, lease)
+		// 支付年月日退避
+		prevPaymentymd = paymentymd
+		first = false
+	}
+	return leaseData, nil
+}
@@ -0,0 +1,93 @@
+package leasecalc
+
+import (
+	"fmt"
+	"sort"
+)
+
+// 分录的贷借区分
+const (
+	DebitDivision  = "1" // 借方
+	CreditDivision = "2" // 贷方
+)
+
+// JournalLine 分录明细(贷借平衡验证用)
+type JournalLine struct {
+	Shiwakeno string `json:"shiwakeno" bson:"shiwakeno"` // 分录番号
+	Group     string `json:"group" bson:"group"`         // 分录的生成单位(履历番号或集计番号)
+	Pattern   string `json:"pattern" bson:"pattern"`     // 分录模式
+	Keiyakuno string `json:"keiyakuno" bson:"keiyakuno"` // 契约番号
+	Book      string `json:"book" bson:"book"`           // 账簿
+	Division  string `json:"division" bson:"division"`   // 贷借区分
+	Amount    Money  `json:"amount" bson:"amount"`       // 分录金额
+}
+
+// JournalImbalance 贷借不一致的分录(生成单位)
+type JournalImbalance struct {
+	Shiwakeno string `json:"shiwakeno" bson:"shiwakeno"` // 分录番号
+	Group     string `json:"group" bson:"group"`         // 分录的生成单位
+	Pattern   string `json:"pattern" bson:"pattern"`     // 分录模式
+	Keiyakuno string `json:"keiyakuno" bson:"keiyakuno"` // 契约番号
+	Book      string `json:"book" bson:"book"`           // 账簿
+	Debit     Money  `json:"debit" bson:"debit"`         // 借方合计
+	Credit    Money  `json:"credit" bson:"credit"`       // 贷方合计
+}
+
+// Error 贷借不一致的错误信息
+func (i JournalImbalance) Error() string {
+	return fmt.Sprintf("仕訳番号[%s]の仕訳パターン[%s]、契約番号[%s]の借方合計%sと貸方合計%sが一致しません", i.Shiwakeno, i.Pattern, i.Keiyakuno, formatAmount(i.Debit), formatAmount(i.Credit))
+}
+
+// CheckJournalBalance 按分录的生成单位(分录番号、账簿、分录模式、契约番号和履历番号)验证借方合计与贷方合计一致
+// 分录番号的合计为各生成单位的合计,所有生成单位一致的场合分录番号单位也一致
+func CheckJournalBalance(lines []JournalLine) []JournalImbalance {
+	type balanceKey struct {
+		shiwakeno, book, pattern, keiyakuno, group string
+	}
+
+	var keys []balanceKey
+	totals := make(map[balanceKey]*JournalImbalance)
+	for _, l := range lines {
+		key := balanceKey{l.Shiwakeno, BookOf(l.Book), l.Pattern, l.Keiyakuno, l.Group}
+		t, ok := totals[key]
+		if !ok {
+			t = &JournalImbalance{
+				Shiwakeno: l.Shiwakeno,
+				Group:     l.Group,
+				Pattern:   l.Pattern,
+				Keiyakuno: l.Keiyakuno,
+				Book:      BookOf(l.Book),
+			}
+			totals[key] = t
+			keys = append(keys, key)
+		}
+		if l.Division == DebitDivision {
+			t.Debit += l.Amount
+		} else {
+			t.Credit += l.Amount
+		}
+	}
+
+	var result []JournalImbalance
+	for _, key := range keys {
+		if t := totals[key]; t.Debit != t.Credit {
+			result = append(result, *t)
+		}
+	}
+	sort.SliceStable(result, func(a, b int) bool {
+		if result[a].Shiwakeno != result[b].Shiwakeno {
+			return result[a].Shiwakeno < result[b].Shiwakeno
+		}
+		return result[a].Keiyakuno < result[b].Keiyakuno
+	})
+
+	return result
+}
+
+// ReverseDivision 取消分录的贷借区分(借方和贷方对调)
+func ReverseDivision(division string) string {
+	if division == DebitDivision {
+		return CreditDivision
+	}
+	return DebitDivision
+}
@@ -0,0 +1,42 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestCheckJournalBalance(t *testing.T) {
+	lines := []JournalLine{
+		// 平衡的分录
+		{Shiwakeno: "S01", Group: "H01", Pattern: "01001", Keiyakuno: "K001", Division: DebitDivision, Amount: MoneyFromInt(1000)},
+		{Shiwakeno: "S01", Group: "H01", Pattern: "01001", Keiyakuno: "K001", Division: CreditDivision, Amount: MoneyFromInt(600)},
+		{Shiwakeno: "S01", Group: "H01", Pattern: "01001", Keiyakuno: "K001", Division: CreditDivision, Amount: MoneyFromInt(400)},
+		// 主账簿为空和main的场合为同一账簿
+		{Shiwakeno: "S01", Group: "1", Pattern: "03001", Keiyakuno: "K002", Book: PrimaryBookID, Division: DebitDivision, Amount: MoneyFromInt(500)},
+		{Shiwakeno: "S01", Group: "1", Pattern: "03001", Keiyakuno: "K002", Division: CreditDivision, Amount: MoneyFromInt(500)},
+		// 其他账簿的分录不一致
+		{Shiwakeno: "S01", Group: "1", Pattern: "03001", Keiyakuno: "K002", Book: "ifrs", Division: DebitDivision, Amount: MoneyFromInt(500)},
+		{Shiwakeno: "S01", Group: "1", Pattern: "03001", Keiyakuno: "K002", Book: "ifrs", Division: CreditDivision, Amount: MoneyFromInt(499)},
+	}
+
+	got := CheckJournalBalance(lines)
+	if len(got) != 1 {
+		t.Fatalf("len(CheckJournalBalance()) = %v, want 1\n got: %+v", len(got), got)
+	}
+	want := JournalImbalance{Shiwakeno: "S01", Group: "1", Pattern: "03001", Keiyakuno: "K002", Book: "ifrs", Debit: MoneyFromInt(500), Credit: MoneyFromInt(499)}
+	if got[0] != want {
+		t.Errorf("CheckJournalBalance()[0] = %+v, want %+v", got[0], want)
+	}
+	if msg := got[0].Error(); msg != "仕訳番号[S01]の仕訳パターン[03001]、契約番号[K002]の借方合計500と貸方合計499が一致しません" {
+		t.Errorf("Error() = %v", msg)
+	}
+
+	// 取消分录(贷借对调)也平衡
+	var reversed []JournalLine
+	for _, l := range lines[:3] {
+		l.Division = ReverseDivision(l.Division)
+		reversed = append(reversed, l)
+	}
+	if got := CheckJournalBalance(reversed); len(got) != 0 {
+		t.Errorf("CheckJournalBalance(reversed) = %+v, want none", got)
+	}
+}
@@ -0,0 +1,258 @@
+package leasecalc
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// LessorClass 贷手租赁的分类
+type LessorClass string
+
+const (
+	// LessorFinance ファイナンス・リース(正味リース投資を計上し、受取利息を利息法で認識)
+	LessorFinance LessorClass = "finance"
+	// LessorOperating オペレーティング・リース(受取リース料を定額で収益認識)
+	LessorOperating LessorClass = "operating"
+)
+
+// ErrSubleaseTerm 转租的租赁期间超过原租赁的剩余期间
+var ErrSubleaseTerm = errors.New("転貸リースの期間が原契約の残存期間を超えています")
+
+// ParseLessorClass 贷手分类转换(未设定或不正的场合返回空,按判定基准自动判定)
+func ParseLessorClass(s string) LessorClass {
+	switch LessorClass(s) {
+	case LessorFinance, LessorOperating:
+		return LessorClass(s)
+	}
+	return ""
+}
+
+// LessorParam 贷手契约(含转租)情报参数
+type LessorParam struct {
+	Keiyakuno     string      `json:"keiyakuno" bson:"keiyakuno"`         // 契约番号
+	HeadKeiyakuno string      `json:"headkeiyakuno" bson:"headkeiyakuno"` // 原契约番号(转租的场合)
+	HeadRemain    int         `json:"headremain" bson:"headremain"`       // 原契约的剩余租赁期间(月数,转租开始时点)
+	Boka          Money       `json:"boka" bson:"boka"`                   // 标的资产的账面价值(转租的场合为原契约使用権資産的账面价值)
+	Leasestymd    time.Time   `json:"leasestymd" bson:"leasestymd"`       // 租赁开始日
+	Leasekikan    int         `json:"leasekikan" bson:"leasekikan"`       // 租赁期间(月数)
+	Rishiritsu    float64     `json:"rishiritsu" bson:"rishiritsu"`       // 计算利子率
+	FairValue     Money       `json:"fairvalue" bson:"fairvalue"`         // 标的资产的公正价值(转租以外)
+	EconomicLife  int         `json:"economiclife" bson:"economiclife"`   // 经济耐用年数(转租以外)
+	ResidualValue Money       `json:"residualValue" bson:"residualValue"` // 無保証残存価値
+	Torihikikbn   string      `json:"torihikikbn" bson:"torihikikbn"`     // 取引判定区分(1:所有権移転)
+	Class         LessorClass `json:"lessorclass" bson:"lessorclass"`     // 贷手分类(未指定的场合自动判定)
+	Payments      []Payment   `json:"payments" bson:"payments"`           // 受取情报(与支付数据相同的形式)
+}
+
+// IsSublease 是否为转租
+func (p LessorParam) IsSublease() bool {
+	return len(p.HeadKeiyakuno) > 0
+}
+
+// LessorIncome 贷手的收益数据(月别)
+type LessorIncome struct {
+	Keiyakuno string      `json:"keiyakuno" bson:"keiyakuno"` // 契约番号
+	Incomeymd string      `json:"incomeymd" bson:"incomeymd"` // 收益年月
+	Class     LessorClass `json:"class" bson:"class"`         // 贷手分类
+	Receipt   Money       `json:"receipt" bson:"receipt"`     // 受取リース料
+	Income    Money       `json:"income" bson:"income"`       // 收益额(ファイナンス:受取利息,オペレーティング:受取リース料收益)
+	Recovery  Money       `json:"recovery" bson:"recovery"`   // 正味リース投資の回収額(ファイナンスのみ)
+	Balance   Money       `json:"balance" bson:"balance"`     // 月末残高(ファイナンス:正味リース投資,オペレーティング:前受(正)/未収(负)リース料)
+}
+
+// LessorResult 贷手契约计算结果
+type LessorResult struct {
+	Class          LessorClass    `json:"lessorclass" bson:"lessorclass"`       // 贷手分类
+	LeaseTotal     Money          `json:"leaseTotal" bson:"leaseTotal"`         // 受取リース料総額
+	PresentTotal   Money          `json:"presentTotal" bson:"presentTotal"`     // 受取リース料の現在価値
+	NetInvestment  Money          `json:"netinvestment" bson:"netinvestment"`   // 正味リース投資(ファイナンスのみ)
+	UnearnedIncome Money          `json:"unearnedincome" bson:"unearnedincome"` // 未経過受取利息(ファイナンスのみ)
+	Sonnekigaku    Money          `json:"sonnekigaku" bson:"sonnekigaku"`       // 標的資産の認識中止による損益額(ファイナンスのみ)
+	Payments       []Payment      `json:"payments" bson:"payments"`             // 受取数据
+	Incomes        []LessorIncome `json:"incomes" bson:"incomes"`               // 收益数据
+}
+
+// ClassifyLessor 贷手分类判定
+// 以下任一条件满足的场合为ファイナンス・リース:
+// 所有权移转;租赁期间为经济耐用年数的75%以上;受取リース料的现在价值为公正价值的90%以上
+// 转租的场合,经济耐用年数和公正价值分别以原契约的剩余期间和使用権資産的账面价值判定
+func ClassifyLessor(rd Rounding, p LessorParam) LessorClass {
+	if p.Torihikikbn == "1" {
+		return LessorFinance
+	}
+
+	life := p.EconomicLife * 12
+	fairValue := p.FairValue
+	if p.IsSublease() {
+		life = p.HeadRemain
+		fairValue = p.Boka
+	}
+
+	if life > 0 && p.Leasekikan*4 >= life*3 {
+		return LessorFinance
+	}
+	if fairValue > 0 {
+		presentTotal, _ := getLeaseTotal(rd, p.Payments, firstDayOfMonth(p.Leasestymd), p.Rishiritsu)
+		if presentTotal*10 >= fairValue*9 {
+			return LessorFinance
+		}
+	}
+
+	return LessorOperating
+}
+
+// LessorCompute 计算贷手契约的收益数据
+// ファイナンス・リース的场合,正味リース投資 = 受取リース料的现在价值 + 無保証残存価値的现在价值,
+// 按计算利子率以利息法算出受取利息和回收额;オペレーティング・リース的场合,受取リース料总额按租赁期间定额计上收益
+func LessorCompute(cfg Config, p LessorParam) (result *LessorResult, err error) {
+	// 受取数据合法性检查
+	if err := payDataValidCheck(false, p.Payments); err != nil {
+		return nil, err
+	}
+	if p.Leasekikan <= 0 {
+		return nil, fmt.Errorf("リース期間[%d]が不正です", p.Leasekikan)
+	}
+	// 转租的租赁期间不能超过原契约的剩余期间
+	if p.IsSublease() && p.Leasekikan > p.HeadRemain {
+		return nil, ErrSubleaseTerm
+	}
+
+	rd := cfg.Rounding
+	class := ParseLessorClass(string(p.Class))
+	if len(class) == 0 {
+		class = ClassifyLessor(rd, p)
+	}
+
+	// 租赁开始日(月初)
+	leasestymd := firstDayOfMonth(p.Leasestymd)
+	presentTotal, leaseTotal := getLeaseTotal(rd, p.Payments, leasestymd, p.Rishiritsu)
+
+	result = &LessorResult{
+		Class:        class,
+		LeaseTotal:   leaseTotal,
+		PresentTotal: presentTotal,
+		Payments:     p.Payments,
+	}
+
+	if class == LessorOperating {
+		result.Incomes, err = straightLineIncome(rd, p, leasestymd, leaseTotal)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
+	}
+
+	// 無保証残存価値作为最终回的受取计算现在价值和利息
+	pays := append([]Payment{}, p.Payments...)
+	if p.ResidualValue != 0 && len(pays) > 0 {
+		pays = append(pays, Payment{
+			Keiyakuno:       p.Keiyakuno,
+			PaymentType:     "残存価値",
+			Paymentymd:      pays[len(pays)-1].Paymentymd,
+			Paymentleasefee: p.ResidualValue,
+		})
+	}
+	netInvestment, grossInvestment := getLeaseTotal(rd, pays, leasestymd, p.Rishiritsu)
+
+	arranged, err := getArrangedPays(pays)
+	if err != nil {
+		return nil, err
+	}
+	leases, err := getLeaseData(rd, arranged, leasestymd, leasestymd, netInvestment, p.Rishiritsu)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, l := range leases {
+		income := LessorIncome{
+			Keiyakuno: p.Keiyakuno,
+			Incomeymd: l.Paymentymd,
+			Class:     class,
+			Receipt:   l.Interest + l.Repayment,
+			Income:    l.Interest,
+			Recovery:  l.Repayment,
+			Balance:   l.Balance,
+		}
+		// 最终回的無保証残存価値不是受取额,作为正味リース投資的残高保留到标的资产返还
+		if i == len(leases)-1 {
+			income.Receipt -= p.ResidualValue
+			income.Recovery -= p.ResidualValue
+			income.Balance += p.ResidualValue
+		}
+		result.Incomes = append(result.Incomes, income)
+	}
+
+	result.NetInvestment = netInvestment
+	result.UnearnedIncome = grossInvestment - netInvestment
+	// 标的资产(转租的场合为使用権資産)的认识中止损益
+	if p.Boka > 0 {
+		result.Sonnekigaku = netInvestment - p.Boka
+	}
+
+	return result, nil
+}
+
+// straightLineIncome オペレーティング・リース的收益数据算出
+// 受取リース料总额按租赁期间的月数定额计上,最终月吸收端数;
+// 残高 = 累计受取额 - 累计收益额(正为前受,负为未收)
+func straightLineIncome(rd Rounding, p LessorParam, leasestymd time.Time, leaseTotal Money) (incomes []LessorIncome, err error) {
+	// 月别受取额
+	receipts := make(map[string]Money)
+	for _, pay := range p.Payments {
+		payymd, err := time.Parse("2006-01-02", pay.Paymentymd)
+		if err != nil {
+			return nil, err
+		}
+		receipts[payymd.Format("2006-01")] += currentPayOf(pay)
+	}
+
+	monthly := rd.Mul(leaseTotal, big.NewRat(1, int64(p.Leasekikan)))
+	var recognized, balance Money
+	for i := 0; i < p.Leasekikan; i++ {
+		ymd := leasestymd.AddDate(0, i, 0)
+		income := monthly
+		if i == p.Leasekikan-1 {
+			income = leaseTotal - recognized
+		}
+		recognized += income
+
+		receipt := receipts[ymd.Format("2006-01")]
+		balance += receipt - income
+
+		incomes = append(incomes, LessorIncome{
+			Keiyakuno: p.Keiyakuno,
+			Incomeymd: ymd.Format("2006-01-02"),
+			Class:     LessorOperating,
+			Receipt:   receipt,
+			Income:    income,
+			Balance:   balance,
+		})
+	}
+
+	return incomes, nil
+}
+
+// HeadLeaseStatus 转租开始时点的原契约状况
+// 剩余期间为转租开始月到原契约满了月的月数,使用権資産的账面价值为转租开始月的月初薄价(主账簿)
+func HeadLeaseStatus(repays []RePayment, leasestymd time.Time, headExpireymd string) (remain int, boka Money, err error) {
+	expire, err := time.Parse("2006-01-02", headExpireymd)
+	if err != nil {
+		return 0, 0, fmt.Errorf("原契約のリース満了日[%s]が不正です", headExpireymd)
+	}
+	start := firstDayOfMonth(leasestymd)
+	remain = getGapMonths(start, expire) + 1
+	if remain <= 0 {
+		return 0, 0, ErrSubleaseTerm
+	}
+
+	ym := start.Format("2006-01")
+	for _, rp := range RePaymentsOfBook(repays, PrimaryBookID) {
+		if len(rp.Syokyakuymd) >= 7 && rp.Syokyakuymd[:7] == ym {
+			return remain, rp.Endboka + rp.Syokyaku, nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("原契約の%sの償却データが存在しません", ym)
+}
@@ -0,0 +1,123 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+// 原契约(5年)的2021-04开始,转租3年,月额12万
+func subleaseParam(t *testing.T, head *ComputeResult) LessorParam {
+	remain, boka, err := HeadLeaseStatus(head.RePayments, date("2021-04-01"), "2025-03-31")
+	if err != nil {
+		t.Fatalf("HeadLeaseStatus() error = %v", err)
+	}
+	return LessorParam{
+		Keiyakuno:     "S0001",
+		HeadKeiyakuno: "K0001",
+		HeadRemain:    remain,
+		Boka:          boka,
+		Leasestymd:    date("2021-04-01"),
+		Leasekikan:    36,
+		Rishiritsu:    0.03,
+		Torihikikbn:   "2",
+		Payments: mustPays(t, PayParam{
+			Paymentstymd:    date("2021-04-25"),
+			Paymentcycle:    1,
+			Paymentday:      25,
+			Paymentcounts:   36,
+			Paymentleasefee: MoneyFromInt(120000),
+			Keiyakuno:       "S0001",
+		}),
+	}
+}
+
+func TestLessorCompute(t *testing.T) {
+	head := mustCompute(t, testConfig, baseParam(t))
+	p := subleaseParam(t, head)
+	if p.HeadRemain != 48 {
+		t.Fatalf("HeadRemain = %v, want 48", p.HeadRemain)
+	}
+
+	// 剩余期间的75%(36/48)以上的转租为ファイナンス・リース
+	got, err := LessorCompute(testConfig, p)
+	if err != nil {
+		t.Fatalf("LessorCompute() error = %v", err)
+	}
+	checkGolden(t, "lessor_finance", got)
+	if got.Class != LessorFinance {
+		t.Errorf("Class = %v, want %v", got.Class, LessorFinance)
+	}
+
+	var income, recovery Money
+	for _, in := range got.Incomes {
+		income += in.Income
+		recovery += in.Recovery
+	}
+	if recovery != got.NetInvestment {
+		t.Errorf("total recovery = %v, want %v", recovery, got.NetInvestment)
+	}
+	if income != got.UnearnedIncome {
+		t.Errorf("total income = %v, want %v", income, got.UnearnedIncome)
+	}
+	if want := got.NetInvestment - p.Boka; got.Sonnekigaku != want {
+		t.Errorf("Sonnekigaku = %v, want %v", got.Sonnekigaku, want)
+	}
+
+	// 无保证残存价值作为正味リース投資的残高保留
+	p.ResidualValue = MoneyFromInt(300000)
+	withResidual, err := LessorCompute(testConfig, p)
+	if err != nil {
+		t.Fatalf("LessorCompute() error = %v", err)
+	}
+	if last := withResidual.Incomes[len(withResidual.Incomes)-1]; last.Balance != p.ResidualValue || last.Receipt != MoneyFromInt(120000) {
+		t.Errorf("last income = %+v, want Balance %v and Receipt 120000", last, p.ResidualValue)
+	}
+
+	// 转租期间超过原契约的剩余期间
+	p.Leasekikan = 49
+	if _, err := LessorCompute(testConfig, p); err != ErrSubleaseTerm {
+		t.Errorf("LessorCompute() error = %v, want %v", err, ErrSubleaseTerm)
+	}
+}
+
+func TestLessorOperating(t *testing.T) {
+	head := mustCompute(t, testConfig, baseParam(t))
+	p := subleaseParam(t, head)
+	// 1年的转租且受取额少的场合为オペレーティング・リース
+	p.Leasekikan = 12
+	p.Payments = mustPays(t, PayParam{
+		Paymentstymd:    date("2021-06-25"),
+		Paymentcycle:    3,
+		Paymentday:      25,
+		Paymentcounts:   4,
+		Paymentleasefee: MoneyFromInt(90000),
+		Keiyakuno:       "S0001",
+	})
+	if class := ClassifyLessor(Rounding{}, p); class != LessorOperating {
+		t.Fatalf("ClassifyLessor() = %v, want %v", class, LessorOperating)
+	}
+
+	got, err := LessorCompute(testConfig, p)
+	if err != nil {
+		t.Fatalf("LessorCompute() error = %v", err)
+	}
+	checkGolden(t, "lessor_operating", got)
+
+	if len(got.Incomes) != 12 {
+		t.Fatalf("len(Incomes) = %v, want 12", len(got.Incomes))
+	}
+	for _, in := range got.Incomes {
+		if in.Income != MoneyFromInt(30000) {
+			t.Errorf("Incomes[%s].Income = %v, want 30000", in.Incomeymd, in.Income)
+		}
+	}
+	// 期间内受取完了的场合,前受/未收残高为0
+	if last := got.Incomes[len(got.Incomes)-1]; last.Balance != 0 {
+		t.Errorf("last Balance = %v, want 0", last.Balance)
+	}
+
+	// 指定的分类优先于自动判定
+	p.Class = LessorFinance
+	if got, err := LessorCompute(testConfig, p); err != nil || got.Class != LessorFinance {
+		t.Errorf("LessorCompute() Class = %v, err = %v, want %v", got.Class, err, LessorFinance)
+	}
+}
@@ -0,0 +1,193 @@
+package leasecalc
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Money 金额(固定小数点,以1/10000为最小单位保存,避免浮点数误差)
+type Money int64
+
+// moneyPlaces Money保存的小数位数
+const moneyPlaces = 4
+
+// moneyScale Money的内部倍率(10^moneyPlaces)
+const moneyScale = 10000
+
+// RoundingMode 金额端数处理方式
+type RoundingMode string
+
+const (
+	// RoundTruncate 切り捨て(向0方向舍去)
+	RoundTruncate RoundingMode = "truncate"
+	// RoundHalfUp 四捨五入(0.5远离0方向进位)
+	RoundHalfUp RoundingMode = "half_up"
+	// RoundHalfEven 銀行丸め(0.5时取偶数)
+	RoundHalfEven RoundingMode = "half_even"
+)
+
+// ParseRoundingMode 端数处理方式转换(未设定或不正的场合,默认切り捨て)
+func ParseRoundingMode(s string) RoundingMode {
+	switch RoundingMode(s) {
+	case RoundHalfUp, RoundHalfEven:
+		return RoundingMode(s)
+	default:
+		return RoundTruncate
+	}
+}
+
+// Rounding 端数处理设定
+type Rounding struct {
+	Mode   RoundingMode `json:"mode" bson:"mode"`     // 端数处理方式
+	Places int          `json:"places" bson:"places"` // 保留小数位数(日元为0)
+}
+
+// NewMoney 浮点数转换为金额(超出精度部分四捨五入)
+func NewMoney(f float64) Money {
+	return Money(math.Round(f * moneyScale))
+}
+
+// MoneyFromInt 整数转换为金额
+func MoneyFromInt(i int64) Money {
+	return Money(i * moneyScale)
+}
+
+// ParseMoney 字符串转换为金额(超出精度部分四捨五入)
+func ParseMoney(s string) (Money, error) {
+	s = strings.TrimSpace(s)
+	if len(s) == 0 {
+		return 0, errors.New("leasecalc: empty money value")
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, errors.New("leasecalc: invalid money value " + strconv.Quote(s))
+	}
+	return Rounding{Mode: RoundHalfUp, Places: moneyPlaces}.Round(r), nil
+}
+
+// String 金额转换为字符串(不输出多余的0)
+func (m Money) String() string {
+	sign := ""
+	u := uint64(m)
+	if m < 0 {
+		sign = "-"
+		u = uint64(-m)
+	}
+	s := strconv.FormatUint(u/moneyScale, 10)
+	if frac := u % moneyScale; frac != 0 {
+		f := strconv.FormatUint(frac+moneyScale, 10)[1:]
+		s += "." + strings.TrimRight(f, "0")
+	}
+	return sign + s
+}
+
+// Float64 金额转换为浮点数(仅用于显示和比率计算)
+func (m Money) Float64() float64 {
+	return float64(m) / moneyScale
+}
+
+// Rat 金额转换为有理数
+func (m Money) Rat() *big.Rat {
+	return big.NewRat(int64(m), moneyScale)
+}
+
+// MarshalJSON 以数值形式输出
+func (m Money) MarshalJSON() ([]byte, error) {
+	return []byte(m.String()), nil
+}
+
+// UnmarshalJSON 数值和字符串形式都可以读取
+func (m *Money) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), `"`)
+	if s == "" || s == "null" {
+		*m = 0
+		return nil
+	}
+	v, err := ParseMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = v
+	return nil
+}
+
+// Round 按端数处理设定将有理数转换为金额
+func (r Rounding) Round(x *big.Rat) Money {
+	places := r.Places
+	if places < 0 {
+		places = 0
+	}
+	if places > moneyPlaces {
+		places = moneyPlaces
+	}
+	pow := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	scaled := new(big.Rat).Mul(x, new(big.Rat).SetInt(pow))
+
+	// 向0方向取整,余数判断进位
+	q, rem := new(big.Int).QuoRem(scaled.Num(), scaled.Denom(), new(big.Int))
+	if rem.Sign() != 0 {
+		// 余数的2倍同分母比较(>0:超过一半 =0:正好一半)
+		half := new(big.Int).Abs(rem)
+		half.Lsh(half, 1)
+		cmp := half.Cmp(scaled.Denom())
+		up := false
+		switch r.Mode {
+		case RoundHalfUp:
+			up = cmp >= 0
+		case RoundHalfEven:
+			up = cmp > 0 || (cmp == 0 && q.Bit(0) == 1)
+		}
+		if up {
+			q.Add(q, big.NewInt(int64(x.Sign())))
+		}
+	}
+
+	for i := places; i < moneyPlaces; i++ {
+		q.Mul(q, big.NewInt(10))
+	}
+	return Money(q.Int64())
+}
+
+// RoundFloat 浮点数计算结果(分录计算式等)按端数处理设定取整
+// 先按Money精度四捨五入消除浮点误差,再进行端数处理
+func (r Rounding) RoundFloat(f float64) Money {
+	return r.Round(NewMoney(f).Rat())
+}
+
+// Mul 金额乘以比率后按端数处理设定取整
+func (r Rounding) Mul(m Money, x *big.Rat) Money {
+	return r.Round(new(big.Rat).Mul(m.Rat(), x))
+}
+
+// Quo 金额除以比率后按端数处理设定取整
+func (r Rounding) Quo(m Money, x *big.Rat) Money {
+	return r.Round(new(big.Rat).Quo(m.Rat(), x))
+}
+
+// ratOf 浮点数按十进制表示转换为有理数(0.03 => 3/100)
+func ratOf(f float64) *big.Rat {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'f', -1, 64))
+	if !ok {
+		return new(big.Rat)
+	}
+	return r
+}
+
+// monthlyRate 月利率(年利率/12)
+func monthlyRate(rishiritsu float64) *big.Rat {
+	return new(big.Rat).Quo(ratOf(rishiritsu), big.NewRat(12, 1))
+}
+
+// powRat 有理数的k次幂(k为负数时取倒数)
+func powRat(x *big.Rat, k int) *big.Rat {
+	if k < 0 {
+		return new(big.Rat).Inv(powRat(x, -k))
+	}
+	e := big.NewInt(int64(k))
+	num := new(big.Int).Exp(x.Num(), e, nil)
+	den := new(big.Int).Exp(x.Denom(), e, nil)
+	return new(big.Rat).SetFrac(num, den)
+}
@@ -0,0 +1,139 @@
+package leasecalc
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "100000", want: "100000"},
+		{in: "-1234.5", want: "-1234.5"},
+		{in: "0.00005", want: "0.0001"},
+		{in: " 12.30 ", want: "12.3"},
+		{in: "1e3", want: "1000"},
+		{in: "", wantErr: true},
+		{in: "abc", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseMoney(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseMoney() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && got.String() != tt.want {
+				t.Errorf("ParseMoney() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyJSON(t *testing.T) {
+	var got struct {
+		A Money `json:"a"`
+		B Money `json:"b"`
+	}
+	if err := json.Unmarshal([]byte(`{"a":1500.25,"b":"300"}`), &got); err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if string(b) != `{"a":1500.25,"b":300}` {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+}
+
+func TestRoundingRound(t *testing.T) {
+	tests := []struct {
+		name string
+		mode RoundingMode
+		x    *big.Rat
+		want string
+	}{
+		{name: "truncate", mode: RoundTruncate, x: big.NewRat(25, 10), want: "2"},
+		{name: "truncate_negative", mode: RoundTruncate, x: big.NewRat(-25, 10), want: "-2"},
+		{name: "half_up", mode: RoundHalfUp, x: big.NewRat(25, 10), want: "3"},
+		{name: "half_up_negative", mode: RoundHalfUp, x: big.NewRat(-25, 10), want: "-3"},
+		{name: "half_up_below", mode: RoundHalfUp, x: big.NewRat(249, 100), want: "2"},
+		{name: "half_even_down", mode: RoundHalfEven, x: big.NewRat(25, 10), want: "2"},
+		{name: "half_even_up", mode: RoundHalfEven, x: big.NewRat(35, 10), want: "4"},
+		{name: "half_even_above", mode: RoundHalfEven, x: big.NewRat(251, 100), want: "3"},
+		{name: "unknown_is_truncate", mode: ParseRoundingMode("ceil"), x: big.NewRat(29, 10), want: "2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := (Rounding{Mode: tt.mode}).Round(tt.x); got.String() != tt.want {
+				t.Errorf("Round() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := (Rounding{Mode: RoundHalfUp, Places: 2}).Round(big.NewRat(12345, 1000)); got.String() != "12.35" {
+		t.Errorf("Round() with places = %v, want 12.35", got)
+	}
+}
+
+// 10年月额支付,各端数处理方式下最终回元本残高为0且期末薄价等于残价保证额
+func TestComputeFinalPlug(t *testing.T) {
+	residual, _ := ParseMoney("333333.33")
+	fee, _ := ParseMoney("98765.43")
+	for _, mode := range []RoundingMode{RoundTruncate, RoundHalfUp, RoundHalfEven} {
+		t.Run(string(mode), func(t *testing.T) {
+			cfg := testConfig
+			cfg.Rounding = Rounding{Mode: mode}
+			got := mustCompute(t, cfg, LRParam{
+				ResidualValue:      residual,
+				Rishiritsu:         0.0275,
+				Leasestymd:         date("2020-04-01"),
+				Leasekikan:         120,
+				InitialDirectCosts: MoneyFromInt(12345),
+				Assetlife:          10,
+				Torihikikbn:        "2",
+				Sykshisankeisan:    "2",
+				FirstMonth:         "2020-04",
+				Payments: mustPays(t, PayParam{
+					Paymentstymd:    date("2020-04-25"),
+					Paymentcycle:    1,
+					Paymentday:      25,
+					Paymentcounts:   120,
+					Paymentleasefee: fee,
+					ResidualValue:   residual,
+				}),
+			})
+
+			last := got.Leases[len(got.Leases)-1]
+			if last.Balance != 0 {
+				t.Errorf("final Balance = %v, want 0", last.Balance)
+			}
+			var repaid Money
+			for _, ls := range got.Leases {
+				repaid += ls.Repayment
+			}
+			if repaid != got.Leases[0].Firstbalance {
+				t.Errorf("total Repayment = %v, want %v", repaid, got.Leases[0].Firstbalance)
+			}
+
+			lastRepay := got.RePayments[len(got.RePayments)-1]
+			if lastRepay.Endboka != residual {
+				t.Errorf("final Endboka = %v, want %v", lastRepay.Endboka, residual)
+			}
+			var syokyaku Money
+			for _, rp := range got.RePayments {
+				syokyaku += rp.Syokyaku
+			}
+			if syokyaku != got.KiSyuBoka-residual {
+				t.Errorf("total Syokyaku = %v, want %v", syokyaku, got.KiSyuBoka-residual)
+			}
+			if lastRepay.Plug == 0 {
+				t.Errorf("final depreciation Plug = 0, want the fractional residual adjustment")
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package leasecalc
+
+import (
+	"errors"
+	"math/big"
+)
+
+// CostModel 租赁费用计上方式
+type CostModel string
+
+const (
+	// CostModelFinance 利息费用和使用権資産定额偿却分别计上(ファイナンス・リース)
+	CostModelFinance CostModel = "finance"
+	// CostModelOperating 单一リース費用按定额计上,使用権資産偿却额为调整项(ASC 842オペレーティング・リース)
+	CostModelOperating CostModel = "operating"
+)
+
+// ErrOperatingTransfer 所有权移转的契约不能按经营租赁计算
+var ErrOperatingTransfer = errors.New("所有権移転リースはオペレーティング・リースとして計算できません")
+
+// ParseCostModel 费用计上方式转换(未设定或不正的场合,默认ファイナンス・リース)
+func ParseCostModel(s string) CostModel {
+	if CostModel(s) == CostModelOperating {
+		return CostModelOperating
+	}
+	return CostModelFinance
+}
+
+// Specified 契约上是否指定了计上方式(空和"null"为未指定)
+func (m CostModel) Specified() bool {
+	return len(m) > 0 && m != "null"
+}
+
+// costModelOf 契约上指定的计上方式优先,未指定的场合使用app设定
+// 所有权移转的契约为ファイナンス・リース,契约上指定了经营租赁的场合返回错误
+func costModelOf(cfg Config, contract CostModel, torihikikbn string) (CostModel, error) {
+	if contract.Specified() {
+		model := ParseCostModel(string(contract))
+		if model == CostModelOperating && torihikikbn == "1" {
+			return model, ErrOperatingTransfer
+		}
+		return model, nil
+	}
+	if torihikikbn == "1" {
+		return CostModelFinance, nil
+	}
+	return ParseCostModel(string(cfg.CostModel)), nil
+}
+
+// CostModel 账簿的费用计上方式(所有权移转的契约为ファイナンス・リース)
+func (b Book) CostModel(torihikikbn string) CostModel {
+	if b.OperatingStraightLine && torihikikbn != "1" {
+		return CostModelOperating
+	}
+	return CostModelFinance
+}
+
+// straightLineCost 经营租赁的单一リース費用算出
+// 费用总额(期首簿価 - 残价保证额 + 期间内利息合计)按月定额计上,
+// 使用権資産偿却额 = 当月リース費用 - 当月利息,期末薄价与残价保证额一致
+// repays为定额法算出的偿还数据(使用其偿却年月和会计年度区分)
+func straightLineCost(rd Rounding, repays []RePayment, leases []Lease, residualValue Money) []RePayment {
+	if len(repays) == 0 {
+		return repays
+	}
+
+	// 月别利息(租赁期间满了后支付的残价保证额等的利息计入最终月)
+	lastYm := repays[len(repays)-1].Syokyakuymd[:7]
+	interests := make(map[string]Money)
+	for _, l := range leases {
+		ym := l.Paymentymd[:7]
+		if ym > lastYm {
+			ym = lastYm
+		}
+		interests[ym] += l.Interest
+	}
+
+	// 费用总额
+	boka := repays[0].Boka
+	total := boka - residualValue
+	for _, rp := range repays {
+		total += interests[rp.Syokyakuymd[:7]]
+	}
+
+	months := int64(len(repays))
+	result := make([]RePayment, 0, len(repays))
+	// 期首薄价
+	periodBoka := boka
+	// 月末薄价
+	endboka := boka
+	for i, rp := range repays {
+		// 会计年度变更(定额法数据的期首薄价变化)的场合,期首薄价更新
+		if i > 0 && rp.Boka != repays[i-1].Boka {
+			periodBoka = endboka
+		}
+		// 当月リース費用 = 费用总额 * 对象月 / 总月数 - 费用总额 * (对象月 - 1) / 总月数
+		cost := rd.Mul(total, big.NewRat(int64(i+1), months)) - rd.Mul(total, big.NewRat(int64(i), months))
+		// 使用権資産偿却额 = 当月リース費用 - 当月利息
+		syokyaku := cost - interests[rp.Syokyakuymd[:7]]
+		endboka -= syokyaku
+
+		result = append(result, RePayment{
+			Leasekaishacd: rp.Leasekaishacd,
+			Keiyakuno:     rp.Keiyakuno,
+			Syokyakukbn:   rp.Syokyakukbn,
+			Boka:          periodBoka,
+			Endboka:       endboka,
+			Syokyaku:      syokyaku,
+			Syokyakuymd:   rp.Syokyakuymd,
+			Leasecost:     cost,
+			Book:          rp.Book,
+		})
+	}
+
+	// 最终月端数调整(期末薄价 = 残价保证额)
+	last := &result[len(result)-1]
+	if plug := last.Endboka - residualValue; plug != 0 {
+		last.Plug = plug
+		last.Syokyaku += plug
+		last.Leasecost += plug
+		last.Endboka = residualValue
+	}
+
+	return result
+}
@@ -0,0 +1,109 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestOperatingCompute(t *testing.T) {
+	p := baseParam(t)
+	p.CostModel = CostModelOperating
+	got := mustCompute(t, testConfig, p)
+	checkGolden(t, "compute_operating", got)
+
+	var interest, cost Money
+	for _, l := range got.Leases {
+		interest += l.Interest
+	}
+	for i, rp := range got.RePayments {
+		cost += rp.Leasecost
+		// 最终月以外的リース費用为定额(端数处理的差异1円以内)
+		if diff := rp.Leasecost - got.RePayments[0].Leasecost; i < len(got.RePayments)-1 && (diff > MoneyFromInt(1) || diff < -MoneyFromInt(1)) {
+			t.Errorf("RePayments[%d].Leasecost = %v, want %v", i, rp.Leasecost, got.RePayments[0].Leasecost)
+		}
+	}
+	if want := got.KiSyuBoka - p.ResidualValue + interest; cost != want {
+		t.Errorf("total lease cost = %v, want %v", cost, want)
+	}
+	if last := got.RePayments[len(got.RePayments)-1]; last.Endboka != p.ResidualValue {
+		t.Errorf("last Endboka = %v, want %v", last.Endboka, p.ResidualValue)
+	}
+
+	// 契约未指定的场合使用app设定
+	cfg := testConfig
+	cfg.CostModel = CostModelOperating
+	if byConfig := mustCompute(t, cfg, baseParam(t)); byConfig.RePayments[0].Leasecost != got.RePayments[0].Leasecost {
+		t.Errorf("Compute() with config cost model = %v, want %v", byConfig.RePayments[0].Leasecost, got.RePayments[0].Leasecost)
+	}
+
+	// 契约上为"null"的场合视为未指定,使用app设定
+	nullModel := baseParam(t)
+	nullModel.CostModel = "null"
+	if byConfig := mustCompute(t, cfg, nullModel); byConfig.RePayments[0].Leasecost != got.RePayments[0].Leasecost {
+		t.Errorf("Compute() with null cost model = %v, want %v", byConfig.RePayments[0].Leasecost, got.RePayments[0].Leasecost)
+	}
+
+	// 所有权移转的契约按app设定计算的场合为ファイナンス・リース
+	transfer := baseParam(t)
+	transfer.Torihikikbn = "1"
+	if got := mustCompute(t, cfg, transfer); got.RePayments[0].Leasecost != 0 {
+		t.Errorf("Compute() transfer Leasecost = %v, want 0", got.RePayments[0].Leasecost)
+	}
+	transfer.CostModel = CostModelOperating
+	if _, err := Compute(testConfig, transfer); err != ErrOperatingTransfer {
+		t.Errorf("Compute() error = %v, want %v", err, ErrOperatingTransfer)
+	}
+}
+
+func TestCostModelSpecified(t *testing.T) {
+	tests := []struct {
+		model CostModel
+		want  bool
+	}{
+		{model: "", want: false},
+		{model: "null", want: false},
+		{model: CostModelFinance, want: true},
+		{model: CostModelOperating, want: true},
+	}
+	for _, tt := range tests {
+		if got := tt.model.Specified(); got != tt.want {
+			t.Errorf("CostModel(%q).Specified() = %v, want %v", tt.model, got, tt.want)
+		}
+	}
+}
+
+func TestOperatingDebtCompute(t *testing.T) {
+	bp := baseParam(t)
+	bp.CostModel = CostModelOperating
+	base := mustCompute(t, testConfig, bp)
+
+	// 2021-06以后的支付额变更
+	var pays []Payment
+	for _, pay := range base.Payments {
+		if pay.PaymentType == "支払" && pay.Paymentymd >= "2021-06" {
+			pay.Paymentleasefee = MoneyFromInt(120000)
+		}
+		pays = append(pays, pay)
+	}
+
+	got, err := DebtCompute(testConfig, base.KiSyuBoka, base.Payments, base.Leases, base.RePayments, DebtParam{
+		Henkouymd:     "2021-05-01",
+		Leasestymd:    "2020-04-01",
+		Leasekikan:    bp.Leasekikan,
+		Keiyakuno:     "K0001",
+		Rishiritsu:    bp.Rishiritsu,
+		ResidualValue: bp.ResidualValue,
+		Assetlife:     bp.Assetlife,
+		Torihikikbn:   bp.Torihikikbn,
+		Percentage:    1,
+		Payments:      pays,
+		CostModel:     CostModelOperating,
+	})
+	if err != nil {
+		t.Fatalf("DebtCompute() error = %v", err)
+	}
+	checkGolden(t, "debt_operating", got)
+
+	if last := got.RePayments[len(got.RePayments)-1]; last.Endboka != bp.ResidualValue {
+		t.Errorf("last Endboka = %v, want %v", last.Endboka, bp.ResidualValue)
+	}
+}
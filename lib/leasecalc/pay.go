@@ -0,0 +1,246 @@
+package leasecalc
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+)
+
+// GeneratePay 生成支付数据(租赁系统用)
+func GeneratePay(q PayParam) (payData []Payment, err error) {
+	// 支付额改定条款检查
+	if err := q.Escalation.Validate(); err != nil {
+		return nil, err
+	}
+	// 有支付计划的场合,按计划的区间生成
+	if len(q.Plan) > 0 {
+		if q.Segments, err = ParsePayPlan(q.Plan); err != nil {
+			return nil, err
+		}
+	}
+	if len(q.Segments) > 0 {
+		if payData, err = expandPlan(q); err != nil {
+			return nil, err
+		}
+		if q.Escalation != nil {
+			payData, _ = escalate(q, payData, "")
+		}
+		return payData, nil
+	}
+
+	// 支付开始日
+	paymentstymd := q.Paymentstymd
+	// 支付周期
+	paymentcycle := q.Paymentcycle
+	// 支付日
+	paymentday := q.Paymentday
+	// 支付回数
+	paymentcounts := q.Paymentcounts
+
+	// 循环支付回数生成结果
+	for i := 0; i < paymentcounts; i++ {
+		var pay Payment
+		// 支付回数
+		pay.Paymentcount = i + 1
+		// 支付金额(初回和最终回リース料有输入的场合,优先使用)
+		pay.Paymentleasefee = q.Paymentleasefee
+		if i == 0 && q.Firstleasefee != 0 {
+			pay.Paymentleasefee = q.Firstleasefee
+		}
+		if i == paymentcounts-1 && q.Finalleasefee != 0 {
+			pay.Paymentleasefee = q.Finalleasefee
+		}
+		// 支付年月日
+		pay.Paymentymd = paymentstymd.Format("2006-01-02")
+		// 其他设置
+		pay.Paymentleasefeehendo = 0
+		pay.Incentives = 0
+		pay.Sonotafee = 0
+		pay.Kaiyakuson = 0
+		pay.PaymentType = "支払"
+		pay.Fixed = false
+		pay.Keiyakuno = q.Keiyakuno
+		// -------获取下期支付年月-------
+		if i == paymentcounts-1 {
+			// 最终回的场合,下月为残价保证额&购入行使权的支付月
+			paymentstymd = getPayDate(firstDayOfMonth(paymentstymd).AddDate(0, 1, 0), paymentday)
+		} else {
+			// 支付月份加上支付周期,配上约定支付日
+			paymentstymd = getPayDate(firstDayOfMonth(paymentstymd).AddDate(0, paymentcycle, 0), paymentday)
+		}
+
+		// 添加支付数据
+		payData = append(payData, pay)
+	}
+
+	// 残价保证额&购入行使权金额==二选一
+	if q.ResidualValue != 0 {
+		payData = append(payData, Payment{
+			Keiyakuno:       q.Keiyakuno,
+			Paymentcount:    len(payData) + 1,
+			Paymentleasefee: q.ResidualValue,
+			Paymentymd:      paymentstymd.Format("2006-01-02"),
+			PaymentType:     "残価保証額",
+			Fixed:           true,
+		})
+		paymentstymd = getPayDate(firstDayOfMonth(paymentstymd).AddDate(0, 1, 0), paymentday)
+	}
+	if q.OptionToPurchase != 0 {
+		payData = append(payData, Payment{
+			Keiyakuno:       q.Keiyakuno,
+			Paymentcount:    len(payData) + 1,
+			Paymentleasefee: q.OptionToPurchase,
+			Paymentymd:      paymentstymd.Format("2006-01-02"),
+			PaymentType:     "購入オプション行使価額",
+			Fixed:           true,
+		})
+	}
+
+	// 有改定条款的场合,按条款改写各回支付金额
+	if q.Escalation != nil {
+		payData, _ = escalate(q, payData, "")
+	}
+
+	return payData, nil
+}
+
+// currentPayOf 当回实际支付金额取得(当回实际支付金额 = 当回支付金额 - 当回优惠 + 变动额)
+func currentPayOf(pay Payment) Money {
+	return pay.Paymentleasefee - pay.Incentives + pay.Paymentleasefeehendo
+}
+
+// discount 现在价值算出(k=基准月到支付年月的月数)
+func discount(rd Rounding, amount Money, rishiritsu float64, k int) Money {
+	// 割引系数 = (1 + 月利率)^k
+	factor := powRat(new(big.Rat).Add(big.NewRat(1, 1), monthlyRate(rishiritsu)), k)
+	return rd.Quo(amount, factor)
+}
+
+// 现在价值累计算出
+func getLeaseTotal(rd Rounding, payments []Payment, leasestymd time.Time, rishiritsu float64) (presentTotal, leaseTotal Money) {
+	for _, pay := range payments {
+		// k幂数取得(租赁开始日到支付年月日的月数)
+		payymd, _ := time.Parse("2006-01-02", pay.Paymentymd)
+		k := getGapMonths(leasestymd, payymd) + 1
+		currentPay := currentPayOf(pay)
+		// リース料累计
+		leaseTotal += currentPay
+		// 现在价值累计
+		presentTotal += discount(rd, currentPay, rishiritsu, k)
+	}
+	return
+}
+
+// 比較開始時点から計算
+func getLeaseDebt(rd Rounding, payments []Payment, rishiritsu float64, firstMonth string) (leaseTotalPayment Money, presentTotalRemain Money) {
+	// 比較開始期首月取得
+	payFirstMonth, _ := time.Parse("2006-1", firstMonth)
+	for _, pay := range payments {
+		// 支付年月日取得
+		payymd, _ := time.Parse("2006-01-02", pay.Paymentymd)
+		// 满足支付年月在比較開始期首月之后的条件
+		if !(payFirstMonth.After(payymd)) {
+			// k幂数取得(比較開始期首月到支付年月日的月数)
+			k := getGapMonths(payFirstMonth, payymd) + 1
+			currentPay := currentPayOf(pay)
+			// 残存リース料累计
+			leaseTotalPayment += currentPay
+			// 残存价值累计
+			presentTotalRemain += discount(rd, currentPay, rishiritsu, k)
+		}
+	}
+	return
+}
+
+// 支付情报整理(同月的支付数据合并为一条)
+func getArrangedPays(oldPays []Payment) (pays []Payment, err error) {
+	var payments []Payment
+	// 前回支付日保存用
+	var prevPaymentymd time.Time
+	// 当回支付金额合计
+	var paymentleasefee Money = 0
+	// 当回优惠合计
+	var incentives Money = 0
+	// 当回变动额合计
+	var paymentleasefeehendo Money = 0
+	// 循环支付情报再整理生成新支付情报
+	for i, pay := range oldPays {
+		// 支付年月日类型转换
+		paymentymd, err := time.Parse("2006-01-02", pay.Paymentymd)
+		if err != nil {
+			return payments, err
+		}
+		// 首条支付数据的场合,累计支付金额&优惠&变动额,支付年月日退避
+		if i == 0 {
+			// 仅有一条支付数据,首条即末条
+			if len(oldPays) == 1 {
+				// 数据出力
+				payments = append(payments, pay)
+			} else {
+				// 数据累计退避
+				paymentleasefee += pay.Paymentleasefee
+				incentives += pay.Incentives
+				paymentleasefeehendo += pay.Paymentleasefeehendo
+				prevPaymentymd = paymentymd
+			}
+			continue
+		}
+		// 最后一条支付数据的场合
+		if i == len(oldPays)-1 {
+			// 前支付年月日与现支付年月日间隔月数取得
+			cycle := getGapMonths(prevPaymentymd, paymentymd)
+			// 间隔为0,即为同月,继续累计然后出力
+			if cycle == 0 {
+				payments = append(payments, Payment{
+					Paymentymd:           pay.Paymentymd,
+					Paymentleasefee:      paymentleasefee + pay.Paymentleasefee,
+					Incentives:           incentives + pay.Incentives,
+					Paymentleasefeehendo: paymentleasefeehendo + pay.Paymentleasefeehendo,
+				})
+				continue
+			}
+			// 间隔大于0,即为异月,先出力前已累计数据然后出力本末回数据
+			if cycle > 0 {
+				payments = append(payments, Payment{
+					Paymentymd:           prevPaymentymd.Format("2006-01-02"),
+					Paymentleasefee:      paymentleasefee,
+					Incentives:           incentives,
+					Paymentleasefeehendo: paymentleasefeehendo,
+				})
+				payments = append(payments, pay)
+				continue
+			}
+			// 其他情形(当前支付年月小于前回支付年月的场合)进入下面返回错误。
+		}
+
+		// 当前支付年月小于前回支付年月的场合,返回错误。
+		if paymentymd.Before(prevPaymentymd) {
+			return payments, fmt.Errorf("支払いデータ行%dで、現在の支払い年月が前の支払い年月よりも少ない。", i+1)
+		}
+		// 前支付年月日与现支付年月日间隔月数取得
+		cycle := getGapMonths(prevPaymentymd, paymentymd)
+		// 间隔为0,即为同月,继续累计
+		if cycle == 0 {
+			paymentleasefee += pay.Paymentleasefee
+			incentives += pay.Incentives
+			paymentleasefeehendo += pay.Paymentleasefeehendo
+			prevPaymentymd = paymentymd
+			continue
+		}
+		// 间隔大于0,即为异月,先出力前已累计数据然后累计本条数据
+		if cycle > 0 {
+			payments = append(payments, Payment{
+				Paymentymd:           prevPaymentymd.Format("2006-01-02"),
+				Paymentleasefee:      paymentleasefee,
+				Incentives:           incentives,
+				Paymentleasefeehendo: paymentleasefeehendo,
+			})
+			paymentleasefee = pay.Paymentleasefee
+			incentives = pay.Incentives
+			paymentleasefeehendo = pay.Paymentleasefeehendo
+			prevPaymentymd = paymentymd
+			continue
+		}
+	}
+	return payments, nil
+}
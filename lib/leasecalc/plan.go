@@ -0,0 +1,247 @@
+package leasecalc
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PayTiming 支付时点
+type PayTiming string
+
+const (
+	// PayInAdvance 前払(各期间的首月支付)
+	PayInAdvance PayTiming = "advance"
+	// PayInArrears 後払(各期间结束的次月支付)
+	PayInArrears PayTiming = "arrears"
+)
+
+// PaySegment 支付计划的区间
+type PaySegment struct {
+	Count   int       `json:"count" bson:"count"`     // 支付回数(免租的场合为期间数)
+	Cycle   int       `json:"cycle" bson:"cycle"`     // 支付周期(月数)
+	Amount  Money     `json:"amount" bson:"amount"`   // 每回支付金额
+	Timing  PayTiming `json:"timing" bson:"timing"`   // 支付时点(未设定的场合为前払)
+	Day     int       `json:"day" bson:"day"`         // 支付日(未设定的场合使用契约的支付日)
+	Free    bool      `json:"free" bson:"free"`       // 免租期间(不生成支付数据)
+	Balloon bool      `json:"balloon" bson:"balloon"` // 一次性支付(与前一区间的最终回合并支付)
+}
+
+// validate 区间检查
+func (s PaySegment) validate(i int) error {
+	if s.Balloon {
+		if i == 0 {
+			return fmt.Errorf("支払計画%d行目:一括払いの前に支払区間が必要です", i+1)
+		}
+		if s.Amount <= 0 {
+			return fmt.Errorf("支払計画%d行目:一括払いの金額が不正です", i+1)
+		}
+		return nil
+	}
+	if s.Count < 1 || s.Cycle < 1 || s.Cycle > 24 {
+		return fmt.Errorf("支払計画%d行目:支払回数または支払サイクルが不正です", i+1)
+	}
+	if s.Day < 0 || s.Day > 31 {
+		return fmt.Errorf("支払計画%d行目:支払日が不正です", i+1)
+	}
+	if s.Free {
+		return nil
+	}
+	if s.Amount <= 0 {
+		return fmt.Errorf("支払計画%d行目:支払金額が不正です", i+1)
+	}
+	if s.Timing != "" && s.Timing != PayInAdvance && s.Timing != PayInArrears {
+		return fmt.Errorf("支払計画%d行目:支払タイミングが不正です", i+1)
+	}
+	return nil
+}
+
+// ParsePayPlan 支付计划文字列解析
+// 区间以";"分隔,各区间的书式如下:
+//
+//	<回数>x<周期>m <金额> [advance|arrears] [day=<支付日>]  例: 12x1m 100000 arrears day=25
+//	free <月数>m                                           例: free 3m
+//	balloon <金额>                                         例: balloon 1000000
+func ParsePayPlan(plan string) (segments []PaySegment, err error) {
+	for i, text := range strings.Split(plan, ";") {
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		line := i + 1
+
+		var s PaySegment
+		switch strings.ToLower(fields[0]) {
+		case "free":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("支払計画%d行目:フリーレント期間の書式が不正です", line)
+			}
+			months, err := parseMonths(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("支払計画%d行目:%v", line, err)
+			}
+			s = PaySegment{Count: 1, Cycle: months, Free: true}
+		case "balloon":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("支払計画%d行目:一括払いの書式が不正です", line)
+			}
+			amount, err := ParseMoney(fields[1])
+			if err != nil {
+				return nil, fmt.Errorf("支払計画%d行目:%v", line, err)
+			}
+			s = PaySegment{Amount: amount, Balloon: true}
+		default:
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("支払計画%d行目:支払区間の書式が不正です", line)
+			}
+			parts := strings.SplitN(strings.ToLower(fields[0]), "x", 2)
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("支払計画%d行目:支払回数と支払サイクルは「12x1m」の形式で指定してください", line)
+			}
+			if s.Count, err = strconv.Atoi(parts[0]); err != nil {
+				return nil, fmt.Errorf("支払計画%d行目:支払回数が不正です", line)
+			}
+			if s.Cycle, err = parseMonths(parts[1]); err != nil {
+				return nil, fmt.Errorf("支払計画%d行目:%v", line, err)
+			}
+			if s.Amount, err = ParseMoney(fields[1]); err != nil {
+				return nil, fmt.Errorf("支払計画%d行目:%v", line, err)
+			}
+			for _, opt := range fields[2:] {
+				opt = strings.ToLower(opt)
+				switch {
+				case opt == string(PayInAdvance) || opt == string(PayInArrears):
+					s.Timing = PayTiming(opt)
+				case strings.HasPrefix(opt, "day="):
+					if s.Day, err = strconv.Atoi(strings.TrimPrefix(opt, "day=")); err != nil {
+						return nil, fmt.Errorf("支払計画%d行目:支払日が不正です", line)
+					}
+				default:
+					return nil, fmt.Errorf("支払計画%d行目:不明な指定[%s]です", line, opt)
+				}
+			}
+		}
+
+		if err := s.validate(len(segments)); err != nil {
+			return nil, err
+		}
+		segments = append(segments, s)
+	}
+
+	if len(segments) == 0 {
+		return nil, errors.New("支払計画が指定されていません")
+	}
+
+	return segments, nil
+}
+
+// parseMonths 月数解析("3m"或"3")
+func parseMonths(s string) (int, error) {
+	months, err := strconv.Atoi(strings.TrimSuffix(strings.ToLower(s), "m"))
+	if err != nil || months < 1 {
+		return 0, fmt.Errorf("月数[%s]が不正です", s)
+	}
+	return months, nil
+}
+
+// expandPlan 按支付计划生成支付数据
+// 支付开始日为最初区间的期间开始日;前払在各期间的首月支付,後払在各期间结束的次月支付,
+// 免租区间只推进期间,一次性支付与前一区间的最终回合并
+func expandPlan(q PayParam) (payData []Payment, err error) {
+	for i, s := range q.Segments {
+		if err := s.validate(i); err != nil {
+			return nil, err
+		}
+	}
+
+	// 期间开始月
+	period := firstDayOfMonth(q.Paymentstymd)
+	for _, s := range q.Segments {
+		if s.Balloon {
+			if len(payData) == 0 {
+				return nil, errors.New("一括払いの前に支払データが存在しません")
+			}
+			payData[len(payData)-1].Paymentleasefee += s.Amount
+			continue
+		}
+		if s.Free {
+			period = period.AddDate(0, s.Count*s.Cycle, 0)
+			continue
+		}
+
+		day := s.Day
+		if day == 0 {
+			day = q.Paymentday
+		}
+		if day == 0 {
+			day = q.Paymentstymd.Day()
+		}
+		for n := 0; n < s.Count; n++ {
+			month := period
+			if s.Timing == PayInArrears {
+				month = period.AddDate(0, s.Cycle, 0)
+			}
+			payData = append(payData, Payment{
+				Keiyakuno:       q.Keiyakuno,
+				Paymentcount:    len(payData) + 1,
+				Paymentleasefee: s.Amount,
+				Paymentymd:      getPayDate(month, day).Format("2006-01-02"),
+				PaymentType:     "支払",
+			})
+			period = period.AddDate(0, s.Cycle, 0)
+		}
+	}
+
+	if len(payData) == 0 {
+		return nil, errors.New("支払計画から支払データが生成されません")
+	}
+
+	// 残价保证额&购入行使权金额在最终支付的次月支付
+	last, _ := time.Parse("2006-01-02", payData[len(payData)-1].Paymentymd)
+	next := getPayDate(firstDayOfMonth(last).AddDate(0, 1, 0), last.Day())
+	if q.ResidualValue != 0 {
+		payData = append(payData, Payment{
+			Keiyakuno:       q.Keiyakuno,
+			Paymentcount:    len(payData) + 1,
+			Paymentleasefee: q.ResidualValue,
+			Paymentymd:      next.Format("2006-01-02"),
+			PaymentType:     "残価保証額",
+			Fixed:           true,
+		})
+		next = getPayDate(firstDayOfMonth(next).AddDate(0, 1, 0), last.Day())
+	}
+	if q.OptionToPurchase != 0 {
+		payData = append(payData, Payment{
+			Keiyakuno:       q.Keiyakuno,
+			Paymentcount:    len(payData) + 1,
+			Paymentleasefee: q.OptionToPurchase,
+			Paymentymd:      next.Format("2006-01-02"),
+			PaymentType:     "購入オプション行使価額",
+			Fixed:           true,
+		})
+	}
+
+	// 生成的支付数据合法性检查(支付顺序和支付日重复等)
+	if err := payDataValidCheck(false, payData); err != nil {
+		return nil, err
+	}
+
+	return payData, nil
+}
+
+// plannedFees 支付计划上各回的支付金额(支付回数为key,改定条款的基础金额用)
+func plannedFees(q PayParam) map[int]Money {
+	fees := make(map[int]Money)
+	pays, err := expandPlan(q)
+	if err != nil {
+		return fees
+	}
+	for _, pay := range pays {
+		if pay.PaymentType == "支払" {
+			fees[pay.Paymentcount] = pay.Paymentleasefee
+		}
+	}
+	return fees
+}
@@ -0,0 +1,70 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestGeneratePayPlan(t *testing.T) {
+	tests := []struct {
+		name string
+		q    PayParam
+	}{
+		{
+			// 免租3个月后季度前払,初回金额不同,最终回一次性支付
+			name: "generate_pay_plan_quarterly",
+			q: PayParam{
+				Paymentstymd:  date("2020-04-01"),
+				Paymentday:    10,
+				Plan:          "free 3m; 1x3m 350000 advance; 3x3m 300000 advance; balloon 1000000",
+				ResidualValue: MoneyFromInt(200000),
+				Keiyakuno:     "K0005",
+			},
+		},
+		{
+			// 月次後払
+			name: "generate_pay_plan_arrears",
+			q: PayParam{
+				Paymentstymd: date("2020-04-01"),
+				Plan:         "6x1m 100000 arrears day=31",
+				Keiyakuno:    "K0006",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkGolden(t, tt.name, mustPays(t, tt.q))
+		})
+	}
+}
+
+func TestParsePayPlanInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		plan string
+	}{
+		{name: "empty", plan: " ; "},
+		{name: "no cycle", plan: "12 100000"},
+		{name: "zero amount", plan: "12x1m 0"},
+		{name: "cycle too long", plan: "1x25m 100000"},
+		{name: "unknown option", plan: "12x1m 100000 monthly"},
+		{name: "balloon first", plan: "balloon 100000; 12x1m 100000"},
+		{name: "free without months", plan: "free"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParsePayPlan(tt.plan); err == nil {
+				t.Errorf("ParsePayPlan(%q) error = nil, want error", tt.plan)
+			}
+		})
+	}
+
+	// 後払的最终回与前払的初回同月的场合,支付日重复
+	_, err := GeneratePay(PayParam{
+		Paymentstymd: date("2020-04-01"),
+		Paymentday:   25,
+		Plan:         "1x1m 100000 arrears; 1x1m 100000 advance",
+	})
+	if err == nil {
+		t.Errorf("GeneratePay() error = nil, want duplicate payment date error")
+	}
+}
@@ -0,0 +1,172 @@
+package leasecalc
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// ReconcileStatus 支付照合的结果区分
+type ReconcileStatus string
+
+// 支付照合的结果区分
+const (
+	ReconcileMatched   ReconcileStatus = "matched"   // 一致(金额相同)
+	ReconcilePartial   ReconcileStatus = "partial"   // 部分一致(金额不同)
+	ReconcileUnmatched ReconcileStatus = "unmatched" // 没有对应预定支付的实际支付
+	ReconcileUnpaid    ReconcileStatus = "unpaid"    // 没有实际支付的预定支付
+)
+
+// ActualPayment 买挂金(AP)的实际支付数据(从银行或ERP的支付文件导入)
+type ActualPayment struct {
+	Leasekaishacd string `json:"leasekaishacd" bson:"leasekaishacd"` // 租赁会社
+	Keiyakuno     string `json:"keiyakuno" bson:"keiyakuno"`         // 契约番号
+	Paymentymd    string `json:"paymentymd" bson:"paymentymd"`       // 支付年月日
+	Amount        Money  `json:"amount" bson:"amount"`               // 支付金额
+}
+
+// ReconcileLine 支付照合结果的明细
+type ReconcileLine struct {
+	Status          ReconcileStatus `json:"status" bson:"status"`                   // 照合结果区分
+	Leasekaishacd   string          `json:"leasekaishacd" bson:"leasekaishacd"`     // 租赁会社
+	Keiyakuno       string          `json:"keiyakuno" bson:"keiyakuno"`             // 契约番号
+	Scheduled       int             `json:"scheduled" bson:"scheduled"`             // 预定支付的下标(没有的场合为-1)
+	Actual          int             `json:"actual" bson:"actual"`                   // 实际支付的下标(没有的场合为-1)
+	Scheduledymd    string          `json:"scheduledymd" bson:"scheduledymd"`       // 预定支付年月日
+	Actualymd       string          `json:"actualymd" bson:"actualymd"`             // 实际支付年月日
+	ScheduledAmount Money           `json:"scheduledamount" bson:"scheduledamount"` // 预定支付金额
+	ActualAmount    Money           `json:"actualamount" bson:"actualamount"`       // 实际支付金额
+	Difference      Money           `json:"difference" bson:"difference"`           // 差额(实际-预定)
+	DayGap          int             `json:"daygap" bson:"daygap"`                   // 支付日的差异天数(实际-预定)
+}
+
+// reconcileKey 照合的对象键(租赁会社和契约番号)
+type reconcileKey struct {
+	leasekaishacd string
+	keiyakuno     string
+}
+
+// Reconcile 预定支付与实际支付按租赁会社、契约番号和支付日的容许天数进行照合
+// 实际支付按支付日顺序,与容许天数以内最近的未照合预定支付对应(天数相同的场合为较早的预定支付)
+// 预定支付金额为当回实际支付金额(支付金额-优惠+变动额)
+func Reconcile(scheduled []Payment, actuals []ActualPayment, toleranceDays int) ([]ReconcileLine, error) {
+	if toleranceDays < 0 {
+		toleranceDays = 0
+	}
+
+	// 预定支付按照合键分组
+	schedDates := make([]time.Time, len(scheduled))
+	groups := make(map[reconcileKey][]int)
+	for i, pay := range scheduled {
+		d, err := time.Parse("2006-01-02", pay.Paymentymd)
+		if err != nil {
+			return nil, fmt.Errorf("契約番号[%s]の支払年月日[%s]が不正です", pay.Keiyakuno, pay.Paymentymd)
+		}
+		schedDates[i] = d
+		key := reconcileKey{pay.Leasekaishacd, pay.Keiyakuno}
+		groups[key] = append(groups[key], i)
+	}
+
+	// 实际支付按支付日顺序照合
+	actDates := make([]time.Time, len(actuals))
+	order := make([]int, len(actuals))
+	for i, act := range actuals {
+		d, err := time.Parse("2006-01-02", act.Paymentymd)
+		if err != nil {
+			return nil, fmt.Errorf("契約番号[%s]の実支払年月日[%s]が不正です", act.Keiyakuno, act.Paymentymd)
+		}
+		actDates[i] = d
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return actDates[order[a]].Before(actDates[order[b]])
+	})
+
+	used := make([]bool, len(scheduled))
+	var lines []ReconcileLine
+	for _, ai := range order {
+		act := actuals[ai]
+		best, bestGap, bestAbs := -1, 0, 0
+		for _, si := range groups[reconcileKey{act.Leasekaishacd, act.Keiyakuno}] {
+			if used[si] {
+				continue
+			}
+			gap := int(actDates[ai].Sub(schedDates[si]).Hours() / 24)
+			abs := gap
+			if abs < 0 {
+				abs = -abs
+			}
+			if abs > toleranceDays {
+				continue
+			}
+			if best < 0 || abs < bestAbs || (abs == bestAbs && schedDates[si].Before(schedDates[best])) {
+				best, bestGap, bestAbs = si, gap, abs
+			}
+		}
+
+		line := ReconcileLine{
+			Status:        ReconcileUnmatched,
+			Leasekaishacd: act.Leasekaishacd,
+			Keiyakuno:     act.Keiyakuno,
+			Scheduled:     -1,
+			Actual:        ai,
+			Actualymd:     act.Paymentymd,
+			ActualAmount:  act.Amount,
+			Difference:    act.Amount,
+		}
+		if best >= 0 {
+			used[best] = true
+			amount := currentPayOf(scheduled[best])
+			line.Status = ReconcileMatched
+			if act.Amount != amount {
+				line.Status = ReconcilePartial
+			}
+			line.Scheduled = best
+			line.Scheduledymd = scheduled[best].Paymentymd
+			line.ScheduledAmount = amount
+			line.Difference = act.Amount - amount
+			line.DayGap = bestGap
+		}
+		lines = append(lines, line)
+	}
+
+	// 没有实际支付的预定支付
+	for i, pay := range scheduled {
+		if used[i] {
+			continue
+		}
+		amount := currentPayOf(pay)
+		lines = append(lines, ReconcileLine{
+			Status:          ReconcileUnpaid,
+			Leasekaishacd:   pay.Leasekaishacd,
+			Keiyakuno:       pay.Keiyakuno,
+			Scheduled:       i,
+			Actual:          -1,
+			Scheduledymd:    pay.Paymentymd,
+			ScheduledAmount: amount,
+			Difference:      -amount,
+		})
+	}
+
+	// 按租赁会社、契约番号、支付日排序
+	sort.SliceStable(lines, func(a, b int) bool {
+		la, lb := lines[a], lines[b]
+		if la.Leasekaishacd != lb.Leasekaishacd {
+			return la.Leasekaishacd < lb.Leasekaishacd
+		}
+		if la.Keiyakuno != lb.Keiyakuno {
+			return la.Keiyakuno < lb.Keiyakuno
+		}
+		return la.date() < lb.date()
+	})
+
+	return lines, nil
+}
+
+// date 明细的排序用日期(有预定支付的场合为预定支付年月日)
+func (l ReconcileLine) date() string {
+	if len(l.Scheduledymd) > 0 {
+		return l.Scheduledymd
+	}
+	return l.Actualymd
+}
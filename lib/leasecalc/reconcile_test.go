@@ -0,0 +1,69 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestReconcile(t *testing.T) {
+	scheduled := []Payment{
+		{Leasekaishacd: "L01", Keiyakuno: "K001", Paymentymd: "2021-04-25", Paymentleasefee: MoneyFromInt(100000)},
+		{Leasekaishacd: "L01", Keiyakuno: "K001", Paymentymd: "2021-05-25", Paymentleasefee: MoneyFromInt(100000)},
+		{Leasekaishacd: "L01", Keiyakuno: "K002", Paymentymd: "2021-04-30", Paymentleasefee: MoneyFromInt(50000), Incentives: MoneyFromInt(5000)},
+		{Leasekaishacd: "L02", Keiyakuno: "K003", Paymentymd: "2021-04-10", Paymentleasefee: MoneyFromInt(30000)},
+	}
+	actuals := []ActualPayment{
+		// 支付日在容许天数以内,金额相同
+		{Leasekaishacd: "L01", Keiyakuno: "K001", Paymentymd: "2021-04-26", Amount: MoneyFromInt(100000)},
+		// 优惠后的金额与实际支付不同
+		{Leasekaishacd: "L01", Keiyakuno: "K002", Paymentymd: "2021-04-28", Amount: MoneyFromInt(47000)},
+		// 租赁会社不同的场合不照合
+		{Leasekaishacd: "L09", Keiyakuno: "K003", Paymentymd: "2021-04-10", Amount: MoneyFromInt(30000)},
+		// 超过容许天数的场合不照合
+		{Leasekaishacd: "L01", Keiyakuno: "K001", Paymentymd: "2021-06-05", Amount: MoneyFromInt(100000)},
+	}
+
+	got, err := Reconcile(scheduled, actuals, 3)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	want := []ReconcileLine{
+		{Status: ReconcileMatched, Leasekaishacd: "L01", Keiyakuno: "K001", Scheduled: 0, Actual: 0, Scheduledymd: "2021-04-25", Actualymd: "2021-04-26", ScheduledAmount: MoneyFromInt(100000), ActualAmount: MoneyFromInt(100000), DayGap: 1},
+		{Status: ReconcileUnpaid, Leasekaishacd: "L01", Keiyakuno: "K001", Scheduled: 1, Actual: -1, Scheduledymd: "2021-05-25", ScheduledAmount: MoneyFromInt(100000), Difference: MoneyFromInt(-100000)},
+		{Status: ReconcileUnmatched, Leasekaishacd: "L01", Keiyakuno: "K001", Scheduled: -1, Actual: 3, Actualymd: "2021-06-05", ActualAmount: MoneyFromInt(100000), Difference: MoneyFromInt(100000)},
+		{Status: ReconcilePartial, Leasekaishacd: "L01", Keiyakuno: "K002", Scheduled: 2, Actual: 1, Scheduledymd: "2021-04-30", Actualymd: "2021-04-28", ScheduledAmount: MoneyFromInt(45000), ActualAmount: MoneyFromInt(47000), Difference: MoneyFromInt(2000), DayGap: -2},
+		{Status: ReconcileUnpaid, Leasekaishacd: "L02", Keiyakuno: "K003", Scheduled: 3, Actual: -1, Scheduledymd: "2021-04-10", ScheduledAmount: MoneyFromInt(30000), Difference: MoneyFromInt(-30000)},
+		{Status: ReconcileUnmatched, Leasekaishacd: "L09", Keiyakuno: "K003", Scheduled: -1, Actual: 2, Actualymd: "2021-04-10", ActualAmount: MoneyFromInt(30000), Difference: MoneyFromInt(30000)},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("len(Reconcile()) = %v, want %v\n got: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Reconcile()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestReconcileNearest(t *testing.T) {
+	// 容许天数以内有多个预定支付的场合,与最近的预定支付照合
+	scheduled := []Payment{
+		{Leasekaishacd: "L01", Keiyakuno: "K001", Paymentymd: "2021-04-01", Paymentleasefee: MoneyFromInt(10000)},
+		{Leasekaishacd: "L01", Keiyakuno: "K001", Paymentymd: "2021-04-15", Paymentleasefee: MoneyFromInt(10000)},
+	}
+	actuals := []ActualPayment{
+		{Leasekaishacd: "L01", Keiyakuno: "K001", Paymentymd: "2021-04-12", Amount: MoneyFromInt(10000)},
+	}
+
+	got, err := Reconcile(scheduled, actuals, 15)
+	if err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+	if len(got) != 2 || got[1].Scheduled != 1 || got[1].Status != ReconcileMatched || got[0].Status != ReconcileUnpaid {
+		t.Errorf("Reconcile() = %+v, want actual matched to 2021-04-15", got)
+	}
+
+	if _, err := Reconcile(scheduled, []ActualPayment{{Paymentymd: "2021/04/12"}}, 15); err == nil {
+		t.Errorf("Reconcile() error = nil, want invalid date error")
+	}
+}
@@ -0,0 +1,157 @@
+package leasecalc
+
+import (
+	"math/big"
+	"time"
+)
+
+// 偿还情报算出
+func getRepayData(rd Rounding, method DepreciationMethod, leasestsyoymd time.Time, genkakikan int, residualValue Money, boka Money, kishuMonth int) (rps []RePayment, err error) {
+	// 当期偿还月数算出
+	calMonths := firstPeriodMonths(kishuMonth, int(leasestsyoymd.Month()), genkakikan)
+
+	return method.Schedule(rd, DepreciationBase{
+		Start:         leasestsyoymd,
+		Months:        genkakikan,
+		Limit:         genkakikan,
+		CalMonths:     calMonths,
+		LeftMonths:    genkakikan,
+		ResidualValue: residualValue,
+		Boka:          boka,
+	}), nil
+}
+
+// 偿还情报算出(開始時点から計算)
+func getRepayDataStart(rd Rounding, method DepreciationMethod, firstMonth time.Time, genkakikan int, residualValue Money, boka Money, leasestymd time.Time) (rps []RePayment, err error) {
+	if firstMonth.Before(leasestymd) {
+		firstMonth = leasestymd
+	}
+	// 剩余月数
+	leftMonths := genkakikan - getGapMonths(leasestymd, firstMonth)
+
+	return method.Schedule(rd, DepreciationBase{
+		Start:         firstMonth,
+		Months:        leftMonths,
+		Limit:         genkakikan,
+		CalMonths:     12,
+		LeftMonths:    leftMonths,
+		ResidualValue: residualValue,
+		Boka:          boka,
+	}), nil
+}
+
+// 偿还情报算出(取得時点に遡って計算)
+func getRepayDataObtain(rd Rounding, method DepreciationMethod, leasestsyoymd time.Time, genkakikan int, residualValue Money, boka Money, firstMonthB time.Time) (rps []RePayment, err error) {
+	// 期首月 = 比較開始期首月
+	return getRepayData(rd, method, leasestsyoymd, genkakikan, residualValue, boka, int(firstMonthB.Month()))
+}
+
+// firstPeriodMonths 首期偿还月数算出
+func firstPeriodMonths(kishuMonth, startMonth, genkakikan int) (calMonths int) {
+	calMonths = 12
+	// 期首月份同租赁开始日月份相同时
+	if kishuMonth == startMonth {
+		if genkakikan < 12 {
+			calMonths = genkakikan
+		}
+	}
+	// 期首月份小于租赁开始日月份时
+	if kishuMonth < startMonth {
+		calMonths = 12 - startMonth + kishuMonth
+		if calMonths > genkakikan {
+			calMonths = genkakikan
+		}
+	}
+	// 期首月份大于租赁开始日月份时
+	if kishuMonth > startMonth {
+		calMonths = kishuMonth - startMonth
+		if calMonths > genkakikan {
+			calMonths = genkakikan
+		}
+	}
+	return calMonths
+}
+
+// periodic 按会计年度生成月别偿还数据
+// annual:会计年度的偿还额算出(boka:期首簿価 offset:偿却开始起的月数) weight:月别偿还额的按分比重
+// 偿却至最终月的场合,端数调整额(Plug)计入最终月,使期末薄价与残价保证额一致
+func periodic(rd Rounding, b DepreciationBase, kbn string, annual func(boka Money, offset, calMonths, leftMonths int) Money, weight func(offset int) *big.Rat) (repayData []RePayment) {
+	syokyakuymd := b.Start
+	calMonths := b.CalMonths
+	leftMonths := b.LeftMonths
+	boka := b.Boka
+	// 计算
+	for i := 0; i < b.Months; i++ {
+		// 当期偿还额合计
+		var syokyakuCount Money = 0
+		// 偿却开始起的月数
+		offset := len(repayData)
+		// 使用権資産額期首簿価-残价保证额
+		present := boka - b.ResidualValue
+		// 当期偿还费算出
+		var syoukyakucurrent Money = 0
+		if boka != 0 {
+			syoukyakucurrent = annual(boka, offset, calMonths, leftMonths)
+		}
+		// 使用権資産額期首簿価-残价保证额 < 以上計算値の場合
+		if p := rd.Round(present.Rat()); p < syoukyakucurrent {
+			syoukyakucurrent = p
+		}
+		// 当期按分比重合计
+		total := new(big.Rat)
+		for j := 0; j < calMonths; j++ {
+			total.Add(total, weight(offset+j))
+		}
+		// 当期偿还数据算出
+		cumulative := new(big.Rat)
+		for j := 1; j <= calMonths; j++ {
+			var repay RePayment
+			// 期首薄价
+			repay.Boka = boka
+			// 偿却年月
+			repay.Syokyakuymd = syokyakuymd.Format("2006-01-02")
+			// 偿却区分
+			repay.Syokyakukbn = kbn
+			// 月别使用権償却額 = 年额偿还费 * 至对象月度的累计比重 - 年额偿却费 * 至前月的累计比重
+			if total.Sign() != 0 {
+				prev := rd.Mul(syoukyakucurrent, new(big.Rat).Quo(cumulative, total))
+				cumulative.Add(cumulative, weight(offset+j-1))
+				repay.Syokyaku = rd.Mul(syoukyakucurrent, new(big.Rat).Quo(cumulative, total)) - prev
+			}
+			// 当期偿还额累计
+			syokyakuCount = syokyakuCount + repay.Syokyaku
+			// 期末薄价
+			repay.Endboka = repay.Boka - syokyakuCount
+			// 下月年月日算出
+			syokyakuymd = firstDayOfMonth(syokyakuymd).AddDate(0, 1, 0)
+			// 添加偿却数据
+			repayData = append(repayData, repay)
+		}
+		// *************下期数据算出*************
+		// 除去当期已计算月
+		i = i + calMonths - 1
+		if i < b.Limit {
+			// 使用権資産額期首簿価
+			boka = boka - syokyakuCount
+			// 偿还月数&剩余月数
+			leftMonths = leftMonths - calMonths
+			if leftMonths <= 12 {
+				calMonths = leftMonths
+			} else {
+				calMonths = 12
+			}
+		}
+	}
+
+	// 最终月端数调整(期末薄价 = 残价保证额)
+	if b.Boka != 0 && len(repayData) > 0 && len(repayData) == b.Months {
+		last := &repayData[len(repayData)-1]
+		if plug := last.Endboka - b.ResidualValue; plug != 0 {
+			last.Plug = plug
+			last.Syokyaku += plug
+			last.Endboka = b.ResidualValue
+		}
+	}
+
+	return repayData
+}
@@ -0,0 +1,297 @@
+package leasecalc
+
+import (
+	"errors"
+	"sort"
+	"time"
+)
+
+// Scenario 模拟方案(各项可以组合,未设定的项目不变)
+type Scenario struct {
+	RateDelta    float64 `json:"rateDelta" bson:"rateDelta"`       // 割引率变动(例:0.005为+50bp)
+	ExtendMonths int     `json:"extendMonths" bson:"extendMonths"` // 租赁期间延长月数
+	Kaiyakuymd   string  `json:"kaiyakuymd" bson:"kaiyakuymd"`     // 解约年月日
+}
+
+// IsEmpty 没有任何变动的方案
+func (s Scenario) IsEmpty() bool {
+	return s.RateDelta == 0 && s.ExtendMonths == 0 && len(s.Kaiyakuymd) == 0
+}
+
+// Validate 方案的合法性检查
+func (s Scenario) Validate() error {
+	if s.IsEmpty() {
+		return errors.New("シミュレーションの条件を指定してください")
+	}
+	if s.ExtendMonths < 0 {
+		return errors.New("延長月数は0以上を指定してください")
+	}
+	if len(s.Kaiyakuymd) > 0 {
+		if len(s.Kaiyakuymd) < 7 {
+			return errors.New("解約年月日が不正です")
+		}
+		if _, err := time.Parse("2006-01", s.Kaiyakuymd[0:7]); err != nil {
+			return errors.New("解約年月日が不正です")
+		}
+	}
+	return nil
+}
+
+// PeriodAmount 月度单位的租赁负债、使用権資産、支付利息和减价偿却费
+type PeriodAmount struct {
+	Ym       string `json:"ym" bson:"ym"`             // 年月
+	Balance  Money  `json:"balance" bson:"balance"`   // 月末リース負債
+	Boka     Money  `json:"boka" bson:"boka"`         // 月末使用権資産
+	Interest Money  `json:"interest" bson:"interest"` // 支払利息
+	Syokyaku Money  `json:"syokyaku" bson:"syokyaku"` // 減価償却費
+}
+
+// PeriodDelta 模拟前后的月度差额
+type PeriodDelta struct {
+	Ym        string       `json:"ym" bson:"ym"`               // 年月
+	Base      PeriodAmount `json:"base" bson:"base"`           // 现在的数据
+	Simulated PeriodAmount `json:"simulated" bson:"simulated"` // 模拟后的数据
+	Delta     PeriodAmount `json:"delta" bson:"delta"`         // 差额(模拟后-现在)
+}
+
+// ExtendPayments 按最终支付条件延长支付数据
+// 延长期间的支付额和支付周期沿用最终回,残价保证金等最终回后的支付移到延长后的期末
+func ExtendPayments(pays []Payment, months int) ([]Payment, error) {
+	if months <= 0 {
+		return pays, nil
+	}
+	last := -1
+	for i, pay := range pays {
+		if pay.PaymentType == "支払" {
+			last = i
+		}
+	}
+	if last < 0 {
+		return nil, errors.New("支払データが存在しません")
+	}
+	lastymd, err := time.Parse("2006-01-02", pays[last].Paymentymd[0:10])
+	if err != nil {
+		return nil, err
+	}
+	// 支付周期(月数)
+	cycle := 1
+	for i := last - 1; i >= 0; i-- {
+		if pays[i].PaymentType != "支払" {
+			continue
+		}
+		prev, err := time.Parse("2006-01-02", pays[i].Paymentymd[0:10])
+		if err != nil {
+			return nil, err
+		}
+		if n := getGapMonths(prev, lastymd); n > 0 {
+			cycle = n
+		}
+		break
+	}
+
+	result := make([]Payment, 0, len(pays)+months/cycle)
+	result = append(result, pays[:last+1]...)
+	count := pays[last].Paymentcount
+	for m := cycle; m <= months; m += cycle {
+		pay := pays[last]
+		count++
+		pay.Paymentcount = count
+		pay.Paymentymd = addMonthsKeepDay(lastymd, m).Format("2006-01-02")
+		pay.Fixed = false
+		result = append(result, pay)
+	}
+	// 最终回后的支付(残价保证金等)
+	for _, pay := range pays[last+1:] {
+		ymd, err := time.Parse("2006-01-02", pay.Paymentymd[0:10])
+		if err != nil {
+			return nil, err
+		}
+		count++
+		pay.Paymentcount = count
+		pay.Paymentymd = addMonthsKeepDay(ymd, months).Format("2006-01-02")
+		result = append(result, pay)
+	}
+
+	return result, nil
+}
+
+// addMonthsKeepDay 加算月数(超过月末的场合为月末)
+func addMonthsKeepDay(t time.Time, months int) time.Time {
+	first := time.Date(t.Year(), t.Month()+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return time.Date(first.Year(), first.Month(), day, 0, 0, 0, 0, t.Location())
+}
+
+// Simulate 按模拟方案重新计算契约的利息和偿还数据(只计算,不更新数据)
+// 割引率变动和期间延长作为处理月度的债务变更计算,解约按中途解约计算
+func Simulate(cfg Config, kisyuBoka Money, pays []Payment, leases []Lease, repays []RePayment, p DebtParam, s Scenario) (sleases []Lease, srepays []RePayment, err error) {
+	if err := s.Validate(); err != nil {
+		return nil, nil, err
+	}
+	spays, sleases, srepays := pays, leases, repays
+
+	if s.RateDelta != 0 || s.ExtendMonths > 0 {
+		p.Henkouymd = RemeasureYmd(cfg)
+		p.Rishiritsu += s.RateDelta
+		p.Leasekikan += s.ExtendMonths
+		if p.Payments, err = ExtendPayments(pays, s.ExtendMonths); err != nil {
+			return nil, nil, err
+		}
+		dr, err := DebtCompute(cfg, kisyuBoka, pays, leases, repays, p)
+		if err != nil {
+			return nil, nil, err
+		}
+		spays, sleases, srepays = dr.Payments, dr.Leases, dr.RePayments
+	}
+
+	if len(s.Kaiyakuymd) > 0 {
+		cr, err := CancelCompute(cfg, spays, sleases, srepays, CancelParam{
+			Kaiyakuymd: s.Kaiyakuymd,
+			Keiyakuno:  p.Keiyakuno,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+		sleases, srepays = cr.Leases, cr.RePayments
+	}
+
+	return sleases, srepays, nil
+}
+
+// Periods 利息和偿还数据按月度汇总(fromym以后)
+// 数据最终月的月末残高为0(满了或解约时终止认识)
+func Periods(leases []Lease, repays []RePayment, fromym string) (ps []PeriodAmount) {
+	from, err := time.Parse("2006-01", fromym)
+	if err != nil {
+		return nil
+	}
+
+	amounts := make(map[string]*PeriodAmount)
+	get := func(ym string) *PeriodAmount {
+		if a, ok := amounts[ym]; ok {
+			return a
+		}
+		a := &PeriodAmount{Ym: ym}
+		amounts[ym] = a
+		return a
+	}
+	// 有数据的年月(没有数据的月度沿用前月的残高)
+	hasLease := make(map[string]bool)
+	hasRepay := make(map[string]bool)
+	var lastLease, lastRepay string
+	for _, l := range leases {
+		ym := l.Paymentymd[0:7]
+		if ym > lastLease {
+			lastLease = ym
+		}
+		hasLease[ym] = true
+		a := get(ym)
+		a.Interest += l.Interest
+		a.Balance = l.Balance
+	}
+	for _, rp := range repays {
+		ym := rp.Syokyakuymd[0:7]
+		a := get(ym)
+		a.Syokyaku += rp.Syokyaku
+		// 调整数据不影响月末薄价
+		if rp.Syokyakukbn == "調整" {
+			continue
+		}
+		if ym > lastRepay {
+			lastRepay = ym
+		}
+		hasRepay[ym] = true
+		a.Boka = rp.Endboka
+	}
+	lastym := lastLease
+	if lastRepay > lastym {
+		lastym = lastRepay
+	}
+
+	// fromym时点的期首残高
+	balance := balanceAt(leases, 0, time.Time{}, from)
+	boka := bokaAt(repays, 0, time.Time{}, from)
+	for t := from; t.Format("2006-01") <= lastym; t = t.AddDate(0, 1, 0) {
+		ym := t.Format("2006-01")
+		a := PeriodAmount{Ym: ym}
+		if v, ok := amounts[ym]; ok {
+			a = *v
+		}
+		if hasLease[ym] {
+			balance = a.Balance
+		}
+		if hasRepay[ym] {
+			boka = a.Boka
+		}
+		a.Balance, a.Boka = balance, boka
+		if ym >= lastLease {
+			a.Balance = 0
+		}
+		if ym >= lastRepay {
+			a.Boka = 0
+		}
+		ps = append(ps, a)
+	}
+
+	return ps
+}
+
+// AddPeriods 月度数据合计(多个契约的汇总用)
+func AddPeriods(total, ps []PeriodAmount) []PeriodAmount {
+	amounts := make(map[string]PeriodAmount, len(total)+len(ps))
+	for _, list := range [][]PeriodAmount{total, ps} {
+		for _, p := range list {
+			a := amounts[p.Ym]
+			a.Ym = p.Ym
+			a.Balance += p.Balance
+			a.Boka += p.Boka
+			a.Interest += p.Interest
+			a.Syokyaku += p.Syokyaku
+			amounts[p.Ym] = a
+		}
+	}
+	result := make([]PeriodAmount, 0, len(amounts))
+	for _, a := range amounts {
+		result = append(result, a)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Ym < result[j].Ym
+	})
+	return result
+}
+
+// DeltaReport 模拟前后的月度差额
+func DeltaReport(base, simulated []PeriodAmount) []PeriodDelta {
+	all := AddPeriods(base, simulated)
+	baseMap := make(map[string]PeriodAmount, len(base))
+	for _, p := range base {
+		baseMap[p.Ym] = p
+	}
+	simMap := make(map[string]PeriodAmount, len(simulated))
+	for _, p := range simulated {
+		simMap[p.Ym] = p
+	}
+
+	deltas := make([]PeriodDelta, 0, len(all))
+	for _, p := range all {
+		b, s := baseMap[p.Ym], simMap[p.Ym]
+		b.Ym, s.Ym = p.Ym, p.Ym
+		deltas = append(deltas, PeriodDelta{
+			Ym:        p.Ym,
+			Base:      b,
+			Simulated: s,
+			Delta: PeriodAmount{
+				Ym:       p.Ym,
+				Balance:  s.Balance - b.Balance,
+				Boka:     s.Boka - b.Boka,
+				Interest: s.Interest - b.Interest,
+				Syokyaku: s.Syokyaku - b.Syokyaku,
+			},
+		})
+	}
+	return deltas
+}
@@ -0,0 +1,86 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func simulationParam(bp LRParam) DebtParam {
+	return DebtParam{
+		Leasestymd:    bp.Leasestymd.Format("2006-01-02"),
+		Leasekikan:    bp.Leasekikan,
+		Keiyakuno:     "K0001",
+		Rishiritsu:    bp.Rishiritsu,
+		ResidualValue: bp.ResidualValue,
+		Assetlife:     bp.Assetlife,
+		Torihikikbn:   bp.Torihikikbn,
+		Percentage:    1,
+	}
+}
+
+func TestExtendPayments(t *testing.T) {
+	bp := baseParam(t)
+	got, err := ExtendPayments(bp.Payments, 24)
+	if err != nil {
+		t.Fatalf("ExtendPayments() error = %v", err)
+	}
+	if len(got) != len(bp.Payments)+24 {
+		t.Fatalf("len(ExtendPayments()) = %v, want %v", len(got), len(bp.Payments)+24)
+	}
+	for i, pay := range got {
+		if pay.Paymentcount != i+1 {
+			t.Fatalf("Payments[%d].Paymentcount = %v, want %v", i, pay.Paymentcount, i+1)
+		}
+	}
+	// 残价保证额的支付移到延长后的期末
+	last := got[len(got)-1]
+	if last.PaymentType == "支払" || last.Paymentymd != "2027-04-25" {
+		t.Errorf("last = %v %v, want residual at 2027-04-25", last.PaymentType, last.Paymentymd)
+	}
+	if p := got[len(got)-2]; p.Paymentymd != "2027-03-25" || p.Paymentleasefee != MoneyFromInt(100000) {
+		t.Errorf("last pay = %v %v, want 2027-03-25 100000", p.Paymentymd, p.Paymentleasefee)
+	}
+}
+
+func TestSimulate(t *testing.T) {
+	bp := baseParam(t)
+	base := mustCompute(t, testConfig, bp)
+	basePeriods := Periods(base.Leases, base.RePayments, testConfig.SyoriYm)
+
+	tests := []struct {
+		name   string
+		s      Scenario
+		lastym string
+	}{
+		{name: "simulate_rate", s: Scenario{RateDelta: 0.005}, lastym: "2025-04"},
+		{name: "simulate_extend", s: Scenario{ExtendMonths: 24}, lastym: "2027-04"},
+		{name: "simulate_cancel", s: Scenario{Kaiyakuymd: "2022-03-31"}, lastym: "2022-03"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			leases, repays, err := Simulate(testConfig, base.KiSyuBoka, base.Payments, base.Leases, base.RePayments, simulationParam(bp), tt.s)
+			if err != nil {
+				t.Fatalf("Simulate() error = %v", err)
+			}
+			ps := Periods(leases, repays, testConfig.SyoriYm)
+			if len(ps) == 0 || ps[0].Ym != testConfig.SyoriYm || ps[len(ps)-1].Ym != tt.lastym {
+				t.Fatalf("Periods() = %v~, want %v~%v", ps, testConfig.SyoriYm, tt.lastym)
+			}
+			if last := ps[len(ps)-1]; last.Balance != 0 || last.Boka != 0 {
+				t.Errorf("last Balance, Boka = %v, %v, want 0, 0", last.Balance, last.Boka)
+			}
+
+			deltas := DeltaReport(basePeriods, ps)
+			checkGolden(t, tt.name, deltas)
+			for _, d := range deltas {
+				if d.Delta.Balance != d.Simulated.Balance-d.Base.Balance || d.Delta.Interest != d.Simulated.Interest-d.Base.Interest {
+					t.Fatalf("Delta[%s] = %v, want simulated - base", d.Ym, d.Delta)
+				}
+			}
+		})
+	}
+
+	// 没有变动的方案不能模拟
+	if _, _, err := Simulate(testConfig, base.KiSyuBoka, base.Payments, base.Leases, base.RePayments, simulationParam(bp), Scenario{}); err == nil {
+		t.Errorf("Simulate() error = nil, want scenario error")
+	}
+}
@@ -0,0 +1,131 @@
+package leasecalc
+
+import (
+	"time"
+)
+
+// 使用権資産的计算方法(Sykshisankeisan,适用开始时的经过措置)
+const (
+	// TransitionStart 適用開始時点から計算(比較開始時点的残存リース料现在价值为使用権資産)
+	TransitionStart = "1"
+	// TransitionObtain 取得時点に遡って計算(仅使用権資産遡及,リース負債从比較開始時点计算)
+	TransitionObtain = "2"
+	// TransitionFull 完全遡及適用(リース負債和使用権資産均从租赁开始日计算,比較期间修正再表示)
+	TransitionFull = "3"
+)
+
+// Comparative 比较期间的修正再表示数据(会计年度单位)
+type Comparative struct {
+	Startym        string `json:"startym" bson:"startym"`               // 期首年月
+	Endym          string `json:"endym" bson:"endym"`                   // 期末年月
+	OpeningBalance Money  `json:"openingBalance" bson:"openingBalance"` // 期首リース負債
+	Interest       Money  `json:"interest" bson:"interest"`             // 支払利息
+	Repayment      Money  `json:"repayment" bson:"repayment"`           // 元本返済額
+	ClosingBalance Money  `json:"closingBalance" bson:"closingBalance"` // 期末リース負債
+	OpeningBoka    Money  `json:"openingBoka" bson:"openingBoka"`       // 期首使用権資産
+	Syokyaku       Money  `json:"syokyaku" bson:"syokyaku"`             // 減価償却費
+	ClosingBoka    Money  `json:"closingBoka" bson:"closingBoka"`       // 期末使用権資産
+}
+
+// fullRetrospective 完全遡及適用的计算
+// 从租赁开始日起生成利息和偿还数据,比較開始期首月时点的使用権資産与リース負債的差额为累积影响额(计入利益剰余金)
+func fullRetrospective(rd Rounding, method DepreciationMethod, costModel CostModel, p LRParam, payments []Payment, genkakikan int, firstMonthB time.Time) (result *ComputeResult, err error) {
+	result = &ComputeResult{}
+	// 租赁开始日(月初)
+	leasestymd := firstDayOfMonth(p.Leasestymd)
+	// 根据参数传入的支付情报算出现在价值合计
+	presentTotal, leaseTotal := getLeaseTotal(rd, p.Payments, leasestymd, p.Rishiritsu)
+	// 初期期首簿価 = 现在价值合计+ 当初直接費用 + 原状回復コスト
+	boka := presentTotal + p.InitialDirectCosts + p.RestorationCosts
+
+	// **********利息情报算出(租赁开始日起)**********
+	leases, err := getLeaseData(rd, payments, leasestymd, leasestymd, presentTotal, p.Rishiritsu)
+	if err != nil {
+		return nil, err
+	}
+	// **********偿还情报算出(租赁开始日起)**********
+	repays, err := getRepayDataObtain(rd, method, leasestymd, genkakikan, p.ResidualValue, boka, firstMonthB)
+	if err != nil {
+		return nil, err
+	}
+	// 经营租赁的场合,单一リース費用按定额计上
+	if costModel == CostModelOperating {
+		repays = straightLineCost(rd, repays, leases, p.ResidualValue)
+	}
+
+	// 比較開始時点的リース負債和使用権資産
+	balance := balanceAt(leases, presentTotal, leasestymd, firstMonthB)
+	useBoka := bokaAt(repays, boka, leasestymd, firstMonthB)
+
+	result.KiSyuBoka = boka
+	result.LeaseTotal = leaseTotal
+	result.PresentTotal = presentTotal
+	// 利益剰余金(累积影响额) = 比較開始時点のリース負債 - 比較開始時点の使用権資産
+	result.Sonnekigaku = balance - useBoka
+	result.Leases = leases
+	result.RePayments = repays
+
+	return result, nil
+}
+
+// balanceAt 指定年月期首时点的リース負債(租赁开始前为0)
+func balanceAt(leases []Lease, principal Money, leasestymd, ym time.Time) Money {
+	if !leasestymd.Before(ym) {
+		return 0
+	}
+	balance := principal
+	key := ym.Format("2006-01")
+	for _, l := range leases {
+		if l.Paymentymd[:7] >= key {
+			break
+		}
+		balance = l.Balance
+	}
+	return balance
+}
+
+// bokaAt 指定年月期首时点的使用権資産(租赁开始前为0)
+func bokaAt(repays []RePayment, boka Money, leasestymd, ym time.Time) Money {
+	if !leasestymd.Before(ym) {
+		return 0
+	}
+	key := ym.Format("2006-01")
+	for _, rp := range repays {
+		if rp.Syokyakukbn == "調整" {
+			continue
+		}
+		if rp.Syokyakuymd[:7] >= key {
+			break
+		}
+		boka = rp.Endboka
+	}
+	return boka
+}
+
+// comparatives 比較開始期首月起至处理月度所属会计年度前的各会计年度的修正再表示数据
+func comparatives(leases []Lease, repays []RePayment, principal, boka Money, leasestymd, firstMonthB time.Time, syoriym string) (cs []Comparative) {
+	for start := firstMonthB; start.Format("2006-01") < syoriym; start = start.AddDate(1, 0, 0) {
+		end := start.AddDate(1, 0, 0)
+		c := Comparative{
+			Startym:        start.Format("2006-01"),
+			Endym:          end.AddDate(0, -1, 0).Format("2006-01"),
+			OpeningBalance: balanceAt(leases, principal, leasestymd, start),
+			ClosingBalance: balanceAt(leases, principal, leasestymd, end),
+			OpeningBoka:    bokaAt(repays, boka, leasestymd, start),
+			ClosingBoka:    bokaAt(repays, boka, leasestymd, end),
+		}
+		for _, l := range leases {
+			if ym := l.Paymentymd[:7]; ym >= c.Startym && ym <= c.Endym {
+				c.Interest += l.Interest
+				c.Repayment += l.Repayment
+			}
+		}
+		for _, rp := range repays {
+			if ym := rp.Syokyakuymd[:7]; ym >= c.Startym && ym <= c.Endym {
+				c.Syokyaku += rp.Syokyaku
+			}
+		}
+		cs = append(cs, c)
+	}
+	return cs
+}
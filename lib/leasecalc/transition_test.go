@@ -0,0 +1,59 @@
+package leasecalc
+
+import (
+	"testing"
+)
+
+func TestFullRetrospective(t *testing.T) {
+	p := baseParam(t)
+	p.Sykshisankeisan = TransitionFull
+	p.FirstMonth = "2020-04"
+	p.Leasestymd = date("2019-04-01")
+	p.Payments = mustPays(t, PayParam{
+		Paymentstymd:    date("2019-04-25"),
+		Paymentcycle:    1,
+		Paymentday:      25,
+		Paymentcounts:   60,
+		Paymentleasefee: MoneyFromInt(100000),
+		ResidualValue:   MoneyFromInt(500000),
+		Keiyakuno:       "K0001",
+	})
+
+	got := mustCompute(t, testConfig, p)
+	checkGolden(t, "transition_full", got)
+
+	// 利息和偿还数据均从租赁开始日生成
+	if ym := got.Leases[0].Paymentymd[:7]; ym != "2019-04" {
+		t.Errorf("Leases[0].Paymentymd = %v, want 2019-04", ym)
+	}
+	if ym := got.RePayments[0].Syokyakuymd[:7]; ym != "2019-04" {
+		t.Errorf("RePayments[0].Syokyakuymd = %v, want 2019-04", ym)
+	}
+
+	// 比較期间为2020-04~2021-03的一个会计年度
+	if len(got.Comparatives) != 1 {
+		t.Fatalf("len(Comparatives) = %v, want 1", len(got.Comparatives))
+	}
+	c := got.Comparatives[0]
+	if c.Startym != "2020-04" || c.Endym != "2021-03" {
+		t.Errorf("Comparatives[0] = %v~%v, want 2020-04~2021-03", c.Startym, c.Endym)
+	}
+	// 累积影响额 = 比較開始時点的リース負債 - 使用権資産
+	if got.Sonnekigaku != c.OpeningBalance-c.OpeningBoka {
+		t.Errorf("Sonnekigaku = %v, want %v", got.Sonnekigaku, c.OpeningBalance-c.OpeningBoka)
+	}
+	if c.ClosingBalance != c.OpeningBalance-c.Repayment {
+		t.Errorf("ClosingBalance = %v, want %v", c.ClosingBalance, c.OpeningBalance-c.Repayment)
+	}
+	if c.ClosingBoka != c.OpeningBoka-c.Syokyaku {
+		t.Errorf("ClosingBoka = %v, want %v", c.ClosingBoka, c.OpeningBoka-c.Syokyaku)
+	}
+
+	// 租赁开始日在比較開始時点以后的场合,没有累积影响额
+	p = baseParam(t)
+	p.Sykshisankeisan = TransitionFull
+	got = mustCompute(t, testConfig, p)
+	if got.Sonnekigaku != 0 {
+		t.Errorf("Sonnekigaku = %v, want 0", got.Sonnekigaku)
+	}
+}
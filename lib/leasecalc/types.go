@@ -0,0 +1,218 @@
+package leasecalc
+
+import (
+	"time"
+)
+
+// Config 计算用的应用设定(由调用方从app设定中取得后传入)
+type Config struct {
+	SyoriYm   string    `json:"syori_ym" bson:"syori_ym"`     // 处理月度(2006-01)
+	KishuYm   string    `json:"kishu_ym" bson:"kishu_ym"`     // 期首月(1~12)
+	Rounding  Rounding  `json:"rounding" bson:"rounding"`     // 金额端数处理设定
+	CostModel CostModel `json:"cost_model" bson:"cost_model"` // 租赁费用计上方式(契约未指定的场合)
+}
+
+// PayParam 支付情报参数
+type PayParam struct {
+	Paymentstymd     time.Time    `json:"paymentstymd" bson:"paymentstymd"`         // 支付开始日
+	Paymentcycle     int          `json:"paymentcycle" bson:"paymentcycle"`         // 支付周期
+	Paymentday       int          `json:"paymentday" bson:"paymentday"`             // 支付日
+	Paymentcounts    int          `json:"paymentcounts" bson:"paymentcounts"`       // 支付回数
+	ResidualValue    Money        `json:"residualValue" bson:"residualValue"`       // 残价保证额
+	Paymentleasefee  Money        `json:"paymentleasefee" bson:"paymentleasefee"`   // 支付金额
+	OptionToPurchase Money        `json:"optionToPurchase" bson:"optionToPurchase"` // 购入行使权金额
+	Firstleasefee    Money        `json:"firstleasefee" bson:"firstleasefee"`       // 初回リース料
+	Finalleasefee    Money        `json:"finalleasefee" bson:"finalleasefee"`       // 最终回リース料
+	Keiyakuno        string       `json:"keiyakuno" bson:"keiyakuno"`               // 契约番号
+	Plan             string       `json:"plan,omitempty" bson:"plan"`               // 支付计划(文字列形式,指定的场合优先于支付周期和回数)
+	Segments         []PaySegment `json:"segments,omitempty" bson:"segments"`       // 支付计划区间
+	Escalation       *Escalation  `json:"escalation,omitempty" bson:"escalation"`   // 支付额改定条款
+	Indexes          []IndexPoint `json:"indexes,omitempty" bson:"indexes"`         // 已公表的指数(指数联动的场合)
+	Rounding         Rounding     `json:"-" bson:"-"`                               // 金额端数处理设定(改定后金额用)
+}
+
+// LRParam 契约追加情报参数
+type LRParam struct {
+	ResidualValue           Money         `json:"residualValue" bson:"residualValue"`                     // 残价保证额
+	Rishiritsu              float64       `json:"rishiritsu" bson:"rishiritsu"`                           // 割引率
+	Leasestymd              time.Time     `json:"leasestymd" bson:"leasestymd"`                           // 租赁开始日
+	CancellationRightOption bool          `json:"cancellationrightoption" bson:"cancellationrightoption"` // 解約行使権オプション
+	Leasekikan              int           `json:"leasekikan" bson:"leasekikan"`                           // 租赁期间
+	ExtentionOption         int           `json:"extentionOption" bson:"extentionOption"`                 // 延长租赁期间
+	PaymentsAtOrPrior       Money         `json:"paymentsAtOrPrior" bson:"paymentsAtOrPrior"`             // 前払リース料
+	IncentivesAtOrPrior     Money         `json:"incentivesAtOrPrior" bson:"incentivesAtOrPrior"`         // リース・インセンティブ（前払）
+	InitialDirectCosts      Money         `json:"initialDirectCosts" bson:"initialDirectCosts"`           // 当初直接費用
+	RestorationCosts        Money         `json:"restorationCosts" bson:"restorationCosts"`               // 原状回復コスト
+	Assetlife               int           `json:"assetlife" bson:"assetlife"`                             // 耐用年限
+	Torihikikbn             string        `json:"torihikikbn" bson:"torihikikbn"`                         // 取引判定区分
+	Payments                []Payment     `json:"payments" bson:"payments"`                               // 支付情报
+	Sykshisankeisan         string        `json:"sykshisankeisan" bson:"sykshisankeisan"`                 // 使用権資産的计算方法(TransitionStart/TransitionObtain/TransitionFull)
+	FirstMonth              string        `json:"firstMonth" bson:"firstMonth"`                           // 比較開始期首月
+	Hkkjitenzan             Money         `json:"hkkjitenzan" bson:"hkkjitenzan"`                         // 比較開始時点の残存リース料
+	Sonnekigaku             Money         `json:"sonnekigaku" bson:"sonnekigaku"`                         // 利益剰余金
+	CostModel               CostModel     `json:"costModel" bson:"costModel"`                             // 租赁费用计上方式
+	Depreciation            *Depreciation `json:"depreciation,omitempty" bson:"depreciation,omitempty"`   // 使用権資産的偿却方法(未设定的场合为定额法)
+}
+
+// DebtParam 债务变更情报参数
+type DebtParam struct {
+	Kaiyakuymd              string        `json:"kaiyakuymd" bson:"kaiyakuymd"`                           // 解约年月
+	Henkouymd               string        `json:"henkouymd" bson:"henkouymd"`                             // 变更年月
+	Leasestymd              string        `json:"leasestymd" bson:"leasestymd"`                           // 租赁开始日
+	CancellationRightOption bool          `json:"cancellationrightoption" bson:"cancellationrightoption"` // 解約行使権オプション
+	Leasekikan              int           `json:"leasekikan" bson:"leasekikan"`                           // 租赁期间
+	ExtentionOption         int           `json:"extentionOption" bson:"extentionOption"`                 // 延长租赁期间
+	Keiyakuno               string        `json:"keiyakuno" bson:"keiyakuno"`                             // 契约番号
+	Rishiritsu              float64       `json:"rishiritsu" bson:"rishiritsu"`                           // 割引率
+	ResidualValue           Money         `json:"residualValue" bson:"residualValue"`                     // 残价保证额
+	Assetlife               int           `json:"assetlife" bson:"assetlife"`                             // 耐用年限
+	Torihikikbn             string        `json:"torihikikbn" bson:"torihikikbn"`                         // 取引判定区分
+	Percentage              float64       `json:"percentage" bson:"percentage"`                           // 剩余资产百分比
+	Payments                []Payment     `json:"payments" bson:"payments"`                               // 支付情报
+	CostModel               CostModel     `json:"costModel" bson:"costModel"`                             // 租赁费用计上方式
+	Depreciation            *Depreciation `json:"depreciation,omitempty" bson:"depreciation,omitempty"`   // 使用権資産的偿却方法(未设定的场合为定额法)
+}
+
+// ExpireParam 契约满了情报参数
+type ExpireParam struct {
+	Henkouymd         string `json:"henkouymd" bson:"henkouymd"`                 // 变更年月
+	Torihikikbn       string `json:"torihikikbn" bson:"torihikikbn"`             // 取引判定区分
+	Expiresyokyakukbn string `json:"expiresyokyakukbn" bson:"expiresyokyakukbn"` // リース満了償却区分
+	Keiyakuno         string `json:"keiyakuno" bson:"keiyakuno"`                 // 契约番号
+}
+
+// CancelParam 中途解约情报参数
+type CancelParam struct {
+	Kaiyakuymd string `json:"kaiyakuymd" bson:"kaiyakuymd"` // 解約年月日
+	Keiyakuno  string `json:"keiyakuno" bson:"keiyakuno"`   // 契约番号
+}
+
+// ImpairmentParam 使用権資産减损情报参数
+type ImpairmentParam struct {
+	Henkouymd       string        `json:"henkouymd" bson:"henkouymd"`                           // 减损年月(当月偿却后减损,次月起按减损后的账面价值偿却)
+	Keiyakuno       string        `json:"keiyakuno" bson:"keiyakuno"`                           // 契约番号
+	Leasekikan      int           `json:"leasekikan" bson:"leasekikan"`                         // 租赁期间
+	ExtentionOption int           `json:"extentionOption" bson:"extentionOption"`               // 延长租赁期间
+	Assetlife       int           `json:"assetlife" bson:"assetlife"`                           // 耐用年限
+	Torihikikbn     string        `json:"torihikikbn" bson:"torihikikbn"`                       // 取引判定区分
+	ResidualValue   Money         `json:"residualValue" bson:"residualValue"`                   // 残价保证额
+	Reversal        bool          `json:"reversal" bson:"reversal"`                             // 减损回转
+	Amount          Money         `json:"amount" bson:"amount"`                                 // 减损损失额(减损回转的场合为回转额)
+	Depreciation    *Depreciation `json:"depreciation,omitempty" bson:"depreciation,omitempty"` // 使用権資産的偿却方法(未设定的场合为定额法)
+}
+
+// Payment 支付数据
+type Payment struct {
+	Leasekaishacd        string `json:"leasekaishacd" bson:"leasekaishacd"`               // 租赁会社
+	Keiyakuno            string `json:"keiyakuno" bson:"keiyakuno"`                       // 契约番号
+	Paymentcount         int    `json:"paymentcount" bson:"paymentcount"`                 // 支付回数
+	PaymentType          string `json:"paymentType" bson:"paymentType"`                   // 支付类型
+	Paymentymd           string `json:"paymentymd" bson:"paymentymd"`                     // 支付年月日
+	Paymentleasefee      Money  `json:"paymentleasefee" bson:"paymentleasefee"`           // 支付金额
+	Paymentleasefeehendo Money  `json:"paymentleasefeehendo" bson:"paymentleasefeehendo"` // 变更支付金额
+	Incentives           Money  `json:"incentives" bson:"incentives"`                     // 优惠金额
+	Sonotafee            Money  `json:"sonotafee" bson:"sonotafee"`                       // 其他金额
+	Kaiyakuson           Money  `json:"kaiyakuson" bson:"kaiyakuson"`                     // 解约损失
+	Fixed                bool   `json:"fixed" bson:"fixed"`                               // 修正否
+}
+
+// Lease 利息数据
+type Lease struct {
+	Leasekaishacd string `json:"leasekaishacd" bson:"leasekaishacd"` // 租赁会社
+	Keiyakuno     string `json:"keiyakuno" bson:"keiyakuno"`         // 契约番号
+	Interest      Money  `json:"interest" bson:"interest"`           // 支付利息相当额
+	Repayment     Money  `json:"repayment" bson:"repayment"`         // 元本返済相当額
+	Balance       Money  `json:"balance" bson:"balance"`             // 元本残高相当額
+	Firstbalance  Money  `json:"firstbalance" bson:"firstbalance"`   // 期首元本残高
+	Present       Money  `json:"present" bson:"present"`             // 現在価値
+	Plug          Money  `json:"plug" bson:"plug"`                   // 最终回端数调整额
+	Paymentymd    string `json:"paymentymd" bson:"paymentymd"`       // 支付年月
+	Book          string `json:"book,omitempty" bson:"book"`         // 账簿(主账簿为空)
+}
+
+// RePayment 偿还数据
+type RePayment struct {
+	Leasekaishacd string `json:"leasekaishacd" bson:"leasekaishacd"`   // 租赁会社
+	Keiyakuno     string `json:"keiyakuno" bson:"keiyakuno"`           // 契约番号
+	Syokyakukbn   string `json:"syokyakukbn" bson:"syokyakukbn"`       // 償却区分
+	Endboka       Money  `json:"endboka" bson:"endboka"`               // 月末薄价
+	Boka          Money  `json:"boka" bson:"boka"`                     // 期首薄价
+	Syokyaku      Money  `json:"syokyaku" bson:"syokyaku"`             // 偿却额
+	Plug          Money  `json:"plug" bson:"plug"`                     // 最终月端数调整额
+	Syokyakuymd   string `json:"syokyakuymd" bson:"syokyakuymd"`       // 偿却年月
+	Leasecost     Money  `json:"leasecost,omitempty" bson:"leasecost"` // 单一リース費用(经营租赁)
+	Genson        Money  `json:"genson,omitempty" bson:"genson"`       // 减损累计额(未减损时的月末薄价-月末薄价,减损回转的上限)
+	Book          string `json:"book,omitempty" bson:"book"`           // 账簿(主账簿为空)
+}
+
+// ComputeResult 新规契约计算结果
+type ComputeResult struct {
+	KiSyuBoka            Money         `json:"kisyuboka" bson:"kisyuboka"`                       // 原始取得价值
+	LeaseTotal           Money         `json:"leaseTotal" bson:"leaseTotal"`                     // リース料総額
+	PresentTotal         Money         `json:"presentTotal" bson:"presentTotal"`                 // 现在价值合计
+	PreDepreciationTotal Money         `json:"preDepreciationTotal" bson:"preDepreciationTotal"` // 処理月度の先月までの償却費の累計額
+	Hkkjitenzan          Money         `json:"hkkjitenzan" bson:"hkkjitenzan"`                   // 比較開始時点の残存リース料
+	Sonnekigaku          Money         `json:"sonnekigaku" bson:"sonnekigaku"`                   // 利益剰余金
+	Payments             []Payment     `json:"payments" bson:"payments"`                         // 支付数据
+	Leases               []Lease       `json:"leases" bson:"leases"`                             // 利息数据
+	RePayments           []RePayment   `json:"repayments" bson:"repayments"`                     // 偿还数据
+	Comparatives         []Comparative `json:"comparatives,omitempty" bson:"comparatives"`       // 比较期间的修正再表示数据(完全遡及適用)
+}
+
+// ChangeResult 契约情报变更计算结果
+type ChangeResult struct {
+	OldDepreciationTotal Money `json:"oldDepreciationTotal" bson:"oldDepreciationTotal"` // リース開始日から変更年月日までの償却費の累計額
+	PayTotalRemain       Money `json:"payTotalRemain" bson:"payTotalRemain"`             // 翌月から最終回までの支払リース料合計額
+	InterestTotalRemain  Money `json:"interestTotalRemain" bson:"interestTotalRemain"`   // 翌月から最終回までの利息合計額
+}
+
+// DebtResult 债务变更计算结果
+type DebtResult struct {
+	KiSyuBoka          Money       `json:"kisyuboka" bson:"kisyuboka"`                     // 原始取得价值
+	OShisannsougaku    Money       `json:"o_shisannsougaku" bson:"o_shisannsougaku"`       // 变更前使用権資産額
+	Shisannsougaku     Money       `json:"shisannsougaku" bson:"shisannsougaku"`           // 变更后使用権資産額
+	OLeasesaimusougaku Money       `json:"o_leasesaimusougaku" bson:"o_leasesaimusougaku"` // 变更前租赁负债额
+	Leasesaimusougaku  Money       `json:"leasesaimusougaku" bson:"leasesaimusougaku"`     // 变更后租赁负债额
+	Shisannsagaku      Money       `json:"shisannsagaku" bson:"shisannsagaku"`             // 使用权资产差额
+	Leasesaimusagaku   Money       `json:"leasesaimusagaku" bson:"leasesaimusagaku"`       // 租赁负债差额
+	Sonnekigaku        Money       `json:"sonnekigaku" bson:"sonnekigaku"`                 // 损益额
+	GensyoPayTotal     Money       `json:"gensyoPayTotal" bson:"gensyoPayTotal"`           // 変更時点の支払残額に対して、比例減少した金額
+	GensyoBalance      Money       `json:"gensyoBalance" bson:"gensyoBalance"`             // 変更時点の元本残高に対して、比例減少した金額
+	GensyoBoka         Money       `json:"gensyoBoka" bson:"gensyoBoka"`                   // 変更時点の帳簿価額に対して、比例減少した金額
+	LeaseTotalAfter    Money       `json:"leaseTotalAfter" bson:"leaseTotalAfter"`         // 再見積変更後現在価値
+	LeaseTotalRemain   Money       `json:"leaseTotalRemain" bson:"leaseTotalRemain"`       // 変更時点の元本残高に対して、比例残の金額
+	PayTotalAfter      Money       `json:"payTotalAfter" bson:"payTotalAfter"`             // 再見積変更後の支払総額
+	PayTotalRemain     Money       `json:"payTotalRemain" bson:"payTotalRemain"`           // 変更時点の支払残額に対して、比例残の金額
+	PayTotalChange     Money       `json:"payTotalChange" bson:"payTotalChange"`           // 支付变动额
+	Payments           []Payment   `json:"payments" bson:"payments"`                       // 支付数据
+	Leases             []Lease     `json:"leases" bson:"leases"`                           // 利息数据
+	RePayments         []RePayment `json:"repayments" bson:"repayments"`                   // 偿还数据
+}
+
+// CancelResult 中途解约计算结果
+type CancelResult struct {
+	RemainDebt          Money       `json:"remaindebt" bson:"remaindebt"`                   // 解約時元本残高
+	Lossgaku            Money       `json:"lossgaku" bson:"lossgaku"`                       // 中途解約による除却損金额
+	SyokyakuTotal       Money       `json:"syokyakuTotal" bson:"syokyakuTotal"`             // 中途解約時点の償却費の累計額
+	PayTotalRemain      Money       `json:"payTotalRemain" bson:"payTotalRemain"`           // 中途解約時点の支払リース料残額
+	InterestTotalRemain Money       `json:"interestTotalRemain" bson:"interestTotalRemain"` // 中途解約時点の利息残
+	Payments            []Payment   `json:"payments" bson:"payments"`                       // 支付数据
+	Leases              []Lease     `json:"leases" bson:"leases"`                           // 利息数据
+	RePayments          []RePayment `json:"repayments" bson:"repayments"`                   // 偿还数据
+}
+
+// ImpairmentResult 使用権資産减损计算结果
+type ImpairmentResult struct {
+	OBoka      Money       `json:"o_boka" bson:"o_boka"`         // 减损前账面价值
+	Boka       Money       `json:"boka" bson:"boka"`             // 减损后账面价值
+	Gensongaku Money       `json:"gensongaku" bson:"gensongaku"` // 减损损失额(减损回转的场合为按上限调整后的回转额)
+	Unimpaired Money       `json:"unimpaired" bson:"unimpaired"` // 未减损时的账面价值
+	RePayments []RePayment `json:"repayments" bson:"repayments"` // 偿还数据
+}
+
+// ExpireResult 满了计算结果
+type ExpireResult struct {
+	Leftgaku   Money       `json:"leftgaku" bson:"leftgaku"`     // 满了時剩余价值
+	Computed   bool        `json:"computed" bson:"computed"`     // 是否进行了满了计算(移転且つ償却停止の場合のみ)
+	RePayments []RePayment `json:"repayments" bson:"repayments"` // 偿还数据
+}
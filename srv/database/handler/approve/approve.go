@@ -17,12 +17,15 @@ type Approve struct{}
 
 // log出力使用
 const (
-	ApproveProcessName = "Approve"
-	ActionFindItems    = "FindItems"
-	ActionFindCount    = "FindCount"
-	ActionFindItem     = "FindItem"
-	ActionAddItem      = "AddItem"
-	ActionDeleteItems  = "DeleteItems"
+	ApproveProcessName   = "Approve"
+	ActionFindItems      = "FindItems"
+	ActionFindCount      = "FindCount"
+	ActionFindItem       = "FindItem"
+	ActionAddItem        = "AddItem"
+	ActionDeleteItems    = "DeleteItems"
+	ActionReviseItem     = "ReviseItem"
+	ActionRevertRevision = "RevertRevision"
+	ActionFindRevisions  = "FindRevisions"
 )
 
 // FindItems 获取审批数据
@@ -107,59 +110,16 @@ func (i *Approve) FindItem(ctx context.Context, req *approve.ItemRequest, rsp *a
 func (i *Approve) AddItem(ctx context.Context, req *approve.AddRequest, rsp *approve.AddResponse) error {
 	utils.InfoLog(ActionAddItem, utils.MsgProcessStarted)
 
-	// 当前语言取得(选项翻译用)
-	lang := utils.GetLanguageData(req.Database, req.LangCd, req.Domain)
-
-	// 选项字段情报取得(选项翻译用)
-	fps := model.FindFieldsParam{
-		AppID:       req.GetAppId(),
-		DatastoreID: req.GetDatastoreId(),
-		FieldType:   "options",
-	}
-	opFields, err := model.FindFields(req.GetDatabase(), &fps)
-	if err != nil {
-		utils.ErrorLog(ActionAddItem, err.Error())
-		return err
-	}
-
-	// 用户情报取得(用户ID转用户名用)
-	userService := user.NewUserService("manage", client.DefaultClient)
-	var reqU user.FindUsersRequest
-	reqU.InvalidatedIn = "true"
-	reqU.Domain = req.Domain
-	reqU.Database = req.Database
-	response, err := userService.FindUsers(context.TODO(), &reqU)
+	convert, err := newConverter(req.GetDatabase(), req.GetAppId(), req.GetDatastoreId(), req.GetLangCd(), req.GetDomain())
 	if err != nil {
 		utils.ErrorLog(ActionAddItem, err.Error())
 		return err
 	}
-	userMap := make(map[string]string)
-	for _, u := range response.Users {
-		userMap[u.UserId] = u.UserName
-	}
 
 	// 审批数据编辑
-	items := make(map[string]*model.Value, len(req.GetItems()))
-	for key, item := range req.GetItems() {
-		items[key] = &model.Value{
-			DataType: item.DataType,
-			Value:    model.GetApproveDataValue(item, getField(key, opFields), userMap, lang, false),
-		}
-	}
-	hs := make(map[string]*model.Value, len(req.GetHistory()))
-	for key, item := range req.GetHistory() {
-		hs[key] = &model.Value{
-			DataType: item.DataType,
-			Value:    model.GetApproveDataValue(item, getField(key, opFields), userMap, lang, true),
-		}
-	}
-	current := make(map[string]*model.Value, len(req.GetCurrent()))
-	for key, item := range req.GetCurrent() {
-		current[key] = &model.Value{
-			DataType: item.DataType,
-			Value:    model.GetApproveDataValue(item, getField(key, opFields), userMap, lang, true),
-		}
-	}
+	items := convert(req.GetItems(), false)
+	hs := convert(req.GetHistory(), true)
+	current := convert(req.GetCurrent(), true)
 
 	params := model.ApproveItem{
 		ItemID:      req.GetItemId(),
@@ -200,6 +160,113 @@ func (i *Approve) DeleteItems(ctx context.Context, req *approve.DeleteRequest, r
 	return nil
 }
 
+// ReviseItem 退回后修改审批数据(再申请)
+func (i *Approve) ReviseItem(ctx context.Context, req *approve.ReviseRequest, rsp *approve.ReviseResponse) error {
+	utils.InfoLog(ActionReviseItem, utils.MsgProcessStarted)
+
+	convert, err := newConverter(req.GetDatabase(), req.GetAppId(), req.GetDatastoreId(), req.GetLangCd(), req.GetDomain())
+	if err != nil {
+		utils.ErrorLog(ActionReviseItem, err.Error())
+		return err
+	}
+
+	params := model.Revision{
+		ExampleID:   req.GetExampleId(),
+		Revision:    req.GetRevision(),
+		ItemMap:     convert(req.GetItems(), false),
+		Current:     convert(req.GetCurrent(), true),
+		SubmittedAt: time.Now(),
+		SubmittedBy: req.GetWriter(),
+	}
+
+	err = model.ReviseApprove(req.GetDatabase(), &params)
+	if err != nil {
+		utils.ErrorLog(ActionReviseItem, err.Error())
+		return err
+	}
+
+	utils.InfoLog(ActionReviseItem, utils.MsgProcessEnded)
+	return nil
+}
+
+// RevertRevision 恢复修改前的审批数据(再申请失败时)
+func (i *Approve) RevertRevision(ctx context.Context, req *approve.RevertRevisionRequest, rsp *approve.RevertRevisionResponse) error {
+	utils.InfoLog(ActionRevertRevision, utils.MsgProcessStarted)
+
+	err := model.RevertRevision(req.GetDatabase(), req.GetExampleId(), req.GetRevision())
+	if err != nil {
+		utils.ErrorLog(ActionRevertRevision, err.Error())
+		return err
+	}
+
+	utils.InfoLog(ActionRevertRevision, utils.MsgProcessEnded)
+	return nil
+}
+
+// FindRevisions 获取审批数据的申请历史
+func (i *Approve) FindRevisions(ctx context.Context, req *approve.RevisionsRequest, rsp *approve.RevisionsResponse) error {
+	utils.InfoLog(ActionFindRevisions, utils.MsgProcessStarted)
+
+	revisions, err := model.FindRevisions(req.GetDatabase(), req.GetExampleId())
+	if err != nil {
+		utils.ErrorLog(ActionFindRevisions, err.Error())
+		return err
+	}
+
+	res := &approve.RevisionsResponse{}
+	for _, r := range revisions {
+		res.Revisions = append(res.Revisions, r.ToProto())
+	}
+
+	*rsp = *res
+
+	utils.InfoLog(ActionFindRevisions, utils.MsgProcessEnded)
+	return nil
+}
+
+// newConverter 获取选项和用户情报,返回把请求的值转换为审批数据的函数
+func newConverter(db, appID, datastoreID, langCd, domain string) (func(values map[string]*approve.Value, history bool) model.ItemMap, error) {
+	// 当前语言取得(选项翻译用)
+	lang := utils.GetLanguageData(db, langCd, domain)
+
+	// 选项字段情报取得(选项翻译用)
+	fps := model.FindFieldsParam{
+		AppID:       appID,
+		DatastoreID: datastoreID,
+		FieldType:   "options",
+	}
+	opFields, err := model.FindFields(db, &fps)
+	if err != nil {
+		return nil, err
+	}
+
+	// 用户情报取得(用户ID转用户名用)
+	userService := user.NewUserService("manage", client.DefaultClient)
+	var reqU user.FindUsersRequest
+	reqU.InvalidatedIn = "true"
+	reqU.Domain = domain
+	reqU.Database = db
+	response, err := userService.FindUsers(context.TODO(), &reqU)
+	if err != nil {
+		return nil, err
+	}
+	userMap := make(map[string]string)
+	for _, u := range response.Users {
+		userMap[u.UserId] = u.UserName
+	}
+
+	return func(values map[string]*approve.Value, history bool) model.ItemMap {
+		result := make(model.ItemMap, len(values))
+		for key, item := range values {
+			result[key] = &model.Value{
+				DataType: item.DataType,
+				Value:    model.GetApproveDataValue(item, getField(key, opFields), userMap, lang, history),
+			}
+		}
+		return result
+	}, nil
+}
+
 // getField 通过字段ID获取字段信息
 func getField(id string, fs []model.Field) (f model.Field) {
 	var res model.Field
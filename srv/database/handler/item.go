@@ -250,6 +250,8 @@ func (i *Item) FindRishiritsu(ctx context.Context, req *item.RishiritsuRequest,
 		DatastoreID: req.GetDatastoreId(),
 		LeaseStymd:  req.GetLeasestymd(),
 		LeaseKikan:  req.GetLeasekikan(),
+		Currency:    req.GetCurrency(),
+		Method:      req.GetMethod(),
 	}
 
 	res, err := model.FindRishiritsu(req.GetDatabase(), &param)
@@ -261,7 +263,12 @@ func (i *Item) FindRishiritsu(ctx context.Context, req *item.RishiritsuRequest,
 		return err
 	}
 
-	rsp.Item = res.ToProto()
+	proto := res.ToProto()
+	rsp.Rishiritsu = proto.GetRishiritsu()
+	rsp.Curve = proto.GetCurve()
+	rsp.Method = proto.GetMethod()
+	rsp.Term = proto.GetTerm()
+	rsp.UsedPoints = proto.GetUsedPoints()
 
 	utils.InfoLog(ActionFindRishiritsu, utils.MsgProcessEnded)
 	return nil
@@ -14,6 +14,7 @@ const (
 	ActionModifyContract    = "ModifyContract"
 	ActionChangeDebt        = "ChangeDebt"
 	ActionContractExpire    = "ContractExpire"
+	ActionImpairContract    = "ImpairContract"
 	ActionTerminateContract = "TerminateContract"
 )
 
@@ -152,3 +153,37 @@ func (i *Item) ContractExpire(ctx context.Context, req *item.ContractExpireReque
 	utils.InfoLog(ActionContractExpire, utils.MsgProcessEnded)
 	return nil
 }
+
+// ImpairContract 使用権資産减损(含减损回转)
+func (i *Item) ImpairContract(ctx context.Context, req *item.ImpairContractRequest, rsp *item.ImpairContractResponse) error {
+	utils.InfoLog(ActionImpairContract, utils.MsgProcessStarted)
+
+	items := make(map[string]*model.Value, len(req.Items))
+	for key, item := range req.Items {
+		items[key] = &model.Value{
+			DataType: item.DataType,
+			Value:    model.GetValueFromProto(item),
+		}
+	}
+
+	params := model.ItemUpdateParam{
+		AppID:       req.GetAppId(),
+		ItemID:      req.GetItemId(),
+		DatastoreID: req.GetDatastoreId(),
+		ItemMap:     items,
+		UpdatedAt:   time.Now(),
+		UpdatedBy:   req.GetWriter(),
+		Owners:      req.GetOwners(),
+		Lang:        req.GetLangCd(),
+		Domain:      req.GetDomain(),
+	}
+
+	err := model.ImpairContract(req.GetDatabase(), req.GetWriter(), &params)
+	if err != nil {
+		utils.ErrorLog(ActionImpairContract, err.Error())
+		return err
+	}
+
+	utils.InfoLog(ActionImpairContract, utils.MsgProcessEnded)
+	return nil
+}
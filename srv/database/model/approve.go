@@ -2436,6 +2436,23 @@ func AddApprove(db string, i *ApproveItem) (id string, err error) {
 		return "", err
 	}
 
+	// 记录第一次申请的数据
+	if len(ini.ExampleID) > 0 {
+		rc := client.Database(database.GetDBName(db)).Collection(RevisionCollection)
+		r := &Revision{
+			ExampleID:   ini.ExampleID,
+			Revision:    0,
+			ItemMap:     ini.ItemMap,
+			Current:     ini.Current,
+			SubmittedAt: ini.CreatedAt,
+			SubmittedBy: ini.CreatedBy,
+		}
+		if err = saveRevision(ctx, rc, r); err != nil {
+			utils.ErrorLog("AddApprove", err.Error())
+			return "", err
+		}
+	}
+
 	return ini.ItemID, nil
 }
 
@@ -2443,6 +2460,7 @@ func AddApprove(db string, i *ApproveItem) (id string, err error) {
 func DeleteApproveItems(db string, items []string) error {
 	client := database.New()
 	c := client.Database(database.GetDBName(db)).Collection(ApproveCollection)
+	rc := client.Database(database.GetDBName(db)).Collection(RevisionCollection)
 	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
 	defer cancel()
 
@@ -2470,6 +2488,13 @@ func DeleteApproveItems(db string, items []string) error {
 				utils.ErrorLog("DeleteApproveItems", err.Error())
 				return err
 			}
+
+			// 删除申请历史
+			_, err = rc.DeleteMany(sc, query)
+			if err != nil {
+				utils.ErrorLog("DeleteApproveItems", err.Error())
+				return err
+			}
 		}
 
 		if err = session.CommitTransaction(sc); err != nil {
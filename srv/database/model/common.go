@@ -273,6 +273,35 @@ func getConfig(db, appID string) (cfg *app.Configs, err error) {
 	return response.GetApp().GetConfigs(), nil
 }
 
+// checkMonthLock 变更日期在已关闭的月度内的场合,不能变更
+func checkMonthLock(db, appID string, date *Value) error {
+	if date == nil {
+		return nil
+	}
+	ymd := GetValueFromModel(date)
+	if len(ymd) < 7 {
+		return nil
+	}
+
+	configService := app.NewAppService("manage", client.DefaultClient)
+
+	var req app.FindAppRequest
+	req.AppId = appID
+	req.Database = db
+
+	response, err := configService.FindApp(context.TODO(), &req)
+	if err != nil {
+		return err
+	}
+
+	closedYm := response.GetApp().GetClosedYm()
+	if len(closedYm) > 0 && ymd[:7] <= closedYm {
+		return fmt.Errorf("%sは締め済みの月度(%s以前)のため変更できません。月度を再開してから処理してください", ymd, closedYm)
+	}
+
+	return nil
+}
+
 func getFields(db, datastoreId string) (fields []*Field, err error) {
 	client := database.New()
 	c := client.Database(database.GetDBName(db)).Collection(FieldsCollection)
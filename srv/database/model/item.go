@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"path"
 	"path/filepath"
 	"reflect"
@@ -14,12 +15,14 @@ import (
 
 	"github.com/goinggo/mapstructure"
 	"github.com/google/uuid"
+	"github.com/spf13/cast"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 
+	"rxcsoft.cn/pit3/lib/leasecalc"
 	"rxcsoft.cn/pit3/srv/database/proto/item"
 	"rxcsoft.cn/pit3/srv/database/utils"
 	"rxcsoft.cn/pit3/srv/journal/proto/journal"
@@ -75,6 +78,14 @@ type (
 		DatastoreID string
 		LeaseStymd  string
 		LeaseKikan  string
+		Currency    string
+		Method      string
+	}
+
+	// RishiritsuResult 利子率取得结果(利率曲线和插值过程)
+	RishiritsuResult struct {
+		Curve *leasecalc.Curve
+		Rate  leasecalc.CurveRate
 	}
 
 	// ItemsParam 分页查询多条记录
@@ -185,6 +196,7 @@ type TmpParam struct {
 	Bunruicd      string
 	Segmentcd     string
 	FileMap       map[string][]Field
+	BookOnly      bool // 只登录主账簿以外的账簿数据
 }
 
 // AttachParam 上传附件数据参数
@@ -3000,8 +3012,8 @@ func getItem(db, itemId, datastoreId string, owners []string) (item Item, err er
 	return result, nil
 }
 
-// FindRishiritsu 获取数据信息(利子率)
-func FindRishiritsu(db string, p *RishiritsuParam) (item Item, err error) {
+// FindRishiritsu 获取追加借入利子率(基准年月最近的利率曲线上按租赁期间插值)
+func FindRishiritsu(db string, p *RishiritsuParam) (result RishiritsuResult, err error) {
 
 	client := database.New()
 	c := client.Database(database.GetDBName(db)).Collection(GetItemCollectionName(p.DatastoreID))
@@ -3036,6 +3048,7 @@ func FindRishiritsu(db string, p *RishiritsuParam) (item Item, err error) {
 		utils.ErrorLog("FindRishiritsu", err.Error())
 		return
 	}
+	term := int(math.Ceil(floatLeaseKikan))
 
 	var resultTime Item
 	pipeTime := []bson.M{}
@@ -3050,12 +3063,24 @@ func FindRishiritsu(db string, p *RishiritsuParam) (item Item, err error) {
 		},
 	}
 
-	queryTime := bson.M{
-		"$match": bson.M{
-			"items.baseym.value": bson.M{
-				"$lte": newTime,
-			},
+	// 未指定通货的场合使用功能通货,没有通货的利率视为功能通货
+	functional, err := rishiritsuFunctional(db, p.DatastoreID)
+	if err != nil {
+		utils.ErrorLog("FindRishiritsu", err.Error())
+		return result, err
+	}
+	currency := leasecalc.CurveCurrency(p.Currency, functional)
+	currencyQuery := rishiritsuCurrencyQuery(currency, functional)
+
+	matchTime := bson.M{
+		"items.baseym.value": bson.M{
+			"$lte": newTime,
 		},
+		"items.currency.value": currencyQuery,
+	}
+
+	queryTime := bson.M{
+		"$match": matchTime,
 	}
 
 	sortTime := bson.M{
@@ -3082,7 +3107,7 @@ func FindRishiritsu(db string, p *RishiritsuParam) (item Item, err error) {
 	curTime, err := c.Aggregate(ctx, pipeTime, optTime)
 	if err != nil {
 		utils.ErrorLog("FindRishiritsu", err.Error())
-		return resultTime, err
+		return result, err
 	}
 	defer curTime.Close(ctx)
 
@@ -3091,66 +3116,37 @@ func FindRishiritsu(db string, p *RishiritsuParam) (item Item, err error) {
 		err := curTime.Decode(&item)
 		if err != nil {
 			utils.ErrorLog("FindRishiritsu", err.Error())
-			return resultTime, err
+			return result, err
 		}
 
 		resultTime = item
 	}
 
 	if len(resultTime.ItemID) == 0 {
-		return resultTime, mongo.ErrNoDocuments
-	}
-
-	var result Item
-	pipe := []bson.M{}
-
-	addFields := bson.M{
-		"$addFields": bson.M{
-			"valueDiff": bson.M{
-				"$abs": bson.M{
-					"$subtract": bson.A{"$items.leaseperiod.value", floatLeaseKikan},
-				},
-			},
-		},
+		return result, mongo.ErrNoDocuments
 	}
 
+	// 同一基准年月(和通货)的所有期间点组成利率曲线
 	query := bson.M{
-		"$match": bson.M{
-			"items.baseym.value": resultTime.ItemMap["baseym"].Value,
-			"items.leaseperiod.value": bson.M{
-				"$gte": floatLeaseKikan,
-			},
-		},
-	}
-
-	sort := bson.M{
-		"$sort": bson.M{
-			"valueDiff": 1,
-		},
+		"items.baseym.value":   resultTime.ItemMap["baseym"].Value,
+		"items.currency.value": currencyQuery,
 	}
 
-	limit := bson.M{
-		"$limit": 1,
-	}
-
-	pipe = append(pipe, addFields)
-	pipe = append(pipe, query)
-	pipe = append(pipe, sort)
-	pipe = append(pipe, limit)
-
-	queryJSON, _ := json.Marshal(pipe)
+	queryJSON, _ := json.Marshal(query)
 	utils.DebugLog("FindRishiritsu", fmt.Sprintf("query: [ %s ]", queryJSON))
 
-	opt := options.Aggregate()
-	opt.SetAllowDiskUse(true)
+	opts := options.Find().SetSort(bson.D{
+		{Key: "items.leaseperiod.value", Value: 1},
+	})
 
-	cur, err := c.Aggregate(ctx, pipe, opt)
+	cur, err := c.Find(ctx, query, opts)
 	if err != nil {
 		utils.ErrorLog("FindRishiritsu", err.Error())
 		return result, err
 	}
 	defer cur.Close(ctx)
 
+	var rows []leasecalc.RatePoint
 	for cur.Next(ctx) {
 		var item Item
 		err := cur.Decode(&item)
@@ -3159,16 +3155,108 @@ func FindRishiritsu(db string, p *RishiritsuParam) (item Item, err error) {
 			return result, err
 		}
 
-		result = item
+		period, ok := item.ItemMap["leaseperiod"]
+		if !ok {
+			continue
+		}
+		rate, ok := item.ItemMap["rishiritsu"]
+		if !ok {
+			continue
+		}
+
+		rowCurrency := ""
+		if v, ok := item.ItemMap["currency"]; ok {
+			rowCurrency = cast.ToString(v.Value)
+		}
+
+		rows = append(rows, leasecalc.RatePoint{
+			Currency: rowCurrency,
+			TenorPoint: leasecalc.TenorPoint{
+				Months: cast.ToInt(math.Ceil(cast.ToFloat64(period.Value))),
+				Rate:   cast.ToFloat64(rate.Value),
+				ItemID: item.ItemID,
+			},
+		})
 	}
 
-	if len(result.ItemID) == 0 {
+	if len(rows) == 0 {
 		return result, mongo.ErrNoDocuments
 	}
 
+	baseYm := cast.ToString(GetFuncParam(resultTime.ItemMap["baseym"]))
+	curve, err := leasecalc.NewCurrencyCurve(baseYm, currency, functional, rows)
+	if err != nil {
+		utils.ErrorLog("FindRishiritsu", err.Error())
+		return result, err
+	}
+
+	rate, err := curve.Interpolate(term, leasecalc.ParseInterpolationMethod(p.Method))
+	if err != nil {
+		if err == leasecalc.ErrTenorOutOfRange {
+			// 超过曲线最长期间的场合,与未找到利子率相同处理
+			return result, mongo.ErrNoDocuments
+		}
+		utils.ErrorLog("FindRishiritsu", err.Error())
+		return result, err
+	}
+
+	result.Curve = curve
+	result.Rate = rate
+
 	return result, nil
 }
 
+// rishiritsuFunctional 获取利率台账所属应用的功能通货
+func rishiritsuFunctional(db, datastoreID string) (string, error) {
+	ds, err := getDatastore(db, datastoreID)
+	if err != nil {
+		return "", err
+	}
+	cfg, err := getConfig(db, ds.AppID)
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.GetFunctionalCurrency(), nil
+}
+
+// rishiritsuCurrencyQuery 利率的通货条件(功能通货的场合包含没有通货的利率)
+func rishiritsuCurrencyQuery(currency, functional string) bson.M {
+	values := bson.A{currency}
+	if currency == functional {
+		values = append(values, "", "null", nil)
+	}
+
+	return bson.M{"$in": values}
+}
+
+// ToProto 转换为proto数据(包含利率曲线、期间点和插值方式,用于审计)
+func (r *RishiritsuResult) ToProto() *item.RishiritsuResponse {
+	toPoints := func(ps []leasecalc.TenorPoint) []*item.TenorPoint {
+		var points []*item.TenorPoint
+		for _, p := range ps {
+			points = append(points, &item.TenorPoint{
+				Months: int32(p.Months),
+				Rate:   p.Rate,
+				ItemId: p.ItemID,
+			})
+		}
+		return points
+	}
+
+	return &item.RishiritsuResponse{
+		Rishiritsu: r.Rate.Rate,
+		Curve: &item.IbrCurve{
+			BaseYm:   r.Curve.BaseYm,
+			Currency: r.Curve.Currency,
+			Points:   toPoints(r.Curve.Points),
+		},
+		Method:     string(r.Rate.Method),
+		Term:       int32(r.Rate.Term),
+		UsedPoints: toPoints(r.Rate.UsedPoints),
+	}
+}
+
 // AddCopyItem 复制台账数据
 func AddCopyItem(db, collection string, i *Item) (id string, err error) {
 	client := database.New()
@@ -3473,44 +3561,47 @@ func AddItem(db, collection, lang, domain string, i *Item) (id string, err error
 				return nil, err
 			}
 
-			if leaseTypeVal == "normal_lease" {
-				// 取利息数据登录数据库 paymentInterest
-				err = insertTempData(client, sc, TmpParam{
-					DB:            db,
-					TemplateID:    templateID,
-					APIKey:        "paymentInterest",
-					UserID:        i.CreatedBy,
-					Owners:        i.Owners,
-					Datastores:    dsList,
-					Keiyakuno:     keiyakuItem,
-					Leasekaishacd: kaisyaItem,
-					Bunruicd:      bunruicdItem,
-					Segmentcd:     segmentcdItem,
-					FileMap:       fieldMap,
-				})
-				if err != nil {
-					utils.ErrorLog("AddItem", err.Error())
-					return nil, err
-				}
+			// 主账簿为短期・少額リース的场合,只登录其他账簿的利息和偿还数据
+			bookOnly := leaseTypeVal != "normal_lease"
 
-				// 取偿还数据登录数据库 repayment
-				err = insertTempData(client, sc, TmpParam{
-					DB:            db,
-					TemplateID:    templateID,
-					APIKey:        "repayment",
-					UserID:        i.CreatedBy,
-					Owners:        i.Owners,
-					Datastores:    dsList,
-					Keiyakuno:     keiyakuItem,
-					Leasekaishacd: kaisyaItem,
-					Bunruicd:      bunruicdItem,
-					Segmentcd:     segmentcdItem,
-					FileMap:       fieldMap,
-				})
-				if err != nil {
-					utils.ErrorLog("AddItem", err.Error())
-					return nil, err
-				}
+			// 取利息数据登录数据库 paymentInterest
+			err = insertTempData(client, sc, TmpParam{
+				DB:            db,
+				TemplateID:    templateID,
+				APIKey:        "paymentInterest",
+				UserID:        i.CreatedBy,
+				Owners:        i.Owners,
+				Datastores:    dsList,
+				Keiyakuno:     keiyakuItem,
+				Leasekaishacd: kaisyaItem,
+				Bunruicd:      bunruicdItem,
+				Segmentcd:     segmentcdItem,
+				FileMap:       fieldMap,
+				BookOnly:      bookOnly,
+			})
+			if err != nil {
+				utils.ErrorLog("AddItem", err.Error())
+				return nil, err
+			}
+
+			// 取偿还数据登录数据库 repayment
+			err = insertTempData(client, sc, TmpParam{
+				DB:            db,
+				TemplateID:    templateID,
+				APIKey:        "repayment",
+				UserID:        i.CreatedBy,
+				Owners:        i.Owners,
+				Datastores:    dsList,
+				Keiyakuno:     keiyakuItem,
+				Leasekaishacd: kaisyaItem,
+				Bunruicd:      bunruicdItem,
+				Segmentcd:     segmentcdItem,
+				FileMap:       fieldMap,
+				BookOnly:      bookOnly,
+			})
+			if err != nil {
+				utils.ErrorLog("AddItem", err.Error())
+				return nil, err
 			}
 
 			// 删除临时数据
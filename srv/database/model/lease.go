@@ -17,6 +17,12 @@ import (
 
 // ModifyContract 契约情报变更
 func ModifyContract(db, collection string, p *ItemUpdateParam) (err error) {
+	// 已关闭月度的锁定检查
+	if err := checkMonthLock(db, p.AppID, p.ItemMap["henkouymd"]); err != nil {
+		utils.ErrorLog("ModifyContract", err.Error())
+		return err
+	}
+
 	// 契约台账履历表取得
 	dsrireki, err := FindDatastoreByKey(db, p.AppID, "rireki")
 	if err != nil {
@@ -343,6 +349,11 @@ func ModifyContract(db, collection string, p *ItemUpdateParam) (err error) {
 
 // ChangeDebt 债务变更
 func ChangeDebt(db, collection string, p *ItemUpdateParam) (err error) {
+	// 已关闭月度的锁定检查
+	if err := checkMonthLock(db, p.AppID, p.ItemMap["henkouymd"]); err != nil {
+		utils.ErrorLog("ChangeDebt", err.Error())
+		return err
+	}
 
 	client := database.New()
 	c := client.Database(database.GetDBName(db)).Collection(GetItemCollectionName(p.DatastoreID))
@@ -898,6 +909,12 @@ func ChangeDebt(db, collection string, p *ItemUpdateParam) (err error) {
 
 // TerminateContract 中途解约
 func TerminateContract(db, collection string, p *ItemUpdateParam) (err error) {
+	// 已关闭月度的锁定检查
+	if err := checkMonthLock(db, p.AppID, p.ItemMap["kaiyakuymd"]); err != nil {
+		utils.ErrorLog("TerminateContract", err.Error())
+		return err
+	}
+
 	client := database.New()
 	c := client.Database(database.GetDBName(db)).Collection(GetItemCollectionName(p.DatastoreID))
 	ct := client.Database(database.GetDBName(db)).Collection(genTplCollectionName(collection))
@@ -1143,6 +1160,13 @@ func TerminateContract(db, collection string, p *ItemUpdateParam) (err error) {
 				utils.ErrorLog("TerminateContract", err.Error())
 				return err
 			}
+			// 参数中没有解约年月日的场合,使用临时数据的解约年月日进行锁定检查
+			if _, ok := p.ItemMap["kaiyakuymd"]; !ok {
+				if err := checkMonthLock(db, p.AppID, rirekiTmp.ItemMap["kaiyakuymd"]); err != nil {
+					utils.ErrorLog("TerminateContract", err.Error())
+					return err
+				}
+			}
 			// 剩余的债务
 			newItemMap["remaindebt"] = &Value{
 				DataType: "number",
@@ -1719,6 +1743,274 @@ func ContractExpire(db, collection string, p *ItemUpdateParam) (err error) {
 	return nil
 }
 
+// ImpairContract 使用権資産减损(含减损回转)
+func ImpairContract(db, collection string, p *ItemUpdateParam) (err error) {
+	// 已关闭月度的锁定检查
+	if err := checkMonthLock(db, p.AppID, p.ItemMap["henkouymd"]); err != nil {
+		utils.ErrorLog("ImpairContract", err.Error())
+		return err
+	}
+
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(GetItemCollectionName(p.DatastoreID))
+	ct := client.Database(database.GetDBName(db)).Collection(genTplCollectionName(collection))
+	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
+	defer cancel()
+
+	// 临时数据ID(减损计算结果)
+	templateID := ""
+	if val, exist := p.ItemMap["template_id"]; exist {
+		templateID = val.Value.(string)
+		// 删除临时数据ID
+		delete(p.ItemMap, "template_id")
+	}
+	if len(templateID) == 0 {
+		return errors.New("減損の計算結果が存在しません")
+	}
+
+	// 事务处理开始
+	session, err := client.StartSession()
+	if err != nil {
+		utils.ErrorLog("ImpairContract", err.Error())
+		return err
+	}
+	if err = session.StartTransaction(); err != nil {
+		utils.ErrorLog("ImpairContract", err.Error())
+		return err
+	}
+	if err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+
+		// 获取所有台账
+		dsList, e := FindDatastores(db, p.AppID, "", "", "")
+		if e != nil {
+			if e.Error() != mongo.ErrNoDocuments.Error() {
+				utils.ErrorLog("ImpairContract", e.Error())
+				return e
+			}
+			dsList = []Datastore{}
+		}
+		dsMap := make(map[string]string)
+		for _, d := range dsList {
+			dsMap[d.ApiKey] = d.DatastoreID
+		}
+
+		cr := client.Database(database.GetDBName(db)).Collection(GetItemCollectionName(dsMap["rireki"]))
+		crepay := client.Database(database.GetDBName(db)).Collection(GetItemCollectionName(dsMap["repayment"]))
+
+		// 查找自增字段
+		param := &FindFieldsParam{
+			AppID:       p.AppID,
+			DatastoreID: p.DatastoreID,
+		}
+
+		allFields, err := FindFields(db, param)
+		if err != nil {
+			utils.ErrorLog("ImpairContract", err.Error())
+			if err.Error() != mongo.ErrNoDocuments.Error() {
+				return err
+			}
+		}
+
+		// 减损前契约情报取得
+		oldItem, e := getItem(db, p.ItemID, p.DatastoreID, p.Owners)
+		if e != nil {
+			if e.Error() == mongo.ErrNoDocuments.Error() {
+				return errors.New("データが存在しないか、あなたまたはデータの申請者はデータを変更する権限がありません")
+			}
+			utils.ErrorLog("ImpairContract", e.Error())
+			return e
+		}
+
+		// 减损计算结果(契约履历)取得
+		var rirekiTmp TemplateItem
+		queryTmp := bson.M{
+			"template_id":   templateID,
+			"datastore_key": "rireki",
+		}
+		if err := ct.FindOne(sc, queryTmp).Decode(&rirekiTmp); err != nil {
+			utils.ErrorLog("ImpairContract", err.Error())
+			return err
+		}
+
+		hs := NewHistory(db, p.UpdatedBy, p.DatastoreID, p.Lang, p.Domain, sc, allFields)
+
+		err = hs.Add("1", p.ItemID, oldItem.ItemMap)
+		if err != nil {
+			utils.ErrorLog("ImpairContract", err.Error())
+			return err
+		}
+
+		// 契约变更情报编辑
+		change := bson.M{
+			"updated_at": p.UpdatedAt,
+			"updated_by": p.UpdatedBy,
+		}
+		for key, value := range p.ItemMap {
+			change["items."+key] = value
+		}
+
+		objectID, e := primitive.ObjectIDFromHex(p.ItemID)
+		if e != nil {
+			utils.ErrorLog("ImpairContract", e.Error())
+			return e
+		}
+		query := bson.M{
+			"_id": objectID,
+		}
+		update := bson.M{"$set": change}
+
+		queryJSON, _ := json.Marshal(query)
+		utils.DebugLog("ImpairContract", fmt.Sprintf("query: [ %s ]", queryJSON))
+		updateJSON, _ := json.Marshal(update)
+		utils.DebugLog("ImpairContract", fmt.Sprintf("update: [ %s ]", updateJSON))
+
+		// 更新契约台账情报
+		if _, err := c.UpdateOne(sc, query, update); err != nil {
+			utils.ErrorLog("ImpairContract", err.Error())
+			return err
+		}
+
+		err = hs.Compare("1", p.ItemMap)
+		if err != nil {
+			utils.ErrorLog("ImpairContract", err.Error())
+			return err
+		}
+
+		err = hs.Commit()
+		if err != nil {
+			utils.ErrorLog("ImpairContract", err.Error())
+			return err
+		}
+
+		// 契约履历数据(减损前后)
+		keiyakuItem := oldItem.ItemMap["keiyakuno"].Value.(string)
+		rirekiSeq, err := uuid.NewUUID()
+		if err != nil {
+			utils.ErrorLog("ImpairContract", err.Error())
+			return err
+		}
+		newItemMap := make(map[string]*Value)
+		oldItemMap := make(map[string]*Value)
+		for key, value := range oldItem.ItemMap {
+			oldItemMap[key] = value
+			newItemMap[key] = value
+		}
+		for key, value := range p.ItemMap {
+			newItemMap[key] = value
+		}
+		// 减损损失额、减损前后的账面价值和操作区分(减损或减损回转)
+		for _, key := range []string{"gensongaku", "o_boka", "boka", "unimpaired", "actkbn"} {
+			if value, exist := rirekiTmp.ItemMap[key]; exist {
+				newItemMap[key] = value
+			}
+		}
+		oldItemMap["actkbn"] = newItemMap["actkbn"]
+		for _, m := range []map[string]*Value{newItemMap, oldItemMap} {
+			m["no"] = &Value{
+				DataType: "text",
+				Value:    rirekiSeq.String(),
+			}
+			// 对接区分编辑
+			m["dockkbn"] = &Value{
+				DataType: "options",
+				Value:    "undo",
+			}
+			// 将契约番号变成lookup类型
+			m["keiyakuno"] = &Value{
+				DataType: "lookup",
+				Value:    keiyakuItem,
+			}
+		}
+		// 修正区分编辑
+		newItemMap["zengokbn"] = &Value{
+			DataType: "options",
+			Value:    "after",
+		}
+		oldItemMap["zengokbn"] = &Value{
+			DataType: "options",
+			Value:    "before",
+		}
+
+		for _, itemMap := range []map[string]*Value{newItemMap, oldItemMap} {
+			var rirekiItem Item
+			rirekiItem.ID = primitive.NewObjectID()
+			rirekiItem.ItemID = rirekiItem.ID.Hex()
+			rirekiItem.AppID = p.AppID
+			rirekiItem.DatastoreID = dsMap["rireki"]
+			rirekiItem.CreatedAt = p.UpdatedAt
+			rirekiItem.CreatedBy = p.UpdatedBy
+			rirekiItem.ItemMap = itemMap
+			rirekiItem.Owners = oldItem.Owners
+
+			queryJSON, _ = json.Marshal(rirekiItem)
+			utils.DebugLog("ImpairContract", fmt.Sprintf("rirekiItem: [ %s ]", queryJSON))
+			if _, err := cr.InsertOne(sc, rirekiItem); err != nil {
+				utils.ErrorLog("ImpairContract", err.Error())
+				return err
+			}
+		}
+
+		/* ******************删除主账簿的偿还数据(其他账簿不受减损影响)************* */
+		querydel := bson.M{
+			"items.keiyakuno.value": keiyakuItem,
+			"items.book": bson.M{
+				"$exists": false,
+			},
+		}
+		if _, err := crepay.DeleteMany(sc, querydel); err != nil {
+			utils.ErrorLog("ImpairContract", err.Error())
+			return err
+		}
+		/* ******************登录减损后重新生成的偿还数据************* */
+		err = insertTempData(client, sc, TmpParam{
+			DB:            db,
+			TemplateID:    templateID,
+			APIKey:        "repayment",
+			UserID:        p.UpdatedBy,
+			Owners:        p.Owners,
+			Datastores:    dsList,
+			Keiyakuno:     keiyakuItem,
+			Leasekaishacd: itemValue(oldItem.ItemMap, "leasekaishacd"),
+			Bunruicd:      itemValue(oldItem.ItemMap, "bunruicd"),
+			Segmentcd:     itemValue(oldItem.ItemMap, "segmentcd"),
+		})
+		if err != nil {
+			utils.ErrorLog("ImpairContract", err.Error())
+			return err
+		}
+
+		// 删除临时数据
+		if _, err := ct.DeleteMany(sc, bson.M{"template_id": templateID}); err != nil {
+			utils.ErrorLog("ImpairContract", err.Error())
+			return err
+		}
+
+		if err = session.CommitTransaction(sc); err != nil {
+			session.AbortTransaction(ctx)
+			utils.ErrorLog("ImpairContract", err.Error())
+			return err
+		}
+		return nil
+	}); err != nil {
+		session.AbortTransaction(ctx)
+		utils.ErrorLog("ImpairContract", err.Error())
+		return err
+	}
+	session.EndSession(ctx)
+
+	return nil
+}
+
+// itemValue 取得文字列型的项目值(不存在的场合为空)
+func itemValue(items map[string]*Value, key string) string {
+	if v, ok := items[key]; ok {
+		if s, ok := v.Value.(string); ok {
+			return s
+		}
+	}
+	return ""
+}
+
 func insertTempData(client *mongo.Client, sc mongo.SessionContext, p TmpParam) error {
 
 	c := client.Database(database.GetDBName(p.DB)).Collection(genTplCollectionName(p.UserID))
@@ -1730,6 +2022,11 @@ func insertTempData(client *mongo.Client, sc mongo.SessionContext, p TmpParam) e
 		"template_id":   p.TemplateID,
 		"datastore_key": p.APIKey,
 	}
+	if p.BookOnly {
+		query["items.book"] = bson.M{
+			"$exists": true,
+		}
+	}
 
 	var payData []TemplateItem
 
@@ -0,0 +1,241 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"rxcsoft.cn/pit3/srv/database/proto/approve"
+	"rxcsoft.cn/pit3/srv/database/utils"
+	database "rxcsoft.cn/utils/mongo"
+)
+
+const (
+	// RevisionCollection 审批数据的申请历史集合
+	RevisionCollection = "approve_revisions"
+)
+
+type (
+	// Revision 每次申请时的审批数据(退回修改后再申请时追加)
+	Revision struct {
+		ID          primitive.ObjectID `json:"id" bson:"_id"`
+		ExampleID   string             `json:"example_id" bson:"example_id"`
+		Revision    int64              `json:"revision" bson:"revision"`
+		ItemMap     ItemMap            `json:"items" bson:"items"`
+		Current     ItemMap            `json:"current" bson:"current"`
+		SubmittedAt time.Time          `json:"submitted_at" bson:"submitted_at"`
+		SubmittedBy string             `json:"submitted_by" bson:"submitted_by"`
+		// 修改前的审批数据(再申请的状态迁移失败时恢复用)
+		Before        ItemMap `json:"before" bson:"before"`
+		BeforeCurrent ItemMap `json:"before_current" bson:"before_current"`
+	}
+)
+
+// ToProto 转换为proto数据
+func (r *Revision) ToProto() *approve.Revision {
+	item := ApproveItem{
+		ItemMap: r.ItemMap,
+		Current: r.Current,
+	}
+	p := item.ToProto(true)
+
+	return &approve.Revision{
+		ExampleId:   r.ExampleID,
+		Revision:    r.Revision,
+		Items:       p.GetItems(),
+		Current:     p.GetCurrent(),
+		SubmittedAt: r.SubmittedAt.String(),
+		SubmittedBy: r.SubmittedBy,
+	}
+}
+
+// saveRevision 记录该次申请的审批数据,同一次申请已经记录的场合覆盖
+func saveRevision(ctx context.Context, c *mongo.Collection, r *Revision) error {
+	query := bson.M{
+		"example_id": r.ExampleID,
+		"revision":   r.Revision,
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"items":        r.ItemMap,
+			"current":      r.Current,
+			"submitted_at": r.SubmittedAt,
+			"submitted_by": r.SubmittedBy,
+		},
+		// 同一次申请重复记录的场合,保留最初的修改前数据
+		"$setOnInsert": bson.M{
+			"_id":            primitive.NewObjectID(),
+			"before":         r.Before,
+			"before_current": r.BeforeCurrent,
+		},
+	}
+
+	_, err := c.UpdateOne(ctx, query, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// ReviseApprove 退回后修改审批数据,并记录该次申请的数据
+func ReviseApprove(db string, r *Revision) error {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(ApproveCollection)
+	rc := client.Database(database.GetDBName(db)).Collection(RevisionCollection)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if r.Revision < 1 {
+		return errors.New("再申請の回数が不正です")
+	}
+
+	change := bson.M{}
+	if len(r.ItemMap) > 0 {
+		change["items"] = r.ItemMap
+	}
+	if len(r.Current) > 0 {
+		change["current"] = r.Current
+	}
+
+	session, err := client.StartSession()
+	if err != nil {
+		utils.ErrorLog("ReviseApprove", err.Error())
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	if err = session.StartTransaction(); err != nil {
+		utils.ErrorLog("ReviseApprove", err.Error())
+		return err
+	}
+
+	if err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		query := bson.M{
+			"example_id": r.ExampleID,
+			"deleted_by": "",
+		}
+
+		// 没有修改的场合也记录该次申请的数据
+		var current ApproveItem
+		if err := c.FindOne(sc, query).Decode(&current); err != nil {
+			return err
+		}
+		if len(change) > 0 {
+			updateJSON, _ := json.Marshal(change)
+			utils.DebugLog("ReviseApprove", fmt.Sprintf("update: [ %s ]", updateJSON))
+
+			if _, err := c.UpdateOne(sc, query, bson.M{"$set": change}); err != nil {
+				return err
+			}
+		}
+		r.Before = current.ItemMap
+		r.BeforeCurrent = current.Current
+		if len(r.ItemMap) == 0 {
+			r.ItemMap = current.ItemMap
+		}
+		if len(r.Current) == 0 {
+			r.Current = current.Current
+		}
+
+		if err := saveRevision(sc, rc, r); err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	}); err != nil {
+		session.AbortTransaction(ctx)
+		utils.ErrorLog("ReviseApprove", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// RevertRevision 再申请的状态迁移失败时,恢复修改前的审批数据并删除该次申请的记录
+func RevertRevision(db, exID string, revision int64) error {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(ApproveCollection)
+	rc := client.Database(database.GetDBName(db)).Collection(RevisionCollection)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	session, err := client.StartSession()
+	if err != nil {
+		utils.ErrorLog("RevertRevision", err.Error())
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	if err = session.StartTransaction(); err != nil {
+		utils.ErrorLog("RevertRevision", err.Error())
+		return err
+	}
+
+	if err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		rquery := bson.M{
+			"example_id": exID,
+			"revision":   revision,
+		}
+
+		var r Revision
+		if err := rc.FindOne(sc, rquery).Decode(&r); err != nil {
+			return err
+		}
+
+		query := bson.M{
+			"example_id": exID,
+			"deleted_by": "",
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"items":   r.Before,
+				"current": r.BeforeCurrent,
+			},
+		}
+		if _, err := c.UpdateOne(sc, query, update); err != nil {
+			return err
+		}
+
+		if _, err := rc.DeleteOne(sc, rquery); err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	}); err != nil {
+		session.AbortTransaction(ctx)
+		utils.ErrorLog("RevertRevision", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+// FindRevisions 获取审批数据的申请历史(按申请顺序)
+func FindRevisions(db, exID string) (items []Revision, err error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(RevisionCollection)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	query := bson.M{
+		"example_id": exID,
+	}
+
+	var result []Revision
+	opts := options.Find().SetSort(bson.D{{Key: "revision", Value: 1}})
+	cur, err := c.Find(ctx, query, opts)
+	if err != nil {
+		utils.ErrorLog("FindRevisions", err.Error())
+		return nil, err
+	}
+	if err := cur.All(ctx, &result); err != nil {
+		utils.ErrorLog("FindRevisions", err.Error())
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -47,6 +47,9 @@ type ApproveService interface {
 	FindItem(ctx context.Context, in *ItemRequest, opts ...client.CallOption) (*ItemResponse, error)
 	AddItem(ctx context.Context, in *AddRequest, opts ...client.CallOption) (*AddResponse, error)
 	DeleteItems(ctx context.Context, in *DeleteRequest, opts ...client.CallOption) (*DeleteResponse, error)
+	ReviseItem(ctx context.Context, in *ReviseRequest, opts ...client.CallOption) (*ReviseResponse, error)
+	RevertRevision(ctx context.Context, in *RevertRevisionRequest, opts ...client.CallOption) (*RevertRevisionResponse, error)
+	FindRevisions(ctx context.Context, in *RevisionsRequest, opts ...client.CallOption) (*RevisionsResponse, error)
 }
 
 type approveService struct {
@@ -111,6 +114,36 @@ func (c *approveService) DeleteItems(ctx context.Context, in *DeleteRequest, opt
 	return out, nil
 }
 
+func (c *approveService) ReviseItem(ctx context.Context, in *ReviseRequest, opts ...client.CallOption) (*ReviseResponse, error) {
+	req := c.c.NewRequest(c.name, "ApproveService.ReviseItem", in)
+	out := new(ReviseResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *approveService) RevertRevision(ctx context.Context, in *RevertRevisionRequest, opts ...client.CallOption) (*RevertRevisionResponse, error) {
+	req := c.c.NewRequest(c.name, "ApproveService.RevertRevision", in)
+	out := new(RevertRevisionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *approveService) FindRevisions(ctx context.Context, in *RevisionsRequest, opts ...client.CallOption) (*RevisionsResponse, error) {
+	req := c.c.NewRequest(c.name, "ApproveService.FindRevisions", in)
+	out := new(RevisionsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ApproveService service
 
 type ApproveServiceHandler interface {
@@ -119,6 +152,9 @@ type ApproveServiceHandler interface {
 	FindItem(context.Context, *ItemRequest, *ItemResponse) error
 	AddItem(context.Context, *AddRequest, *AddResponse) error
 	DeleteItems(context.Context, *DeleteRequest, *DeleteResponse) error
+	ReviseItem(context.Context, *ReviseRequest, *ReviseResponse) error
+	RevertRevision(context.Context, *RevertRevisionRequest, *RevertRevisionResponse) error
+	FindRevisions(context.Context, *RevisionsRequest, *RevisionsResponse) error
 }
 
 func RegisterApproveServiceHandler(s server.Server, hdlr ApproveServiceHandler, opts ...server.HandlerOption) error {
@@ -128,6 +164,9 @@ func RegisterApproveServiceHandler(s server.Server, hdlr ApproveServiceHandler,
 		FindItem(ctx context.Context, in *ItemRequest, out *ItemResponse) error
 		AddItem(ctx context.Context, in *AddRequest, out *AddResponse) error
 		DeleteItems(ctx context.Context, in *DeleteRequest, out *DeleteResponse) error
+		ReviseItem(ctx context.Context, in *ReviseRequest, out *ReviseResponse) error
+		RevertRevision(ctx context.Context, in *RevertRevisionRequest, out *RevertRevisionResponse) error
+		FindRevisions(ctx context.Context, in *RevisionsRequest, out *RevisionsResponse) error
 	}
 	type ApproveService struct {
 		approveService
@@ -159,3 +198,15 @@ func (h *approveServiceHandler) AddItem(ctx context.Context, in *AddRequest, out
 func (h *approveServiceHandler) DeleteItems(ctx context.Context, in *DeleteRequest, out *DeleteResponse) error {
 	return h.ApproveServiceHandler.DeleteItems(ctx, in, out)
 }
+
+func (h *approveServiceHandler) ReviseItem(ctx context.Context, in *ReviseRequest, out *ReviseResponse) error {
+	return h.ApproveServiceHandler.ReviseItem(ctx, in, out)
+}
+
+func (h *approveServiceHandler) RevertRevision(ctx context.Context, in *RevertRevisionRequest, out *RevertRevisionResponse) error {
+	return h.ApproveServiceHandler.RevertRevision(ctx, in, out)
+}
+
+func (h *approveServiceHandler) FindRevisions(ctx context.Context, in *RevisionsRequest, out *RevisionsResponse) error {
+	return h.ApproveServiceHandler.FindRevisions(ctx, in, out)
+}
@@ -990,6 +990,403 @@ func (m *ListItems) GetItems() map[string]*Value {
 	return nil
 }
 
+// 退回后修改审批数据（同时记录该次申请的数据）
+type ReviseRequest struct {
+	ExampleId            string            `protobuf:"bytes,1,opt,name=example_id,json=exampleId,proto3" json:"example_id"`
+	AppId                string            `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id"`
+	DatastoreId          string            `protobuf:"bytes,3,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
+	Items                map[string]*Value `protobuf:"bytes,4,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Current              map[string]*Value `protobuf:"bytes,5,rep,name=current,proto3" json:"current" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Revision             int64             `protobuf:"varint,6,opt,name=revision,proto3" json:"revision"`
+	LangCd               string            `protobuf:"bytes,7,opt,name=lang_cd,json=langCd,proto3" json:"lang_cd"`
+	Domain               string            `protobuf:"bytes,8,opt,name=domain,proto3" json:"domain"`
+	Writer               string            `protobuf:"bytes,9,opt,name=writer,proto3" json:"writer"`
+	Database             string            `protobuf:"bytes,10,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ReviseRequest) Reset()         { *m = ReviseRequest{} }
+func (m *ReviseRequest) String() string { return proto.CompactTextString(m) }
+func (*ReviseRequest) ProtoMessage()    {}
+func (*ReviseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b9c0c60c2b7d1b, []int{13}
+}
+
+func (m *ReviseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviseRequest.Unmarshal(m, b)
+}
+func (m *ReviseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviseRequest.Marshal(b, m, deterministic)
+}
+func (m *ReviseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviseRequest.Merge(m, src)
+}
+func (m *ReviseRequest) XXX_Size() int {
+	return xxx_messageInfo_ReviseRequest.Size(m)
+}
+func (m *ReviseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviseRequest proto.InternalMessageInfo
+
+func (m *ReviseRequest) GetExampleId() string {
+	if m != nil {
+		return m.ExampleId
+	}
+	return ""
+}
+
+func (m *ReviseRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *ReviseRequest) GetDatastoreId() string {
+	if m != nil {
+		return m.DatastoreId
+	}
+	return ""
+}
+
+func (m *ReviseRequest) GetItems() map[string]*Value {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ReviseRequest) GetCurrent() map[string]*Value {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *ReviseRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *ReviseRequest) GetLangCd() string {
+	if m != nil {
+		return m.LangCd
+	}
+	return ""
+}
+
+func (m *ReviseRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ReviseRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+func (m *ReviseRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+type ReviseResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReviseResponse) Reset()         { *m = ReviseResponse{} }
+func (m *ReviseResponse) String() string { return proto.CompactTextString(m) }
+func (*ReviseResponse) ProtoMessage()    {}
+func (*ReviseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b9c0c60c2b7d1b, []int{14}
+}
+
+func (m *ReviseResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReviseResponse.Unmarshal(m, b)
+}
+func (m *ReviseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReviseResponse.Marshal(b, m, deterministic)
+}
+func (m *ReviseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReviseResponse.Merge(m, src)
+}
+func (m *ReviseResponse) XXX_Size() int {
+	return xxx_messageInfo_ReviseResponse.Size(m)
+}
+func (m *ReviseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReviseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReviseResponse proto.InternalMessageInfo
+
+// 再申请的状态迁移失败时恢复修改前的审批数据
+type RevertRevisionRequest struct {
+	ExampleId            string   `protobuf:"bytes,1,opt,name=example_id,json=exampleId,proto3" json:"example_id"`
+	Revision             int64    `protobuf:"varint,2,opt,name=revision,proto3" json:"revision"`
+	Database             string   `protobuf:"bytes,3,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevertRevisionRequest) Reset()         { *m = RevertRevisionRequest{} }
+func (m *RevertRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*RevertRevisionRequest) ProtoMessage()    {}
+func (*RevertRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b9c0c60c2b7d1b, []int{15}
+}
+
+func (m *RevertRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevertRevisionRequest.Unmarshal(m, b)
+}
+func (m *RevertRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevertRevisionRequest.Marshal(b, m, deterministic)
+}
+func (m *RevertRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertRevisionRequest.Merge(m, src)
+}
+func (m *RevertRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_RevertRevisionRequest.Size(m)
+}
+func (m *RevertRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertRevisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertRevisionRequest proto.InternalMessageInfo
+
+func (m *RevertRevisionRequest) GetExampleId() string {
+	if m != nil {
+		return m.ExampleId
+	}
+	return ""
+}
+
+func (m *RevertRevisionRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *RevertRevisionRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+type RevertRevisionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevertRevisionResponse) Reset()         { *m = RevertRevisionResponse{} }
+func (m *RevertRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*RevertRevisionResponse) ProtoMessage()    {}
+func (*RevertRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b9c0c60c2b7d1b, []int{16}
+}
+
+func (m *RevertRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevertRevisionResponse.Unmarshal(m, b)
+}
+func (m *RevertRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevertRevisionResponse.Marshal(b, m, deterministic)
+}
+func (m *RevertRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertRevisionResponse.Merge(m, src)
+}
+func (m *RevertRevisionResponse) XXX_Size() int {
+	return xxx_messageInfo_RevertRevisionResponse.Size(m)
+}
+func (m *RevertRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertRevisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertRevisionResponse proto.InternalMessageInfo
+
+// 每次申请时的审批数据
+type Revision struct {
+	ExampleId            string            `protobuf:"bytes,1,opt,name=example_id,json=exampleId,proto3" json:"example_id"`
+	Revision             int64             `protobuf:"varint,2,opt,name=revision,proto3" json:"revision"`
+	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Current              map[string]*Value `protobuf:"bytes,4,rep,name=current,proto3" json:"current" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SubmittedAt          string            `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at"`
+	SubmittedBy          string            `protobuf:"bytes,6,opt,name=submitted_by,json=submittedBy,proto3" json:"submitted_by"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Revision) Reset()         { *m = Revision{} }
+func (m *Revision) String() string { return proto.CompactTextString(m) }
+func (*Revision) ProtoMessage()    {}
+func (*Revision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b9c0c60c2b7d1b, []int{17}
+}
+
+func (m *Revision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Revision.Unmarshal(m, b)
+}
+func (m *Revision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Revision.Marshal(b, m, deterministic)
+}
+func (m *Revision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revision.Merge(m, src)
+}
+func (m *Revision) XXX_Size() int {
+	return xxx_messageInfo_Revision.Size(m)
+}
+func (m *Revision) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revision proto.InternalMessageInfo
+
+func (m *Revision) GetExampleId() string {
+	if m != nil {
+		return m.ExampleId
+	}
+	return ""
+}
+
+func (m *Revision) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Revision) GetItems() map[string]*Value {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *Revision) GetCurrent() map[string]*Value {
+	if m != nil {
+		return m.Current
+	}
+	return nil
+}
+
+func (m *Revision) GetSubmittedAt() string {
+	if m != nil {
+		return m.SubmittedAt
+	}
+	return ""
+}
+
+func (m *Revision) GetSubmittedBy() string {
+	if m != nil {
+		return m.SubmittedBy
+	}
+	return ""
+}
+
+// 查找申请的历史
+type RevisionsRequest struct {
+	ExampleId            string   `protobuf:"bytes,1,opt,name=example_id,json=exampleId,proto3" json:"example_id"`
+	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevisionsRequest) Reset()         { *m = RevisionsRequest{} }
+func (m *RevisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*RevisionsRequest) ProtoMessage()    {}
+func (*RevisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b9c0c60c2b7d1b, []int{18}
+}
+
+func (m *RevisionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevisionsRequest.Unmarshal(m, b)
+}
+func (m *RevisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevisionsRequest.Marshal(b, m, deterministic)
+}
+func (m *RevisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionsRequest.Merge(m, src)
+}
+func (m *RevisionsRequest) XXX_Size() int {
+	return xxx_messageInfo_RevisionsRequest.Size(m)
+}
+func (m *RevisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionsRequest proto.InternalMessageInfo
+
+func (m *RevisionsRequest) GetExampleId() string {
+	if m != nil {
+		return m.ExampleId
+	}
+	return ""
+}
+
+func (m *RevisionsRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+type RevisionsResponse struct {
+	Revisions            []*Revision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RevisionsResponse) Reset()         { *m = RevisionsResponse{} }
+func (m *RevisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*RevisionsResponse) ProtoMessage()    {}
+func (*RevisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_01b9c0c60c2b7d1b, []int{19}
+}
+
+func (m *RevisionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevisionsResponse.Unmarshal(m, b)
+}
+func (m *RevisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevisionsResponse.Marshal(b, m, deterministic)
+}
+func (m *RevisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevisionsResponse.Merge(m, src)
+}
+func (m *RevisionsResponse) XXX_Size() int {
+	return xxx_messageInfo_RevisionsResponse.Size(m)
+}
+func (m *RevisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevisionsResponse proto.InternalMessageInfo
+
+func (m *RevisionsResponse) GetRevisions() []*Revision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
 // 删除数据记录
 type DeleteRequest struct {
 	Items                []string `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
@@ -1003,7 +1400,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_01b9c0c60c2b7d1b, []int{13}
+	return fileDescriptor_01b9c0c60c2b7d1b, []int{20}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1048,7 +1445,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_01b9c0c60c2b7d1b, []int{14}
+	return fileDescriptor_01b9c0c60c2b7d1b, []int{21}
 }
 
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
@@ -1090,6 +1487,17 @@ func init() {
 	proto.RegisterType((*AddResponse)(nil), "approve.AddResponse")
 	proto.RegisterType((*ListItems)(nil), "approve.ListItems")
 	proto.RegisterMapType((map[string]*Value)(nil), "approve.ListItems.ItemsEntry")
+	proto.RegisterType((*ReviseRequest)(nil), "approve.ReviseRequest")
+	proto.RegisterMapType((map[string]*Value)(nil), "approve.ReviseRequest.CurrentEntry")
+	proto.RegisterMapType((map[string]*Value)(nil), "approve.ReviseRequest.ItemsEntry")
+	proto.RegisterType((*ReviseResponse)(nil), "approve.ReviseResponse")
+	proto.RegisterType((*RevertRevisionRequest)(nil), "approve.RevertRevisionRequest")
+	proto.RegisterType((*RevertRevisionResponse)(nil), "approve.RevertRevisionResponse")
+	proto.RegisterType((*Revision)(nil), "approve.Revision")
+	proto.RegisterMapType((map[string]*Value)(nil), "approve.Revision.CurrentEntry")
+	proto.RegisterMapType((map[string]*Value)(nil), "approve.Revision.ItemsEntry")
+	proto.RegisterType((*RevisionsRequest)(nil), "approve.RevisionsRequest")
+	proto.RegisterType((*RevisionsResponse)(nil), "approve.RevisionsResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "approve.DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "approve.DeleteResponse")
 }
@@ -1097,81 +1505,96 @@ func init() {
 func init() { proto.RegisterFile("approve.proto", fileDescriptor_01b9c0c60c2b7d1b) }
 
 var fileDescriptor_01b9c0c60c2b7d1b = []byte{
-	// 1207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x57, 0x4b, 0x73, 0xdb, 0xd4,
-	0x17, 0xaf, 0xfc, 0xd6, 0xf1, 0xa3, 0xed, 0x8d, 0x93, 0xe8, 0xef, 0xfe, 0x43, 0x1d, 0x0d, 0x65,
-	0x3c, 0x5d, 0x64, 0x91, 0x02, 0xd3, 0x06, 0x16, 0xe4, 0x41, 0xa9, 0x18, 0xa6, 0x33, 0x38, 0x0c,
-	0x2c, 0x3d, 0x37, 0xbe, 0x37, 0xa9, 0xa6, 0xb6, 0x24, 0xa4, 0xeb, 0x24, 0xee, 0x47, 0x60, 0xc9,
-	0xb7, 0x61, 0xc9, 0x96, 0x25, 0x9f, 0x84, 0x8f, 0xc0, 0x9c, 0x7b, 0xae, 0x64, 0xc9, 0x51, 0x02,
-	0x33, 0x85, 0x15, 0x9b, 0x8c, 0xce, 0xef, 0x3c, 0xef, 0x79, 0xc6, 0xd0, 0xe5, 0x51, 0x14, 0x87,
-	0x97, 0x72, 0x2f, 0x8a, 0x43, 0x15, 0xb2, 0xa6, 0x21, 0xdd, 0xdf, 0x2c, 0xb0, 0x8f, 0xc3, 0x40,
-	0xf8, 0xca, 0x0f, 0x03, 0xf6, 0x3f, 0x68, 0x9d, 0xfb, 0x72, 0x26, 0x26, 0xbe, 0x70, 0xac, 0xa1,
-	0x35, 0xb2, 0xc7, 0x4d, 0x4d, 0x7b, 0x82, 0xed, 0x00, 0x10, 0x4b, 0x2d, 0x23, 0xe9, 0x54, 0x34,
-	0xd3, 0xd6, 0xc8, 0x77, 0xcb, 0x48, 0xb2, 0x5d, 0xe8, 0x24, 0x92, 0xc7, 0xd3, 0x37, 0x93, 0x4b,
-	0x3e, 0x5b, 0x48, 0xa7, 0xaa, 0x05, 0xda, 0x84, 0x7d, 0x8f, 0x10, 0x1b, 0x40, 0x2b, 0x8c, 0x64,
-	0xcc, 0x55, 0x18, 0x3b, 0x35, 0xcd, 0xce, 0x68, 0xb4, 0xee, 0x27, 0x13, 0xb1, 0x0c, 0xf8, 0xdc,
-	0x9f, 0x3a, 0xf5, 0xa1, 0x35, 0x6a, 0x8d, 0x6d, 0x3f, 0x39, 0x21, 0x80, 0x3d, 0x81, 0xde, 0x34,
-	0x0d, 0x92, 0x02, 0x68, 0x68, 0x03, 0xdd, 0x0c, 0xc5, 0x20, 0xdc, 0x03, 0xa8, 0x93, 0xab, 0x47,
-	0x60, 0x0b, 0xae, 0x38, 0x89, 0xd2, 0x43, 0x5a, 0x08, 0xe8, 0x50, 0xfb, 0x50, 0xa7, 0x18, 0xe9,
-	0x11, 0x44, 0xb8, 0x3f, 0x37, 0xa0, 0x7d, 0x48, 0x49, 0xf1, 0x94, 0x9c, 0xb3, 0x6d, 0x68, 0xfa,
-	0x4a, 0xce, 0x57, 0x99, 0x68, 0x20, 0xe9, 0x09, 0xb6, 0x09, 0x0d, 0x1e, 0x45, 0x88, 0x1b, 0x7d,
-	0x1e, 0x45, 0x9e, 0xc0, 0x04, 0xa0, 0x87, 0x44, 0x85, 0xb1, 0x44, 0xa6, 0x49, 0x40, 0x86, 0x79,
-	0x82, 0x7d, 0x02, 0x75, 0xb4, 0x91, 0x38, 0xb5, 0x61, 0x75, 0xd4, 0xde, 0x7f, 0xbc, 0x97, 0xd6,
-	0x24, 0xe7, 0x77, 0x0f, 0xff, 0x24, 0x5f, 0x06, 0x2a, 0x5e, 0x8e, 0x49, 0x9a, 0x7d, 0x06, 0xcd,
-	0x37, 0x3e, 0xda, 0x58, 0x3a, 0x75, 0xad, 0xb8, 0x5b, 0xaa, 0xf8, 0x8a, 0x64, 0x48, 0x35, 0xd5,
-	0x40, 0xe5, 0xe9, 0x22, 0x8e, 0x65, 0xa0, 0x9c, 0xfb, 0x77, 0x28, 0x1f, 0x93, 0x8c, 0x51, 0x36,
-	0x1a, 0x58, 0x15, 0x79, 0xcd, 0xe7, 0xd1, 0x4c, 0xbf, 0x88, 0x52, 0x6e, 0x1b, 0xc4, 0x13, 0xec,
-	0xff, 0x60, 0xf3, 0x28, 0x9a, 0xf9, 0x53, 0x1e, 0x28, 0xa7, 0x49, 0xdc, 0x0c, 0xc0, 0x72, 0x1b,
-	0x4f, 0xb1, 0xd3, 0xa3, 0x12, 0xa4, 0x34, 0xd6, 0xd3, 0x7c, 0x4f, 0x12, 0xc5, 0xd5, 0x22, 0x71,
-	0x5a, 0x43, 0x6b, 0x54, 0x1d, 0xa7, 0x5d, 0x7a, 0xaa, 0x41, 0xb6, 0x0b, 0xb5, 0x20, 0x14, 0xd2,
-	0xb1, 0x87, 0xd6, 0xa8, 0xbd, 0xdf, 0xcd, 0x22, 0x7f, 0x1d, 0x0a, 0x39, 0xd6, 0x2c, 0x0c, 0x71,
-	0x1a, 0x4b, 0xae, 0xa4, 0x98, 0x70, 0xe5, 0x00, 0x05, 0x61, 0x90, 0x43, 0x95, 0x67, 0x9f, 0x2d,
-	0x9d, 0x76, 0x81, 0x7d, 0xb4, 0x44, 0xb6, 0x90, 0x33, 0x69, 0xb4, 0x3b, 0xc4, 0x36, 0x08, 0x69,
-	0xa7, 0xec, 0xb3, 0xa5, 0xd3, 0x2d, 0xb0, 0x8f, 0x96, 0x83, 0x57, 0x00, 0xab, 0x6a, 0xb1, 0x07,
-	0x50, 0x7d, 0x2b, 0x97, 0xa6, 0x59, 0xf0, 0x93, 0x7d, 0x98, 0x6f, 0xb4, 0xf6, 0x7e, 0x2f, 0x8b,
-	0x5f, 0x37, 0xa9, 0x69, 0xbc, 0x83, 0xca, 0x73, 0x6b, 0xf0, 0x35, 0x74, 0xf2, 0xe5, 0x7b, 0x5f,
-	0x5b, 0xf9, 0x6a, 0xbe, 0x8f, 0x2d, 0xf7, 0x0f, 0x0b, 0x6a, 0x98, 0x6c, 0x9c, 0x06, 0x4c, 0x77,
-	0x6e, 0x1a, 0x90, 0xf4, 0x04, 0x4e, 0x9a, 0x66, 0x04, 0x7c, 0x2e, 0xd3, 0xa9, 0x46, 0xe0, 0x35,
-	0x9f, 0xcb, 0x8c, 0xa9, 0xc7, 0xb0, 0xba, 0x62, 0xea, 0x31, 0x7c, 0x04, 0x76, 0x14, 0xcb, 0xcb,
-	0x89, 0xae, 0x70, 0x9d, 0x98, 0x08, 0x68, 0x7f, 0xa8, 0x29, 0xaf, 0x15, 0x31, 0x1b, 0x46, 0x53,
-	0x5e, 0x2b, 0xcd, 0xc4, 0xbe, 0x4b, 0x12, 0xff, 0x22, 0x90, 0x32, 0x71, 0x9a, 0xc3, 0xaa, 0xee,
-	0xbb, 0x14, 0xc0, 0x1d, 0xc6, 0xa7, 0x8a, 0x7c, 0xb6, 0x68, 0x87, 0xf1, 0xa9, 0xd2, 0x2e, 0x5d,
-	0xe8, 0xea, 0x78, 0x2e, 0xe2, 0x70, 0xa1, 0x27, 0x98, 0x1a, 0xa2, 0x8d, 0xe0, 0x57, 0x88, 0x79,
-	0xc2, 0xfd, 0xbd, 0x02, 0x1d, 0x5d, 0xd5, 0xb1, 0xfc, 0x71, 0x21, 0x13, 0xc5, 0x36, 0xa0, 0x7e,
-	0x75, 0xbe, 0x7a, 0x78, 0xed, 0xea, 0xbc, 0x64, 0xda, 0x2b, 0x37, 0xa7, 0xfd, 0x45, 0x7e, 0x67,
-	0xcd, 0xfc, 0x44, 0x39, 0x55, 0x3d, 0x80, 0x2c, 0x4b, 0x77, 0xb6, 0x77, 0x73, 0x7b, 0xec, 0x1b,
-	0x3f, 0x51, 0x25, 0xeb, 0xae, 0x56, 0xb2, 0xee, 0xd8, 0x63, 0x30, 0xfb, 0x95, 0x64, 0x28, 0x87,
-	0x40, 0x90, 0x16, 0xd8, 0x01, 0x88, 0xf8, 0x85, 0x9c, 0xf8, 0x81, 0x90, 0xd7, 0x3a, 0x8d, 0xd5,
-	0xb1, 0x8d, 0x88, 0x87, 0x80, 0xae, 0x00, 0xb2, 0x13, 0xff, 0x9d, 0xd4, 0xf3, 0x5b, 0x1d, 0xb7,
-	0x10, 0x38, 0xf5, 0xdf, 0xe9, 0x8a, 0x2f, 0x12, 0x19, 0xe3, 0xe3, 0x28, 0x8b, 0x0d, 0x24, 0x3d,
-	0xc1, 0xb6, 0xa0, 0x61, 0x66, 0xd6, 0xd6, 0x2a, 0x86, 0xc2, 0x79, 0xc7, 0xe7, 0x9f, 0xf1, 0x44,
-	0x9a, 0x39, 0xcc, 0x68, 0xf7, 0x5b, 0xe8, 0x9a, 0x9c, 0x26, 0x51, 0x18, 0x24, 0x92, 0x3d, 0x4d,
-	0x57, 0xa1, 0xa5, 0x73, 0xd2, 0x2f, 0x5b, 0x4a, 0xe9, 0xfe, 0xeb, 0x43, 0x5d, 0x85, 0x8a, 0xcf,
-	0x74, 0x92, 0xab, 0x63, 0x22, 0xdc, 0x1f, 0xa0, 0x73, 0x1c, 0x2e, 0x02, 0x75, 0x67, 0x99, 0x56,
-	0xb1, 0x56, 0x6e, 0x8d, 0xb5, 0xba, 0x16, 0xeb, 0x13, 0xe8, 0x1a, 0xc3, 0x26, 0xd6, 0xcc, 0xbf,
-	0x95, 0xf7, 0xff, 0x16, 0xda, 0x3a, 0x48, 0xe3, 0xbe, 0xb8, 0x2a, 0xad, 0xf5, 0x55, 0xf9, 0x37,
-	0xfa, 0xe5, 0xae, 0x98, 0x9e, 0x53, 0x4f, 0x66, 0x21, 0x8d, 0xa0, 0x86, 0xb9, 0xd1, 0x7e, 0x6e,
-	0xcb, 0x9e, 0x96, 0x70, 0x7f, 0x69, 0x02, 0x1c, 0x0a, 0x91, 0x86, 0xf9, 0x2f, 0x5c, 0xb5, 0x8f,
-	0x8b, 0x57, 0xed, 0x83, 0x55, 0x30, 0x99, 0xdb, 0x92, 0xa3, 0x76, 0xb0, 0x7e, 0xd4, 0x86, 0x65,
-	0x7a, 0xe5, 0x37, 0xed, 0x60, 0xfd, 0xa6, 0x95, 0xea, 0x96, 0x9f, 0xb4, 0x6d, 0x68, 0xce, 0x78,
-	0x70, 0x31, 0x99, 0x0a, 0xe7, 0x01, 0x25, 0x00, 0xc9, 0x63, 0xdd, 0x2a, 0x22, 0x9c, 0x73, 0x3f,
-	0x70, 0x1e, 0x12, 0x4e, 0xd4, 0x5f, 0xdd, 0xc0, 0x2d, 0x68, 0x5c, 0xc5, 0xbe, 0x92, 0xb1, 0x39,
-	0x80, 0x86, 0x2a, 0x54, 0xb3, 0x55, 0xac, 0x26, 0x7b, 0x0a, 0x0f, 0x23, 0xbe, 0x9c, 0xcb, 0x40,
-	0x99, 0xeb, 0x87, 0x96, 0x6d, 0x2d, 0x74, 0xdf, 0x30, 0xe8, 0x00, 0x7a, 0x82, 0x1d, 0xc1, 0xc6,
-	0x9a, 0x2c, 0x9a, 0x71, 0x60, 0x6d, 0x95, 0xe0, 0xda, 0xa0, 0x09, 0x7b, 0x58, 0xb0, 0x70, 0xc2,
-	0x15, 0x67, 0x7b, 0x2b, 0x1b, 0x7e, 0xa0, 0x64, 0x2c, 0x13, 0xb5, 0x5a, 0x7e, 0xa9, 0xbc, 0x67,
-	0x38, 0x9e, 0x60, 0x2f, 0x61, 0xf3, 0x86, 0xbc, 0xf6, 0xda, 0xb9, 0xd5, 0xeb, 0xc6, 0x9a, 0x15,
-	0xed, 0x77, 0x17, 0x3a, 0xb1, 0xcc, 0x2c, 0x09, 0x73, 0x40, 0xdb, 0x19, 0x46, 0x4b, 0x72, 0x25,
-	0xa2, 0x7d, 0xf4, 0x6e, 0xf5, 0xd1, 0xcd, 0x24, 0xd1, 0xfa, 0x7f, 0xe0, 0xfa, 0x7e, 0x04, 0x6d,
-	0xdd, 0xcf, 0x66, 0xe8, 0x6f, 0x9b, 0x5d, 0xf7, 0x27, 0x0b, 0xec, 0x2c, 0x4d, 0xec, 0x59, 0x71,
-	0xb5, 0xee, 0xdc, 0xcc, 0xe4, 0xcd, 0x71, 0xfc, 0xe7, 0x92, 0xe9, 0x1e, 0x42, 0xf7, 0x44, 0xff,
-	0x87, 0x94, 0xae, 0x9c, 0x7e, 0x3e, 0x1e, 0x3b, 0x9d, 0xff, 0xfc, 0x7c, 0x54, 0xd6, 0xb6, 0xdd,
-	0x03, 0xe8, 0xa5, 0x26, 0xe8, 0xe9, 0xfb, 0xbf, 0x56, 0xa0, 0x67, 0x76, 0xdb, 0xa9, 0x8c, 0x2f,
-	0xfd, 0xa9, 0x64, 0x9f, 0x83, 0xfd, 0xd2, 0x0f, 0x04, 0xbd, 0x79, 0x33, 0x8b, 0x27, 0x7f, 0xba,
-	0x07, 0x5b, 0xeb, 0x30, 0x99, 0x73, 0xef, 0xa5, 0xda, 0x7a, 0xd1, 0xe7, 0xb4, 0xf3, 0x17, 0x25,
-	0xa7, 0x5d, 0xb8, 0x07, 0xee, 0x3d, 0xf6, 0x02, 0x5a, 0xa9, 0x6f, 0xd6, 0x2f, 0xf8, 0x48, 0x75,
-	0x37, 0xd7, 0xd0, 0x4c, 0xf5, 0x53, 0x68, 0x1e, 0x0a, 0xd2, 0xdc, 0x28, 0xd9, 0x5a, 0x83, 0x7e,
-	0x11, 0xcc, 0xf4, 0xbe, 0x80, 0x36, 0xe5, 0x84, 0x1e, 0xbc, 0x8a, 0xad, 0x90, 0xec, 0xc1, 0xf6,
-	0x0d, 0x3c, 0xb5, 0x70, 0xd6, 0xd0, 0xbf, 0xfc, 0x9e, 0xfd, 0x19, 0x00, 0x00, 0xff, 0xff, 0x2a,
-	0xb5, 0x81, 0x0d, 0x0a, 0x0e, 0x00, 0x00,
+	// 1456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcb, 0x6e, 0xdc, 0xd4,
+	0x1b, 0xff, 0x4f, 0x3c, 0x37, 0x7f, 0x73, 0x69, 0x72, 0x72, 0x73, 0xa7, 0xed, 0xbf, 0x13, 0x43,
+	0x51, 0xd4, 0x45, 0x90, 0x52, 0x2e, 0x6d, 0x00, 0x41, 0x9a, 0x50, 0x6a, 0x04, 0x95, 0x70, 0x10,
+	0x2c, 0x47, 0x27, 0x73, 0x4e, 0x53, 0xab, 0x33, 0xb6, 0xb1, 0xcf, 0xa4, 0x99, 0x3e, 0x02, 0x1b,
+	0x24, 0xde, 0x80, 0xc7, 0xe0, 0x15, 0x58, 0xf2, 0x24, 0xec, 0xd8, 0xa2, 0x73, 0xf5, 0x65, 0x3c,
+	0x21, 0xa2, 0x61, 0xc5, 0x26, 0x9a, 0xf3, 0xfb, 0xae, 0xfe, 0x2e, 0xbf, 0x63, 0x07, 0x7a, 0x38,
+	0x8e, 0x93, 0xe8, 0x9c, 0xee, 0xc5, 0x49, 0xc4, 0x22, 0xd4, 0x52, 0x47, 0xf7, 0xb7, 0x1a, 0xd8,
+	0x47, 0x51, 0x48, 0x02, 0x16, 0x44, 0x21, 0xba, 0x09, 0xed, 0xe7, 0x01, 0x9d, 0x90, 0x51, 0x40,
+	0x9c, 0xda, 0xb0, 0xb6, 0x6b, 0xfb, 0x2d, 0x71, 0xf6, 0x08, 0xba, 0x03, 0x20, 0x45, 0x6c, 0x1e,
+	0x53, 0x67, 0x45, 0x08, 0x6d, 0x81, 0x7c, 0x3b, 0x8f, 0x29, 0xda, 0x81, 0x6e, 0x4a, 0x71, 0x32,
+	0x7e, 0x31, 0x3a, 0xc7, 0x93, 0x19, 0x75, 0x2c, 0xa1, 0xd0, 0x91, 0xd8, 0x77, 0x1c, 0x42, 0x03,
+	0x68, 0x47, 0x31, 0x4d, 0x30, 0x8b, 0x12, 0xa7, 0x2e, 0xc4, 0xe6, 0xcc, 0xbd, 0x07, 0xe9, 0x88,
+	0xcc, 0x43, 0x3c, 0x0d, 0xc6, 0x4e, 0x63, 0x58, 0xdb, 0x6d, 0xfb, 0x76, 0x90, 0x1e, 0x4b, 0x00,
+	0xdd, 0x83, 0xfe, 0x58, 0x27, 0x29, 0x13, 0x68, 0x0a, 0x07, 0x3d, 0x83, 0xf2, 0x24, 0xdc, 0x03,
+	0x68, 0xc8, 0x50, 0xb7, 0xc0, 0x26, 0x98, 0x61, 0xa9, 0x2a, 0x1f, 0xa4, 0xcd, 0x01, 0x91, 0xea,
+	0x06, 0x34, 0x64, 0x8e, 0xf2, 0x21, 0xe4, 0xc1, 0xfd, 0xb9, 0x09, 0x9d, 0x43, 0x59, 0x14, 0x8f,
+	0xd1, 0x29, 0xda, 0x86, 0x56, 0xc0, 0xe8, 0x34, 0xab, 0x44, 0x93, 0x1f, 0x3d, 0x82, 0x36, 0xa1,
+	0x89, 0xe3, 0x98, 0xe3, 0xca, 0x1e, 0xc7, 0xb1, 0x47, 0x78, 0x01, 0x78, 0x84, 0x94, 0x45, 0x09,
+	0xe5, 0x42, 0x55, 0x00, 0x83, 0x79, 0x04, 0xbd, 0x0f, 0x0d, 0xee, 0x23, 0x75, 0xea, 0x43, 0x6b,
+	0xb7, 0xb3, 0x7f, 0x77, 0x4f, 0xf7, 0x24, 0x17, 0x77, 0x8f, 0xff, 0x49, 0x3f, 0x0f, 0x59, 0x32,
+	0xf7, 0xa5, 0x36, 0xfa, 0x08, 0x5a, 0x2f, 0x02, 0xee, 0x63, 0xee, 0x34, 0x84, 0xe1, 0x4e, 0xa5,
+	0xe1, 0x53, 0xa9, 0x23, 0x4d, 0xb5, 0x05, 0x37, 0x1e, 0xcf, 0x92, 0x84, 0x86, 0xcc, 0xb9, 0x71,
+	0x89, 0xf1, 0x91, 0xd4, 0x51, 0xc6, 0xca, 0x82, 0x77, 0x85, 0x5e, 0xe0, 0x69, 0x3c, 0x11, 0x4f,
+	0x24, 0x4b, 0x6e, 0x2b, 0xc4, 0x23, 0xe8, 0x36, 0xd8, 0x38, 0x8e, 0x27, 0xc1, 0x18, 0x87, 0xcc,
+	0x69, 0x49, 0xa9, 0x01, 0x78, 0xbb, 0x55, 0xa4, 0xc4, 0xe9, 0xcb, 0x16, 0xe8, 0x33, 0xef, 0xa7,
+	0xfa, 0x3d, 0x4a, 0x19, 0x66, 0xb3, 0xd4, 0x69, 0x0f, 0x6b, 0xbb, 0x96, 0xaf, 0xa7, 0xf4, 0x44,
+	0x80, 0x68, 0x07, 0xea, 0x61, 0x44, 0xa8, 0x63, 0x0f, 0x6b, 0xbb, 0x9d, 0xfd, 0x9e, 0xc9, 0xfc,
+	0x59, 0x44, 0xa8, 0x2f, 0x44, 0x3c, 0xc5, 0x71, 0x42, 0x31, 0xa3, 0x64, 0x84, 0x99, 0x03, 0x32,
+	0x09, 0x85, 0x1c, 0xb2, 0xbc, 0xf8, 0x74, 0xee, 0x74, 0x0a, 0xe2, 0xc7, 0x73, 0x2e, 0x26, 0x74,
+	0x42, 0x95, 0x75, 0x57, 0x8a, 0x15, 0x22, 0xad, 0xb5, 0xf8, 0x74, 0xee, 0xf4, 0x0a, 0xe2, 0xc7,
+	0xf3, 0xc1, 0x53, 0x80, 0xac, 0x5b, 0x68, 0x15, 0xac, 0x97, 0x74, 0xae, 0x86, 0x85, 0xff, 0x44,
+	0x6f, 0xe7, 0x07, 0xad, 0xb3, 0xdf, 0x37, 0xf9, 0x8b, 0x21, 0x55, 0x83, 0x77, 0xb0, 0xf2, 0xb0,
+	0x36, 0xf8, 0x12, 0xba, 0xf9, 0xf6, 0xbd, 0xa9, 0xaf, 0x7c, 0x37, 0xdf, 0xc4, 0x97, 0xfb, 0x47,
+	0x0d, 0xea, 0xbc, 0xd8, 0x7c, 0x1b, 0x78, 0xb9, 0x73, 0xdb, 0xc0, 0x8f, 0x1e, 0xe1, 0x9b, 0x26,
+	0x04, 0x21, 0x9e, 0x52, 0xbd, 0xd5, 0x1c, 0x78, 0x86, 0xa7, 0xd4, 0x08, 0xc5, 0x1a, 0x5a, 0x99,
+	0x50, 0xac, 0xe1, 0x2d, 0xb0, 0xe3, 0x84, 0x9e, 0x8f, 0x44, 0x87, 0x1b, 0x52, 0xc8, 0x01, 0x11,
+	0x8f, 0x5b, 0xd2, 0x0b, 0x26, 0x85, 0x4d, 0x65, 0x49, 0x2f, 0x98, 0x10, 0xf2, 0xb9, 0x4b, 0xd3,
+	0xe0, 0x2c, 0xa4, 0x34, 0x75, 0x5a, 0x43, 0x4b, 0xcc, 0x9d, 0x06, 0x38, 0x87, 0xe1, 0x31, 0x93,
+	0x31, 0xdb, 0x92, 0xc3, 0xf0, 0x98, 0x89, 0x90, 0x2e, 0xf4, 0x44, 0x3e, 0x67, 0x49, 0x34, 0x13,
+	0x1b, 0x2c, 0x07, 0xa2, 0xc3, 0xc1, 0x2f, 0x38, 0xe6, 0x11, 0xf7, 0xf7, 0x15, 0xe8, 0x8a, 0xae,
+	0xfa, 0xf4, 0x87, 0x19, 0x4d, 0x19, 0x5a, 0x87, 0xc6, 0xab, 0xe7, 0xd9, 0x83, 0xd7, 0x5f, 0x3d,
+	0xaf, 0xd8, 0xf6, 0x95, 0xc5, 0x6d, 0x7f, 0x94, 0xe7, 0xac, 0x49, 0x90, 0x32, 0xc7, 0x12, 0x0b,
+	0x88, 0x4c, 0xb9, 0x0d, 0xef, 0xe6, 0x78, 0xec, 0xab, 0x20, 0x65, 0x15, 0x74, 0x57, 0xaf, 0xa0,
+	0x3b, 0x74, 0x17, 0x14, 0xbf, 0x4a, 0x1d, 0x59, 0x43, 0x90, 0x90, 0x50, 0xb8, 0x03, 0x10, 0xe3,
+	0x33, 0x3a, 0x0a, 0x42, 0x42, 0x2f, 0x44, 0x19, 0x2d, 0xdf, 0xe6, 0x88, 0xc7, 0x01, 0xd1, 0x01,
+	0x2e, 0x4e, 0x83, 0xd7, 0x54, 0xec, 0xaf, 0xe5, 0xb7, 0x39, 0x70, 0x12, 0xbc, 0x16, 0x1d, 0x9f,
+	0xa5, 0x34, 0xe1, 0x0f, 0x27, 0xab, 0xd8, 0xe4, 0x47, 0x8f, 0xa0, 0x2d, 0x68, 0xaa, 0x9d, 0xb5,
+	0x85, 0x89, 0x3a, 0xf1, 0x7d, 0xe7, 0x8f, 0x7f, 0x8a, 0x53, 0xaa, 0xf6, 0xd0, 0x9c, 0xdd, 0x6f,
+	0xa0, 0xa7, 0x6a, 0x9a, 0xc6, 0x51, 0x98, 0x52, 0x74, 0x5f, 0x53, 0x61, 0x4d, 0xd4, 0x64, 0xa3,
+	0x8a, 0x94, 0x34, 0xff, 0x6d, 0x40, 0x83, 0x45, 0x0c, 0x4f, 0x44, 0x91, 0x2d, 0x5f, 0x1e, 0xdc,
+	0xef, 0xa1, 0x7b, 0x14, 0xcd, 0x42, 0x76, 0x69, 0x9b, 0xb2, 0x5c, 0x57, 0x96, 0xe6, 0x6a, 0x95,
+	0x72, 0xbd, 0x07, 0x3d, 0xe5, 0x58, 0xe5, 0x6a, 0xe2, 0xd7, 0xf2, 0xf1, 0x5f, 0x42, 0x47, 0x24,
+	0xa9, 0xc2, 0x17, 0xa9, 0xb2, 0x56, 0xa6, 0xca, 0x2b, 0xcc, 0xcb, 0x65, 0x39, 0x3d, 0x94, 0x33,
+	0x69, 0x52, 0xda, 0x85, 0x3a, 0xaf, 0x8d, 0x88, 0xb3, 0xac, 0x7a, 0x42, 0xc3, 0xfd, 0xb5, 0x05,
+	0x70, 0x48, 0x88, 0x4e, 0xf3, 0x5f, 0xb8, 0xd5, 0xde, 0x2b, 0xde, 0x6a, 0xff, 0xcf, 0x92, 0x31,
+	0x61, 0x2b, 0x2e, 0xb5, 0x83, 0xf2, 0xa5, 0x36, 0xac, 0xb2, 0xab, 0xbe, 0xd3, 0x0e, 0xca, 0x77,
+	0x5a, 0xa5, 0x6d, 0xf5, 0x95, 0xb6, 0x0d, 0xad, 0x09, 0x0e, 0xcf, 0x46, 0x63, 0xe2, 0xac, 0xca,
+	0x02, 0xf0, 0xe3, 0x91, 0x18, 0x15, 0x12, 0x4d, 0x71, 0x10, 0x3a, 0x6b, 0x12, 0x97, 0xa7, 0xbf,
+	0xbb, 0x03, 0xb7, 0xa0, 0xf9, 0x2a, 0x09, 0x18, 0x4d, 0xd4, 0x05, 0xa8, 0x4e, 0x85, 0x6e, 0xb6,
+	0x8b, 0xdd, 0x44, 0xf7, 0x61, 0x2d, 0xc6, 0xf3, 0x29, 0x0d, 0x99, 0xba, 0xfd, 0xb8, 0x67, 0x5b,
+	0x28, 0xdd, 0x50, 0x02, 0x79, 0x01, 0x7a, 0x04, 0x3d, 0x86, 0xf5, 0x92, 0x2e, 0x77, 0xe3, 0x40,
+	0x89, 0x4a, 0x38, 0x6d, 0xc8, 0x0d, 0x5b, 0x2b, 0x78, 0x38, 0xc6, 0x0c, 0xa3, 0xbd, 0xcc, 0x47,
+	0x10, 0x32, 0x9a, 0xd0, 0x94, 0x65, 0xe4, 0xa7, 0xf5, 0x3d, 0x25, 0xf1, 0x08, 0x7a, 0x02, 0x9b,
+	0x0b, 0xfa, 0x22, 0x6a, 0x77, 0x69, 0xd4, 0xf5, 0x92, 0x17, 0x11, 0x77, 0x07, 0xba, 0x09, 0x35,
+	0x9e, 0x88, 0xba, 0x40, 0x3b, 0x06, 0x93, 0x24, 0x99, 0xa9, 0x88, 0x18, 0xfd, 0xa5, 0x31, 0x7a,
+	0x46, 0x93, 0x7b, 0xff, 0x0f, 0xdc, 0xbe, 0xef, 0x40, 0x47, 0xcc, 0xb3, 0x5a, 0xfa, 0x65, 0xbb,
+	0xeb, 0xfe, 0x58, 0x03, 0xdb, 0x94, 0x09, 0x3d, 0x28, 0x52, 0xeb, 0x9d, 0xc5, 0x4a, 0x2e, 0xae,
+	0xe3, 0xf5, 0x15, 0xd3, 0xfd, 0xd3, 0x82, 0x9e, 0x4f, 0xcf, 0x83, 0x94, 0x5e, 0x91, 0x1a, 0xff,
+	0x39, 0xf3, 0x7c, 0x58, 0x64, 0x9e, 0xec, 0xcd, 0xb6, 0x10, 0xbf, 0x82, 0x7c, 0x3e, 0xc9, 0x08,
+	0x44, 0x92, 0xcf, 0x5b, 0x4b, 0x4c, 0xab, 0x39, 0x64, 0x00, 0xed, 0x84, 0xab, 0x05, 0x51, 0xa8,
+	0x2e, 0x55, 0x73, 0xce, 0xf3, 0x4b, 0x6b, 0x09, 0xbf, 0xb4, 0x0b, 0xfc, 0x92, 0x11, 0x88, 0xbd,
+	0x94, 0x40, 0x4a, 0xd7, 0xe9, 0xf5, 0x8e, 0xfe, 0xb5, 0x8d, 0xeb, 0x2a, 0xf4, 0x75, 0xf5, 0xe4,
+	0xc4, 0xba, 0x21, 0x6c, 0xfa, 0xf4, 0x9c, 0x26, 0xcc, 0x57, 0xe5, 0xb9, 0xe2, 0x48, 0xe4, 0x0b,
+	0xbc, 0x52, 0x2a, 0xf0, 0x65, 0xd7, 0xa4, 0x03, 0x5b, 0xe5, 0x78, 0x2a, 0x93, 0x9f, 0x2c, 0x68,
+	0x6b, 0xf0, 0x4d, 0xa2, 0xef, 0xeb, 0x91, 0x93, 0xef, 0x72, 0xb7, 0x8b, 0x73, 0x13, 0x44, 0x61,
+	0xc5, 0xb4, 0x3d, 0xcc, 0xa6, 0xad, 0x7c, 0x45, 0x1a, 0xab, 0xea, 0x41, 0xe3, 0x1f, 0xd5, 0xb3,
+	0xd3, 0x69, 0xc0, 0xd4, 0x07, 0x4a, 0x43, 0x7d, 0x54, 0x6b, 0xec, 0xb0, 0xa4, 0x72, 0x3a, 0x77,
+	0x9a, 0x25, 0x95, 0xeb, 0xfe, 0x4c, 0xb9, 0xb6, 0x69, 0xf9, 0x1a, 0x56, 0xf5, 0xd3, 0xa7, 0x57,
+	0x1f, 0x0b, 0xd3, 0xfa, 0x95, 0x52, 0xeb, 0x8f, 0x61, 0x2d, 0xe7, 0x4e, 0x31, 0xe6, 0xbb, 0x60,
+	0xeb, 0xce, 0x69, 0x3a, 0x5c, 0x5b, 0xa8, 0xbd, 0x9f, 0xe9, 0xb8, 0x87, 0xd0, 0x3b, 0x16, 0x9f,
+	0x77, 0x3a, 0xa3, 0x8d, 0x3c, 0x99, 0xda, 0xba, 0xa3, 0x97, 0x25, 0xb2, 0x0a, 0x7d, 0xed, 0x42,
+	0x66, 0xb1, 0xff, 0x4b, 0x1d, 0xfa, 0xea, 0xc5, 0xec, 0x84, 0x26, 0xe7, 0xc1, 0x98, 0xa2, 0x8f,
+	0xc1, 0x7e, 0x12, 0x84, 0x44, 0x12, 0xf6, 0xa6, 0x49, 0x29, 0xff, 0xdd, 0x31, 0xd8, 0x2a, 0xc3,
+	0x6a, 0x94, 0xff, 0xa7, 0xad, 0xc5, 0x5b, 0x6a, 0xce, 0x3a, 0xff, 0x3a, 0x3c, 0xd8, 0x2a, 0xc3,
+	0xc6, 0xfa, 0x11, 0xb4, 0x75, 0x6c, 0xb4, 0x51, 0x88, 0xa1, 0x6d, 0x37, 0x4b, 0xa8, 0x31, 0xfd,
+	0x00, 0x5a, 0x87, 0x44, 0x5a, 0xae, 0x57, 0xbc, 0x72, 0x0d, 0x36, 0x8a, 0xa0, 0xb1, 0xfb, 0x0c,
+	0x3a, 0xb2, 0x26, 0xf2, 0x81, 0xb3, 0xdc, 0x0a, 0xc5, 0x1e, 0x6c, 0x2f, 0xe0, 0xc6, 0xc3, 0xa7,
+	0x00, 0x92, 0x5b, 0x44, 0xf0, 0xad, 0x6a, 0xba, 0x1e, 0x6c, 0x2f, 0xe0, 0xc6, 0xc1, 0x09, 0xf4,
+	0x8b, 0xd4, 0x80, 0x0a, 0x5b, 0xb8, 0xc8, 0x51, 0x83, 0xbb, 0x4b, 0xe5, 0xc6, 0xe9, 0x53, 0xe8,
+	0xf1, 0x52, 0x6a, 0x49, 0x8a, 0x6e, 0x2e, 0x4c, 0x97, 0x69, 0xe7, 0xa0, 0x4a, 0xa4, 0x3d, 0x9d,
+	0x36, 0xc5, 0xbf, 0xe5, 0x1e, 0xfc, 0x35, 0x00, 0x3c, 0xe6, 0xb0, 0x32, 0xa7, 0x13, 0x00, 0x00,
 }
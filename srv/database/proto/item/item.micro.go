@@ -67,6 +67,7 @@ type ItemService interface {
 	ChangeLabelTime(ctx context.Context, in *LabelTimeRequest, opts ...client.CallOption) (*LabelTimeResponse, error)
 	ChangeDebt(ctx context.Context, in *ChangeDebtRequest, opts ...client.CallOption) (*ChangeDebtResponse, error)
 	ContractExpire(ctx context.Context, in *ContractExpireRequest, opts ...client.CallOption) (*ContractExpireResponse, error)
+	ImpairContract(ctx context.Context, in *ImpairContractRequest, opts ...client.CallOption) (*ImpairContractResponse, error)
 	ModifyContract(ctx context.Context, in *ModifyContractRequest, opts ...client.CallOption) (*ModifyContractResponse, error)
 	TerminateContract(ctx context.Context, in *TerminateContractRequest, opts ...client.CallOption) (*TerminateContractResponse, error)
 	// double stream
@@ -380,6 +381,16 @@ func (c *itemService) ContractExpire(ctx context.Context, in *ContractExpireRequ
 	return out, nil
 }
 
+func (c *itemService) ImpairContract(ctx context.Context, in *ImpairContractRequest, opts ...client.CallOption) (*ImpairContractResponse, error) {
+	req := c.c.NewRequest(c.name, "ItemService.ImpairContract", in)
+	out := new(ImpairContractResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *itemService) ModifyContract(ctx context.Context, in *ModifyContractRequest, opts ...client.CallOption) (*ModifyContractResponse, error) {
 	req := c.c.NewRequest(c.name, "ItemService.ModifyContract", in)
 	out := new(ModifyContractResponse)
@@ -692,6 +703,7 @@ type ItemServiceHandler interface {
 	ChangeLabelTime(context.Context, *LabelTimeRequest, *LabelTimeResponse) error
 	ChangeDebt(context.Context, *ChangeDebtRequest, *ChangeDebtResponse) error
 	ContractExpire(context.Context, *ContractExpireRequest, *ContractExpireResponse) error
+	ImpairContract(context.Context, *ImpairContractRequest, *ImpairContractResponse) error
 	ModifyContract(context.Context, *ModifyContractRequest, *ModifyContractResponse) error
 	TerminateContract(context.Context, *TerminateContractRequest, *TerminateContractResponse) error
 	// double stream
@@ -731,6 +743,7 @@ func RegisterItemServiceHandler(s server.Server, hdlr ItemServiceHandler, opts .
 		ChangeLabelTime(ctx context.Context, in *LabelTimeRequest, out *LabelTimeResponse) error
 		ChangeDebt(ctx context.Context, in *ChangeDebtRequest, out *ChangeDebtResponse) error
 		ContractExpire(ctx context.Context, in *ContractExpireRequest, out *ContractExpireResponse) error
+		ImpairContract(ctx context.Context, in *ImpairContractRequest, out *ImpairContractResponse) error
 		ModifyContract(ctx context.Context, in *ModifyContractRequest, out *ModifyContractResponse) error
 		TerminateContract(ctx context.Context, in *TerminateContractRequest, out *TerminateContractResponse) error
 		ImportItem(ctx context.Context, stream server.Stream) error
@@ -887,6 +900,10 @@ func (h *itemServiceHandler) ContractExpire(ctx context.Context, in *ContractExp
 	return h.ItemServiceHandler.ContractExpire(ctx, in, out)
 }
 
+func (h *itemServiceHandler) ImpairContract(ctx context.Context, in *ImpairContractRequest, out *ImpairContractResponse) error {
+	return h.ItemServiceHandler.ImpairContract(ctx, in, out)
+}
+
 func (h *itemServiceHandler) ModifyContract(ctx context.Context, in *ModifyContractRequest, out *ModifyContractResponse) error {
 	return h.ItemServiceHandler.ModifyContract(ctx, in, out)
 }
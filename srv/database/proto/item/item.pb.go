@@ -202,7 +202,7 @@ type Item struct {
 	ItemId               string            `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id"`
 	AppId                string            `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id"`
 	DatastoreId          string            `protobuf:"bytes,3,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
-	Items                map[string]*Value `protobuf:"bytes,4,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Items                map[string]*Value `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Owners               []string          `protobuf:"bytes,6,rep,name=owners,proto3" json:"owners"`
 	CheckType            string            `protobuf:"bytes,15,opt,name=check_type,json=checkType,proto3" json:"check_type"`
 	CheckStatus          string            `protobuf:"bytes,18,opt,name=check_status,json=checkStatus,proto3" json:"check_status"`
@@ -1212,6 +1212,8 @@ type RishiritsuRequest struct {
 	Leasestymd           string   `protobuf:"bytes,2,opt,name=leasestymd,proto3" json:"leasestymd"`
 	Leasekikan           string   `protobuf:"bytes,3,opt,name=leasekikan,proto3" json:"leasekikan"`
 	Database             string   `protobuf:"bytes,4,opt,name=database,proto3" json:"database"`
+	Currency             string   `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency"`
+	Method               string   `protobuf:"bytes,6,opt,name=method,proto3" json:"method"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1270,6 +1272,20 @@ func (m *RishiritsuRequest) GetDatabase() string {
 	return ""
 }
 
+func (m *RishiritsuRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *RishiritsuRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
 type ItemResponse struct {
 	Item                 *Item    `protobuf:"bytes,1,opt,name=item,proto3" json:"item"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1310,10 +1326,15 @@ func (m *ItemResponse) GetItem() *Item {
 }
 
 type RishiritsuResponse struct {
-	Item                 *Item    `protobuf:"bytes,1,opt,name=item,proto3" json:"item"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Item                 *Item         `protobuf:"bytes,1,opt,name=item,proto3" json:"item"`
+	Rishiritsu           float64       `protobuf:"fixed64,2,opt,name=rishiritsu,proto3" json:"rishiritsu"`
+	Curve                *IbrCurve     `protobuf:"bytes,3,opt,name=curve,proto3" json:"curve"`
+	Method               string        `protobuf:"bytes,4,opt,name=method,proto3" json:"method"`
+	Term                 int32         `protobuf:"varint,5,opt,name=term,proto3" json:"term"`
+	UsedPoints           []*TenorPoint `protobuf:"bytes,6,rep,name=used_points,json=usedPoints,proto3" json:"used_points"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *RishiritsuResponse) Reset()         { *m = RishiritsuResponse{} }
@@ -1348,11 +1369,158 @@ func (m *RishiritsuResponse) GetItem() *Item {
 	return nil
 }
 
+func (m *RishiritsuResponse) GetRishiritsu() float64 {
+	if m != nil {
+		return m.Rishiritsu
+	}
+	return 0
+}
+
+func (m *RishiritsuResponse) GetCurve() *IbrCurve {
+	if m != nil {
+		return m.Curve
+	}
+	return nil
+}
+
+func (m *RishiritsuResponse) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *RishiritsuResponse) GetTerm() int32 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *RishiritsuResponse) GetUsedPoints() []*TenorPoint {
+	if m != nil {
+		return m.UsedPoints
+	}
+	return nil
+}
+
+// 追加借入利子率曲线(每个基准年月和通货一条)
+type IbrCurve struct {
+	BaseYm               string        `protobuf:"bytes,1,opt,name=base_ym,json=baseYm,proto3" json:"base_ym"`
+	Currency             string        `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency"`
+	Points               []*TenorPoint `protobuf:"bytes,3,rep,name=points,proto3" json:"points"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *IbrCurve) Reset()         { *m = IbrCurve{} }
+func (m *IbrCurve) String() string { return proto.CompactTextString(m) }
+func (*IbrCurve) ProtoMessage()    {}
+func (*IbrCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6007f868cf6553df, []int{20}
+}
+
+func (m *IbrCurve) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IbrCurve.Unmarshal(m, b)
+}
+func (m *IbrCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IbrCurve.Marshal(b, m, deterministic)
+}
+func (m *IbrCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbrCurve.Merge(m, src)
+}
+func (m *IbrCurve) XXX_Size() int {
+	return xxx_messageInfo_IbrCurve.Size(m)
+}
+func (m *IbrCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbrCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbrCurve proto.InternalMessageInfo
+
+func (m *IbrCurve) GetBaseYm() string {
+	if m != nil {
+		return m.BaseYm
+	}
+	return ""
+}
+
+func (m *IbrCurve) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *IbrCurve) GetPoints() []*TenorPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// 利率曲线的期间点
+type TenorPoint struct {
+	Months               int32    `protobuf:"varint,1,opt,name=months,proto3" json:"months"`
+	Rate                 float64  `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate"`
+	ItemId               string   `protobuf:"bytes,3,opt,name=item_id,json=itemId,proto3" json:"item_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TenorPoint) Reset()         { *m = TenorPoint{} }
+func (m *TenorPoint) String() string { return proto.CompactTextString(m) }
+func (*TenorPoint) ProtoMessage()    {}
+func (*TenorPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6007f868cf6553df, []int{21}
+}
+
+func (m *TenorPoint) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TenorPoint.Unmarshal(m, b)
+}
+func (m *TenorPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TenorPoint.Marshal(b, m, deterministic)
+}
+func (m *TenorPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TenorPoint.Merge(m, src)
+}
+func (m *TenorPoint) XXX_Size() int {
+	return xxx_messageInfo_TenorPoint.Size(m)
+}
+func (m *TenorPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_TenorPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TenorPoint proto.InternalMessageInfo
+
+func (m *TenorPoint) GetMonths() int32 {
+	if m != nil {
+		return m.Months
+	}
+	return 0
+}
+
+func (m *TenorPoint) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *TenorPoint) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
 // 添加数据
 type AddRequest struct {
 	AppId                string            `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id"`
 	DatastoreId          string            `protobuf:"bytes,2,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
-	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Owners               []string          `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners"`
 	Writer               string            `protobuf:"bytes,5,opt,name=writer,proto3" json:"writer"`
 	Database             string            `protobuf:"bytes,6,opt,name=database,proto3" json:"database"`
@@ -1367,7 +1535,7 @@ func (m *AddRequest) Reset()         { *m = AddRequest{} }
 func (m *AddRequest) String() string { return proto.CompactTextString(m) }
 func (*AddRequest) ProtoMessage()    {}
 func (*AddRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{20}
+	return fileDescriptor_6007f868cf6553df, []int{22}
 }
 
 func (m *AddRequest) XXX_Unmarshal(b []byte) error {
@@ -1455,7 +1623,7 @@ func (m *AddResponse) Reset()         { *m = AddResponse{} }
 func (m *AddResponse) String() string { return proto.CompactTextString(m) }
 func (*AddResponse) ProtoMessage()    {}
 func (*AddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{21}
+	return fileDescriptor_6007f868cf6553df, []int{23}
 }
 
 func (m *AddResponse) XXX_Unmarshal(b []byte) error {
@@ -1484,7 +1652,7 @@ func (m *AddResponse) GetItemId() string {
 }
 
 type ListItems struct {
-	Items                map[string]*Value `protobuf:"bytes,1,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Items                map[string]*Value `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1494,7 +1662,7 @@ func (m *ListItems) Reset()         { *m = ListItems{} }
 func (m *ListItems) String() string { return proto.CompactTextString(m) }
 func (*ListItems) ProtoMessage()    {}
 func (*ListItems) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{22}
+	return fileDescriptor_6007f868cf6553df, []int{24}
 }
 
 func (m *ListItems) XXX_Unmarshal(b []byte) error {
@@ -1524,7 +1692,7 @@ func (m *ListItems) GetItems() map[string]*Value {
 
 // 附加数据
 type AttachItems struct {
-	Items                map[string]*Value `protobuf:"bytes,1,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Items                map[string]*Value `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	DatastoreId          string            `protobuf:"bytes,2,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -1535,7 +1703,7 @@ func (m *AttachItems) Reset()         { *m = AttachItems{} }
 func (m *AttachItems) String() string { return proto.CompactTextString(m) }
 func (*AttachItems) ProtoMessage()    {}
 func (*AttachItems) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{23}
+	return fileDescriptor_6007f868cf6553df, []int{25}
 }
 
 func (m *AttachItems) XXX_Unmarshal(b []byte) error {
@@ -1571,8 +1739,8 @@ func (m *AttachItems) GetDatastoreId() string {
 }
 
 type ChangeData struct {
-	Query                map[string]*Value `protobuf:"bytes,1,rep,name=query,proto3" json:"query" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Change               map[string]*Value `protobuf:"bytes,2,rep,name=change,proto3" json:"change" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Query                map[string]*Value `protobuf:"bytes,1,rep,name=query,proto3" json:"query,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Change               map[string]*Value `protobuf:"bytes,2,rep,name=change,proto3" json:"change,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Index                int64             `protobuf:"varint,3,opt,name=index,proto3" json:"index"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -1583,7 +1751,7 @@ func (m *ChangeData) Reset()         { *m = ChangeData{} }
 func (m *ChangeData) String() string { return proto.CompactTextString(m) }
 func (*ChangeData) ProtoMessage()    {}
 func (*ChangeData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{24}
+	return fileDescriptor_6007f868cf6553df, []int{26}
 }
 
 func (m *ChangeData) XXX_Unmarshal(b []byte) error {
@@ -1645,7 +1813,7 @@ func (m *MappingMetaData) Reset()         { *m = MappingMetaData{} }
 func (m *MappingMetaData) String() string { return proto.CompactTextString(m) }
 func (*MappingMetaData) ProtoMessage()    {}
 func (*MappingMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{25}
+	return fileDescriptor_6007f868cf6553df, []int{27}
 }
 
 func (m *MappingMetaData) XXX_Unmarshal(b []byte) error {
@@ -1751,7 +1919,7 @@ func (m *MappingUploadRequest) Reset()         { *m = MappingUploadRequest{} }
 func (m *MappingUploadRequest) String() string { return proto.CompactTextString(m) }
 func (*MappingUploadRequest) ProtoMessage()    {}
 func (*MappingUploadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{26}
+	return fileDescriptor_6007f868cf6553df, []int{28}
 }
 
 func (m *MappingUploadRequest) XXX_Unmarshal(b []byte) error {
@@ -1836,7 +2004,7 @@ func (m *MappingUploadResponse) Reset()         { *m = MappingUploadResponse{} }
 func (m *MappingUploadResponse) String() string { return proto.CompactTextString(m) }
 func (*MappingUploadResponse) ProtoMessage()    {}
 func (*MappingUploadResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{27}
+	return fileDescriptor_6007f868cf6553df, []int{29}
 }
 
 func (m *MappingUploadResponse) XXX_Unmarshal(b []byte) error {
@@ -1890,7 +2058,7 @@ func (m *ImportMetaData) Reset()         { *m = ImportMetaData{} }
 func (m *ImportMetaData) String() string { return proto.CompactTextString(m) }
 func (*ImportMetaData) ProtoMessage()    {}
 func (*ImportMetaData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{28}
+	return fileDescriptor_6007f868cf6553df, []int{30}
 }
 
 func (m *ImportMetaData) XXX_Unmarshal(b []byte) error {
@@ -1986,7 +2154,7 @@ func (m *ImportData) Reset()         { *m = ImportData{} }
 func (m *ImportData) String() string { return proto.CompactTextString(m) }
 func (*ImportData) ProtoMessage()    {}
 func (*ImportData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{29}
+	return fileDescriptor_6007f868cf6553df, []int{31}
 }
 
 func (m *ImportData) XXX_Unmarshal(b []byte) error {
@@ -2036,7 +2204,7 @@ func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
 func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportRequest) ProtoMessage()    {}
 func (*ImportRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{30}
+	return fileDescriptor_6007f868cf6553df, []int{32}
 }
 
 func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
@@ -2121,7 +2289,7 @@ func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
 func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportResponse) ProtoMessage()    {}
 func (*ImportResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{31}
+	return fileDescriptor_6007f868cf6553df, []int{33}
 }
 
 func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
@@ -2171,7 +2339,7 @@ func (m *ImportCheckRequest) Reset()         { *m = ImportCheckRequest{} }
 func (m *ImportCheckRequest) String() string { return proto.CompactTextString(m) }
 func (*ImportCheckRequest) ProtoMessage()    {}
 func (*ImportCheckRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{32}
+	return fileDescriptor_6007f868cf6553df, []int{34}
 }
 
 func (m *ImportCheckRequest) XXX_Unmarshal(b []byte) error {
@@ -2256,7 +2424,7 @@ func (m *ImportCheckResponse) Reset()         { *m = ImportCheckResponse{} }
 func (m *ImportCheckResponse) String() string { return proto.CompactTextString(m) }
 func (*ImportCheckResponse) ProtoMessage()    {}
 func (*ImportCheckResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{33}
+	return fileDescriptor_6007f868cf6553df, []int{35}
 }
 
 func (m *ImportCheckResponse) XXX_Unmarshal(b []byte) error {
@@ -2308,7 +2476,7 @@ func (m *Error) Reset()         { *m = Error{} }
 func (m *Error) String() string { return proto.CompactTextString(m) }
 func (*Error) ProtoMessage()    {}
 func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{34}
+	return fileDescriptor_6007f868cf6553df, []int{36}
 }
 
 func (m *Error) XXX_Unmarshal(b []byte) error {
@@ -2385,7 +2553,7 @@ func (m *ImportResult) Reset()         { *m = ImportResult{} }
 func (m *ImportResult) String() string { return proto.CompactTextString(m) }
 func (*ImportResult) ProtoMessage()    {}
 func (*ImportResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{35}
+	return fileDescriptor_6007f868cf6553df, []int{37}
 }
 
 func (m *ImportResult) XXX_Unmarshal(b []byte) error {
@@ -2445,7 +2613,7 @@ func (m *InventoryItemRequest) Reset()         { *m = InventoryItemRequest{} }
 func (m *InventoryItemRequest) String() string { return proto.CompactTextString(m) }
 func (*InventoryItemRequest) ProtoMessage()    {}
 func (*InventoryItemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{36}
+	return fileDescriptor_6007f868cf6553df, []int{38}
 }
 
 func (m *InventoryItemRequest) XXX_Unmarshal(b []byte) error {
@@ -2532,7 +2700,7 @@ func (m *InventoryItemResponse) Reset()         { *m = InventoryItemResponse{} }
 func (m *InventoryItemResponse) String() string { return proto.CompactTextString(m) }
 func (*InventoryItemResponse) ProtoMessage()    {}
 func (*InventoryItemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{37}
+	return fileDescriptor_6007f868cf6553df, []int{39}
 }
 
 func (m *InventoryItemResponse) XXX_Unmarshal(b []byte) error {
@@ -2566,7 +2734,7 @@ func (m *ResetInventoryItemsRequest) Reset()         { *m = ResetInventoryItemsR
 func (m *ResetInventoryItemsRequest) String() string { return proto.CompactTextString(m) }
 func (*ResetInventoryItemsRequest) ProtoMessage()    {}
 func (*ResetInventoryItemsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{38}
+	return fileDescriptor_6007f868cf6553df, []int{40}
 }
 
 func (m *ResetInventoryItemsRequest) XXX_Unmarshal(b []byte) error {
@@ -2618,7 +2786,7 @@ func (m *ResetInventoryItemsResponse) Reset()         { *m = ResetInventoryItems
 func (m *ResetInventoryItemsResponse) String() string { return proto.CompactTextString(m) }
 func (*ResetInventoryItemsResponse) ProtoMessage()    {}
 func (*ResetInventoryItemsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{39}
+	return fileDescriptor_6007f868cf6553df, []int{41}
 }
 
 func (m *ResetInventoryItemsResponse) XXX_Unmarshal(b []byte) error {
@@ -2655,7 +2823,7 @@ func (m *MutilInventoryItemRequest) Reset()         { *m = MutilInventoryItemReq
 func (m *MutilInventoryItemRequest) String() string { return proto.CompactTextString(m) }
 func (*MutilInventoryItemRequest) ProtoMessage()    {}
 func (*MutilInventoryItemRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{40}
+	return fileDescriptor_6007f868cf6553df, []int{42}
 }
 
 func (m *MutilInventoryItemRequest) XXX_Unmarshal(b []byte) error {
@@ -2728,7 +2896,7 @@ func (m *MutilInventoryItemResponse) Reset()         { *m = MutilInventoryItemRe
 func (m *MutilInventoryItemResponse) String() string { return proto.CompactTextString(m) }
 func (*MutilInventoryItemResponse) ProtoMessage()    {}
 func (*MutilInventoryItemResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{41}
+	return fileDescriptor_6007f868cf6553df, []int{43}
 }
 
 func (m *MutilInventoryItemResponse) XXX_Unmarshal(b []byte) error {
@@ -2754,7 +2922,7 @@ type ModifyRequest struct {
 	AppId                string            `protobuf:"bytes,6,opt,name=app_id,json=appId,proto3" json:"app_id"`
 	ItemId               string            `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id"`
 	DatastoreId          string            `protobuf:"bytes,2,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
-	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Owners               []string          `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners"`
 	Writer               string            `protobuf:"bytes,5,opt,name=writer,proto3" json:"writer"`
 	Database             string            `protobuf:"bytes,7,opt,name=database,proto3" json:"database"`
@@ -2769,7 +2937,7 @@ func (m *ModifyRequest) Reset()         { *m = ModifyRequest{} }
 func (m *ModifyRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyRequest) ProtoMessage()    {}
 func (*ModifyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{42}
+	return fileDescriptor_6007f868cf6553df, []int{44}
 }
 
 func (m *ModifyRequest) XXX_Unmarshal(b []byte) error {
@@ -2863,7 +3031,7 @@ func (m *ModifyResponse) Reset()         { *m = ModifyResponse{} }
 func (m *ModifyResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyResponse) ProtoMessage()    {}
 func (*ModifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{43}
+	return fileDescriptor_6007f868cf6553df, []int{45}
 }
 
 func (m *ModifyResponse) XXX_Unmarshal(b []byte) error {
@@ -2899,7 +3067,7 @@ func (m *JournalRequest) Reset()         { *m = JournalRequest{} }
 func (m *JournalRequest) String() string { return proto.CompactTextString(m) }
 func (*JournalRequest) ProtoMessage()    {}
 func (*JournalRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{44}
+	return fileDescriptor_6007f868cf6553df, []int{46}
 }
 
 func (m *JournalRequest) XXX_Unmarshal(b []byte) error {
@@ -2958,7 +3126,7 @@ func (m *JournalResponse) Reset()         { *m = JournalResponse{} }
 func (m *JournalResponse) String() string { return proto.CompactTextString(m) }
 func (*JournalResponse) ProtoMessage()    {}
 func (*JournalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{45}
+	return fileDescriptor_6007f868cf6553df, []int{47}
 }
 
 func (m *JournalResponse) XXX_Unmarshal(b []byte) error {
@@ -2996,7 +3164,7 @@ func (m *StatusRequest) Reset()         { *m = StatusRequest{} }
 func (m *StatusRequest) String() string { return proto.CompactTextString(m) }
 func (*StatusRequest) ProtoMessage()    {}
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{46}
+	return fileDescriptor_6007f868cf6553df, []int{48}
 }
 
 func (m *StatusRequest) XXX_Unmarshal(b []byte) error {
@@ -3069,7 +3237,7 @@ func (m *StatusResponse) Reset()         { *m = StatusResponse{} }
 func (m *StatusResponse) String() string { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()    {}
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{47}
+	return fileDescriptor_6007f868cf6553df, []int{49}
 }
 
 func (m *StatusResponse) XXX_Unmarshal(b []byte) error {
@@ -3106,7 +3274,7 @@ func (m *OwnersRequest) Reset()         { *m = OwnersRequest{} }
 func (m *OwnersRequest) String() string { return proto.CompactTextString(m) }
 func (*OwnersRequest) ProtoMessage()    {}
 func (*OwnersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{48}
+	return fileDescriptor_6007f868cf6553df, []int{50}
 }
 
 func (m *OwnersRequest) XXX_Unmarshal(b []byte) error {
@@ -3172,7 +3340,7 @@ func (m *OwnersResponse) Reset()         { *m = OwnersResponse{} }
 func (m *OwnersResponse) String() string { return proto.CompactTextString(m) }
 func (*OwnersResponse) ProtoMessage()    {}
 func (*OwnersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{49}
+	return fileDescriptor_6007f868cf6553df, []int{51}
 }
 
 func (m *OwnersResponse) XXX_Unmarshal(b []byte) error {
@@ -3212,7 +3380,7 @@ func (m *SelectOwnersRequest) Reset()         { *m = SelectOwnersRequest{} }
 func (m *SelectOwnersRequest) String() string { return proto.CompactTextString(m) }
 func (*SelectOwnersRequest) ProtoMessage()    {}
 func (*SelectOwnersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{50}
+	return fileDescriptor_6007f868cf6553df, []int{52}
 }
 
 func (m *SelectOwnersRequest) XXX_Unmarshal(b []byte) error {
@@ -3299,7 +3467,7 @@ func (m *SelectOwnersResponse) Reset()         { *m = SelectOwnersResponse{} }
 func (m *SelectOwnersResponse) String() string { return proto.CompactTextString(m) }
 func (*SelectOwnersResponse) ProtoMessage()    {}
 func (*SelectOwnersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{51}
+	return fileDescriptor_6007f868cf6553df, []int{53}
 }
 
 func (m *SelectOwnersResponse) XXX_Unmarshal(b []byte) error {
@@ -3337,7 +3505,7 @@ func (m *ItemOwnerRequest) Reset()         { *m = ItemOwnerRequest{} }
 func (m *ItemOwnerRequest) String() string { return proto.CompactTextString(m) }
 func (*ItemOwnerRequest) ProtoMessage()    {}
 func (*ItemOwnerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{52}
+	return fileDescriptor_6007f868cf6553df, []int{54}
 }
 
 func (m *ItemOwnerRequest) XXX_Unmarshal(b []byte) error {
@@ -3410,7 +3578,7 @@ func (m *ItemOwnerResponse) Reset()         { *m = ItemOwnerResponse{} }
 func (m *ItemOwnerResponse) String() string { return proto.CompactTextString(m) }
 func (*ItemOwnerResponse) ProtoMessage()    {}
 func (*ItemOwnerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{53}
+	return fileDescriptor_6007f868cf6553df, []int{55}
 }
 
 func (m *ItemOwnerResponse) XXX_Unmarshal(b []byte) error {
@@ -3435,7 +3603,7 @@ var xxx_messageInfo_ItemOwnerResponse proto.InternalMessageInfo
 type DeleteRequest struct {
 	ItemId               string            `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id"`
 	DatastoreId          string            `protobuf:"bytes,2,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
-	Items                map[string]*Value `protobuf:"bytes,5,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Items                map[string]*Value `protobuf:"bytes,5,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Owners               []string          `protobuf:"bytes,6,rep,name=owners,proto3" json:"owners"`
 	Writer               string            `protobuf:"bytes,3,opt,name=writer,proto3" json:"writer"`
 	Database             string            `protobuf:"bytes,4,opt,name=database,proto3" json:"database"`
@@ -3450,7 +3618,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{54}
+	return fileDescriptor_6007f868cf6553df, []int{56}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -3541,7 +3709,7 @@ func (m *DeleteDatastoreItemsRequest) Reset()         { *m = DeleteDatastoreItem
 func (m *DeleteDatastoreItemsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteDatastoreItemsRequest) ProtoMessage()    {}
 func (*DeleteDatastoreItemsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{55}
+	return fileDescriptor_6007f868cf6553df, []int{57}
 }
 
 func (m *DeleteDatastoreItemsRequest) XXX_Unmarshal(b []byte) error {
@@ -3602,7 +3770,7 @@ func (m *DeleteItemsRequest) Reset()         { *m = DeleteItemsRequest{} }
 func (m *DeleteItemsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteItemsRequest) ProtoMessage()    {}
 func (*DeleteItemsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{56}
+	return fileDescriptor_6007f868cf6553df, []int{58}
 }
 
 func (m *DeleteItemsRequest) XXX_Unmarshal(b []byte) error {
@@ -3689,7 +3857,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{57}
+	return fileDescriptor_6007f868cf6553df, []int{59}
 }
 
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
@@ -3729,7 +3897,7 @@ func (m *SelectedItemsRequest) Reset()         { *m = SelectedItemsRequest{} }
 func (m *SelectedItemsRequest) String() string { return proto.CompactTextString(m) }
 func (*SelectedItemsRequest) ProtoMessage()    {}
 func (*SelectedItemsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{58}
+	return fileDescriptor_6007f868cf6553df, []int{60}
 }
 
 func (m *SelectedItemsRequest) XXX_Unmarshal(b []byte) error {
@@ -3817,7 +3985,7 @@ func (m *SelectedItemsResponse) Reset()         { *m = SelectedItemsResponse{} }
 func (m *SelectedItemsResponse) String() string { return proto.CompactTextString(m) }
 func (*SelectedItemsResponse) ProtoMessage()    {}
 func (*SelectedItemsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{59}
+	return fileDescriptor_6007f868cf6553df, []int{61}
 }
 
 func (m *SelectedItemsResponse) XXX_Unmarshal(b []byte) error {
@@ -3859,7 +4027,7 @@ func (m *LabelTimeRequest) Reset()         { *m = LabelTimeRequest{} }
 func (m *LabelTimeRequest) String() string { return proto.CompactTextString(m) }
 func (*LabelTimeRequest) ProtoMessage()    {}
 func (*LabelTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{60}
+	return fileDescriptor_6007f868cf6553df, []int{62}
 }
 
 func (m *LabelTimeRequest) XXX_Unmarshal(b []byte) error {
@@ -3911,7 +4079,7 @@ func (m *LabelTimeResponse) Reset()         { *m = LabelTimeResponse{} }
 func (m *LabelTimeResponse) String() string { return proto.CompactTextString(m) }
 func (*LabelTimeResponse) ProtoMessage()    {}
 func (*LabelTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{61}
+	return fileDescriptor_6007f868cf6553df, []int{63}
 }
 
 func (m *LabelTimeResponse) XXX_Unmarshal(b []byte) error {
@@ -3937,7 +4105,7 @@ type ChangeDebtRequest struct {
 	AppId                string            `protobuf:"bytes,6,opt,name=app_id,json=appId,proto3" json:"app_id"`
 	ItemId               string            `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id"`
 	DatastoreId          string            `protobuf:"bytes,2,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
-	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Owners               []string          `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners"`
 	Writer               string            `protobuf:"bytes,5,opt,name=writer,proto3" json:"writer"`
 	Database             string            `protobuf:"bytes,7,opt,name=database,proto3" json:"database"`
@@ -3952,7 +4120,7 @@ func (m *ChangeDebtRequest) Reset()         { *m = ChangeDebtRequest{} }
 func (m *ChangeDebtRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangeDebtRequest) ProtoMessage()    {}
 func (*ChangeDebtRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{62}
+	return fileDescriptor_6007f868cf6553df, []int{64}
 }
 
 func (m *ChangeDebtRequest) XXX_Unmarshal(b []byte) error {
@@ -4046,7 +4214,7 @@ func (m *ChangeDebtResponse) Reset()         { *m = ChangeDebtResponse{} }
 func (m *ChangeDebtResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeDebtResponse) ProtoMessage()    {}
 func (*ChangeDebtResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{63}
+	return fileDescriptor_6007f868cf6553df, []int{65}
 }
 
 func (m *ChangeDebtResponse) XXX_Unmarshal(b []byte) error {
@@ -4073,7 +4241,7 @@ type ContractExpireRequest struct {
 	ItemId               string            `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id"`
 	DatastoreId          string            `protobuf:"bytes,3,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
 	Writer               string            `protobuf:"bytes,4,opt,name=writer,proto3" json:"writer"`
-	Items                map[string]*Value `protobuf:"bytes,6,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Items                map[string]*Value `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Owners               []string          `protobuf:"bytes,7,rep,name=owners,proto3" json:"owners"`
 	Database             string            `protobuf:"bytes,5,opt,name=database,proto3" json:"database"`
 	LangCd               string            `protobuf:"bytes,8,opt,name=lang_cd,json=langCd,proto3" json:"lang_cd"`
@@ -4087,7 +4255,7 @@ func (m *ContractExpireRequest) Reset()         { *m = ContractExpireRequest{} }
 func (m *ContractExpireRequest) String() string { return proto.CompactTextString(m) }
 func (*ContractExpireRequest) ProtoMessage()    {}
 func (*ContractExpireRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{64}
+	return fileDescriptor_6007f868cf6553df, []int{66}
 }
 
 func (m *ContractExpireRequest) XXX_Unmarshal(b []byte) error {
@@ -4181,7 +4349,7 @@ func (m *ContractExpireResponse) Reset()         { *m = ContractExpireResponse{}
 func (m *ContractExpireResponse) String() string { return proto.CompactTextString(m) }
 func (*ContractExpireResponse) ProtoMessage()    {}
 func (*ContractExpireResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{65}
+	return fileDescriptor_6007f868cf6553df, []int{67}
 }
 
 func (m *ContractExpireResponse) XXX_Unmarshal(b []byte) error {
@@ -4202,12 +4370,147 @@ func (m *ContractExpireResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractExpireResponse proto.InternalMessageInfo
 
+// 使用権資産减损(含减损回转)
+type ImpairContractRequest struct {
+	AppId                string            `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id"`
+	ItemId               string            `protobuf:"bytes,2,opt,name=item_id,json=itemId,proto3" json:"item_id"`
+	DatastoreId          string            `protobuf:"bytes,3,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
+	Writer               string            `protobuf:"bytes,4,opt,name=writer,proto3" json:"writer"`
+	Items                map[string]*Value `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Owners               []string          `protobuf:"bytes,7,rep,name=owners,proto3" json:"owners"`
+	Database             string            `protobuf:"bytes,5,opt,name=database,proto3" json:"database"`
+	LangCd               string            `protobuf:"bytes,8,opt,name=lang_cd,json=langCd,proto3" json:"lang_cd"`
+	Domain               string            `protobuf:"bytes,9,opt,name=domain,proto3" json:"domain"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImpairContractRequest) Reset()         { *m = ImpairContractRequest{} }
+func (m *ImpairContractRequest) String() string { return proto.CompactTextString(m) }
+func (*ImpairContractRequest) ProtoMessage()    {}
+func (*ImpairContractRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6007f868cf6553df, []int{68}
+}
+
+func (m *ImpairContractRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImpairContractRequest.Unmarshal(m, b)
+}
+func (m *ImpairContractRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImpairContractRequest.Marshal(b, m, deterministic)
+}
+func (m *ImpairContractRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImpairContractRequest.Merge(m, src)
+}
+func (m *ImpairContractRequest) XXX_Size() int {
+	return xxx_messageInfo_ImpairContractRequest.Size(m)
+}
+func (m *ImpairContractRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImpairContractRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImpairContractRequest proto.InternalMessageInfo
+
+func (m *ImpairContractRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *ImpairContractRequest) GetItemId() string {
+	if m != nil {
+		return m.ItemId
+	}
+	return ""
+}
+
+func (m *ImpairContractRequest) GetDatastoreId() string {
+	if m != nil {
+		return m.DatastoreId
+	}
+	return ""
+}
+
+func (m *ImpairContractRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+func (m *ImpairContractRequest) GetItems() map[string]*Value {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ImpairContractRequest) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
+}
+
+func (m *ImpairContractRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *ImpairContractRequest) GetLangCd() string {
+	if m != nil {
+		return m.LangCd
+	}
+	return ""
+}
+
+func (m *ImpairContractRequest) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+type ImpairContractResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImpairContractResponse) Reset()         { *m = ImpairContractResponse{} }
+func (m *ImpairContractResponse) String() string { return proto.CompactTextString(m) }
+func (*ImpairContractResponse) ProtoMessage()    {}
+func (*ImpairContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6007f868cf6553df, []int{69}
+}
+
+func (m *ImpairContractResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImpairContractResponse.Unmarshal(m, b)
+}
+func (m *ImpairContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImpairContractResponse.Marshal(b, m, deterministic)
+}
+func (m *ImpairContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImpairContractResponse.Merge(m, src)
+}
+func (m *ImpairContractResponse) XXX_Size() int {
+	return xxx_messageInfo_ImpairContractResponse.Size(m)
+}
+func (m *ImpairContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImpairContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImpairContractResponse proto.InternalMessageInfo
+
 // 契约情报变更
 type ModifyContractRequest struct {
 	AppId                string            `protobuf:"bytes,6,opt,name=app_id,json=appId,proto3" json:"app_id"`
 	ItemId               string            `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id"`
 	DatastoreId          string            `protobuf:"bytes,2,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
-	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Owners               []string          `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners"`
 	Writer               string            `protobuf:"bytes,5,opt,name=writer,proto3" json:"writer"`
 	Database             string            `protobuf:"bytes,7,opt,name=database,proto3" json:"database"`
@@ -4222,7 +4525,7 @@ func (m *ModifyContractRequest) Reset()         { *m = ModifyContractRequest{} }
 func (m *ModifyContractRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyContractRequest) ProtoMessage()    {}
 func (*ModifyContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{66}
+	return fileDescriptor_6007f868cf6553df, []int{70}
 }
 
 func (m *ModifyContractRequest) XXX_Unmarshal(b []byte) error {
@@ -4316,7 +4619,7 @@ func (m *ModifyContractResponse) Reset()         { *m = ModifyContractResponse{}
 func (m *ModifyContractResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyContractResponse) ProtoMessage()    {}
 func (*ModifyContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{67}
+	return fileDescriptor_6007f868cf6553df, []int{71}
 }
 
 func (m *ModifyContractResponse) XXX_Unmarshal(b []byte) error {
@@ -4342,7 +4645,7 @@ type TerminateContractRequest struct {
 	AppId                string            `protobuf:"bytes,6,opt,name=app_id,json=appId,proto3" json:"app_id"`
 	ItemId               string            `protobuf:"bytes,1,opt,name=item_id,json=itemId,proto3" json:"item_id"`
 	DatastoreId          string            `protobuf:"bytes,2,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
-	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Items                map[string]*Value `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Owners               []string          `protobuf:"bytes,4,rep,name=owners,proto3" json:"owners"`
 	Writer               string            `protobuf:"bytes,5,opt,name=writer,proto3" json:"writer"`
 	Database             string            `protobuf:"bytes,7,opt,name=database,proto3" json:"database"`
@@ -4357,7 +4660,7 @@ func (m *TerminateContractRequest) Reset()         { *m = TerminateContractReque
 func (m *TerminateContractRequest) String() string { return proto.CompactTextString(m) }
 func (*TerminateContractRequest) ProtoMessage()    {}
 func (*TerminateContractRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{68}
+	return fileDescriptor_6007f868cf6553df, []int{72}
 }
 
 func (m *TerminateContractRequest) XXX_Unmarshal(b []byte) error {
@@ -4451,7 +4754,7 @@ func (m *TerminateContractResponse) Reset()         { *m = TerminateContractResp
 func (m *TerminateContractResponse) String() string { return proto.CompactTextString(m) }
 func (*TerminateContractResponse) ProtoMessage()    {}
 func (*TerminateContractResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6007f868cf6553df, []int{69}
+	return fileDescriptor_6007f868cf6553df, []int{73}
 }
 
 func (m *TerminateContractResponse) XXX_Unmarshal(b []byte) error {
@@ -4496,6 +4799,8 @@ func init() {
 	proto.RegisterType((*RishiritsuRequest)(nil), "item.RishiritsuRequest")
 	proto.RegisterType((*ItemResponse)(nil), "item.ItemResponse")
 	proto.RegisterType((*RishiritsuResponse)(nil), "item.RishiritsuResponse")
+	proto.RegisterType((*IbrCurve)(nil), "item.IbrCurve")
+	proto.RegisterType((*TenorPoint)(nil), "item.TenorPoint")
 	proto.RegisterType((*AddRequest)(nil), "item.AddRequest")
 	proto.RegisterMapType((map[string]*Value)(nil), "item.AddRequest.ItemsEntry")
 	proto.RegisterType((*AddResponse)(nil), "item.AddResponse")
@@ -4551,6 +4856,9 @@ func init() {
 	proto.RegisterType((*ContractExpireRequest)(nil), "item.ContractExpireRequest")
 	proto.RegisterMapType((map[string]*Value)(nil), "item.ContractExpireRequest.ItemsEntry")
 	proto.RegisterType((*ContractExpireResponse)(nil), "item.ContractExpireResponse")
+	proto.RegisterType((*ImpairContractRequest)(nil), "item.ImpairContractRequest")
+	proto.RegisterMapType((map[string]*Value)(nil), "item.ImpairContractRequest.ItemsEntry")
+	proto.RegisterType((*ImpairContractResponse)(nil), "item.ImpairContractResponse")
 	proto.RegisterType((*ModifyContractRequest)(nil), "item.ModifyContractRequest")
 	proto.RegisterMapType((map[string]*Value)(nil), "item.ModifyContractRequest.ItemsEntry")
 	proto.RegisterType((*ModifyContractResponse)(nil), "item.ModifyContractResponse")
@@ -4562,186 +4870,197 @@ func init() {
 func init() { proto.RegisterFile("item.proto", fileDescriptor_6007f868cf6553df) }
 
 var fileDescriptor_6007f868cf6553df = []byte{
-	// 2890 bytes of a gzipped FileDescriptorProto
+	// 3060 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x3b, 0xcd, 0x6f, 0x1b, 0xc7,
-	0xf5, 0x5a, 0x7e, 0xf3, 0x91, 0x94, 0xc8, 0xd1, 0x87, 0x69, 0x2a, 0x76, 0xa4, 0x4d, 0xe2, 0x9f,
-	0xe3, 0x00, 0x86, 0x7f, 0x8a, 0x61, 0xa4, 0xf9, 0x68, 0x22, 0xeb, 0x23, 0x55, 0x22, 0xd9, 0x09,
-	0x65, 0x05, 0x28, 0x50, 0x80, 0x58, 0x71, 0xc7, 0xd2, 0x42, 0xcb, 0x5d, 0x66, 0x77, 0x68, 0x85,
-	0x39, 0xa7, 0xa7, 0xde, 0x9a, 0x43, 0x83, 0x00, 0x05, 0x72, 0x2f, 0xd0, 0xa2, 0xd7, 0x02, 0x39,
-	0xf6, 0x92, 0x7f, 0xa1, 0x3d, 0xf6, 0xd8, 0x43, 0xd1, 0xde, 0x7a, 0x28, 0x8a, 0xf9, 0xda, 0x9d,
-	0x59, 0x2e, 0x69, 0x4a, 0x96, 0x63, 0xd7, 0x17, 0x81, 0xf3, 0xde, 0xcc, 0x9b, 0xf7, 0x3d, 0xef,
-	0xcd, 0xac, 0x00, 0x1c, 0x82, 0x7b, 0x37, 0xfb, 0x81, 0x4f, 0x7c, 0x94, 0xa3, 0xbf, 0xcd, 0x1f,
-	0x0c, 0x28, 0x6f, 0xf8, 0x9e, 0xed, 0x10, 0xc7, 0xf7, 0xd0, 0x65, 0x28, 0x3d, 0x74, 0xb0, 0x6b,
-	0x77, 0x1c, 0xbb, 0x69, 0xac, 0x18, 0xd7, 0xcb, 0xed, 0x22, 0x1b, 0xef, 0xd8, 0xe8, 0x0a, 0x00,
-	0x47, 0x91, 0x61, 0x1f, 0x37, 0x33, 0x0c, 0x59, 0x66, 0x90, 0x07, 0xc3, 0x3e, 0x46, 0xab, 0x50,
-	0x0d, 0xb1, 0x15, 0x74, 0x8f, 0x3b, 0x8f, 0x2c, 0x77, 0x80, 0x9b, 0x59, 0x36, 0xa1, 0xc2, 0x61,
-	0x9f, 0x51, 0x10, 0x6a, 0x41, 0xc9, 0xef, 0xe3, 0xc0, 0x22, 0x7e, 0xd0, 0xcc, 0x31, 0x74, 0x34,
-	0xa6, 0xd4, 0x9d, 0xb0, 0x63, 0x0f, 0x3d, 0xab, 0xe7, 0x74, 0x9b, 0xf9, 0x15, 0xe3, 0x7a, 0xa9,
-	0x5d, 0x76, 0xc2, 0x4d, 0x0e, 0x40, 0xaf, 0xc1, 0x6c, 0x57, 0x32, 0xc9, 0x19, 0x28, 0x30, 0x02,
-	0xb5, 0x08, 0x4a, 0x99, 0x30, 0xdf, 0x86, 0x3c, 0xdf, 0x6a, 0x19, 0xca, 0xb6, 0x45, 0x2c, 0x3e,
-	0x95, 0x0b, 0x52, 0xa2, 0x00, 0xc6, 0xea, 0x02, 0xe4, 0x39, 0x8f, 0x5c, 0x08, 0x3e, 0x30, 0xbf,
-	0xc9, 0x41, 0x6e, 0x87, 0xe0, 0x1e, 0xba, 0x04, 0x45, 0xaa, 0x99, 0x58, 0x05, 0x05, 0x3a, 0xdc,
-	0xb1, 0xd1, 0x22, 0x14, 0xac, 0x7e, 0x9f, 0xc2, 0xc5, 0x42, 0xab, 0xdf, 0xdf, 0xb1, 0xa9, 0xe4,
-	0x94, 0x74, 0x48, 0xfc, 0x00, 0x53, 0xa4, 0x90, 0x3c, 0x82, 0xed, 0xd8, 0xe8, 0x0d, 0xc8, 0x53,
-	0x1a, 0x61, 0x33, 0xb7, 0x92, 0xbd, 0x5e, 0x59, 0x5b, 0xbc, 0xc9, 0xcc, 0xb0, 0x23, 0xff, 0x84,
-	0x5b, 0x1e, 0x09, 0x86, 0x6d, 0x3e, 0x07, 0x2d, 0x41, 0xc1, 0x3f, 0xf5, 0x70, 0x10, 0x36, 0x0b,
-	0x2b, 0x59, 0xba, 0x3d, 0x1f, 0x51, 0x15, 0x75, 0x8f, 0x71, 0xf7, 0x84, 0x0b, 0x35, 0xc7, 0x0d,
-	0xc0, 0x20, 0xd2, 0x00, 0x1c, 0x1d, 0x12, 0x8b, 0x0c, 0xc2, 0x26, 0xe2, 0x6c, 0x30, 0xd8, 0x3e,
-	0x03, 0x31, 0x0a, 0x01, 0xb6, 0x08, 0xb6, 0x3b, 0x16, 0x69, 0x16, 0x05, 0x05, 0x0e, 0x59, 0x27,
-	0x2a, 0xfa, 0x70, 0xd8, 0x2c, 0x69, 0xe8, 0xbb, 0x43, 0x8a, 0x1e, 0xf4, 0x6d, 0xb9, 0xba, 0xcc,
-	0xd1, 0x02, 0xc2, 0x57, 0x4b, 0xf4, 0xe1, 0xb0, 0x09, 0x1a, 0x9a, 0xaf, 0x66, 0xac, 0xf0, 0xd5,
-	0x15, 0x85, 0xfb, 0x68, 0x6f, 0x81, 0x3e, 0x1c, 0x36, 0xab, 0x1a, 0x9a, 0xaf, 0x76, 0xad, 0x43,
-	0xec, 0x76, 0x88, 0xd3, 0xc3, 0xcd, 0x3a, 0x47, 0x33, 0xc8, 0x03, 0xa7, 0x87, 0xa9, 0xca, 0x84,
-	0xd4, 0x0d, 0x6e, 0x31, 0x3e, 0x6a, 0x6d, 0x01, 0xc4, 0xfa, 0x45, 0x75, 0xc8, 0x9e, 0xe0, 0xa1,
-	0x30, 0x2a, 0xfd, 0x89, 0x56, 0x55, 0x4f, 0xa8, 0xac, 0x55, 0xb8, 0x5d, 0x98, 0x0b, 0x09, 0xb7,
-	0x78, 0x3b, 0xf3, 0x96, 0x61, 0xfe, 0x3b, 0x03, 0x55, 0x46, 0xa7, 0x8d, 0x3f, 0x1f, 0xe0, 0x90,
-	0x28, 0x9e, 0x60, 0x4c, 0xf2, 0x84, 0xcc, 0xa8, 0x27, 0xdc, 0x51, 0x1d, 0xd9, 0x75, 0x42, 0xd2,
-	0xcc, 0x32, 0x97, 0x98, 0xe3, 0x5b, 0x47, 0x91, 0xa8, 0x78, 0xf6, 0xae, 0x13, 0x92, 0x94, 0x00,
-	0xc8, 0xa5, 0x04, 0x00, 0xd5, 0x53, 0xdf, 0x3a, 0xc2, 0x1d, 0xc7, 0xb3, 0xf1, 0x17, 0x2c, 0x8c,
-	0xb2, 0xed, 0x32, 0x85, 0xec, 0x50, 0x00, 0x0d, 0x0b, 0x86, 0x0e, 0x9d, 0x2f, 0x79, 0x04, 0x65,
-	0xdb, 0x25, 0x0a, 0xd8, 0x77, 0xbe, 0xc4, 0xe8, 0x55, 0xc8, 0x87, 0x7e, 0x40, 0xc2, 0x66, 0x91,
-	0x71, 0x34, 0xcb, 0x39, 0xda, 0xf7, 0x03, 0x42, 0x65, 0x6f, 0x73, 0xa4, 0xe2, 0x9d, 0x65, 0xcd,
-	0x3b, 0x5b, 0xc0, 0x02, 0xec, 0xd0, 0x0a, 0xb1, 0xb0, 0x6e, 0x34, 0xa6, 0xdb, 0x3a, 0x61, 0xc7,
-	0x0f, 0x9c, 0x23, 0xc7, 0x63, 0x9e, 0x51, 0x6a, 0x97, 0x9c, 0xf0, 0x3e, 0x1b, 0xa3, 0xab, 0x00,
-	0xe1, 0xb1, 0x7f, 0xba, 0xeb, 0xfb, 0x27, 0x83, 0x3e, 0xb3, 0x7c, 0xa9, 0xad, 0x40, 0xcc, 0xaf,
-	0x32, 0x30, 0xb7, 0xe9, 0x9f, 0x7a, 0xae, 0x6f, 0xd9, 0xcf, 0xbd, 0xfe, 0x23, 0x1d, 0xe6, 0xa7,
-	0xd3, 0x61, 0x61, 0xac, 0x0e, 0x8b, 0xba, 0x0e, 0xcd, 0x4d, 0x28, 0x49, 0x32, 0x34, 0x4b, 0x53,
-	0x42, 0x9d, 0xd8, 0x9b, 0x8b, 0x74, 0xfc, 0x31, 0x66, 0x81, 0xc2, 0x50, 0x6a, 0x82, 0x2b, 0x53,
-	0x08, 0x73, 0x6a, 0x73, 0x0d, 0xea, 0xb1, 0x2e, 0xc3, 0xbe, 0xef, 0x85, 0x18, 0x5d, 0x05, 0x76,
-	0x12, 0x30, 0x4a, 0x95, 0x35, 0x88, 0x73, 0x53, 0x9b, 0x9f, 0x10, 0x1f, 0x42, 0x4d, 0x38, 0xbf,
-	0x58, 0xb0, 0x22, 0xb3, 0x99, 0xc1, 0x84, 0x54, 0x57, 0x88, 0x14, 0xb6, 0x00, 0x79, 0xe2, 0x13,
-	0xcb, 0x65, 0x0c, 0x64, 0xdb, 0x7c, 0x60, 0x76, 0xa1, 0xb2, 0xed, 0x78, 0x17, 0x60, 0x44, 0x55,
-	0x4f, 0xd9, 0x84, 0x9e, 0x3e, 0x80, 0x2a, 0xdf, 0x44, 0x30, 0x7b, 0x09, 0x8a, 0xbe, 0x6b, 0x77,
-	0x06, 0x81, 0x2b, 0xb3, 0xb9, 0xef, 0xda, 0x07, 0x81, 0x4b, 0x11, 0x1e, 0x3e, 0x65, 0x08, 0xbe,
-	0x45, 0xc1, 0xc3, 0xa7, 0x07, 0x81, 0x6b, 0xfe, 0xc5, 0x80, 0xea, 0x86, 0x3f, 0xf0, 0xc8, 0x73,
-	0xef, 0x6d, 0xb1, 0x1f, 0xe5, 0xc7, 0xfa, 0x51, 0x21, 0xa1, 0x9f, 0xd7, 0xa0, 0x26, 0x84, 0x13,
-	0x0a, 0x4a, 0xb7, 0xd5, 0x9f, 0x0c, 0xa8, 0x7f, 0x6c, 0x05, 0xd6, 0x05, 0x29, 0x42, 0xad, 0x2b,
-	0xb2, 0x93, 0xea, 0x8a, 0x5c, 0xb2, 0xae, 0x38, 0x8f, 0x8c, 0xaf, 0x43, 0x43, 0xe1, 0x3d, 0x29,
-	0xa7, 0xa1, 0xca, 0xf9, 0x4b, 0x03, 0x16, 0x0f, 0xbc, 0xf5, 0x7e, 0x3f, 0xf0, 0x1f, 0xe1, 0x0b,
-	0xca, 0xf1, 0xf1, 0x69, 0x94, 0x55, 0x4f, 0x23, 0x8d, 0xe5, 0x5c, 0x82, 0xe5, 0x9b, 0xb0, 0x94,
-	0x64, 0x63, 0x22, 0xdf, 0xdf, 0x1a, 0x50, 0x61, 0x11, 0x27, 0xb8, 0x1d, 0x5b, 0xb4, 0x4c, 0xc1,
-	0xaf, 0x96, 0x9e, 0xb3, 0x89, 0xf4, 0x1c, 0xeb, 0x3f, 0x37, 0x56, 0xff, 0xf9, 0x84, 0x30, 0xbf,
-	0x36, 0xa0, 0xd1, 0x76, 0xc2, 0x63, 0x27, 0x70, 0x48, 0x38, 0x90, 0x2c, 0x26, 0x39, 0x31, 0x46,
-	0x39, 0xb9, 0x0a, 0xe0, 0x62, 0x2b, 0xc4, 0x21, 0x19, 0xf6, 0x24, 0xab, 0x0a, 0x24, 0xc2, 0x9f,
-	0x38, 0x27, 0x96, 0x27, 0xb4, 0xab, 0x40, 0x1e, 0xa3, 0xe1, 0x2a, 0x57, 0xd8, 0x94, 0x69, 0xef,
-	0x36, 0x20, 0x55, 0x86, 0x29, 0x57, 0x7d, 0x9f, 0x01, 0x58, 0xb7, 0x2f, 0x20, 0xc7, 0xfd, 0xbf,
-	0x4c, 0xb2, 0x3c, 0x63, 0x2c, 0xf3, 0x9d, 0x62, 0xd2, 0x13, 0x0b, 0x47, 0xdd, 0x54, 0x4b, 0x50,
-	0x38, 0x0d, 0x1c, 0x82, 0x03, 0x61, 0x28, 0x31, 0x9a, 0x14, 0x42, 0xd4, 0x9f, 0x5c, 0xcb, 0x3b,
-	0xea, 0x74, 0x6d, 0x51, 0x08, 0x16, 0xe8, 0x70, 0x83, 0x39, 0xb7, 0xed, 0xf7, 0x2c, 0xc7, 0x13,
-	0x15, 0xa0, 0x18, 0x5d, 0x54, 0xa9, 0x75, 0x0d, 0x2a, 0x4c, 0xc6, 0x38, 0x7b, 0xa7, 0xba, 0xb5,
-	0xf9, 0x95, 0x01, 0x65, 0x9a, 0x2a, 0xd9, 0x9e, 0xe8, 0x96, 0x7e, 0x22, 0xb5, 0x38, 0xf1, 0x08,
-	0x3f, 0xaa, 0xab, 0x8b, 0x62, 0xf7, 0x77, 0x06, 0x54, 0xd6, 0x09, 0xb1, 0xba, 0xc7, 0x9c, 0x91,
-	0x35, 0x9d, 0x91, 0x97, 0x84, 0xd5, 0xe2, 0x19, 0x29, 0x66, 0x7b, 0xbc, 0x33, 0x5c, 0x14, 0xb7,
-	0xdf, 0x66, 0x00, 0x36, 0x8e, 0x2d, 0xef, 0x08, 0x6f, 0x5a, 0xc4, 0xa2, 0x2e, 0xf6, 0xf9, 0x00,
-	0x07, 0x43, 0xc1, 0xac, 0x70, 0xb1, 0x78, 0xc2, 0xcd, 0x4f, 0x29, 0x56, 0xf0, 0xca, 0x66, 0xa2,
-	0xdb, 0x50, 0xe8, 0x32, 0x7c, 0x33, 0xa3, 0x0a, 0xa8, 0xac, 0xe1, 0x3f, 0xf9, 0x22, 0x31, 0x97,
-	0xa6, 0x30, 0x5e, 0x90, 0x66, 0x79, 0x0a, 0x63, 0x03, 0x2a, 0x54, 0xbc, 0xc1, 0xb9, 0x85, 0x6a,
-	0x6d, 0x43, 0x45, 0xd9, 0xf3, 0xfc, 0xca, 0xf9, 0x63, 0x06, 0xe6, 0xf6, 0xac, 0x7e, 0xdf, 0xf1,
-	0x8e, 0xf6, 0x30, 0xb1, 0x98, 0x86, 0xce, 0x1f, 0xbe, 0xab, 0x50, 0xed, 0x71, 0x62, 0xea, 0xb9,
-	0x56, 0x11, 0x30, 0x76, 0xb2, 0xbd, 0x0c, 0x15, 0xde, 0x1e, 0xf1, 0x19, 0x3c, 0x36, 0x45, 0x0f,
-	0x25, 0x8f, 0x3e, 0x11, 0xb7, 0x05, 0x2d, 0x6e, 0xe3, 0x38, 0x2f, 0x6a, 0x71, 0xfe, 0x0a, 0xd4,
-	0x04, 0x41, 0xad, 0x42, 0xaf, 0x72, 0xe0, 0xfd, 0xd1, 0xbc, 0x5d, 0x1a, 0x1f, 0xf4, 0x30, 0x26,
-	0xe8, 0x2b, 0x6a, 0xd0, 0x9b, 0xdf, 0x19, 0xb0, 0x20, 0x74, 0x76, 0xd0, 0x57, 0x0b, 0xf4, 0xeb,
-	0xd1, 0x11, 0x48, 0x15, 0x37, 0xbb, 0x56, 0x17, 0x85, 0x30, 0xf6, 0x6c, 0xde, 0x8b, 0x46, 0x87,
-	0xe2, 0x1b, 0x90, 0xeb, 0x61, 0x62, 0x09, 0xe3, 0x88, 0xce, 0x38, 0x61, 0x87, 0x9f, 0xcd, 0xb4,
-	0xd9, 0x24, 0x74, 0x0d, 0x72, 0x94, 0x59, 0xe6, 0x47, 0x15, 0x49, 0x34, 0x76, 0x3e, 0x3a, 0x8f,
-	0xe2, 0xef, 0x96, 0xa1, 0x18, 0x70, 0x4e, 0x4c, 0x07, 0x16, 0x13, 0x1c, 0x8a, 0xd4, 0xf2, 0x6a,
-	0x82, 0xc5, 0xaa, 0x60, 0x51, 0x67, 0xef, 0x06, 0x14, 0x02, 0x1c, 0x0e, 0x5c, 0x22, 0x18, 0x44,
-	0x22, 0xe3, 0xf7, 0xfa, 0x7e, 0x40, 0x6b, 0x8b, 0x81, 0x4b, 0xda, 0x62, 0x86, 0xf9, 0x1f, 0x03,
-	0x66, 0x39, 0x22, 0x72, 0xa0, 0x51, 0x6f, 0x3c, 0xff, 0x25, 0xc2, 0xb8, 0xf4, 0x3e, 0x62, 0xf6,
-	0x7c, 0x8a, 0xd9, 0xc7, 0xf9, 0xd2, 0x84, 0x96, 0xe3, 0xcc, 0x67, 0x80, 0xe9, 0x00, 0x70, 0xf9,
-	0x99, 0xec, 0xaf, 0xc5, 0xb9, 0xd0, 0x88, 0x6b, 0xde, 0x28, 0x29, 0xcb, 0xf4, 0x77, 0x1b, 0xaa,
-	0x16, 0xcb, 0x8f, 0x1d, 0x3e, 0x9b, 0x27, 0x96, 0xc6, 0x48, 0xe6, 0x6c, 0x57, 0xac, 0x78, 0x60,
-	0x7e, 0x63, 0x40, 0x4d, 0x1a, 0xe1, 0xac, 0x2e, 0x77, 0x43, 0x73, 0xb9, 0x05, 0xd5, 0xa2, 0xd3,
-	0x79, 0x5c, 0x2c, 0x64, 0x9a, 0xc7, 0x1d, 0x4a, 0x2f, 0x78, 0x8a, 0xae, 0xf6, 0x5b, 0x03, 0x10,
-	0x47, 0x6c, 0x1c, 0xe3, 0xee, 0xc9, 0x33, 0xd0, 0xc1, 0xe4, 0xa8, 0x3b, 0x82, 0x79, 0x8d, 0xbd,
-	0xa7, 0xa6, 0x88, 0xef, 0x0d, 0xc8, 0x6f, 0x05, 0x01, 0xbf, 0x41, 0x7c, 0xe8, 0x04, 0x21, 0xe9,
-	0xb8, 0x8e, 0x87, 0x45, 0xb1, 0x5c, 0x66, 0x90, 0x5d, 0xc7, 0x63, 0x77, 0x10, 0xae, 0x25, 0xb1,
-	0xbc, 0xd5, 0x29, 0x51, 0x00, 0x43, 0xae, 0x42, 0xb5, 0x3b, 0x08, 0x02, 0xec, 0x09, 0x3c, 0x3f,
-	0xa7, 0x2a, 0x02, 0xc6, 0xa6, 0xa8, 0x1d, 0x4c, 0x6e, 0x4c, 0x07, 0xe3, 0x59, 0x3d, 0x99, 0xc7,
-	0x79, 0x07, 0x73, 0xcf, 0xea, 0xb1, 0x9d, 0x31, 0xe5, 0xb0, 0xd3, 0x0b, 0x8f, 0x64, 0x9d, 0xc5,
-	0x00, 0x7b, 0xe1, 0x91, 0xd9, 0x85, 0xaa, 0x2a, 0x17, 0x0d, 0x2d, 0xc7, 0x0b, 0x71, 0x40, 0x84,
-	0x04, 0x62, 0x44, 0xe1, 0x3d, 0xdf, 0x76, 0x1e, 0x0e, 0x05, 0xef, 0x62, 0x84, 0x5e, 0x81, 0x02,
-	0xa3, 0x25, 0xeb, 0x44, 0x71, 0xba, 0x31, 0x95, 0xb4, 0x05, 0xca, 0xfc, 0x97, 0x01, 0x0b, 0x3b,
-	0xde, 0x23, 0xec, 0x11, 0x3f, 0x18, 0x4e, 0xd5, 0x35, 0xc4, 0x59, 0xaa, 0x78, 0xc6, 0x83, 0x8f,
-	0x9e, 0xf5, 0x3d, 0xeb, 0x48, 0x36, 0xe6, 0x7c, 0x40, 0xcf, 0x3a, 0x7e, 0x39, 0xc9, 0xd4, 0x22,
-	0xd2, 0x09, 0xbf, 0xf1, 0xdb, 0xa6, 0x90, 0xc4, 0xe5, 0x66, 0x3e, 0x79, 0xb9, 0x19, 0xa7, 0xaf,
-	0xdc, 0xb4, 0x25, 0xac, 0x79, 0x09, 0x16, 0x13, 0x42, 0x73, 0x2f, 0x34, 0x8f, 0xa0, 0xd5, 0xc6,
-	0x21, 0x26, 0x1a, 0xf6, 0x71, 0x7d, 0x5f, 0xcc, 0x41, 0x66, 0x2c, 0x07, 0xc9, 0xbb, 0x88, 0x2b,
-	0xb0, 0x9c, 0xba, 0x91, 0xe0, 0xe3, 0x07, 0x03, 0x2e, 0xef, 0x0d, 0x88, 0xe3, 0xa6, 0xda, 0x66,
-	0x05, 0xaa, 0xc2, 0x36, 0xfc, 0xe6, 0xc0, 0x60, 0x59, 0x1d, 0xb8, 0x81, 0xd8, 0x2d, 0xc1, 0x14,
-	0xd6, 0xd0, 0xd5, 0x9a, 0x1b, 0xaf, 0xd6, 0xac, 0x26, 0x54, 0xac, 0x83, 0x82, 0xaa, 0x83, 0x49,
-	0x3d, 0xdf, 0x4b, 0xd0, 0x4a, 0x93, 0x45, 0x88, 0xfa, 0xd7, 0x0c, 0xd4, 0xf6, 0x98, 0xc7, 0x8e,
-	0xaa, 0x59, 0xdb, 0xe2, 0x49, 0xfa, 0xd8, 0xdb, 0x7a, 0xcb, 0x74, 0x55, 0xd4, 0x12, 0xea, 0xb6,
-	0x17, 0xdc, 0x35, 0x15, 0x9f, 0x93, 0xae, 0xa9, 0x0e, 0xb3, 0x52, 0x4c, 0xa1, 0xf0, 0x5f, 0x19,
-	0x30, 0xfb, 0x91, 0x3f, 0x08, 0x3c, 0xcb, 0x3d, 0x43, 0xff, 0xad, 0xca, 0x96, 0x49, 0xc8, 0x76,
-	0x05, 0x20, 0x24, 0x56, 0x40, 0x3a, 0xb4, 0xaa, 0x10, 0xfe, 0x52, 0x66, 0x90, 0x4d, 0x8b, 0xc4,
-	0xf9, 0x95, 0x61, 0x45, 0xef, 0x4d, 0x01, 0x14, 0x69, 0x36, 0x60, 0x2e, 0x62, 0x46, 0x30, 0xf8,
-	0x7b, 0x03, 0x6a, 0x22, 0xef, 0x4f, 0x0e, 0x3c, 0xc5, 0x23, 0x32, 0x13, 0x3d, 0x22, 0x3b, 0xe9,
-	0x26, 0x26, 0xa7, 0xdd, 0xc4, 0x9c, 0xa3, 0x23, 0xa6, 0x3a, 0x96, 0xfc, 0x0a, 0x11, 0xbe, 0x33,
-	0xa0, 0xc6, 0xcb, 0xab, 0x33, 0xa8, 0x78, 0x19, 0xca, 0xbe, 0x6b, 0xf3, 0x52, 0x4d, 0xea, 0xd8,
-	0x77, 0x6d, 0x46, 0x87, 0x22, 0x3d, 0x7c, 0x2a, 0x90, 0x22, 0x9b, 0x78, 0xf8, 0x94, 0x23, 0xa7,
-	0xc9, 0x81, 0xf9, 0x51, 0xa6, 0x25, 0x87, 0x82, 0xe9, 0xdf, 0x64, 0x60, 0x7e, 0x1f, 0xbb, 0xb8,
-	0x4b, 0x74, 0xd6, 0x9f, 0xdb, 0x4b, 0xce, 0x05, 0xc8, 0x73, 0x7d, 0x70, 0xd9, 0xf8, 0xe0, 0x5c,
-	0xf5, 0xec, 0x15, 0x80, 0x48, 0xf5, 0x61, 0xb3, 0xc4, 0xa2, 0xbd, 0x2c, 0x75, 0x1f, 0x9a, 0x4b,
-	0xb0, 0xa0, 0x2b, 0x46, 0x68, 0xec, 0x0f, 0x06, 0xd4, 0x69, 0x90, 0x32, 0xf0, 0x93, 0xab, 0x4b,
-	0xf1, 0xe7, 0xac, 0xe6, 0xcf, 0x91, 0xa0, 0xb9, 0x74, 0x41, 0xa7, 0x77, 0xd5, 0x79, 0x68, 0x28,
-	0x0c, 0x0b, 0x31, 0xfe, 0x9c, 0x81, 0xda, 0x26, 0x76, 0x31, 0xc1, 0x17, 0x71, 0x67, 0x18, 0xe5,
-	0xda, 0xbc, 0x9a, 0x6b, 0x35, 0xfa, 0x67, 0x78, 0xda, 0x1c, 0x77, 0x0e, 0x4d, 0xb8, 0xcf, 0x7b,
-	0x66, 0xb9, 0x96, 0xc0, 0x32, 0x17, 0x73, 0x33, 0x52, 0x87, 0x5a, 0x3e, 0x4c, 0x91, 0x02, 0xce,
-	0x53, 0x4a, 0x7c, 0x9d, 0x01, 0xc4, 0xb7, 0xfd, 0xdf, 0x78, 0x88, 0xbc, 0x04, 0xc5, 0x41, 0x88,
-	0x03, 0xba, 0xb9, 0xf0, 0x5b, 0x3a, 0x4c, 0x1c, 0x31, 0x4f, 0x7a, 0xe9, 0x48, 0xd3, 0x9b, 0x74,
-	0x39, 0xe1, 0xe5, 0xff, 0x30, 0x64, 0x14, 0x63, 0xfb, 0x82, 0x34, 0x95, 0x2c, 0xc4, 0xb2, 0x23,
-	0x85, 0xd8, 0xb8, 0x12, 0xe2, 0xc7, 0x51, 0xc2, 0x1d, 0x58, 0x4c, 0x48, 0x2c, 0xba, 0xad, 0x2b,
-	0x00, 0x36, 0xd3, 0x8e, 0xf2, 0xfa, 0x55, 0xe6, 0x90, 0x83, 0xc0, 0x35, 0x43, 0xa8, 0xef, 0xca,
-	0x17, 0xf4, 0x0b, 0x2d, 0x3a, 0x27, 0xf9, 0xf1, 0x3c, 0x34, 0x94, 0x4d, 0x85, 0xd1, 0xfe, 0x96,
-	0x81, 0x86, 0xe8, 0x27, 0xf1, 0x21, 0x79, 0x8a, 0x15, 0xe2, 0x5b, 0x7a, 0x85, 0x68, 0x6a, 0xad,
-	0x6c, 0xbc, 0xf5, 0x0b, 0x5a, 0x25, 0x2e, 0x00, 0x52, 0x45, 0x15, 0xca, 0xff, 0x7b, 0x06, 0x16,
-	0x37, 0x7c, 0x8f, 0x04, 0x56, 0x97, 0x6c, 0x7d, 0xd1, 0x77, 0x02, 0xfc, 0x74, 0x0b, 0xb2, 0xd4,
-	0x1a, 0xe6, 0x5d, 0x69, 0x98, 0x02, 0x33, 0xcc, 0xb5, 0x28, 0x09, 0x8d, 0xb2, 0x35, 0xd1, 0x38,
-	0xc5, 0x69, 0xdf, 0xa8, 0x9e, 0x99, 0x11, 0x9a, 0xb0, 0x94, 0x14, 0x4b, 0x31, 0x04, 0xaf, 0xe2,
-	0xe5, 0x84, 0xa7, 0x18, 0x09, 0xef, 0xea, 0x91, 0x70, 0x4d, 0xed, 0x95, 0x12, 0xdb, 0xbf, 0xa0,
-	0xd1, 0xd0, 0x84, 0xa5, 0xa4, 0xb8, 0xc2, 0x10, 0xff, 0xcc, 0x40, 0xf3, 0x01, 0x0e, 0x7a, 0x8e,
-	0x67, 0x11, 0xfc, 0x23, 0xd8, 0xe2, 0x7d, 0xdd, 0x16, 0xaf, 0x73, 0x86, 0xc7, 0x71, 0xf0, 0x82,
-	0x9a, 0x63, 0x19, 0x2e, 0xa7, 0x48, 0xcc, 0x2d, 0x72, 0xe3, 0xff, 0x00, 0xe2, 0x3b, 0x4c, 0x54,
-	0x81, 0xe2, 0xfe, 0xd6, 0xc6, 0x83, 0x9d, 0xfb, 0xf7, 0xea, 0x33, 0xa8, 0x0a, 0xa5, 0x8d, 0xfb,
-	0x7b, 0x9f, 0xec, 0x6e, 0x3d, 0xd8, 0xaa, 0x1b, 0x37, 0x56, 0xa1, 0xa0, 0x4c, 0x3a, 0xd8, 0xd8,
-	0xd8, 0xda, 0xdf, 0xaf, 0xcf, 0x20, 0x80, 0xc2, 0xf6, 0xfa, 0xce, 0xee, 0xd6, 0x66, 0xdd, 0x58,
-	0xfb, 0x1a, 0xf1, 0x97, 0xf3, 0x7d, 0x1c, 0x3c, 0x72, 0xba, 0x18, 0xdd, 0x81, 0xf2, 0xb6, 0xe3,
-	0xf1, 0xa3, 0x13, 0xa1, 0xf8, 0x41, 0x57, 0x56, 0x0e, 0xad, 0x79, 0x0d, 0x26, 0x7c, 0x64, 0x46,
-	0xae, 0x63, 0x1f, 0x19, 0xc8, 0x75, 0xea, 0xd7, 0x12, 0x72, 0x9d, 0xf6, 0x15, 0x82, 0x39, 0x83,
-	0xee, 0x42, 0x8d, 0xae, 0x8b, 0x3e, 0x50, 0x40, 0x4b, 0x7c, 0x5e, 0xf2, 0x6b, 0x8b, 0xd6, 0xa5,
-	0x11, 0x78, 0x44, 0xe3, 0x53, 0x40, 0x94, 0x86, 0xfe, 0xc5, 0x00, 0x12, 0x0f, 0x78, 0xa9, 0x9f,
-	0x33, 0xb4, 0x5e, 0x4a, 0x47, 0x46, 0x24, 0xdf, 0x84, 0x92, 0x54, 0x03, 0x6a, 0x28, 0xcf, 0xda,
-	0x62, 0x39, 0x52, 0x41, 0xd1, 0xa2, 0x2d, 0x98, 0x65, 0x1f, 0xdb, 0x44, 0xef, 0xe4, 0x48, 0x30,
-	0x3d, 0xf2, 0xfa, 0xdf, 0x6a, 0x8e, 0x22, 0x22, 0x32, 0xb7, 0xa0, 0xb8, 0x6e, 0xf3, 0xad, 0xeb,
-	0xc9, 0x77, 0xee, 0x56, 0x43, 0x81, 0x44, 0x2b, 0x7e, 0x02, 0xc0, 0x83, 0x97, 0x2d, 0x9a, 0x4f,
-	0xb9, 0xe9, 0x69, 0x2d, 0xe8, 0xc0, 0x68, 0xe9, 0x3b, 0x00, 0x1b, 0xbe, 0xf7, 0xd0, 0xe9, 0xb1,
-	0xa5, 0x62, 0x96, 0x7e, 0x55, 0xd2, 0x5a, 0x4c, 0x40, 0xa3, 0xc5, 0xef, 0x41, 0xf5, 0x43, 0xec,
-	0xe1, 0xc0, 0xe2, 0x75, 0xf8, 0x59, 0x97, 0x6f, 0xc3, 0xa2, 0x5c, 0xbe, 0x7f, 0xec, 0x0f, 0x4e,
-	0x86, 0xd6, 0xc9, 0xe0, 0x3c, 0x74, 0x3e, 0x82, 0x9a, 0x76, 0xcf, 0x86, 0xc4, 0x8b, 0x77, 0xda,
-	0x45, 0x62, 0x6b, 0x39, 0x15, 0x17, 0xd1, 0xfa, 0x39, 0xa0, 0xd1, 0x8b, 0x3b, 0xf4, 0xb2, 0xd0,
-	0xde, 0xb8, 0xeb, 0xc9, 0xd6, 0xca, 0xf8, 0x09, 0x11, 0xe9, 0x5f, 0xc0, 0x7c, 0xca, 0xfd, 0x27,
-	0x12, 0x4b, 0xc7, 0xdf, 0xc1, 0xb6, 0x56, 0x27, 0xcc, 0x50, 0x7d, 0x20, 0xee, 0x88, 0xa4, 0x0f,
-	0x68, 0x1d, 0xa8, 0xf4, 0x81, 0x44, 0x8f, 0x40, 0xe3, 0x67, 0x21, 0xad, 0x87, 0x43, 0xab, 0xea,
-	0xfc, 0xd4, 0xfe, 0x6e, 0x2c, 0xc9, 0xf7, 0xa1, 0xa2, 0xf4, 0x67, 0xa8, 0xa9, 0x4e, 0x9b, 0x8a,
-	0xc0, 0x27, 0xd0, 0xe0, 0x30, 0x5e, 0xcc, 0x73, 0x32, 0x2d, 0xf9, 0x80, 0x33, 0xda, 0xd1, 0x48,
-	0xbb, 0xa6, 0xd6, 0xfe, 0xe6, 0xcc, 0x2d, 0x03, 0xbd, 0x03, 0x55, 0x5e, 0xef, 0x89, 0x37, 0x40,
-	0xa1, 0x22, 0xed, 0xde, 0x47, 0xb2, 0x93, 0xb8, 0xf3, 0x98, 0x41, 0x7b, 0xb2, 0x58, 0x54, 0xef,
-	0x44, 0xd0, 0x65, 0x75, 0x4f, 0x9d, 0x50, 0x2b, 0x0d, 0x15, 0x91, 0xdb, 0x84, 0x39, 0x4e, 0x2e,
-	0xba, 0x98, 0x90, 0x79, 0x2f, 0x79, 0xb5, 0x22, 0xf3, 0xde, 0xe8, 0x0d, 0xc6, 0x4c, 0x2c, 0x91,
-	0x48, 0xf2, 0xf3, 0xda, 0xfb, 0x91, 0x2e, 0x51, 0xe2, 0xb2, 0x4e, 0x61, 0x61, 0x37, 0xfe, 0x6e,
-	0x58, 0xbc, 0x49, 0x26, 0xda, 0x20, 0xc9, 0xc2, 0x68, 0xa7, 0x32, 0x83, 0xd6, 0xa3, 0x4f, 0x28,
-	0xf0, 0x21, 0x91, 0xe9, 0x6e, 0xa4, 0x83, 0x90, 0xe9, 0x2e, 0xa5, 0xde, 0xa6, 0xaa, 0x9d, 0xd5,
-	0x4b, 0x40, 0x99, 0xb9, 0x53, 0xeb, 0x5d, 0x99, 0xb9, 0xc7, 0x54, 0x8d, 0x8c, 0x9c, 0x5e, 0xc8,
-	0x48, 0x72, 0xa9, 0xd5, 0x9c, 0x24, 0x37, 0xa6, 0xf6, 0x99, 0x41, 0x9f, 0x41, 0x63, 0xe4, 0x20,
-	0x46, 0x57, 0x27, 0xd7, 0x24, 0xad, 0x97, 0xc7, 0xe2, 0x95, 0xd4, 0x29, 0x1e, 0x87, 0xd5, 0x70,
-	0xd5, 0x9e, 0x70, 0x5b, 0x0b, 0x89, 0x87, 0x3e, 0xb1, 0xf4, 0xba, 0x71, 0xcb, 0x40, 0xbb, 0x30,
-	0xa7, 0xbc, 0x28, 0x32, 0x1a, 0x4d, 0x75, 0xba, 0xfa, 0x0e, 0xda, 0xba, 0x9c, 0x82, 0xd1, 0xa8,
-	0xdd, 0x83, 0x9a, 0xf6, 0x55, 0x80, 0x0c, 0xb4, 0xb4, 0x8f, 0x19, 0x64, 0xa0, 0xa5, 0x7e, 0x46,
-	0x20, 0xe8, 0xbd, 0x07, 0x25, 0xf9, 0x5d, 0x2d, 0x12, 0x59, 0x3b, 0xf1, 0xcd, 0x72, 0x6b, 0x29,
-	0x09, 0x56, 0x22, 0xf5, 0xa7, 0xd0, 0xa0, 0xe7, 0xe8, 0xba, 0x67, 0x73, 0xb3, 0x6c, 0x3b, 0x2e,
-	0x96, 0xa7, 0xb0, 0xf2, 0xc9, 0xac, 0x3c, 0x85, 0xd5, 0x0f, 0x5c, 0xd9, 0xfa, 0x0f, 0xa0, 0xb2,
-	0x7f, 0x7a, 0xf2, 0x04, 0x1c, 0x1c, 0x16, 0xd8, 0xff, 0x84, 0xbc, 0xf9, 0xdf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x61, 0xc6, 0x67, 0x14, 0x21, 0x32, 0x00, 0x00,
+	0xf5, 0x5e, 0x7e, 0xf3, 0x91, 0x92, 0xc8, 0xd1, 0xd7, 0x7a, 0x65, 0x3b, 0xf2, 0x26, 0xf1, 0xcf,
+	0x71, 0x00, 0xc3, 0x51, 0x82, 0x20, 0xbf, 0x7c, 0x34, 0x51, 0x24, 0x39, 0x65, 0x22, 0xc5, 0x09,
+	0x25, 0x07, 0x08, 0x50, 0x80, 0x58, 0x71, 0xc7, 0xd2, 0x42, 0xe4, 0x2e, 0xb3, 0x3b, 0xb4, 0xc2,
+	0x9c, 0xd3, 0x53, 0x8f, 0x3d, 0x34, 0x08, 0x50, 0x20, 0xf7, 0x02, 0x2d, 0x7a, 0x2d, 0x90, 0x63,
+	0x7b, 0xc8, 0x7f, 0x50, 0xb4, 0xa7, 0xa2, 0xc7, 0x1e, 0x8a, 0xf6, 0xd6, 0x43, 0x51, 0xcc, 0xd7,
+	0xee, 0xcc, 0x72, 0xc9, 0x50, 0x8a, 0x9c, 0xd8, 0xb9, 0x08, 0x9c, 0xf7, 0x66, 0xde, 0xbc, 0xaf,
+	0x79, 0xf3, 0xde, 0xdb, 0x11, 0x80, 0x47, 0x70, 0xff, 0xf6, 0x20, 0x0c, 0x48, 0x80, 0x0a, 0xf4,
+	0xb7, 0xfd, 0x8d, 0x01, 0xd5, 0xad, 0xc0, 0x77, 0x3d, 0xe2, 0x05, 0x3e, 0xba, 0x0c, 0x95, 0x07,
+	0x1e, 0xee, 0xb9, 0x1d, 0xcf, 0x35, 0x8d, 0x75, 0xe3, 0x66, 0xb5, 0x5d, 0x66, 0xe3, 0x96, 0x8b,
+	0xae, 0x02, 0x70, 0x14, 0x19, 0x0d, 0xb0, 0x99, 0x63, 0xc8, 0x2a, 0x83, 0x1c, 0x8c, 0x06, 0x18,
+	0x5d, 0x87, 0x7a, 0x84, 0x9d, 0xb0, 0x7b, 0xdc, 0x79, 0xe8, 0xf4, 0x86, 0xd8, 0xcc, 0xb3, 0x09,
+	0x35, 0x0e, 0xfb, 0x88, 0x82, 0x90, 0x05, 0x95, 0x60, 0x80, 0x43, 0x87, 0x04, 0xa1, 0x59, 0x60,
+	0xe8, 0x78, 0x4c, 0xa9, 0x7b, 0x51, 0xc7, 0x1d, 0xf9, 0x4e, 0xdf, 0xeb, 0x9a, 0xc5, 0x75, 0xe3,
+	0x66, 0xa5, 0x5d, 0xf5, 0xa2, 0x6d, 0x0e, 0x40, 0xcf, 0xc2, 0x7c, 0x57, 0x32, 0xc9, 0x19, 0x28,
+	0x31, 0x02, 0x73, 0x31, 0x94, 0x32, 0x61, 0xbf, 0x0a, 0x45, 0xbe, 0xd5, 0x1a, 0x54, 0x5d, 0x87,
+	0x38, 0x7c, 0x2a, 0x17, 0xa4, 0x42, 0x01, 0x8c, 0xd5, 0x25, 0x28, 0x72, 0x1e, 0xb9, 0x10, 0x7c,
+	0x60, 0x7f, 0x51, 0x80, 0x42, 0x8b, 0xe0, 0x3e, 0x5a, 0x85, 0x32, 0xd5, 0x4c, 0xa2, 0x82, 0x12,
+	0x1d, 0xb6, 0x5c, 0xb4, 0x0c, 0x25, 0x67, 0x30, 0xa0, 0x70, 0xb1, 0xd0, 0x19, 0x0c, 0x5a, 0x2e,
+	0x95, 0x9c, 0x92, 0x8e, 0x48, 0x10, 0x62, 0x8a, 0x14, 0x92, 0xc7, 0xb0, 0x96, 0x8b, 0x9e, 0x87,
+	0x22, 0xa5, 0x11, 0x99, 0x85, 0xf5, 0xfc, 0xcd, 0xda, 0xc6, 0xf2, 0x6d, 0x66, 0x86, 0x96, 0xfc,
+	0x13, 0xed, 0xf8, 0x24, 0x1c, 0xb5, 0xf9, 0x1c, 0xb4, 0x02, 0xa5, 0xe0, 0xd4, 0xc7, 0x61, 0x64,
+	0x96, 0xd6, 0xf3, 0x74, 0x7b, 0x3e, 0xa2, 0x2a, 0xea, 0x1e, 0xe3, 0xee, 0x09, 0x17, 0x6a, 0x81,
+	0x1b, 0x80, 0x41, 0xa4, 0x01, 0x38, 0x3a, 0x22, 0x0e, 0x19, 0x46, 0x26, 0xe2, 0x6c, 0x30, 0xd8,
+	0x3e, 0x03, 0x31, 0x0a, 0x21, 0x76, 0x08, 0x76, 0x3b, 0x0e, 0x31, 0xcb, 0x82, 0x02, 0x87, 0x6c,
+	0x12, 0x15, 0x7d, 0x38, 0x32, 0x2b, 0x1a, 0xfa, 0xed, 0x11, 0x45, 0x0f, 0x07, 0xae, 0x5c, 0x5d,
+	0xe5, 0x68, 0x01, 0xe1, 0xab, 0x25, 0xfa, 0x70, 0x64, 0x82, 0x86, 0xe6, 0xab, 0x19, 0x2b, 0x7c,
+	0x75, 0x4d, 0xe1, 0x3e, 0xde, 0x5b, 0xa0, 0x0f, 0x47, 0x66, 0x5d, 0x43, 0xf3, 0xd5, 0x3d, 0xe7,
+	0x10, 0xf7, 0x3a, 0xc4, 0xeb, 0x63, 0xb3, 0xc1, 0xd1, 0x0c, 0x72, 0xe0, 0xf5, 0x31, 0x55, 0x99,
+	0x90, 0xba, 0xc9, 0x2d, 0xc6, 0x47, 0xd6, 0x0e, 0x40, 0xa2, 0x5f, 0xd4, 0x80, 0xfc, 0x09, 0x1e,
+	0x09, 0xa3, 0xd2, 0x9f, 0xe8, 0xba, 0xea, 0x09, 0xb5, 0x8d, 0x1a, 0xb7, 0x0b, 0x73, 0x21, 0xe1,
+	0x16, 0xaf, 0xe6, 0x5e, 0x31, 0xec, 0xff, 0xe4, 0xa0, 0xce, 0xe8, 0xb4, 0xf1, 0x27, 0x43, 0x1c,
+	0x11, 0xc5, 0x13, 0x8c, 0x69, 0x9e, 0x90, 0x1b, 0xf7, 0x84, 0x97, 0x55, 0x47, 0xee, 0x79, 0x11,
+	0x31, 0xf3, 0xcc, 0x25, 0x16, 0xf8, 0xd6, 0xf1, 0x49, 0x54, 0x3c, 0x7b, 0xd7, 0x8b, 0x48, 0xc6,
+	0x01, 0x28, 0x64, 0x1c, 0x00, 0xaa, 0xa7, 0x81, 0x73, 0x84, 0x3b, 0x9e, 0xef, 0xe2, 0x4f, 0xd9,
+	0x31, 0xca, 0xb7, 0xab, 0x14, 0xd2, 0xa2, 0x00, 0x7a, 0x2c, 0x18, 0x3a, 0xf2, 0x3e, 0xe3, 0x27,
+	0x28, 0xdf, 0xae, 0x50, 0xc0, 0xbe, 0xf7, 0x19, 0x46, 0xcf, 0x40, 0x31, 0x0a, 0x42, 0x12, 0x99,
+	0x65, 0xc6, 0xd1, 0x3c, 0xe7, 0x68, 0x3f, 0x08, 0x09, 0x95, 0xbd, 0xcd, 0x91, 0x8a, 0x77, 0x56,
+	0x35, 0xef, 0xb4, 0x80, 0x1d, 0xb0, 0x43, 0x27, 0xc2, 0xc2, 0xba, 0xf1, 0x98, 0x6e, 0xeb, 0x45,
+	0x9d, 0x20, 0xf4, 0x8e, 0x3c, 0x9f, 0x79, 0x46, 0xa5, 0x5d, 0xf1, 0xa2, 0x7b, 0x6c, 0x8c, 0xae,
+	0x01, 0x44, 0xc7, 0xc1, 0xe9, 0x6e, 0x10, 0x9c, 0x0c, 0x07, 0xcc, 0xf2, 0x95, 0xb6, 0x02, 0xb1,
+	0x3f, 0xcf, 0xc1, 0xc2, 0x76, 0x70, 0xea, 0xf7, 0x02, 0xc7, 0x7d, 0xec, 0xf5, 0x1f, 0xeb, 0xb0,
+	0x38, 0x9b, 0x0e, 0x4b, 0x13, 0x75, 0x58, 0xd6, 0x75, 0x68, 0x6f, 0x43, 0x45, 0x92, 0xa1, 0x51,
+	0x9a, 0x12, 0xea, 0x24, 0xde, 0x5c, 0xa6, 0xe3, 0xf7, 0x30, 0x3b, 0x28, 0x0c, 0xa5, 0x06, 0xb8,
+	0x2a, 0x85, 0x30, 0xa7, 0xb6, 0x37, 0xa0, 0x91, 0xe8, 0x32, 0x1a, 0x04, 0x7e, 0x84, 0xd1, 0x35,
+	0x60, 0x37, 0x01, 0xa3, 0x54, 0xdb, 0x80, 0x24, 0x36, 0xb5, 0xf9, 0x0d, 0xf1, 0x0e, 0xcc, 0x09,
+	0xe7, 0x17, 0x0b, 0xd6, 0x65, 0x34, 0x33, 0xd6, 0xf3, 0xa9, 0x15, 0x1c, 0x41, 0x23, 0x2c, 0x09,
+	0x88, 0xd3, 0x63, 0x0c, 0xe4, 0xdb, 0x7c, 0x60, 0x77, 0xa1, 0x76, 0xd7, 0xf3, 0x2f, 0xc0, 0x88,
+	0xaa, 0x9e, 0xf2, 0x29, 0x3d, 0xbd, 0x05, 0x75, 0xbe, 0x89, 0x60, 0x76, 0x15, 0xca, 0x41, 0xcf,
+	0xed, 0x0c, 0xc3, 0x9e, 0x8c, 0xe6, 0x41, 0xcf, 0xbd, 0x1f, 0xf6, 0x28, 0xc2, 0xc7, 0xa7, 0x0c,
+	0xc1, 0xb7, 0x28, 0xf9, 0xf8, 0xf4, 0x7e, 0xd8, 0xb3, 0xff, 0x62, 0x40, 0x7d, 0x2b, 0x18, 0xfa,
+	0xe4, 0xb1, 0xf7, 0xb6, 0xc4, 0x8f, 0x8a, 0x13, 0xfd, 0xa8, 0x94, 0xd2, 0xcf, 0xb3, 0x30, 0x27,
+	0x84, 0x13, 0x0a, 0xca, 0xb6, 0xd5, 0x1f, 0x0c, 0x68, 0xbc, 0xe7, 0x84, 0xce, 0x05, 0x29, 0x42,
+	0xcd, 0x2b, 0xf2, 0xd3, 0xf2, 0x8a, 0x42, 0x3a, 0xaf, 0x38, 0x8f, 0x8c, 0xcf, 0x41, 0x53, 0xe1,
+	0x3d, 0x2d, 0xa7, 0xa1, 0xca, 0xf9, 0x73, 0x03, 0x96, 0xef, 0xfb, 0x9b, 0x83, 0x41, 0x18, 0x3c,
+	0xc4, 0x17, 0x14, 0xe3, 0x93, 0xdb, 0x28, 0xaf, 0xde, 0x46, 0x1a, 0xcb, 0x85, 0x14, 0xcb, 0xb7,
+	0x61, 0x25, 0xcd, 0xc6, 0x54, 0xbe, 0xbf, 0x34, 0xa0, 0xc6, 0x4e, 0x9c, 0xe0, 0x76, 0x62, 0xd2,
+	0x32, 0x03, 0xbf, 0x5a, 0x78, 0xce, 0xa7, 0xc2, 0x73, 0xa2, 0xff, 0xc2, 0x44, 0xfd, 0x17, 0x53,
+	0xc2, 0xfc, 0xc9, 0x80, 0x66, 0xdb, 0x8b, 0x8e, 0xbd, 0xd0, 0x23, 0xd1, 0x50, 0xb2, 0x98, 0xe6,
+	0xc4, 0x18, 0xe7, 0xe4, 0x1a, 0x40, 0x0f, 0x3b, 0x11, 0x8e, 0xc8, 0xa8, 0x2f, 0x59, 0x55, 0x20,
+	0x31, 0xfe, 0xc4, 0x3b, 0x71, 0x7c, 0xa1, 0x5d, 0x05, 0x32, 0x4d, 0xc3, 0x14, 0xd7, 0x1d, 0x86,
+	0x21, 0xf6, 0xbb, 0x23, 0xc9, 0xb0, 0x1c, 0x53, 0x21, 0xfb, 0x98, 0x1c, 0x07, 0xae, 0x70, 0x25,
+	0x31, 0xb2, 0x6f, 0xf3, 0x7b, 0x7f, 0xe6, 0x50, 0xf9, 0x67, 0x03, 0x90, 0x2a, 0xf8, 0x6c, 0xcb,
+	0xa8, 0x58, 0x61, 0xbc, 0x8a, 0x89, 0x6d, 0xb4, 0x15, 0x08, 0xbd, 0x55, 0xba, 0xc3, 0xf0, 0x21,
+	0x0f, 0x76, 0xf1, 0xad, 0xd2, 0x3a, 0x0c, 0xb7, 0x28, 0xb4, 0xcd, 0x91, 0x8a, 0x10, 0x05, 0x55,
+	0x08, 0x84, 0xa0, 0x40, 0x70, 0xd8, 0x67, 0x42, 0x17, 0xdb, 0xec, 0x37, 0x7a, 0x01, 0x6a, 0xc3,
+	0x08, 0xbb, 0x9d, 0x41, 0xe0, 0xf9, 0x84, 0x5f, 0x43, 0xb5, 0x8d, 0x06, 0xa7, 0x7b, 0x80, 0xfd,
+	0x20, 0xfc, 0x80, 0x22, 0xda, 0x40, 0x27, 0xb1, 0x9f, 0x91, 0xed, 0x41, 0x45, 0xee, 0x48, 0xbd,
+	0x8d, 0xea, 0xb4, 0x33, 0xea, 0x4b, 0x6f, 0xa3, 0xc3, 0x8f, 0xfb, 0x9a, 0x92, 0x73, 0x29, 0x25,
+	0xdf, 0x84, 0x92, 0xd8, 0x2e, 0x3f, 0x61, 0x3b, 0x81, 0xb7, 0x3f, 0x04, 0x48, 0xa0, 0x4c, 0xae,
+	0xc0, 0x27, 0xc7, 0x11, 0xdb, 0xab, 0xd8, 0x16, 0x23, 0x2a, 0x57, 0xe8, 0x10, 0x2c, 0xf4, 0xc5,
+	0x7e, 0xab, 0xc7, 0x20, 0xaf, 0x1e, 0x03, 0xfb, 0xeb, 0x1c, 0xc0, 0xa6, 0x7b, 0x01, 0x77, 0xcf,
+	0x0b, 0xf2, 0xf2, 0xe3, 0x42, 0xac, 0x71, 0x21, 0x12, 0xd2, 0x53, 0x13, 0x7a, 0xfd, 0x08, 0xad,
+	0x40, 0xe9, 0x34, 0xf4, 0x08, 0x0e, 0x85, 0x3f, 0x8a, 0xd1, 0xb4, 0xd0, 0x46, 0x05, 0xec, 0x39,
+	0xfe, 0x51, 0xa7, 0xeb, 0x8a, 0x04, 0xbd, 0x44, 0x87, 0x5b, 0x2c, 0xe8, 0xb8, 0x41, 0xdf, 0xf1,
+	0x7c, 0x91, 0x99, 0x8b, 0xd1, 0x45, 0xa5, 0xc0, 0x37, 0xa0, 0xc6, 0x64, 0x4c, 0x6e, 0xd5, 0xcc,
+	0x70, 0x63, 0x7f, 0x6e, 0x40, 0x95, 0x5e, 0x61, 0x6c, 0x4f, 0x74, 0x47, 0xcf, 0x14, 0x2c, 0x4e,
+	0x3c, 0xc6, 0x8f, 0xeb, 0xea, 0xa2, 0xd8, 0xfd, 0x8d, 0x01, 0xb5, 0x4d, 0x42, 0x9c, 0xee, 0x31,
+	0x67, 0x64, 0x43, 0x67, 0xe4, 0x8a, 0xb0, 0x5a, 0x32, 0x23, 0xc3, 0x6c, 0xdf, 0xee, 0x0c, 0x17,
+	0xc5, 0xed, 0x97, 0x39, 0x80, 0xad, 0x63, 0xc7, 0x3f, 0xc2, 0xdb, 0x0e, 0x71, 0xa8, 0x8b, 0x7d,
+	0x32, 0xc4, 0xe1, 0xc8, 0x34, 0x54, 0x17, 0x4b, 0x26, 0xdc, 0xfe, 0x90, 0x62, 0x05, 0xaf, 0x6c,
+	0x26, 0x7a, 0x09, 0x4a, 0x5d, 0x86, 0x37, 0x73, 0xaa, 0x80, 0xca, 0x1a, 0xfe, 0x93, 0x2f, 0x12,
+	0x73, 0xe9, 0xd5, 0xc2, 0x0b, 0x85, 0x3c, 0xbf, 0x5a, 0xd8, 0x80, 0x0a, 0x95, 0x6c, 0x70, 0x6e,
+	0xa1, 0xac, 0xbb, 0x50, 0x53, 0xf6, 0x3c, 0xbf, 0x72, 0x7e, 0x9f, 0x83, 0x85, 0x3d, 0x67, 0x30,
+	0xf0, 0xfc, 0xa3, 0x3d, 0x4c, 0x1c, 0xa6, 0xa1, 0xf3, 0x1f, 0xdf, 0xeb, 0x50, 0xef, 0x73, 0x62,
+	0x6a, 0xbe, 0x51, 0x13, 0x30, 0x96, 0x71, 0x3c, 0x05, 0x35, 0x5e, 0xb6, 0xf2, 0x19, 0xfc, 0x6c,
+	0x8a, 0xda, 0x56, 0xa6, 0x24, 0xe2, 0xdc, 0x96, 0xb4, 0x73, 0x9b, 0x9c, 0xf3, 0xb2, 0x76, 0xce,
+	0x9f, 0x86, 0x39, 0x41, 0x50, 0xab, 0x9c, 0xea, 0x1c, 0x78, 0x6f, 0xfc, 0x3e, 0xad, 0x4c, 0x3e,
+	0xf4, 0x30, 0xe1, 0xd0, 0xd7, 0xd4, 0x43, 0x6f, 0x7f, 0x65, 0xc0, 0x92, 0xd0, 0xd9, 0xfd, 0x81,
+	0x5a, 0x38, 0xdd, 0x8c, 0x53, 0x13, 0xaa, 0xb8, 0x79, 0x19, 0x83, 0xf7, 0xb1, 0xef, 0xf2, 0x1e,
+	0x41, 0x9c, 0xac, 0x3c, 0x0f, 0x85, 0x3e, 0x26, 0x8e, 0x30, 0x8e, 0xe8, 0x58, 0xa4, 0xec, 0xf0,
+	0xd3, 0x4b, 0x6d, 0x36, 0x09, 0xdd, 0x80, 0x02, 0x65, 0x56, 0xdc, 0x4f, 0x8d, 0xb4, 0xf3, 0xd1,
+	0x79, 0x14, 0xff, 0x76, 0x15, 0xca, 0x21, 0xe7, 0xc4, 0xf6, 0x60, 0x39, 0xc5, 0xa1, 0x08, 0x2d,
+	0xcf, 0xa4, 0x58, 0xac, 0x0b, 0x16, 0x75, 0xf6, 0x6e, 0x41, 0x29, 0xc4, 0xd1, 0xb0, 0x47, 0x04,
+	0x83, 0x48, 0xdc, 0x89, 0xfd, 0x41, 0x10, 0x92, 0x36, 0xc3, 0xb4, 0xc5, 0x0c, 0xfb, 0xbf, 0x06,
+	0xcc, 0x73, 0x44, 0xec, 0x40, 0xe3, 0xde, 0x78, 0xfe, 0xe6, 0xce, 0xa4, 0xf0, 0x3e, 0x66, 0xf6,
+	0x62, 0x86, 0xd9, 0x27, 0xf9, 0xd2, 0x94, 0x52, 0xf0, 0xcc, 0x77, 0x80, 0xed, 0x01, 0x70, 0xf9,
+	0x99, 0xec, 0xcf, 0x26, 0xb1, 0xd0, 0x48, 0x6a, 0x91, 0x38, 0x28, 0xcb, 0xf0, 0xf7, 0x12, 0xd4,
+	0x1d, 0x16, 0x1f, 0x3b, 0x7c, 0x36, 0x0f, 0x2c, 0xcd, 0xb1, 0xc8, 0xd9, 0xae, 0x39, 0xc9, 0xc0,
+	0xfe, 0xc2, 0x80, 0x39, 0x69, 0x84, 0xb3, 0xba, 0xdc, 0x2d, 0xcd, 0xe5, 0x96, 0x54, 0x8b, 0xce,
+	0xe6, 0x71, 0x89, 0x90, 0x59, 0x1e, 0x77, 0x28, 0xbd, 0xe0, 0x11, 0xba, 0xda, 0xaf, 0x0d, 0x40,
+	0x1c, 0xb1, 0x45, 0x7b, 0x57, 0x3f, 0x80, 0x0e, 0xa6, 0x9f, 0xba, 0x23, 0x58, 0xd4, 0xd8, 0x7b,
+	0x64, 0x8a, 0xf8, 0xda, 0x80, 0xe2, 0x4e, 0x18, 0xf2, 0xce, 0xee, 0x03, 0x2f, 0x8c, 0x48, 0xa7,
+	0xe7, 0xf9, 0x58, 0x14, 0x31, 0x55, 0x06, 0xd9, 0xf5, 0x7c, 0xd6, 0x1b, 0xea, 0x39, 0x12, 0xcb,
+	0x4b, 0xd0, 0x4a, 0xcf, 0x11, 0x48, 0xda, 0xd3, 0x64, 0xe9, 0xa3, 0xc0, 0xf3, 0x7b, 0xaa, 0x26,
+	0x60, 0x6c, 0x8a, 0x5a, 0x59, 0x16, 0x26, 0x54, 0x96, 0xbe, 0xd3, 0x97, 0x71, 0x9c, 0x57, 0x96,
+	0xef, 0x3b, 0x7d, 0xb6, 0x33, 0xa6, 0x1c, 0x76, 0xfa, 0xd1, 0x91, 0xcc, 0xb3, 0x18, 0x60, 0x2f,
+	0x3a, 0xb2, 0xbb, 0x50, 0x57, 0xe5, 0xa2, 0x47, 0xcb, 0xf3, 0x23, 0x1c, 0x12, 0x21, 0x81, 0x18,
+	0xf1, 0xe4, 0xd4, 0xf5, 0x1e, 0x8c, 0x04, 0xef, 0x62, 0x84, 0x9e, 0x86, 0x12, 0xa3, 0x25, 0xf3,
+	0x44, 0x71, 0xbb, 0x31, 0x95, 0xb4, 0x05, 0xca, 0xfe, 0xb7, 0x01, 0x4b, 0x2d, 0xff, 0x21, 0xf6,
+	0x49, 0x10, 0x8e, 0x66, 0xaa, 0xe6, 0x92, 0x28, 0x55, 0x3e, 0xe3, 0xc5, 0x47, 0xef, 0xfa, 0xbe,
+	0x73, 0x24, 0x1b, 0x26, 0x7c, 0x40, 0xef, 0x3a, 0xde, 0x34, 0x66, 0x6a, 0x11, 0xe1, 0x84, 0x77,
+	0x62, 0xef, 0x52, 0x48, 0xaa, 0xe9, 0x5c, 0x4c, 0x37, 0x9d, 0x93, 0xf0, 0x55, 0x98, 0x35, 0x85,
+	0xb5, 0x57, 0x61, 0x39, 0x25, 0x34, 0xf7, 0x42, 0xfb, 0x08, 0xac, 0x36, 0x8e, 0x30, 0xd1, 0xb0,
+	0xdf, 0x56, 0x8f, 0x27, 0x1c, 0xe4, 0x26, 0x72, 0x90, 0xee, 0x11, 0x5d, 0x85, 0xb5, 0xcc, 0x8d,
+	0x04, 0x1f, 0xdf, 0x18, 0x70, 0x79, 0x6f, 0x48, 0xbc, 0x5e, 0xa6, 0x6d, 0xd6, 0xa1, 0x2e, 0x6c,
+	0xc3, 0x3b, 0x3a, 0x06, 0x8b, 0xea, 0xc0, 0x0d, 0xc4, 0xba, 0x37, 0x33, 0x58, 0x43, 0x57, 0x6b,
+	0x61, 0xb2, 0x5a, 0xf3, 0x9a, 0x50, 0x89, 0x0e, 0x4a, 0xaa, 0x0e, 0xa6, 0xd5, 0xe2, 0x57, 0xc0,
+	0xca, 0x92, 0x45, 0x88, 0xfa, 0xd7, 0x1c, 0xcc, 0xed, 0x31, 0x8f, 0x1d, 0x57, 0xb3, 0xb6, 0xc5,
+	0x77, 0xe9, 0x2f, 0xbc, 0xa4, 0x97, 0x4c, 0xd7, 0x44, 0x2e, 0xa1, 0x6e, 0x7b, 0xc1, 0x55, 0x53,
+	0xf9, 0x31, 0xa9, 0x9a, 0x1a, 0x30, 0x2f, 0xc5, 0x14, 0x0a, 0xff, 0x85, 0x01, 0xf3, 0xef, 0x06,
+	0xc3, 0xd0, 0x77, 0x7a, 0x67, 0xe8, 0x8b, 0xa8, 0xb2, 0xe5, 0x52, 0xb2, 0xd1, 0x8e, 0x2f, 0x71,
+	0x42, 0xd2, 0x71, 0x1d, 0x22, 0x5d, 0xbd, 0xca, 0x20, 0xdb, 0x0e, 0x49, 0xe2, 0x2b, 0xc3, 0x8a,
+	0x9e, 0x08, 0x05, 0x50, 0xa4, 0xdd, 0x84, 0x85, 0x98, 0x19, 0xc1, 0xe0, 0x6f, 0x0d, 0x98, 0x13,
+	0x71, 0x7f, 0xfa, 0xc1, 0x53, 0x3c, 0x22, 0x37, 0xd5, 0x23, 0xf2, 0xd3, 0x3a, 0x64, 0x05, 0xad,
+	0x43, 0x76, 0x8e, 0x8a, 0x98, 0xea, 0x58, 0xf2, 0x2b, 0x44, 0xf8, 0xca, 0x80, 0x39, 0x9e, 0x5e,
+	0x9d, 0x41, 0xc5, 0x6b, 0x50, 0xa5, 0x7d, 0x62, 0xe6, 0x64, 0x52, 0xc7, 0x41, 0xcf, 0x65, 0x74,
+	0x28, 0x92, 0xf6, 0x8a, 0x39, 0x52, 0x44, 0x13, 0x1f, 0x9f, 0x72, 0xe4, 0x2c, 0x31, 0xb0, 0x38,
+	0xce, 0xb4, 0xe4, 0x50, 0x30, 0xfd, 0xab, 0x1c, 0x2c, 0xee, 0xe3, 0x1e, 0xee, 0x12, 0x9d, 0xf5,
+	0xc7, 0xb6, 0xf9, 0xbc, 0x04, 0x45, 0xae, 0x0f, 0x2e, 0x5b, 0x31, 0x48, 0x29, 0x63, 0xf6, 0x7c,
+	0xf6, 0x2a, 0x40, 0xac, 0xfa, 0xc8, 0xac, 0xb0, 0xd3, 0x5e, 0x95, 0xba, 0x8f, 0xec, 0x15, 0x58,
+	0xd2, 0x15, 0x23, 0x34, 0xf6, 0x3b, 0x03, 0x1a, 0xf4, 0x90, 0x32, 0xf0, 0x77, 0x57, 0xd7, 0xa4,
+	0xd6, 0x51, 0x22, 0x68, 0x21, 0x5b, 0xd0, 0xd9, 0x5d, 0x75, 0x11, 0x9a, 0x0a, 0xc3, 0x42, 0x8c,
+	0x3f, 0xe6, 0x60, 0x6e, 0x1b, 0xf7, 0x30, 0xc1, 0x17, 0xd1, 0xcb, 0x8d, 0x63, 0x6d, 0x51, 0x8d,
+	0xb5, 0x1a, 0xfd, 0x33, 0x7c, 0x72, 0x9e, 0x74, 0x0f, 0x4d, 0xeb, 0xb3, 0xfe, 0x50, 0xb1, 0x96,
+	0xc0, 0x1a, 0x17, 0x73, 0x3b, 0x56, 0x87, 0x9a, 0x3e, 0xcc, 0x10, 0x02, 0xce, 0x93, 0x4a, 0xfc,
+	0x32, 0x07, 0x88, 0x6f, 0xfb, 0x64, 0x7c, 0x20, 0x5e, 0x85, 0xf2, 0x30, 0xc2, 0x21, 0xdd, 0x5c,
+	0xf8, 0x2d, 0x1d, 0xb6, 0xdc, 0x69, 0x7e, 0x7b, 0xf6, 0x82, 0xb3, 0x01, 0xf3, 0xd2, 0xe5, 0x84,
+	0x97, 0xff, 0xd3, 0x90, 0xa7, 0x18, 0xbb, 0x17, 0xa4, 0xa9, 0x74, 0x22, 0x96, 0x1f, 0x4b, 0xc4,
+	0x26, 0xa5, 0x10, 0xdf, 0x8f, 0x12, 0x5e, 0x86, 0xe5, 0x94, 0xc4, 0xa2, 0xda, 0xba, 0x0a, 0xe0,
+	0x32, 0xed, 0x28, 0x5f, 0x25, 0xab, 0x1c, 0x42, 0xbf, 0x3f, 0x46, 0xd0, 0xd8, 0x95, 0x2f, 0x1b,
+	0x2e, 0x34, 0xe9, 0x9c, 0xe6, 0xc7, 0x8b, 0xd0, 0x54, 0x36, 0x15, 0x46, 0xfb, 0x7b, 0x0e, 0x9a,
+	0xa2, 0x9e, 0xc4, 0x87, 0xe4, 0x11, 0x66, 0x88, 0xaf, 0xe8, 0x19, 0xa2, 0xad, 0x95, 0xb2, 0xc9,
+	0xd6, 0x3f, 0xd2, 0x2c, 0x71, 0x09, 0x90, 0x2a, 0xaa, 0x50, 0xfe, 0x3f, 0x72, 0xb0, 0xbc, 0x15,
+	0xf8, 0x24, 0x74, 0xba, 0x64, 0xe7, 0xd3, 0x81, 0x17, 0xe2, 0x47, 0x9b, 0x90, 0x65, 0xe6, 0x30,
+	0xaf, 0x4b, 0xc3, 0xf0, 0x2f, 0x44, 0x37, 0xe2, 0x20, 0x34, 0xce, 0xd6, 0x54, 0xe3, 0x94, 0x67,
+	0xfd, 0x76, 0xf8, 0x83, 0x19, 0xc1, 0x84, 0x95, 0xb4, 0x58, 0x8a, 0x21, 0x5a, 0xfd, 0x81, 0xe3,
+	0x85, 0x72, 0xc2, 0x63, 0x63, 0x88, 0x4c, 0xb6, 0x9e, 0x7c, 0x43, 0xa4, 0xc5, 0x52, 0x0c, 0xc1,
+	0xcb, 0xa9, 0xc9, 0x86, 0xb8, 0xb0, 0x90, 0xf4, 0xba, 0x1e, 0x92, 0x6e, 0xa8, 0x45, 0xeb, 0x59,
+	0x14, 0xfe, 0xe4, 0x86, 0x25, 0x13, 0x56, 0xd2, 0xe2, 0x0a, 0x43, 0xfc, 0x2b, 0x07, 0xe6, 0x01,
+	0x0e, 0xfb, 0x9e, 0xef, 0x10, 0xfc, 0x3d, 0xd8, 0xe2, 0x4d, 0xdd, 0x16, 0xcf, 0xc9, 0x0f, 0xc7,
+	0xd9, 0x1c, 0xfc, 0x48, 0xcd, 0xb1, 0x06, 0x97, 0x33, 0x24, 0xe6, 0x16, 0xb9, 0xf5, 0x7f, 0x00,
+	0x49, 0x33, 0x19, 0xd5, 0xa0, 0xbc, 0xbf, 0xb3, 0x75, 0xd0, 0xba, 0xf7, 0x7e, 0xe3, 0x12, 0xaa,
+	0x43, 0x65, 0xeb, 0xde, 0xde, 0x07, 0xbb, 0x3b, 0x07, 0x3b, 0x0d, 0xe3, 0xd6, 0x75, 0x28, 0x29,
+	0x93, 0xee, 0x6f, 0x6d, 0xed, 0xec, 0xef, 0x37, 0x2e, 0x21, 0x80, 0xd2, 0xdd, 0xcd, 0xd6, 0xee,
+	0xce, 0x76, 0xc3, 0xd8, 0xf8, 0x1b, 0xe2, 0x4f, 0x4b, 0xf6, 0x71, 0xf8, 0xd0, 0xeb, 0x62, 0xf4,
+	0x32, 0x54, 0xe9, 0x8b, 0x2a, 0x26, 0x03, 0x42, 0xc9, 0xe3, 0x05, 0x99, 0xc2, 0x59, 0x8b, 0x1a,
+	0x4c, 0xf8, 0xc8, 0x25, 0xb9, 0x8e, 0xbd, 0xc2, 0x91, 0xeb, 0xd4, 0xe7, 0x44, 0xd6, 0xa2, 0x06,
+	0x8b, 0xd7, 0xbd, 0x0d, 0x73, 0x74, 0x5d, 0xfc, 0x82, 0x07, 0xad, 0xf0, 0x79, 0xe9, 0xe7, 0x48,
+	0xd6, 0xea, 0x18, 0x3c, 0xa6, 0xf1, 0x21, 0x20, 0x4a, 0x43, 0x7f, 0x52, 0x83, 0xc4, 0x97, 0xd4,
+	0xcc, 0xf7, 0x3e, 0xd6, 0x95, 0x6c, 0x64, 0x4c, 0xf2, 0x45, 0xa8, 0x48, 0x35, 0xa0, 0x66, 0x22,
+	0xb1, 0x5c, 0x8e, 0x54, 0x50, 0xbc, 0x68, 0x07, 0xe6, 0xe9, 0xa2, 0xe4, 0x4d, 0x08, 0x12, 0x4c,
+	0x8f, 0x3d, 0x8f, 0xb1, 0xcc, 0x71, 0x44, 0x4c, 0xe6, 0x0e, 0x94, 0x37, 0x5d, 0xbe, 0x75, 0x23,
+	0xfd, 0xe0, 0xc0, 0x6a, 0x2a, 0x90, 0x78, 0xc5, 0xff, 0x03, 0xf0, 0xc3, 0xcb, 0x16, 0x2d, 0x66,
+	0xb4, 0xdc, 0xac, 0x25, 0x1d, 0x18, 0x2f, 0x7d, 0x0d, 0x60, 0x2b, 0xf0, 0x1f, 0x78, 0x7d, 0xb6,
+	0x54, 0xcc, 0xd2, 0x7b, 0x56, 0xd6, 0x72, 0x0a, 0x1a, 0x2f, 0x7e, 0x03, 0xea, 0xef, 0x60, 0x1f,
+	0x87, 0x0e, 0xc1, 0xe7, 0x59, 0x7e, 0x17, 0x96, 0xe5, 0xf2, 0xfd, 0xe3, 0x60, 0x78, 0x32, 0x72,
+	0x4e, 0x86, 0xe7, 0xa1, 0xf3, 0x2e, 0xcc, 0x69, 0x0d, 0x4f, 0x24, 0x9e, 0x1e, 0x64, 0x75, 0x74,
+	0xad, 0xb5, 0x4c, 0x5c, 0x4c, 0xeb, 0x63, 0x40, 0xe3, 0x1d, 0x54, 0xf4, 0x94, 0xd0, 0xde, 0xa4,
+	0x3e, 0xb1, 0xb5, 0x3e, 0x79, 0x42, 0x4c, 0xfa, 0x67, 0xb0, 0x98, 0xd1, 0x88, 0x46, 0x62, 0xe9,
+	0xe4, 0x66, 0xb8, 0x75, 0x7d, 0xca, 0x0c, 0xd5, 0x07, 0x92, 0xd2, 0x54, 0xfa, 0x80, 0xd6, 0x0a,
+	0xb0, 0x96, 0x74, 0xa0, 0x72, 0x7e, 0x96, 0xb2, 0x8a, 0x69, 0x74, 0x5d, 0x9d, 0x9f, 0x59, 0x68,
+	0x4f, 0x24, 0xf9, 0x26, 0xd4, 0x12, 0x6e, 0x22, 0x64, 0xaa, 0xd3, 0x66, 0x22, 0xf0, 0x01, 0x34,
+	0x39, 0x8c, 0x57, 0x55, 0x9c, 0x8c, 0x25, 0xbf, 0xa4, 0x8d, 0x97, 0x96, 0xd6, 0x5a, 0x26, 0x4e,
+	0xd2, 0xbb, 0x63, 0xa0, 0xd7, 0xa0, 0xce, 0x13, 0x6f, 0xf1, 0x31, 0x56, 0xa8, 0x48, 0x6b, 0xc0,
+	0x59, 0x4b, 0x3a, 0x30, 0x66, 0x67, 0x4f, 0x66, 0xed, 0x6a, 0x73, 0x0a, 0x5d, 0x56, 0xf7, 0xd4,
+	0x09, 0x59, 0x59, 0xa8, 0x98, 0xdc, 0x36, 0x2c, 0x70, 0x72, 0x71, 0x87, 0x48, 0xc6, 0xbd, 0x74,
+	0x8f, 0xcb, 0x5a, 0x1d, 0x83, 0x2b, 0x67, 0x57, 0x48, 0x24, 0x82, 0xfc, 0xa2, 0xf6, 0x21, 0x4f,
+	0x97, 0x28, 0xd5, 0x35, 0x55, 0x58, 0xd8, 0x4d, 0x1e, 0xd6, 0x8b, 0x8f, 0xc3, 0xa9, 0x7a, 0xd4,
+	0x5a, 0x1d, 0x83, 0xc7, 0x54, 0x36, 0xe3, 0xb7, 0x2c, 0xf8, 0x90, 0xc8, 0x70, 0x37, 0x56, 0xca,
+	0x59, 0xe6, 0x38, 0x42, 0x51, 0xed, 0xbc, 0x9e, 0x8b, 0xcb, 0xc8, 0x9d, 0x59, 0x78, 0x58, 0x57,
+	0xb2, 0x91, 0x2a, 0x39, 0x3d, 0xa3, 0x94, 0xe4, 0x32, 0xd3, 0x67, 0xeb, 0x4a, 0x36, 0x52, 0x25,
+	0xa7, 0xe7, 0x45, 0x92, 0x5c, 0x66, 0x72, 0x68, 0x5d, 0xc9, 0x46, 0xc6, 0xe4, 0x3e, 0x82, 0xe6,
+	0xd8, 0xbd, 0x8e, 0xae, 0x4d, 0x4f, 0x71, 0xac, 0xa7, 0x26, 0xe2, 0x95, 0x48, 0x2c, 0x3e, 0xfa,
+	0xab, 0xa7, 0x5f, 0xfb, 0x34, 0x6f, 0x2d, 0xe9, 0x40, 0xb9, 0xf4, 0xa6, 0x71, 0xc7, 0x40, 0xbb,
+	0xb0, 0xa0, 0x7c, 0x29, 0x66, 0x34, 0x4c, 0x75, 0xba, 0xfa, 0x7d, 0xdb, 0xba, 0x9c, 0x81, 0xd1,
+	0xa8, 0xbd, 0x0f, 0x73, 0xda, 0x6b, 0x0f, 0x79, 0x6e, 0xb3, 0x1e, 0xa9, 0x58, 0x6b, 0x99, 0x38,
+	0x8d, 0xde, 0x1b, 0x50, 0x91, 0xef, 0xd8, 0x91, 0xb8, 0x04, 0x52, 0xff, 0x23, 0x60, 0xad, 0xa4,
+	0xc1, 0xca, 0xc1, 0xff, 0x09, 0x34, 0xe9, 0xb5, 0xbc, 0xe9, 0xbb, 0xdc, 0x2c, 0x77, 0xbd, 0x1e,
+	0x96, 0x97, 0xba, 0xf2, 0x44, 0xdd, 0x42, 0x2a, 0x48, 0x59, 0xff, 0x16, 0xd4, 0xf6, 0x4f, 0x4f,
+	0xbe, 0x03, 0x07, 0x87, 0x25, 0xf6, 0x3f, 0x58, 0x2f, 0xfe, 0x6f, 0x00, 0xad, 0x3f, 0x68, 0xe6,
+	0x91, 0x35, 0x00, 0x00,
 }
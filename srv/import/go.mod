@@ -9,6 +9,7 @@ replace (
 	google.golang.org/genproto => google.golang.org/genproto v0.0.0-20191216164720-4f79533eabd1
 	google.golang.org/grpc => google.golang.org/grpc v1.26.0
 	rxcsoft.cn/k8s/go/web => ../../../k8s/go/web
+	rxcsoft.cn/pit3/lib/leasecalc => ../../lib/leasecalc
 	rxcsoft.cn/pit3/lib/logger => ../../lib/logger
 	rxcsoft.cn/pit3/lib/msg => ../../lib/msg
 	rxcsoft.cn/pit3/srv/database => ../database
@@ -39,6 +40,7 @@ require (
 	golang.org/x/text v0.3.6
 	gopkg.in/alexcesaro/quotedprintable.v3 v3.0.0-20150716171945-2caba252f4dc // indirect
 	gopkg.in/gomail.v2 v2.0.0-20160411212932-81ebce5c23df
+	rxcsoft.cn/pit3/lib/leasecalc v0.0.0-00010101000000-000000000000
 	rxcsoft.cn/pit3/lib/logger v0.0.0-00010101000000-000000000000
 	rxcsoft.cn/pit3/lib/msg v0.0.0-00010101000000-000000000000
 	rxcsoft.cn/pit3/srv/database v0.0.0-00010101000000-000000000000
@@ -192,6 +192,22 @@ func GetConfig(db, appID string) (cfg *app.Configs, err error) {
 	return response.GetApp().GetConfigs(), nil
 }
 
+// GetBooks 获取主账簿以外的会计账簿
+func GetBooks(db, appID string) (books []*app.Book, err error) {
+	configService := app.NewAppService("manage", client.DefaultClient)
+
+	var req app.FindAppRequest
+	req.AppId = appID
+	req.Database = db
+
+	response, err := configService.FindApp(context.TODO(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetApp().GetBooks(), nil
+}
+
 // charCount 获取文本的字数
 func CharCount(str string) int {
 	r := []rune(str)
@@ -0,0 +1,101 @@
+package lease
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/micro/go-micro/v2/client"
+	"github.com/spf13/cast"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+	"rxcsoft.cn/pit3/srv/import/common/loggerx"
+)
+
+// indexDatastoreKey 物价指数台账的apikey
+const indexDatastoreKey = "ds_priceindex"
+
+// escalationOf 导入数据上的支付额改定条款(未设定的场合返回nil)
+func escalationOf(cols map[string]*item.Value) (*leasecalc.Escalation, error) {
+	kind := cols["escalationkbn"].GetValue()
+	if len(kind) == 0 || kind == "null" {
+		return nil, nil
+	}
+
+	e := &leasecalc.Escalation{
+		Kind:      leasecalc.EscalationKind(kind),
+		IndexCode: cols["indexcode"].GetValue(),
+	}
+	var err error
+	if v := cols["escalationrate"].GetValue(); len(v) > 0 {
+		if e.Rate, err = cast.ToFloat64E(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := cols["escalationinterval"].GetValue(); len(v) > 0 {
+		if e.Interval, err = cast.ToIntE(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := cols["baseindex"].GetValue(); len(v) > 0 {
+		if e.BaseIndex, err = cast.ToFloat64E(v); err != nil {
+			return nil, err
+		}
+	}
+	// 阶梯改定以JSON形式保存([{"from":"2021-04","amount":110000}])
+	if v := cols["escalationsteps"].GetValue(); len(v) > 0 {
+		if err := json.Unmarshal([]byte(v), &e.Steps); err != nil {
+			return nil, err
+		}
+	}
+
+	return e, e.Validate()
+}
+
+// findIndexPoints 取得指数代码的已公表指数
+func findIndexPoints(db, appID, datastoreID, indexCode string) (indexes []leasecalc.IndexPoint, err error) {
+	if len(datastoreID) == 0 {
+		return nil, nil
+	}
+
+	itemService := item.NewItemService("database", client.DefaultClient)
+
+	var req item.ItemsRequest
+	req.ConditionList = []*item.Condition{
+		{
+			FieldId:     "indexcode",
+			FieldType:   "text",
+			SearchValue: indexCode,
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+	}
+	req.ConditionType = "and"
+	req.DatastoreId = datastoreID
+	req.AppId = appID
+	req.IsOrigin = true
+	req.Database = db
+
+	response, err := itemService.FindItems(context.TODO(), &req)
+	if err != nil {
+		loggerx.ErrorLog("findIndexPoints", err.Error())
+		return nil, err
+	}
+
+	for _, it := range response.GetItems() {
+		ym := it.GetItems()["indexym"].GetValue()
+		if len(ym) < 7 {
+			continue
+		}
+		value, err := cast.ToFloat64E(it.GetItems()["indexvalue"].GetValue())
+		if err != nil {
+			loggerx.ErrorLog("findIndexPoints", err.Error())
+			return nil, err
+		}
+		indexes = append(indexes, leasecalc.IndexPoint{
+			Ym:    ym[:7],
+			Value: value,
+		})
+	}
+
+	return indexes, nil
+}
@@ -0,0 +1,67 @@
+package lease
+
+import (
+	"context"
+
+	"github.com/micro/go-micro/v2/client"
+	"github.com/spf13/cast"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+	"rxcsoft.cn/pit3/srv/import/common/loggerx"
+)
+
+// assetsDatastoreKey 资产分类台账的apikey
+const assetsDatastoreKey = "assets"
+
+// findExemptionPolicy 取得资产分类的免除规定适用方针(资产分类未设定方针的场合返回nil)
+func findExemptionPolicy(db, appID, datastoreID, bunruicd string) (*leasecalc.ExemptionPolicy, error) {
+	if len(datastoreID) == 0 || len(bunruicd) == 0 || bunruicd == "null" {
+		return nil, nil
+	}
+
+	itemService := item.NewItemService("database", client.DefaultClient)
+
+	var req item.ItemsRequest
+	req.ConditionList = []*item.Condition{
+		{
+			FieldId:     "assets_class_id",
+			FieldType:   "text",
+			SearchValue: bunruicd,
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+	}
+	req.ConditionType = "and"
+	req.DatastoreId = datastoreID
+	req.AppId = appID
+	req.IsOrigin = true
+	req.Database = db
+
+	response, err := itemService.FindItems(context.TODO(), &req)
+	if err != nil {
+		loggerx.ErrorLog("findExemptionPolicy", err.Error())
+		return nil, err
+	}
+	if len(response.GetItems()) == 0 {
+		return nil, nil
+	}
+
+	items := response.GetItems()[0].GetItems()
+	minor := items["minorunitamount"].GetValue()
+	short := items["shortelection"].GetValue()
+	if len(minor) == 0 && len(short) == 0 {
+		return nil, nil
+	}
+
+	p := &leasecalc.ExemptionPolicy{AssetClass: bunruicd}
+	if len(minor) > 0 {
+		if p.MinorUnitAmount, err = leasecalc.ParseMoney(minor); err != nil {
+			loggerx.ErrorLog("findExemptionPolicy", err.Error())
+			return nil, err
+		}
+	}
+	p.ShortTerm = cast.ToBool(short)
+	p.ExcludeVariable = cast.ToBool(items["excludevariable"].GetValue())
+
+	return p, nil
+}
@@ -0,0 +1,70 @@
+package lease
+
+import (
+	"context"
+	"errors"
+
+	"github.com/micro/go-micro/v2/client"
+	"github.com/spf13/cast"
+	"rxcsoft.cn/pit3/lib/leasecalc"
+	"rxcsoft.cn/pit3/srv/database/proto/item"
+	"rxcsoft.cn/pit3/srv/import/common/loggerx"
+)
+
+// fxDatastoreKey 为替汇率台账的apikey
+const fxDatastoreKey = "ds_fxrate"
+
+// fxRateCheck 外币契约的取得时汇率(租赁开始月的汇率)是否已登录
+func fxRateCheck(db, appID, datastoreID, currency, leasestymd string) error {
+	if len(datastoreID) == 0 {
+		return errors.New("為替レート台帳が設定されていません")
+	}
+	if len(leasestymd) < 7 {
+		return errors.New("リース開始日が不正です")
+	}
+
+	itemService := item.NewItemService("database", client.DefaultClient)
+
+	var req item.ItemsRequest
+	req.ConditionList = []*item.Condition{
+		{
+			FieldId:     "currency",
+			FieldType:   "text",
+			SearchValue: currency,
+			Operator:    "=",
+			IsDynamic:   true,
+		},
+	}
+	req.ConditionType = "and"
+	req.DatastoreId = datastoreID
+	req.AppId = appID
+	req.IsOrigin = true
+	req.Database = db
+
+	response, err := itemService.FindItems(context.TODO(), &req)
+	if err != nil {
+		loggerx.ErrorLog("fxRateCheck", err.Error())
+		return err
+	}
+
+	var rates []leasecalc.FxRate
+	for _, it := range response.GetItems() {
+		ym := it.GetItems()["rateym"].GetValue()
+		if len(ym) < 7 {
+			continue
+		}
+		rate, err := cast.ToFloat64E(it.GetItems()["rate"].GetValue())
+		if err != nil {
+			loggerx.ErrorLog("fxRateCheck", err.Error())
+			return err
+		}
+		rates = append(rates, leasecalc.FxRate{
+			Currency: currency,
+			Ym:       ym[:7],
+			Rate:     rate,
+		})
+	}
+
+	_, err = leasecalc.RateAt(rates, currency, leasestymd[:7])
+	return err
+}
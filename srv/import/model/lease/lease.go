@@ -18,6 +18,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"golang.org/x/text/encoding/japanese"
 	"golang.org/x/text/transform"
+	"rxcsoft.cn/pit3/lib/leasecalc"
 	"rxcsoft.cn/pit3/lib/msg"
 	"rxcsoft.cn/pit3/srv/database/proto/datastore"
 	"rxcsoft.cn/pit3/srv/database/proto/field"
@@ -259,6 +260,31 @@ func Import(base Params, file FileParams) {
 		return
 	}
 
+	// 导入只计算主账簿的偿还数据和分录,设定了其他账簿的应用不受理
+	books, err := model.GetBooks(db, appID)
+	if err == nil && len(books) > 0 {
+		err = errors.New("複数の会計帳簿を設定したアプリでは、リース契約のCSVインポートは利用できません")
+	}
+	if err != nil {
+		store.Set(uploadID, "")
+		loggerx.ErrorLog("readCsvFileAndImport", err.Error())
+		path := filex.WriteAndSaveFile(domain, appID, []string{err.Error()})
+
+		// 发送消息 数据验证错误，停止上传
+		model.ModifyTask(task.ModifyRequest{
+			JobId:       jobID,
+			Message:     err.Error(),
+			CurrentStep: "check-data",
+			EndTime:     time.Now().UTC().Format("2006-01-02 15:04:05"),
+			ErrorFile: &task.File{
+				Url:  path.MediaLink,
+				Name: path.Name,
+			},
+			Database: db,
+		}, userID)
+		return
+	}
+
 	// 获取台账信息
 	datastoreService := datastore.NewDataStoreService("database", client.DefaultClient)
 
@@ -342,6 +368,9 @@ func Import(base Params, file FileParams) {
 		userID:      userID,
 		handleMonth: cfg.GetSyoriYm(),
 		beginMonth:  cfg.GetKishuYm(),
+		roundMode:   cfg.GetRoundingMode(),
+		costModel:   cfg.GetCostModel(),
+		functional:  cfg.GetFunctionalCurrency(),
 		smallAmount: cfg.GetMinorBaseAmount(),
 		shortPeriod: cfg.GetShortLeases(),
 		allFields:   fieldList,
@@ -1631,28 +1660,28 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 			})
 			return nil, nil, checkDataExistError
 		}
-		residual, err := cast.ToFloat64E(cols["residualValue"].GetValue())
+		residual, err := leasecalc.ParseMoney(cols["residualValue"].GetValue())
 		if err != nil {
 			checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "residualValue",
 				ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 			})
 			return nil, nil, checkDataExistError
 		}
-		paymentleasefee, err := cast.ToFloat64E(cols["paymentleasefee"].GetValue())
+		paymentleasefee, err := leasecalc.ParseMoney(cols["paymentleasefee"].GetValue())
 		if err != nil {
 			checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "paymentleasefee",
 				ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 			})
 			return nil, nil, checkDataExistError
 		}
-		firstleasefee, err := cast.ToFloat64E(cols["firstleasefee"].GetValue())
+		firstleasefee, err := leasecalc.ParseMoney(cols["firstleasefee"].GetValue())
 		// if err != nil {
 		// 	checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "firstleasefee",
 		// 		ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 		// 	})
 		// 	return nil, nil, checkDataExistError
 		// }
-		finalleasefee, err := cast.ToFloat64E(cols["finalleasefee"].GetValue())
+		finalleasefee, err := leasecalc.ParseMoney(cols["finalleasefee"].GetValue())
 		// if err != nil {
 		// 	checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "finalleasefee",
 		// 		ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -1703,14 +1732,14 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 		// 	})
 		// 	return nil, nil, checkDataExistError
 		// }
-		initialDirectCosts, err := cast.ToFloat64E(cols["initialDirectCosts"].GetValue())
+		initialDirectCosts, err := leasecalc.ParseMoney(cols["initialDirectCosts"].GetValue())
 		// if err != nil {
 		// 	checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "initialDirectCosts",
 		// 		ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 		// 	})
 		// 	return nil, nil, checkDataExistError
 		// }
-		restorationCosts, err := cast.ToFloat64E(cols["restorationCosts"].GetValue())
+		restorationCosts, err := leasecalc.ParseMoney(cols["restorationCosts"].GetValue())
 		// if err != nil {
 		// 	checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "restorationCosts",
 		// 		ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -1727,7 +1756,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 		torihikikbn := cols["torihikikbn"].GetValue()
 		// leasekaishacd := cols["leasekaishacd"].GetValue()
 		sykshisankeisan := cols["sykshisankeisan"].GetValue()
-		if sykshisankeisan == "1" || sykshisankeisan == "2" {
+		if sykshisankeisan == "1" || sykshisankeisan == "2" || sykshisankeisan == "3" {
 		} else {
 			checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "sykshisankeisan",
 				ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -1761,6 +1790,8 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 			req.DatastoreId = responseDid.GetDatastore().DatastoreId
 			req.Leasekikan = cols["leasekikan"].GetValue()
 			req.Leasestymd = leasestymdStr
+			// 按契约的通货检索利率(未设定的场合使用功能通货)
+			req.Currency = leasecalc.CurveCurrency(cols["currency"].GetValue(), p.functional)
 			req.Database = p.db
 
 			response, err := itemService.FindRishiritsu(context.TODO(), &req)
@@ -1771,11 +1802,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				return nil, nil, checkDataExistError
 			}
 
-			for key, value := range response.GetItem().GetItems() {
-				if key == "rishiritsu" {
-					rishiritsu = cast.ToFloat64(value.GetValue())
-				}
-			}
+			rishiritsu = response.GetRishiritsu()
 
 			cols["rishiritsu"] = &item.Value{
 				DataType: "number",
@@ -1816,6 +1843,28 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				Firstleasefee: firstleasefee,
 				Finalleasefee: finalleasefee,
 				Keiyakuno:     keiyakuno,
+				Rounding:      leasecalc.Rounding{Mode: leasecalc.ParseRoundingMode(p.roundMode)},
+			}
+			// 支付计划(指定的场合按计划的区间生成支付数据)
+			if plan := cols["payplan"].GetValue(); len(plan) > 0 && plan != "null" {
+				q.Plan = plan
+			}
+			// 支付额改定条款(指数联动的场合使用已公表的指数)
+			q.Escalation, err = escalationOf(cols)
+			if err != nil {
+				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "escalationkbn",
+					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
+				})
+				return nil, nil, checkDataExistError
+			}
+			if q.Escalation != nil && q.Escalation.Kind == leasecalc.EscalationIndex {
+				q.Indexes, err = findIndexPoints(p.db, p.appID, p.dsMap[indexDatastoreKey], q.Escalation.IndexCode)
+				if err != nil {
+					checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "indexcode",
+						ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
+					})
+					return nil, nil, checkDataExistError
+				}
 			}
 			pay, err := generatePay(q)
 			if err != nil {
@@ -1823,13 +1872,46 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				return nil, nil, checkDataExistError
 			}
 			payData = pay
+			// 按支付计划生成的场合,支付回数为计划上的支付回数
+			if len(q.Plan) > 0 {
+				counts := 0
+				for _, pay := range payData {
+					if pay.PaymentType == "支払" {
+						counts++
+					}
+				}
+				cols["paymentcounts"] = &item.Value{
+					DataType: "number",
+					Value:    cast.ToString(counts),
+				}
+			}
+		}
+
+		// 外币契约的场合,租赁开始月的汇率(取得时汇率)必须已登录
+		if currency := cols["currency"].GetValue(); currency != "null" && leasecalc.IsForeignCurrency(currency, p.functional) {
+			if err := fxRateCheck(p.db, p.appID, p.dsMap[fxDatastoreKey], currency, leasestymd); err != nil {
+				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "currency", ErrorMsg: err.Error()})
+				return nil, nil, checkDataExistError
+			}
 		}
 
-		leaseType := shortOrMinorJudge(p.db, p.appID, p.smallAmount, p.shortPeriod, leasekikan, extentionOption, payData)
+		// 短期リースまたは少額リース判定(资产分类的方针优先)
+		policy, err := findExemptionPolicy(p.db, p.appID, p.dsMap[assetsDatastoreKey], cols["bunruicd"].GetValue())
+		if err != nil {
+			checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "bunruicd", ErrorMsg: err.Error()})
+			return nil, nil, checkDataExistError
+		}
+		exemption := exemptionJudge(policy, p.smallAmount, p.shortPeriod, cast.ToInt(cols["suryo"].GetValue()), leasekikan, extentionOption, payData)
+		leaseType := exemption.LeaseType
 		cols["lease_type"] = &item.Value{
 			DataType: "options",
 			Value:    leaseType,
 		}
+		// 判定理由
+		cols["exemption_reason"] = &item.Value{
+			DataType: "text",
+			Value:    exemption.Reason,
+		}
 
 		expireymd, err := getExpireymd(leasestymd, leasekikan, extentionOption)
 		if err != nil {
@@ -1884,6 +1966,9 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				DsMap:                   p.dsMap,
 				HandleMonth:             p.handleMonth,
 				BeginMonth:              p.beginMonth,
+				RoundingMode:            p.roundMode,
+				CostModel:               p.costModel,
+				KeiyakuCostModel:        cols["costmodel"].GetValue(),
 				FirstMonth:              p.firstMonth,
 				// Leasekaishacd:           leasekaishacd,
 				Sykshisankeisan: sykshisankeisan,
@@ -2061,35 +2146,35 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			paymentleasefee, err := cast.ToFloat64E(it.Items["paymentleasefee"].GetValue())
+			paymentleasefee, err := leasecalc.ParseMoney(it.Items["paymentleasefee"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "paymentleasefee",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			paymentleasefeehendo, err := cast.ToFloat64E(it.Items["paymentleasefeehendo"].GetValue())
+			paymentleasefeehendo, err := leasecalc.ParseMoney(it.Items["paymentleasefeehendo"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "paymentleasefeehendo",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			incentives, err := cast.ToFloat64E(it.Items["incentives"].GetValue())
+			incentives, err := leasecalc.ParseMoney(it.Items["incentives"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "incentives",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			sonotafee, err := cast.ToFloat64E(it.Items["sonotafee"].GetValue())
+			sonotafee, err := leasecalc.ParseMoney(it.Items["sonotafee"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "sonotafee",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			kaiyakuson, err := cast.ToFloat64E(it.Items["kaiyakuson"].GetValue())
+			kaiyakuson, err := leasecalc.ParseMoney(it.Items["kaiyakuson"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "kaiyakuson",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
@@ -2144,28 +2229,28 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 		}
 
 		for _, it := range lResp.GetItems() {
-			interest, err := cast.ToFloat64E(it.Items["interest"].GetValue())
+			interest, err := leasecalc.ParseMoney(it.Items["interest"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "interest",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			repayment, err := cast.ToFloat64E(it.Items["repayment"].GetValue())
+			repayment, err := leasecalc.ParseMoney(it.Items["repayment"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "repayment",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			balance, err := cast.ToFloat64E(it.Items["balance"].GetValue())
+			balance, err := leasecalc.ParseMoney(it.Items["balance"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "balance",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			present, err := cast.ToFloat64E(it.Items["present"].GetValue())
+			present, err := leasecalc.ParseMoney(it.Items["present"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "present",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
@@ -2173,6 +2258,8 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				return nil, nil, checkDataExistError
 			}
 
+			// 最终回端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
 			paymentymd := it.Items["paymentymd"].GetValue()
 
 			lease := Lease{
@@ -2180,6 +2267,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				Repayment:  repayment,
 				Balance:    balance,
 				Present:    present,
+				Plug:       plug,
 				Paymentymd: paymentymd,
 			}
 
@@ -2208,21 +2296,21 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 		}
 
 		for _, it := range rResp.GetItems() {
-			endboka, err := cast.ToFloat64E(it.Items["endboka"].GetValue())
+			endboka, err := leasecalc.ParseMoney(it.Items["endboka"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "endboka",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			boka, err := cast.ToFloat64E(it.Items["boka"].GetValue())
+			boka, err := leasecalc.ParseMoney(it.Items["boka"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "boka",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			syokyaku, err := cast.ToFloat64E(it.Items["syokyaku"].GetValue())
+			syokyaku, err := leasecalc.ParseMoney(it.Items["syokyaku"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "syokyaku",
 					ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容: %v", err),
@@ -2230,6 +2318,10 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				return nil, nil, checkDataExistError
 			}
 
+			// 最终月端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+			// 单一リース費用(经营租赁,任意项目)
+			leasecost, _ := leasecalc.ParseMoney(it.Items["leasecost"].GetValue())
 			syokyakuymd := it.Items["syokyakuymd"].GetValue()
 			syokyakukbn := it.Items["syokyakukbn"].GetValue()
 
@@ -2237,6 +2329,8 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				Endboka:     endboka,
 				Boka:        boka,
 				Syokyaku:    syokyaku,
+				Plug:        plug,
+				Leasecost:   leasecost,
 				Syokyakuymd: syokyakuymd,
 				Syokyakukbn: syokyakukbn,
 			}
@@ -2264,7 +2358,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 			})
 			return nil, nil, checkDataExistError
 		}
-		paymentleasefee, err := cast.ToFloat64E(oldItem.Items["paymentleasefee"].GetValue())
+		paymentleasefee, err := leasecalc.ParseMoney(oldItem.Items["paymentleasefee"].GetValue())
 		if err != nil {
 			checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "paymentleasefee",
 				ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -2288,9 +2382,9 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 		}
 
 		// 从变更数据中获取，如果获取不到，则从变更前的数据中获取既定值(可变更非必须)
-		var residual float64
+		var residual leasecalc.Money
 		if val, ok := cols["residualValue"]; ok {
-			num, err := cast.ToFloat64E(val.GetValue())
+			num, err := leasecalc.ParseMoney(val.GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "residualValue",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -2299,7 +2393,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 			}
 			residual = num
 		} else {
-			num, err := cast.ToFloat64E(oldItem.Items["residualValue"].GetValue())
+			num, err := leasecalc.ParseMoney(oldItem.Items["residualValue"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "residualValue",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -2308,9 +2402,9 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 			}
 			residual = num
 		}
-		var optionTo float64
+		var optionTo leasecalc.Money
 		if val, ok := cols["optionToPurchase"]; ok {
-			num, err := cast.ToFloat64E(val.GetValue())
+			num, err := leasecalc.ParseMoney(val.GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "optionToPurchase",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -2319,7 +2413,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 			}
 			optionTo = num
 		} else {
-			num, err := cast.ToFloat64E(oldItem.Items["optionToPurchase"].GetValue())
+			num, err := leasecalc.ParseMoney(oldItem.Items["optionToPurchase"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "optionToPurchase",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -2357,7 +2451,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 			})
 			return nil, nil, checkDataExistError
 		}
-		kiSyuBoka, err := cast.ToFloat64E(oldItem.Items["kisyuboka"].GetValue())
+		kiSyuBoka, err := leasecalc.ParseMoney(oldItem.Items["kisyuboka"].GetValue())
 		if err != nil {
 			checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容：: %v", err)})
 			return nil, nil, checkDataExistError
@@ -2402,7 +2496,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容：: %v", err)})
 				return nil, nil, checkDataExistError
 			}
-			residual, err = cast.ToFloat64E(oldItem.Items["residualValue"].GetValue())
+			residual, err = leasecalc.ParseMoney(oldItem.Items["residualValue"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容：: %v", err)})
 				return nil, nil, checkDataExistError
@@ -2412,7 +2506,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容：: %v", err)})
 				return nil, nil, checkDataExistError
 			}
-			optionTo, err = cast.ToFloat64E(oldItem.Items["optionToPurchase"].GetValue())
+			optionTo, err = leasecalc.ParseMoney(oldItem.Items["optionToPurchase"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, ErrorMsg: fmt.Sprintf("エラーが発生した計算結果で発生し、エラーの内容：: %v", err)})
 				return nil, nil, checkDataExistError
@@ -2546,6 +2640,9 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 			Item:                    oldItem,
 			HandleMonth:             p.handleMonth,
 			BeginMonth:              p.beginMonth,
+			RoundingMode:            p.roundMode,
+			CostModel:               p.costModel,
+			KeiyakuCostModel:        oldItem.Items["costmodel"].GetValue(),
 			Change:                  rowMap,
 			seq:                     seq.String(),
 		}
@@ -2669,35 +2766,35 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			paymentleasefee, err := cast.ToFloat64E(it.Items["paymentleasefee"].GetValue())
+			paymentleasefee, err := leasecalc.ParseMoney(it.Items["paymentleasefee"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "paymentleasefee",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			paymentleasefeehendo, err := cast.ToFloat64E(it.Items["paymentleasefeehendo"].GetValue())
+			paymentleasefeehendo, err := leasecalc.ParseMoney(it.Items["paymentleasefeehendo"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "paymentleasefeehendo",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			incentives, err := cast.ToFloat64E(it.Items["incentives"].GetValue())
+			incentives, err := leasecalc.ParseMoney(it.Items["incentives"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "incentives",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			sonotafee, err := cast.ToFloat64E(it.Items["sonotafee"].GetValue())
+			sonotafee, err := leasecalc.ParseMoney(it.Items["sonotafee"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "sonotafee",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			kaiyakuson, err := cast.ToFloat64E(it.Items["kaiyakuson"].GetValue())
+			kaiyakuson, err := leasecalc.ParseMoney(it.Items["kaiyakuson"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "kaiyakuson",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -2752,28 +2849,28 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 		}
 
 		for _, it := range lResp.GetItems() {
-			interest, err := cast.ToFloat64E(it.Items["interest"].GetValue())
+			interest, err := leasecalc.ParseMoney(it.Items["interest"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "interest",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			repayment, err := cast.ToFloat64E(it.Items["repayment"].GetValue())
+			repayment, err := leasecalc.ParseMoney(it.Items["repayment"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "repayment",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			balance, err := cast.ToFloat64E(it.Items["balance"].GetValue())
+			balance, err := leasecalc.ParseMoney(it.Items["balance"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "balance",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			present, err := cast.ToFloat64E(it.Items["present"].GetValue())
+			present, err := leasecalc.ParseMoney(it.Items["present"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "present",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -2781,6 +2878,8 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				return nil, nil, checkDataExistError
 			}
 
+			// 最终回端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
 			paymentymd := it.Items["paymentymd"].GetValue()
 
 			lease := Lease{
@@ -2788,6 +2887,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				Repayment:  repayment,
 				Balance:    balance,
 				Present:    present,
+				Plug:       plug,
 				Paymentymd: paymentymd,
 			}
 
@@ -2816,21 +2916,21 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 		}
 
 		for _, it := range rResp.GetItems() {
-			endboka, err := cast.ToFloat64E(it.Items["endboka"].GetValue())
+			endboka, err := leasecalc.ParseMoney(it.Items["endboka"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "endboka",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			boka, err := cast.ToFloat64E(it.Items["boka"].GetValue())
+			boka, err := leasecalc.ParseMoney(it.Items["boka"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "boka",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
 				})
 				return nil, nil, checkDataExistError
 			}
-			syokyaku, err := cast.ToFloat64E(it.Items["syokyaku"].GetValue())
+			syokyaku, err := leasecalc.ParseMoney(it.Items["syokyaku"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{CurrentLine: line, FieldId: "syokyaku",
 					ErrorMsg: fmt.Sprintf("支払いテーブルの生成にエラーがあり、エラーの内容: %v", err),
@@ -2838,6 +2938,10 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				return nil, nil, checkDataExistError
 			}
 
+			// 最终月端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+			// 单一リース費用(经营租赁,任意项目)
+			leasecost, _ := leasecalc.ParseMoney(it.Items["leasecost"].GetValue())
 			syokyakuymd := it.Items["syokyakuymd"].GetValue()
 			syokyakukbn := it.Items["syokyakukbn"].GetValue()
 
@@ -2845,6 +2949,8 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				Endboka:     endboka,
 				Boka:        boka,
 				Syokyaku:    syokyaku,
+				Plug:        plug,
+				Leasecost:   leasecost,
 				Syokyakuymd: syokyakuymd,
 				Syokyakukbn: syokyakukbn,
 			}
@@ -2987,7 +3093,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 		}
 
 		for _, it := range rResp.GetItems() {
-			endboka, err := cast.ToFloat64E(it.Items["endboka"].GetValue())
+			endboka, err := leasecalc.ParseMoney(it.Items["endboka"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -2996,7 +3102,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			boka, err := cast.ToFloat64E(it.Items["boka"].GetValue())
+			boka, err := leasecalc.ParseMoney(it.Items["boka"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3005,7 +3111,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			syokyaku, err := cast.ToFloat64E(it.Items["syokyaku"].GetValue())
+			syokyaku, err := leasecalc.ParseMoney(it.Items["syokyaku"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3015,6 +3121,10 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				return nil, nil, checkDataExistError
 			}
 
+			// 最终月端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+			// 单一リース費用(经营租赁,任意项目)
+			leasecost, _ := leasecalc.ParseMoney(it.Items["leasecost"].GetValue())
 			syokyakuymd := it.Items["syokyakuymd"].GetValue()
 			syokyakukbn := it.Items["syokyakukbn"].GetValue()
 
@@ -3022,6 +3132,8 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				Endboka:     endboka,
 				Boka:        boka,
 				Syokyaku:    syokyaku,
+				Plug:        plug,
+				Leasecost:   leasecost,
 				Syokyakuymd: syokyakuymd,
 				Syokyakukbn: syokyakukbn,
 			}
@@ -3201,7 +3313,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			paymentleasefee, err := cast.ToFloat64E(it.Items["paymentleasefee"].GetValue())
+			paymentleasefee, err := leasecalc.ParseMoney(it.Items["paymentleasefee"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3210,7 +3322,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			paymentleasefeehendo, err := cast.ToFloat64E(it.Items["paymentleasefeehendo"].GetValue())
+			paymentleasefeehendo, err := leasecalc.ParseMoney(it.Items["paymentleasefeehendo"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3219,7 +3331,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			incentives, err := cast.ToFloat64E(it.Items["incentives"].GetValue())
+			incentives, err := leasecalc.ParseMoney(it.Items["incentives"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3228,7 +3340,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			sonotafee, err := cast.ToFloat64E(it.Items["sonotafee"].GetValue())
+			sonotafee, err := leasecalc.ParseMoney(it.Items["sonotafee"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3237,7 +3349,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			kaiyakuson, err := cast.ToFloat64E(it.Items["kaiyakuson"].GetValue())
+			kaiyakuson, err := leasecalc.ParseMoney(it.Items["kaiyakuson"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3300,7 +3412,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 		}
 
 		for _, it := range lResp.GetItems() {
-			interest, err := cast.ToFloat64E(it.Items["interest"].GetValue())
+			interest, err := leasecalc.ParseMoney(it.Items["interest"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3309,7 +3421,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			repayment, err := cast.ToFloat64E(it.Items["repayment"].GetValue())
+			repayment, err := leasecalc.ParseMoney(it.Items["repayment"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3318,7 +3430,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			balance, err := cast.ToFloat64E(it.Items["balance"].GetValue())
+			balance, err := leasecalc.ParseMoney(it.Items["balance"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3327,7 +3439,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			present, err := cast.ToFloat64E(it.Items["present"].GetValue())
+			present, err := leasecalc.ParseMoney(it.Items["present"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3337,6 +3449,8 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				continue
 			}
 
+			// 最终回端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
 			paymentymd := it.Items["paymentymd"].GetValue()
 
 			lease := Lease{
@@ -3344,6 +3458,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				Repayment:  repayment,
 				Balance:    balance,
 				Present:    present,
+				Plug:       plug,
 				Paymentymd: paymentymd,
 			}
 
@@ -3375,7 +3490,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 		}
 
 		for _, it := range rResp.GetItems() {
-			endboka, err := cast.ToFloat64E(it.Items["endboka"].GetValue())
+			endboka, err := leasecalc.ParseMoney(it.Items["endboka"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3384,7 +3499,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			boka, err := cast.ToFloat64E(it.Items["boka"].GetValue())
+			boka, err := leasecalc.ParseMoney(it.Items["boka"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3393,7 +3508,7 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				})
 				return nil, nil, checkDataExistError
 			}
-			syokyaku, err := cast.ToFloat64E(it.Items["syokyaku"].GetValue())
+			syokyaku, err := leasecalc.ParseMoney(it.Items["syokyaku"].GetValue())
 			if err != nil {
 				checkDataExistError = append(checkDataExistError, &item.Error{
 					CurrentLine: line,
@@ -3403,6 +3518,10 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				return nil, nil, checkDataExistError
 			}
 
+			// 最终月端数调整额(任意项目)
+			plug, _ := leasecalc.ParseMoney(it.Items["plug"].GetValue())
+			// 单一リース費用(经营租赁,任意项目)
+			leasecost, _ := leasecalc.ParseMoney(it.Items["leasecost"].GetValue())
 			syokyakuymd := it.Items["syokyakuymd"].GetValue()
 			syokyakukbn := it.Items["syokyakukbn"].GetValue()
 
@@ -3410,6 +3529,8 @@ func checkAndBuildItems(p checkParam) (list *item.ListItems, attachItems attachD
 				Endboka:     endboka,
 				Boka:        boka,
 				Syokyaku:    syokyaku,
+				Plug:        plug,
+				Leasecost:   leasecost,
 				Syokyakuymd: syokyakuymd,
 				Syokyakukbn: syokyakukbn,
 			}
@@ -27,6 +27,9 @@ type checkParam struct {
 	firstMonth  string //比较开始期首月度
 	handleMonth string // 处理年月度
 	beginMonth  string // 期首月度
+	roundMode   string // 金额端数处理方式
+	costModel   string // 租赁费用计上方式
+	functional  string // 功能通货
 	smallAmount string // 少额租赁范围
 	shortPeriod string // 短期租赁范围
 	specialchar string
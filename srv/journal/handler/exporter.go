@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+
+	"rxcsoft.cn/pit3/srv/journal/model/exporter"
+	"rxcsoft.cn/pit3/srv/journal/proto/journal"
+	"rxcsoft.cn/pit3/srv/journal/utils"
+)
+
+// log出力使用
+const (
+	ActionFindExporters = "FindExporters"
+	ActionExportJournal = "ExportJournal"
+)
+
+// exporterProto 出力文件的形式转换为proto数据
+func exporterProto(f exporter.Format) *journal.Exporter {
+	return &journal.Exporter{
+		Name:          f.Name,
+		DisplayName:   f.DisplayName,
+		CharEncoding:  f.CharEncoding,
+		SeparatorChar: f.Separator,
+		Extension:     f.Extension,
+	}
+}
+
+// FindExporters 获取登录的分录出力适配器
+func (f *Journal) FindExporters(ctx context.Context, req *journal.FindExportersRequest, rsp *journal.FindExportersResponse) error {
+	utils.InfoLog(ActionFindExporters, utils.MsgProcessStarted)
+
+	for _, e := range exporter.Exporters() {
+		rsp.Exporters = append(rsp.Exporters, exporterProto(e.Format()))
+	}
+
+	utils.InfoLog(ActionFindExporters, utils.MsgProcessEnded)
+	return nil
+}
+
+// ExportJournal 分录明细按适配器的形式编辑,返回出力行和验证结果
+func (f *Journal) ExportJournal(ctx context.Context, req *journal.ExportRequest, rsp *journal.ExportResponse) error {
+	utils.InfoLog(ActionExportJournal, utils.MsgProcessStarted)
+
+	var lines []exporter.Line
+	for _, l := range req.GetLines() {
+		lines = append(lines, l.GetItems())
+	}
+
+	options := exporter.Options{
+		AccountCodes: req.GetAccountCodes(),
+		TaxCodes:     req.GetTaxCodes(),
+		CompanyCode:  req.GetCompanyCode(),
+		Currency:     req.GetCurrency(),
+	}
+
+	result, err := exporter.Export(req.GetExporter(), lines, options, int(req.GetPreview()))
+	if err != nil {
+		utils.ErrorLog(ActionExportJournal, err.Error())
+		return err
+	}
+
+	rsp.Exporter = exporterProto(result.Format)
+	for _, row := range result.Rows {
+		rsp.Rows = append(rsp.Rows, &journal.ExportRow{
+			Cells: row,
+		})
+	}
+	rsp.HeaderRows = int64(result.HeaderRows)
+	rsp.Vouchers = int64(result.Vouchers)
+	rsp.Lines = int64(result.Lines)
+	for _, i := range result.Issues {
+		rsp.Issues = append(rsp.Issues, &journal.ExportIssue{
+			Level:     i.Level,
+			Shiwakeno: i.Shiwakeno,
+			Voucher:   int64(i.Voucher),
+			Line:      int64(i.Line),
+			Message:   i.String(),
+		})
+	}
+
+	utils.InfoLog(ActionExportJournal, utils.MsgProcessEnded)
+	return nil
+}
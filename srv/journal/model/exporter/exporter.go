@@ -0,0 +1,357 @@
+package exporter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/text/encoding/japanese"
+
+	"rxcsoft.cn/pit3/lib/leasecalc"
+)
+
+// 文字编码
+const (
+	EncodingShiftJIS = "Shift-JIS"
+	EncodingUTF8     = "UTF-8"
+)
+
+// 验证结果的级别
+const (
+	LevelError   = "error"   // 错误(不能下载)
+	LevelWarning = "warning" // 警告(可以下载)
+)
+
+// Format 出力文件的形式
+type Format struct {
+	Name         string `json:"name"`           // 适配器名
+	DisplayName  string `json:"display_name"`   // 显示名称
+	CharEncoding string `json:"char_encoding"`  // 文字编码
+	Separator    string `json:"separator_char"` // 分隔符
+	Extension    string `json:"extension"`      // 文件扩展名
+}
+
+// Line 出力对象的分录明细(分录台账的字段值)
+type Line map[string]string
+
+// Entry 传票的明细
+type Entry struct {
+	Line     int             // 输入中的行号(1开始)
+	Division string          // 贷借区分
+	Subject  string          // 勘定科目
+	Amount   leasecalc.Money // 金额
+	Remark   string          // 摘要
+}
+
+// Voucher 传票(同一生成单位的分录明细)
+type Voucher struct {
+	No        int       // 传票番号(文件内的连番)
+	Shiwakeno string    // 分录番号
+	Date      time.Time // 计上日
+	Keiyakuno string    // 契约番号
+	Remark    string    // 摘要
+	Entries   []Entry   // 明细
+}
+
+// Options 出力选项
+type Options struct {
+	AccountCodes map[string]string // 勘定科目对应的科目代码
+	TaxCodes     map[string]string // 勘定科目对应的税区分
+	CompanyCode  string            // 公司代码
+	Currency     string            // 货币
+}
+
+// TaxCode 勘定科目的税区分(未设定的场合为def)
+func (o Options) TaxCode(subject, def string) string {
+	if code, ok := o.TaxCodes[subject]; ok && len(code) > 0 {
+		return code
+	}
+	return def
+}
+
+// Issue 验证结果
+type Issue struct {
+	Level     string `json:"level"`     // 级别
+	Shiwakeno string `json:"shiwakeno"` // 分录番号
+	Voucher   int    `json:"voucher"`   // 传票番号
+	Line      int    `json:"line"`      // 明细行号
+	Message   string `json:"message"`   // 内容
+}
+
+// String 验证结果的文字列(错误文件用)
+func (i Issue) String() string {
+	level := "エラー"
+	if i.Level == LevelWarning {
+		level = "警告"
+	}
+	if i.Line > 0 {
+		return fmt.Sprintf("[%s]仕訳番号[%s]の伝票%d、%d行目:%s", level, i.Shiwakeno, i.Voucher, i.Line, i.Message)
+	}
+	return fmt.Sprintf("[%s]仕訳番号[%s]の伝票%d:%s", level, i.Shiwakeno, i.Voucher, i.Message)
+}
+
+// Exporter 分录出力适配器
+type Exporter interface {
+	// Format 出力文件的形式
+	Format() Format
+	// Header 文件的头部行(没有的场合为nil)
+	Header() [][]string
+	// Rows 传票编辑为出力行,同时返回适配器固有的验证结果
+	Rows(v *Voucher, o Options) ([][]string, []Issue)
+}
+
+var (
+	mu        sync.RWMutex
+	exporters = make(map[string]Exporter)
+)
+
+// Register 登录适配器(同名的场合覆盖)
+func Register(e Exporter) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	exporters[e.Format().Name] = e
+}
+
+// Lookup 按名称取得适配器
+func Lookup(name string) (Exporter, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	e, ok := exporters[name]
+	return e, ok
+}
+
+// Exporters 登录的全部适配器(按名称排序)
+func Exporters() []Exporter {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var result []Exporter
+	for _, e := range exporters {
+		result = append(result, e)
+	}
+	sort.Slice(result, func(a, b int) bool {
+		return result[a].Format().Name < result[b].Format().Name
+	})
+	return result
+}
+
+// Result 出力结果
+type Result struct {
+	Format     Format     // 出力文件的形式
+	Rows       [][]string // 出力行(包含头部行)
+	HeaderRows int        // 头部行数
+	Vouchers   int        // 传票件数
+	Lines      int        // 明细件数
+	Issues     []Issue    // 验证结果
+}
+
+// HasError 是否有错误级别的验证结果
+func (r *Result) HasError() bool {
+	for _, i := range r.Issues {
+		if i.Level == LevelError {
+			return true
+		}
+	}
+	return false
+}
+
+// Export 分录明细编辑为传票,按适配器的形式出力
+// 验证对象为全部传票,preview大于0的场合出力行只返回最初的preview件传票
+func Export(name string, lines []Line, o Options, preview int) (*Result, error) {
+	e, ok := Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("出力形式[%s]は登録されていません", name)
+	}
+
+	vouchers, issues := buildVouchers(lines)
+
+	result := &Result{
+		Format:   e.Format(),
+		Vouchers: len(vouchers),
+		Lines:    len(lines),
+		Issues:   issues,
+	}
+	header := e.Header()
+	result.Rows = append(result.Rows, header...)
+	result.HeaderRows = len(header)
+
+	for _, v := range vouchers {
+		rows, is := e.Rows(v, o)
+		result.Issues = append(result.Issues, is...)
+		if result.Format.CharEncoding == EncodingShiftJIS {
+			result.Issues = append(result.Issues, checkShiftJIS(v, rows)...)
+		}
+		if preview <= 0 || v.No <= preview {
+			result.Rows = append(result.Rows, rows...)
+		}
+	}
+	// 验证结果按传票和明细的顺序排列
+	sort.SliceStable(result.Issues, func(a, b int) bool {
+		if result.Issues[a].Voucher != result.Issues[b].Voucher {
+			return result.Issues[a].Voucher < result.Issues[b].Voucher
+		}
+		return result.Issues[a].Line < result.Issues[b].Line
+	})
+
+	return result, nil
+}
+
+// voucherKey 传票的生成单位(与贷借平衡验证的单位相同)
+func voucherKey(l Line) leasecalc.JournalLine {
+	group := l["historyno"]
+	if len(group) == 0 {
+		group = l["shiwakeaggno_parent"]
+	}
+	return leasecalc.JournalLine{
+		Shiwakeno: l["shiwakeno"],
+		Group:     group,
+		Pattern:   l["partten"],
+		Keiyakuno: l["keiyakuno"],
+		Book:      leasecalc.BookOf(l["book"]),
+	}
+}
+
+// buildVouchers 分录明细按生成单位编辑为传票,同时验证明细和贷借平衡
+func buildVouchers(lines []Line) ([]*Voucher, []Issue) {
+	var vouchers []*Voucher
+	var issues []Issue
+	index := make(map[leasecalc.JournalLine]*Voucher)
+	balance := make(map[*Voucher][]leasecalc.JournalLine)
+
+	for i, l := range lines {
+		key := voucherKey(l)
+		v, ok := index[key]
+		if !ok {
+			v = &Voucher{
+				No:        len(vouchers) + 1,
+				Shiwakeno: key.Shiwakeno,
+				Keiyakuno: key.Keiyakuno,
+				Remark:    l["remark"],
+			}
+			date := l["keijoudate"]
+			if len(date) == 0 || strings.HasPrefix(date, "0001-01-01") {
+				date = l["shiwakeymd"]
+			}
+			if d, err := time.Parse("2006-01-02", date); err == nil {
+				v.Date = d
+			} else {
+				issues = append(issues, Issue{LevelError, v.Shiwakeno, v.No, i + 1, "計上日が不正です"})
+			}
+			index[key] = v
+			vouchers = append(vouchers, v)
+		}
+
+		amount, err := leasecalc.ParseMoney(l["shiwakekingaku"])
+		if err != nil {
+			issues = append(issues, Issue{LevelError, v.Shiwakeno, v.No, i + 1, "仕訳金額が不正です"})
+			continue
+		}
+		if len(l["kanjokamoku"]) == 0 {
+			issues = append(issues, Issue{LevelError, v.Shiwakeno, v.No, i + 1, "勘定科目が設定されていません"})
+			continue
+		}
+		bl := key
+		bl.Division = l["taishakukubun"]
+		bl.Amount = amount
+		balance[v] = append(balance[v], bl)
+
+		// 金额为0的明细不出力
+		if amount == 0 {
+			issues = append(issues, Issue{LevelWarning, v.Shiwakeno, v.No, i + 1, "金額が0のため出力しません"})
+			continue
+		}
+		entry := Entry{
+			Line:     i + 1,
+			Division: l["taishakukubun"],
+			Subject:  l["kanjokamoku"],
+			Amount:   amount,
+			Remark:   l["remark"],
+		}
+		// 负数的金额贷借对调后出力
+		if amount < 0 {
+			entry.Division = leasecalc.ReverseDivision(entry.Division)
+			entry.Amount = -amount
+		}
+		v.Entries = append(v.Entries, entry)
+	}
+
+	for _, v := range vouchers {
+		for _, im := range leasecalc.CheckJournalBalance(balance[v]) {
+			issues = append(issues, Issue{LevelError, v.Shiwakeno, v.No, 0, im.Error()})
+		}
+		if len(v.Entries) == 0 {
+			issues = append(issues, Issue{LevelWarning, v.Shiwakeno, v.No, 0, "出力する明細がありません"})
+		}
+	}
+
+	return vouchers, issues
+}
+
+// pairEntries 传票的借方明细和贷方明细按顺序组成一行(借方和贷方的件数不同的场合,多余的行只有一方)
+func pairEntries(v *Voucher) [][2]*Entry {
+	var debits, credits []*Entry
+	for i := range v.Entries {
+		e := &v.Entries[i]
+		if e.Division == leasecalc.DebitDivision {
+			debits = append(debits, e)
+		} else {
+			credits = append(credits, e)
+		}
+	}
+
+	n := len(debits)
+	if len(credits) > n {
+		n = len(credits)
+	}
+	pairs := make([][2]*Entry, n)
+	for i := range pairs {
+		if i < len(debits) {
+			pairs[i][0] = debits[i]
+		}
+		if i < len(credits) {
+			pairs[i][1] = credits[i]
+		}
+	}
+	return pairs
+}
+
+// yenAmount 日元金额(会计软件只能导入整数金额,有小数的场合返回错误)
+func yenAmount(v *Voucher, e *Entry) (string, []Issue) {
+	if e.Amount != (leasecalc.Rounding{Mode: leasecalc.RoundTruncate}).Round(e.Amount.Rat()) {
+		return e.Amount.String(), []Issue{{LevelError, v.Shiwakeno, v.No, e.Line, "金額に小数点以下の端数があります"}}
+	}
+	return e.Amount.String(), nil
+}
+
+// truncate 超过最大字节数(Shift-JIS换算)的文字列截断,截断的场合返回警告
+func truncate(v *Voucher, line int, name, s string, max int) (string, []Issue) {
+	size := 0
+	for i, r := range s {
+		w := 1
+		if r > 0x7f {
+			w = 2
+		}
+		if size+w > max {
+			return s[:i], []Issue{{LevelWarning, v.Shiwakeno, v.No, line, fmt.Sprintf("%sが%dバイトを超えるため切り捨てます", name, max)}}
+		}
+		size += w
+	}
+	return s, nil
+}
+
+// checkShiftJIS 出力行中不能用Shift-JIS编码的文字的验证
+func checkShiftJIS(v *Voucher, rows [][]string) []Issue {
+	encoder := japanese.ShiftJIS.NewEncoder()
+	for _, row := range rows {
+		for _, cell := range row {
+			if _, err := encoder.String(cell); err != nil {
+				return []Issue{{LevelError, v.Shiwakeno, v.No, 0, fmt.Sprintf("[%s]にShift-JISで出力できない文字が含まれています", cell)}}
+			}
+		}
+	}
+	return nil
+}
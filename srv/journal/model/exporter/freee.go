@@ -0,0 +1,61 @@
+package exporter
+
+import (
+	"strconv"
+)
+
+// freee freee会计(振替伝票インポート形式,同一管理番号的行为一张传票)
+type freee struct{}
+
+func init() {
+	Register(freee{})
+}
+
+// Format 出力文件的形式
+func (freee) Format() Format {
+	return Format{
+		Name:         "freee",
+		DisplayName:  "freee会計",
+		CharEncoding: EncodingUTF8,
+		Separator:    ",",
+		Extension:    "csv",
+	}
+}
+
+// Header 标题行
+func (freee) Header() [][]string {
+	return [][]string{
+		{
+			"発生日", "管理番号",
+			"借方勘定科目", "借方税区分", "借方金額", "借方税額", "借方取引先", "借方品目", "借方部門", "借方メモタグ", "借方備考",
+			"貸方勘定科目", "貸方税区分", "貸方金額", "貸方税額", "貸方取引先", "貸方品目", "貸方部門", "貸方メモタグ", "貸方備考",
+			"決算整理仕訳",
+		},
+	}
+}
+
+// Rows 传票编辑为freee会计的行(借方和贷方组成一行)
+func (freee) Rows(v *Voucher, o Options) ([][]string, []Issue) {
+	var rows [][]string
+	var issues []Issue
+
+	for _, p := range pairEntries(v) {
+		// 借方和贷方的科目、税区分、金额、税额、取引先、品目、部门、メモタグ、备考
+		side := func(e *Entry) []string {
+			if e == nil {
+				return []string{"", "", "", "", "", "", "", "", ""}
+			}
+			amount, is := yenAmount(v, e)
+			issues = append(issues, is...)
+			return []string{e.Subject, o.TaxCode(e.Subject, "対象外"), amount, "", "", "", "", "", v.Keiyakuno}
+		}
+
+		row := []string{v.Date.Format("2006/01/02"), strconv.Itoa(v.No)}
+		row = append(row, side(p[0])...)
+		row = append(row, side(p[1])...)
+		row = append(row, "")
+		rows = append(rows, row)
+	}
+
+	return rows, issues
+}
@@ -0,0 +1,64 @@
+package exporter
+
+import (
+	"strconv"
+)
+
+// moneyForward マネーフォワード クラウド会計(仕訳帳インポート形式,同一取引No的行为一张传票)
+type moneyForward struct{}
+
+func init() {
+	Register(moneyForward{})
+}
+
+// Format 出力文件的形式
+func (moneyForward) Format() Format {
+	return Format{
+		Name:         "moneyforward",
+		DisplayName:  "マネーフォワード クラウド会計",
+		CharEncoding: EncodingShiftJIS,
+		Separator:    ",",
+		Extension:    "csv",
+	}
+}
+
+// Header 标题行
+func (moneyForward) Header() [][]string {
+	return [][]string{
+		{
+			"取引No", "取引日",
+			"借方勘定科目", "借方補助科目", "借方部門", "借方取引先", "借方税区分", "借方インボイス", "借方金額(円)", "借方税額",
+			"貸方勘定科目", "貸方補助科目", "貸方部門", "貸方取引先", "貸方税区分", "貸方インボイス", "貸方金額(円)", "貸方税額",
+			"摘要", "仕訳メモ", "タグ", "MF仕訳タイプ", "決算整理仕訳",
+		},
+	}
+}
+
+// Rows 传票编辑为マネーフォワード的行(借方和贷方组成一行)
+func (moneyForward) Rows(v *Voucher, o Options) ([][]string, []Issue) {
+	var rows [][]string
+	var issues []Issue
+
+	remark, is := truncate(v, 0, "摘要", v.Remark, 200)
+	issues = append(issues, is...)
+
+	for _, p := range pairEntries(v) {
+		// 借方和贷方的科目、补助科目、部门、取引先、税区分、インボイス、金额、税额
+		side := func(e *Entry) []string {
+			if e == nil {
+				return []string{"", "", "", "", "", "", "", ""}
+			}
+			amount, is := yenAmount(v, e)
+			issues = append(issues, is...)
+			return []string{e.Subject, "", "", "", o.TaxCode(e.Subject, "対象外"), "", amount, ""}
+		}
+
+		row := []string{strconv.Itoa(v.No), v.Date.Format("2006/01/02")}
+		row = append(row, side(p[0])...)
+		row = append(row, side(p[1])...)
+		row = append(row, remark, v.Keiyakuno, "", "", "")
+		rows = append(rows, row)
+	}
+
+	return rows, issues
+}
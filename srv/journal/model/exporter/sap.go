@@ -0,0 +1,79 @@
+package exporter
+
+import (
+	"fmt"
+
+	"rxcsoft.cn/pit3/lib/leasecalc"
+)
+
+// SAP的记账码
+const (
+	sapDebitKey  = "40" // 借方(总账科目)
+	sapCreditKey = "50" // 贷方(总账科目)
+)
+
+// sapFlat SAP形式的平面文件(传票头部行H和明细行L,Tab分隔)
+type sapFlat struct{}
+
+func init() {
+	Register(sapFlat{})
+}
+
+// Format 出力文件的形式
+func (sapFlat) Format() Format {
+	return Format{
+		Name:         "sap",
+		DisplayName:  "SAP (フラットファイル)",
+		CharEncoding: EncodingUTF8,
+		Separator:    "\t",
+		Extension:    "txt",
+	}
+}
+
+// Header 传票头部行和明细行的项目定义(2行)
+func (sapFlat) Header() [][]string {
+	return [][]string{
+		{"H", "BLDAT", "BUDAT", "BLART", "BUKRS", "WAERS", "XBLNR", "BKTXT"},
+		{"L", "BSCHL", "HKONT", "WRBTR", "MWSKZ", "ZUONR", "SGTXT"},
+	}
+}
+
+// Rows 传票编辑为头部行和明细行(明细按科目代码和记账码出力)
+func (sapFlat) Rows(v *Voucher, o Options) ([][]string, []Issue) {
+	var issues []Issue
+
+	if len(o.CompanyCode) == 0 {
+		issues = append(issues, Issue{LevelError, v.Shiwakeno, v.No, 0, "会社コードが設定されていません"})
+	}
+	currency := o.Currency
+	if len(currency) == 0 {
+		currency = "JPY"
+	}
+
+	date := v.Date.Format("20060102")
+	text, is := truncate(v, 0, "伝票ヘッダテキスト", v.Remark, 25)
+	issues = append(issues, is...)
+	rows := [][]string{
+		{"H", date, date, "SA", o.CompanyCode, currency, v.Shiwakeno, text},
+	}
+
+	assignment, is := truncate(v, 0, "ソートキー", v.Keiyakuno, 18)
+	issues = append(issues, is...)
+	for i := range v.Entries {
+		e := &v.Entries[i]
+		account, ok := o.AccountCodes[e.Subject]
+		if !ok || len(account) == 0 {
+			issues = append(issues, Issue{LevelError, v.Shiwakeno, v.No, e.Line, fmt.Sprintf("勘定科目[%s]の勘定コードが設定されていません", e.Subject)})
+		}
+		key := sapCreditKey
+		if e.Division == leasecalc.DebitDivision {
+			key = sapDebitKey
+		}
+		lineText, is := truncate(v, e.Line, "明細テキスト", e.Remark, 50)
+		issues = append(issues, is...)
+
+		rows = append(rows, []string{"L", key, account, e.Amount.String(), o.TaxCode(e.Subject, ""), assignment, lineText})
+	}
+
+	return rows, issues
+}
@@ -0,0 +1,77 @@
+package exporter
+
+import (
+	"strconv"
+)
+
+// 弥生会计的识别标志
+const (
+	yayoiSingle = "2000" // 单行传票
+	yayoiFirst  = "2110" // 复数行传票的第一行
+	yayoiMiddle = "2100" // 复数行传票的中间行
+	yayoiLast   = "2101" // 复数行传票的最终行
+)
+
+// yayoi 弥生会计(仕訳日記帳インポート形式,无标题行)
+type yayoi struct{}
+
+func init() {
+	Register(yayoi{})
+}
+
+// Format 出力文件的形式
+func (yayoi) Format() Format {
+	return Format{
+		Name:         "yayoi",
+		DisplayName:  "弥生会計",
+		CharEncoding: EncodingShiftJIS,
+		Separator:    ",",
+		Extension:    "csv",
+	}
+}
+
+// Header 弥生会计的导入文件没有标题行
+func (yayoi) Header() [][]string {
+	return nil
+}
+
+// Rows 传票编辑为弥生会计的行(借方和贷方组成一行,复数行传票按识别标志区分)
+func (yayoi) Rows(v *Voucher, o Options) ([][]string, []Issue) {
+	var rows [][]string
+	var issues []Issue
+
+	remark, is := truncate(v, 0, "摘要", v.Remark, 64)
+	issues = append(issues, is...)
+
+	pairs := pairEntries(v)
+	for i, p := range pairs {
+		flag := yayoiMiddle
+		switch {
+		case len(pairs) == 1:
+			flag = yayoiSingle
+		case i == 0:
+			flag = yayoiFirst
+		case i == len(pairs)-1:
+			flag = yayoiLast
+		}
+
+		// 借方和贷方的科目、税区分、金额
+		side := func(e *Entry) []string {
+			if e == nil {
+				return []string{"", "", "", "", "", ""}
+			}
+			amount, is := yenAmount(v, e)
+			issues = append(issues, is...)
+			return []string{e.Subject, "", "", o.TaxCode(e.Subject, "対象外"), amount, ""}
+		}
+
+		row := []string{flag, strconv.Itoa(v.No), "", v.Date.Format("2006/01/02")}
+		row = append(row, side(p[0])...)
+		row = append(row, side(p[1])...)
+		// 摘要、番号、期日、タイプ、生成元、仕訳メモ、付箋1、付箋2、调整
+		row = append(row, remark, "", "", "0", "", v.Keiyakuno, "0", "0", "no")
+		rows = append(rows, row)
+	}
+
+	return rows, issues
+}
@@ -1078,6 +1078,468 @@ func (m *FindDownloadSettingResponse) GetFieldRule() []*FieldRule {
 	return nil
 }
 
+// 分录出力适配器
+type Exporter struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name"`
+	DisplayName          string   `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name"`
+	CharEncoding         string   `protobuf:"bytes,3,opt,name=char_encoding,json=charEncoding,proto3" json:"char_encoding"`
+	SeparatorChar        string   `protobuf:"bytes,4,opt,name=separator_char,json=separatorChar,proto3" json:"separator_char"`
+	Extension            string   `protobuf:"bytes,5,opt,name=extension,proto3" json:"extension"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Exporter) Reset()         { *m = Exporter{} }
+func (m *Exporter) String() string { return proto.CompactTextString(m) }
+func (*Exporter) ProtoMessage()    {}
+func (*Exporter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{16}
+}
+
+func (m *Exporter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Exporter.Unmarshal(m, b)
+}
+func (m *Exporter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Exporter.Marshal(b, m, deterministic)
+}
+func (m *Exporter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Exporter.Merge(m, src)
+}
+func (m *Exporter) XXX_Size() int {
+	return xxx_messageInfo_Exporter.Size(m)
+}
+func (m *Exporter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Exporter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Exporter proto.InternalMessageInfo
+
+func (m *Exporter) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Exporter) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *Exporter) GetCharEncoding() string {
+	if m != nil {
+		return m.CharEncoding
+	}
+	return ""
+}
+
+func (m *Exporter) GetSeparatorChar() string {
+	if m != nil {
+		return m.SeparatorChar
+	}
+	return ""
+}
+
+func (m *Exporter) GetExtension() string {
+	if m != nil {
+		return m.Extension
+	}
+	return ""
+}
+
+// 查询分录出力适配器
+type FindExportersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindExportersRequest) Reset()         { *m = FindExportersRequest{} }
+func (m *FindExportersRequest) String() string { return proto.CompactTextString(m) }
+func (*FindExportersRequest) ProtoMessage()    {}
+func (*FindExportersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{17}
+}
+
+func (m *FindExportersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindExportersRequest.Unmarshal(m, b)
+}
+func (m *FindExportersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindExportersRequest.Marshal(b, m, deterministic)
+}
+func (m *FindExportersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindExportersRequest.Merge(m, src)
+}
+func (m *FindExportersRequest) XXX_Size() int {
+	return xxx_messageInfo_FindExportersRequest.Size(m)
+}
+func (m *FindExportersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindExportersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindExportersRequest proto.InternalMessageInfo
+
+type FindExportersResponse struct {
+	Exporters            []*Exporter `protobuf:"bytes,1,rep,name=exporters,proto3" json:"exporters"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *FindExportersResponse) Reset()         { *m = FindExportersResponse{} }
+func (m *FindExportersResponse) String() string { return proto.CompactTextString(m) }
+func (*FindExportersResponse) ProtoMessage()    {}
+func (*FindExportersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{18}
+}
+
+func (m *FindExportersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindExportersResponse.Unmarshal(m, b)
+}
+func (m *FindExportersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindExportersResponse.Marshal(b, m, deterministic)
+}
+func (m *FindExportersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindExportersResponse.Merge(m, src)
+}
+func (m *FindExportersResponse) XXX_Size() int {
+	return xxx_messageInfo_FindExportersResponse.Size(m)
+}
+func (m *FindExportersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindExportersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindExportersResponse proto.InternalMessageInfo
+
+func (m *FindExportersResponse) GetExporters() []*Exporter {
+	if m != nil {
+		return m.Exporters
+	}
+	return nil
+}
+
+// 出力对象的分录明细
+type ExportLine struct {
+	Items                map[string]string `protobuf:"bytes,1,rep,name=items,proto3" json:"items" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExportLine) Reset()         { *m = ExportLine{} }
+func (m *ExportLine) String() string { return proto.CompactTextString(m) }
+func (*ExportLine) ProtoMessage()    {}
+func (*ExportLine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{19}
+}
+
+func (m *ExportLine) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportLine.Unmarshal(m, b)
+}
+func (m *ExportLine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportLine.Marshal(b, m, deterministic)
+}
+func (m *ExportLine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportLine.Merge(m, src)
+}
+func (m *ExportLine) XXX_Size() int {
+	return xxx_messageInfo_ExportLine.Size(m)
+}
+func (m *ExportLine) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportLine.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportLine proto.InternalMessageInfo
+
+func (m *ExportLine) GetItems() map[string]string {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+// 分录按适配器的形式编辑
+type ExportRequest struct {
+	Exporter             string            `protobuf:"bytes,1,opt,name=exporter,proto3" json:"exporter"`
+	Lines                []*ExportLine     `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines"`
+	AccountCodes         map[string]string `protobuf:"bytes,3,rep,name=account_codes,json=accountCodes,proto3" json:"account_codes" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	TaxCodes             map[string]string `protobuf:"bytes,4,rep,name=tax_codes,json=taxCodes,proto3" json:"tax_codes" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	CompanyCode          string            `protobuf:"bytes,5,opt,name=company_code,json=companyCode,proto3" json:"company_code"`
+	Currency             string            `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency"`
+	Preview              int64             `protobuf:"varint,7,opt,name=preview,proto3" json:"preview"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ExportRequest) Reset()         { *m = ExportRequest{} }
+func (m *ExportRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportRequest) ProtoMessage()    {}
+func (*ExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{20}
+}
+
+func (m *ExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRequest.Unmarshal(m, b)
+}
+func (m *ExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRequest.Merge(m, src)
+}
+func (m *ExportRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportRequest.Size(m)
+}
+func (m *ExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRequest proto.InternalMessageInfo
+
+func (m *ExportRequest) GetExporter() string {
+	if m != nil {
+		return m.Exporter
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetLines() []*ExportLine {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetAccountCodes() map[string]string {
+	if m != nil {
+		return m.AccountCodes
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetTaxCodes() map[string]string {
+	if m != nil {
+		return m.TaxCodes
+	}
+	return nil
+}
+
+func (m *ExportRequest) GetCompanyCode() string {
+	if m != nil {
+		return m.CompanyCode
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *ExportRequest) GetPreview() int64 {
+	if m != nil {
+		return m.Preview
+	}
+	return 0
+}
+
+// 出力行
+type ExportRow struct {
+	Cells                []string `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportRow) Reset()         { *m = ExportRow{} }
+func (m *ExportRow) String() string { return proto.CompactTextString(m) }
+func (*ExportRow) ProtoMessage()    {}
+func (*ExportRow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{21}
+}
+
+func (m *ExportRow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportRow.Unmarshal(m, b)
+}
+func (m *ExportRow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportRow.Marshal(b, m, deterministic)
+}
+func (m *ExportRow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportRow.Merge(m, src)
+}
+func (m *ExportRow) XXX_Size() int {
+	return xxx_messageInfo_ExportRow.Size(m)
+}
+func (m *ExportRow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportRow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportRow proto.InternalMessageInfo
+
+func (m *ExportRow) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+// 验证结果
+type ExportIssue struct {
+	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level"`
+	Shiwakeno            string   `protobuf:"bytes,2,opt,name=shiwakeno,proto3" json:"shiwakeno"`
+	Voucher              int64    `protobuf:"varint,3,opt,name=voucher,proto3" json:"voucher"`
+	Line                 int64    `protobuf:"varint,4,opt,name=line,proto3" json:"line"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportIssue) Reset()         { *m = ExportIssue{} }
+func (m *ExportIssue) String() string { return proto.CompactTextString(m) }
+func (*ExportIssue) ProtoMessage()    {}
+func (*ExportIssue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{22}
+}
+
+func (m *ExportIssue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportIssue.Unmarshal(m, b)
+}
+func (m *ExportIssue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportIssue.Marshal(b, m, deterministic)
+}
+func (m *ExportIssue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportIssue.Merge(m, src)
+}
+func (m *ExportIssue) XXX_Size() int {
+	return xxx_messageInfo_ExportIssue.Size(m)
+}
+func (m *ExportIssue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportIssue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportIssue proto.InternalMessageInfo
+
+func (m *ExportIssue) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *ExportIssue) GetShiwakeno() string {
+	if m != nil {
+		return m.Shiwakeno
+	}
+	return ""
+}
+
+func (m *ExportIssue) GetVoucher() int64 {
+	if m != nil {
+		return m.Voucher
+	}
+	return 0
+}
+
+func (m *ExportIssue) GetLine() int64 {
+	if m != nil {
+		return m.Line
+	}
+	return 0
+}
+
+func (m *ExportIssue) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ExportResponse struct {
+	Exporter             *Exporter      `protobuf:"bytes,1,opt,name=exporter,proto3" json:"exporter"`
+	Rows                 []*ExportRow   `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows"`
+	HeaderRows           int64          `protobuf:"varint,3,opt,name=header_rows,json=headerRows,proto3" json:"header_rows"`
+	Vouchers             int64          `protobuf:"varint,4,opt,name=vouchers,proto3" json:"vouchers"`
+	Lines                int64          `protobuf:"varint,5,opt,name=lines,proto3" json:"lines"`
+	Issues               []*ExportIssue `protobuf:"bytes,6,rep,name=issues,proto3" json:"issues"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ExportResponse) Reset()         { *m = ExportResponse{} }
+func (m *ExportResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportResponse) ProtoMessage()    {}
+func (*ExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_04fd98cceb1b9191, []int{23}
+}
+
+func (m *ExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportResponse.Unmarshal(m, b)
+}
+func (m *ExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportResponse.Merge(m, src)
+}
+func (m *ExportResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportResponse.Size(m)
+}
+func (m *ExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportResponse proto.InternalMessageInfo
+
+func (m *ExportResponse) GetExporter() *Exporter {
+	if m != nil {
+		return m.Exporter
+	}
+	return nil
+}
+
+func (m *ExportResponse) GetRows() []*ExportRow {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+func (m *ExportResponse) GetHeaderRows() int64 {
+	if m != nil {
+		return m.HeaderRows
+	}
+	return 0
+}
+
+func (m *ExportResponse) GetVouchers() int64 {
+	if m != nil {
+		return m.Vouchers
+	}
+	return 0
+}
+
+func (m *ExportResponse) GetLines() int64 {
+	if m != nil {
+		return m.Lines
+	}
+	return 0
+}
+
+func (m *ExportResponse) GetIssues() []*ExportIssue {
+	if m != nil {
+		return m.Issues
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Journal)(nil), "journal.Journal")
 	proto.RegisterType((*Pattern)(nil), "journal.Pattern")
@@ -1095,71 +1557,111 @@ func init() {
 	proto.RegisterType((*FieldRule)(nil), "journal.FieldRule")
 	proto.RegisterType((*FindDownloadSettingRequest)(nil), "journal.FindDownloadSettingRequest")
 	proto.RegisterType((*FindDownloadSettingResponse)(nil), "journal.FindDownloadSettingResponse")
+	proto.RegisterType((*Exporter)(nil), "journal.Exporter")
+	proto.RegisterType((*FindExportersRequest)(nil), "journal.FindExportersRequest")
+	proto.RegisterType((*FindExportersResponse)(nil), "journal.FindExportersResponse")
+	proto.RegisterType((*ExportLine)(nil), "journal.ExportLine")
+	proto.RegisterMapType((map[string]string)(nil), "journal.ExportLine.ItemsEntry")
+	proto.RegisterType((*ExportRequest)(nil), "journal.ExportRequest")
+	proto.RegisterMapType((map[string]string)(nil), "journal.ExportRequest.AccountCodesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "journal.ExportRequest.TaxCodesEntry")
+	proto.RegisterType((*ExportRow)(nil), "journal.ExportRow")
+	proto.RegisterType((*ExportIssue)(nil), "journal.ExportIssue")
+	proto.RegisterType((*ExportResponse)(nil), "journal.ExportResponse")
 }
 
 func init() { proto.RegisterFile("journal.proto", fileDescriptor_04fd98cceb1b9191) }
 
 var fileDescriptor_04fd98cceb1b9191 = []byte{
-	// 971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcf, 0x8f, 0xdb, 0x44,
-	0x14, 0xee, 0x26, 0xdd, 0xfc, 0x78, 0x4e, 0xb6, 0xab, 0x41, 0xb4, 0xde, 0x00, 0xda, 0xd6, 0x0b,
-	0x52, 0x41, 0xa8, 0x12, 0xe5, 0x8c, 0x44, 0xb7, 0xdb, 0x95, 0x02, 0x14, 0x90, 0x97, 0xbb, 0x35,
-	0xc9, 0x4c, 0x12, 0xb7, 0xce, 0x8c, 0x3b, 0x1e, 0xef, 0xe2, 0x1b, 0x7f, 0x00, 0x37, 0xfe, 0x49,
-	0x0e, 0x48, 0x9c, 0xb9, 0xa1, 0x99, 0x79, 0xe3, 0x3a, 0x4e, 0x76, 0x97, 0x0a, 0x71, 0xe3, 0x16,
-	0x7f, 0xef, 0xf9, 0xcd, 0xe7, 0xf7, 0xbd, 0x6f, 0x9e, 0x02, 0xe3, 0x57, 0xb2, 0x54, 0x82, 0x66,
-	0x4f, 0x72, 0x25, 0xb5, 0x24, 0x7d, 0x7c, 0x8c, 0x7e, 0xed, 0x40, 0xff, 0x1b, 0xf7, 0x9b, 0x7c,
-	0x04, 0x80, 0x70, 0x92, 0xb2, 0x70, 0xef, 0xe1, 0xde, 0xe3, 0x61, 0x3c, 0x44, 0x64, 0xca, 0xc8,
-	0x23, 0x18, 0xf9, 0xb0, 0xa0, 0x6b, 0x1e, 0x76, 0x6c, 0x42, 0x80, 0xd8, 0xf7, 0x74, 0xcd, 0xc9,
-	0xe7, 0x30, 0xc8, 0xa9, 0xd6, 0x5c, 0x89, 0x22, 0xec, 0x3e, 0xec, 0x3e, 0x0e, 0x9e, 0x1e, 0x3e,
-	0xf1, 0x07, 0xff, 0xe8, 0x02, 0x71, 0x9d, 0x41, 0xde, 0x87, 0x1e, 0xcd, 0x73, 0x73, 0xd6, 0x5d,
-	0x5b, 0x6a, 0x9f, 0xe6, 0xf9, 0x94, 0x19, 0x1a, 0x73, 0xc5, 0xa9, 0xe6, 0x2c, 0xa1, 0x3a, 0xdc,
-	0x77, 0x34, 0x10, 0x79, 0xa6, 0x9b, 0xe1, 0x59, 0x15, 0xf6, 0x36, 0xc2, 0xa7, 0x95, 0x09, 0x97,
-	0x39, 0xf3, 0x6f, 0xf7, 0x5d, 0x18, 0x11, 0xf7, 0xb6, 0x0f, 0xcf, 0xaa, 0x70, 0xb0, 0x11, 0x3e,
-	0xad, 0xa2, 0x0a, 0xfa, 0xc8, 0xd3, 0x64, 0x22, 0xd3, 0x46, 0x37, 0x10, 0x71, 0xdd, 0xf0, 0xe1,
-	0x66, 0x37, 0x10, 0xf3, 0xdd, 0x28, 0xca, 0xd9, 0x2b, 0x3e, 0xd7, 0xdb, 0xdd, 0xb8, 0x70, 0x81,
-	0xb8, 0xce, 0x88, 0x7e, 0xe9, 0x40, 0x1f, 0x51, 0x72, 0x0c, 0x01, 0xe2, 0xc9, 0x6b, 0x5e, 0xe1,
-	0xe1, 0x80, 0xd0, 0xb7, 0xbc, 0x22, 0x9f, 0xc2, 0x61, 0xc6, 0x05, 0x4b, 0xc5, 0x32, 0x61, 0xe9,
-	0x65, 0x5a, 0xa4, 0x52, 0x20, 0x83, 0x7b, 0x88, 0x9f, 0x21, 0x6c, 0x88, 0x32, 0xbe, 0xa0, 0x65,
-	0xa6, 0x1d, 0xd1, 0xae, 0x23, 0x8a, 0x98, 0x25, 0xfa, 0x08, 0x46, 0xfe, 0x38, 0x9b, 0xe2, 0xe4,
-	0xf0, 0x14, 0x6c, 0xca, 0x31, 0x04, 0x74, 0x2d, 0x4b, 0x81, 0x19, 0x4e, 0x15, 0x70, 0x90, 0xaf,
-	0x81, 0x09, 0x8b, 0x94, 0x67, 0x0c, 0x85, 0xc1, 0x97, 0xce, 0x0d, 0x64, 0x6a, 0xcc, 0x57, 0x54,
-	0x2c, 0x79, 0xb2, 0xc8, 0xe8, 0x12, 0xb5, 0x01, 0x07, 0x9d, 0x67, 0x74, 0x19, 0x9d, 0xc1, 0x3d,
-	0x9c, 0xc5, 0x22, 0xe6, 0x6f, 0x4a, 0x5e, 0xe8, 0xc6, 0x8c, 0xec, 0x35, 0x67, 0x64, 0x02, 0x03,
-	0x46, 0x35, 0x9d, 0xd1, 0xc2, 0x77, 0xbe, 0x7e, 0x8e, 0xbe, 0x86, 0xc3, 0xb7, 0x55, 0x8a, 0x5c,
-	0x8a, 0xc2, 0x4a, 0x81, 0x9d, 0x2f, 0xc2, 0xbd, 0x96, 0x14, 0x98, 0x1c, 0xd7, 0x19, 0xd1, 0x0c,
-	0x0e, 0x3c, 0x88, 0x34, 0x6e, 0xb1, 0xc6, 0x5b, 0x96, 0x9d, 0xeb, 0x58, 0x76, 0x5b, 0x2c, 0xbf,
-	0xaa, 0xbf, 0xb5, 0x26, 0xf9, 0x19, 0x78, 0x5b, 0xda, 0x13, 0x76, 0x71, 0xac, 0x7d, 0xfb, 0x06,
-	0xc6, 0xd3, 0x75, 0x2e, 0x95, 0xf6, 0x0c, 0xdf, 0xe9, 0x0b, 0x6f, 0xea, 0x1f, 0xb9, 0x0f, 0xbd,
-	0x2b, 0x95, 0x6a, 0xae, 0x90, 0x33, 0x3e, 0x45, 0x87, 0x70, 0xe0, 0x8f, 0x74, 0x84, 0xa3, 0x3f,
-	0x3a, 0x30, 0x7e, 0x29, 0x59, 0xba, 0xa8, 0xfe, 0x5d, 0x9f, 0x36, 0xad, 0xd6, 0x6d, 0x5b, 0xad,
-	0xe5, 0x86, 0xbb, 0xff, 0xc8, 0x0d, 0xfb, 0xbb, 0xdd, 0xd0, 0x9a, 0xc1, 0xa0, 0x3d, 0x83, 0x5b,
-	0x5e, 0xe8, 0xdd, 0xea, 0x85, 0xfe, 0xad, 0x5e, 0x18, 0x6c, 0x7b, 0xa1, 0x29, 0xc0, 0xf0, 0x5a,
-	0x01, 0xa0, 0x2d, 0x80, 0xef, 0x36, 0x0a, 0xf0, 0x5b, 0x17, 0x8e, 0x9e, 0x31, 0x76, 0x26, 0xaf,
-	0x44, 0x26, 0x29, 0xbb, 0xe0, 0x5a, 0xa7, 0x62, 0x79, 0x8b, 0x77, 0x8e, 0x21, 0xc8, 0x68, 0x25,
-	0x4b, 0xdd, 0xbc, 0xb8, 0xc0, 0x41, 0x96, 0xfe, 0x09, 0x8c, 0xe7, 0x2b, 0xaa, 0x12, 0x2e, 0xe6,
-	0xd2, 0x34, 0x0f, 0x15, 0x19, 0x19, 0xf0, 0x05, 0x62, 0x46, 0xb3, 0x15, 0xa7, 0x8c, 0xab, 0x44,
-	0xc9, 0x2b, 0xd4, 0x64, 0xe8, 0x90, 0x58, 0x5e, 0x91, 0x4f, 0xe0, 0xa0, 0xe0, 0x39, 0x55, 0x54,
-	0x4b, 0x95, 0x98, 0x17, 0x51, 0x90, 0x71, 0x8d, 0x3e, 0x5f, 0x51, 0x65, 0xb9, 0xa4, 0x82, 0x27,
-	0x33, 0xc5, 0xe9, 0xeb, 0x02, 0x9b, 0x0d, 0x06, 0x3a, 0xb5, 0x88, 0x69, 0xe5, 0x22, 0xfd, 0x99,
-	0xb3, 0x24, 0xe3, 0x62, 0xa9, 0x57, 0xb6, 0xd9, 0x83, 0x38, 0xb0, 0xd8, 0x77, 0x16, 0x32, 0x29,
-	0xa2, 0x5c, 0xcf, 0xb8, 0x4a, 0x52, 0xcd, 0xd7, 0x85, 0xed, 0x76, 0x37, 0x0e, 0x1c, 0x36, 0x35,
-	0x90, 0x21, 0x7b, 0x49, 0xb3, 0x94, 0x39, 0xd1, 0x5d, 0xbf, 0x87, 0x16, 0xb1, 0x9a, 0x7f, 0x01,
-	0x60, 0x85, 0x4a, 0x54, 0x99, 0xf1, 0x10, 0xac, 0x7b, 0x48, 0xed, 0x1e, 0x2b, 0x58, 0x5c, 0x66,
-	0x3c, 0x1e, 0x2e, 0xfc, 0xcf, 0x0d, 0xfd, 0x82, 0x96, 0xb5, 0x3f, 0x84, 0xc9, 0x2e, 0x51, 0x50,
-	0xb3, 0x3f, 0xf7, 0x60, 0x58, 0x97, 0x34, 0xbd, 0x66, 0x98, 0xe8, 0xe4, 0x70, 0x52, 0x8d, 0x3c,
-	0xe8, 0xe7, 0x89, 0xb3, 0x54, 0x27, 0x73, 0x29, 0x34, 0x17, 0xda, 0xef, 0x1a, 0x83, 0x3d, 0x77,
-	0x10, 0x39, 0x82, 0x81, 0xfb, 0x84, 0xda, 0x40, 0x7d, 0xfb, 0x3c, 0x65, 0x4e, 0x0a, 0xcb, 0x21,
-	0x59, 0x73, 0xbd, 0x92, 0x7e, 0xdd, 0x8e, 0x11, 0x7d, 0x69, 0x41, 0xd3, 0x23, 0x57, 0x41, 0x57,
-	0xb9, 0xbf, 0xe0, 0xdd, 0x07, 0xff, 0x54, 0xe5, 0x96, 0x83, 0xf9, 0xc0, 0x42, 0x4b, 0xc5, 0xcd,
-	0x21, 0xe8, 0x8b, 0x1a, 0x9b, 0x32, 0x33, 0xb7, 0x0b, 0xa9, 0xd6, 0xf5, 0xda, 0xc5, 0xa7, 0xe8,
-	0x07, 0x98, 0x9c, 0xa7, 0xe2, 0x1d, 0xa7, 0xf4, 0xa6, 0x1b, 0xfe, 0xaf, 0x0e, 0x7c, 0xb0, 0xb3,
-	0x22, 0x5e, 0xa4, 0xff, 0x0f, 0xfe, 0x7f, 0x36, 0xf8, 0x4f, 0x7f, 0xef, 0xd6, 0xcb, 0xf1, 0x82,
-	0xab, 0xcb, 0x74, 0xce, 0xc9, 0x0b, 0x18, 0x19, 0x35, 0xfc, 0xd2, 0x25, 0x61, 0x7b, 0xf1, 0xf8,
-	0x6d, 0x3e, 0x39, 0xda, 0x11, 0x41, 0x5b, 0xdc, 0x21, 0xa7, 0x10, 0x34, 0xca, 0x90, 0x07, 0x5b,
-	0xeb, 0x0b, 0x8b, 0x84, 0xdb, 0x81, 0x46, 0x0d, 0x5c, 0x8b, 0xbe, 0xca, 0xfd, 0x3a, 0x79, 0x63,
-	0x5d, 0x4e, 0x1e, 0x6c, 0xe1, 0xcd, 0x1a, 0xee, 0x9a, 0xdd, 0xae, 0xb1, 0xb1, 0xec, 0x1a, 0x35,
-	0x5a, 0xd7, 0xf2, 0x1d, 0x92, 0x00, 0xd9, 0xbe, 0x02, 0x48, 0x54, 0xbf, 0x70, 0xed, 0xa5, 0x3d,
-	0x39, 0xb9, 0x31, 0xa7, 0x3e, 0x60, 0x06, 0xef, 0xed, 0x70, 0x00, 0x39, 0x69, 0x88, 0x77, 0x9d,
-	0xe3, 0x26, 0x1f, 0xdf, 0x9c, 0xe4, 0xcf, 0x98, 0xf5, 0xec, 0x7f, 0x85, 0x2f, 0xff, 0x0e, 0x00,
-	0x00, 0xff, 0xff, 0xbd, 0x83, 0xf9, 0x24, 0x3c, 0x0c, 0x00, 0x00,
+	// 1438 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0x4d, 0x8f, 0xdb, 0x36,
+	0x13, 0x7e, 0x6d, 0xaf, 0xd7, 0xf6, 0x78, 0xbd, 0xd9, 0x30, 0x1f, 0xeb, 0xe8, 0xcd, 0xa7, 0x92,
+	0x16, 0x49, 0x91, 0x6e, 0xd1, 0xb4, 0x87, 0xa0, 0x45, 0xd1, 0xee, 0x26, 0x1b, 0xd4, 0x69, 0xd2,
+	0x06, 0x4a, 0xee, 0x02, 0x2d, 0xd1, 0xb6, 0x12, 0x59, 0x54, 0x24, 0xca, 0x5e, 0x01, 0x3d, 0xf4,
+	0x5a, 0xa0, 0xb7, 0xfe, 0x8c, 0xfe, 0x88, 0xfe, 0x9d, 0x1e, 0x0a, 0xf4, 0xdc, 0x4b, 0x51, 0x90,
+	0x1c, 0xca, 0xb2, 0xec, 0xdd, 0xcd, 0xa2, 0xe8, 0xad, 0x37, 0xcd, 0xc3, 0xe1, 0xf0, 0xe1, 0x3c,
+	0x33, 0x24, 0x6d, 0xe8, 0xbd, 0xe6, 0x59, 0x12, 0xd1, 0x70, 0x2f, 0x4e, 0xb8, 0xe0, 0xa4, 0x85,
+	0xa6, 0xfd, 0x53, 0x1d, 0x5a, 0x4f, 0xf5, 0x37, 0xb9, 0x06, 0x80, 0xb0, 0x1b, 0xf8, 0xfd, 0xda,
+	0xcd, 0xda, 0xdd, 0x8e, 0xd3, 0x41, 0x64, 0xe0, 0x93, 0x5b, 0xb0, 0x65, 0x86, 0x23, 0x3a, 0x65,
+	0xfd, 0xba, 0x72, 0xe8, 0x22, 0xf6, 0x2d, 0x9d, 0x32, 0x72, 0x1f, 0xda, 0x31, 0x15, 0x82, 0x25,
+	0x51, 0xda, 0x6f, 0xdc, 0x6c, 0xdc, 0xed, 0x3e, 0xd8, 0xd9, 0x33, 0x0b, 0xbf, 0xd0, 0x03, 0x4e,
+	0xe1, 0x41, 0x2e, 0xc1, 0x26, 0x8d, 0x63, 0xb9, 0xd6, 0x86, 0x0a, 0xd5, 0xa4, 0x71, 0x3c, 0xf0,
+	0x25, 0x0d, 0x2f, 0x61, 0x54, 0x30, 0xdf, 0xa5, 0xa2, 0xdf, 0xd4, 0x34, 0x10, 0xd9, 0x17, 0xe5,
+	0xe1, 0x61, 0xde, 0xdf, 0x5c, 0x1a, 0x3e, 0xc8, 0xe5, 0x70, 0x16, 0xfb, 0x66, 0x76, 0x4b, 0x0f,
+	0x23, 0xa2, 0x67, 0x9b, 0xe1, 0x61, 0xde, 0x6f, 0x2f, 0x0d, 0x1f, 0xe4, 0x76, 0x0e, 0x2d, 0xe4,
+	0x29, 0x3d, 0x91, 0x69, 0x29, 0x1b, 0x88, 0xe8, 0x6c, 0x98, 0xe1, 0x72, 0x36, 0x10, 0x33, 0xd9,
+	0x48, 0xb3, 0xe1, 0x6b, 0xe6, 0x89, 0xd5, 0x6c, 0xbc, 0xd4, 0x03, 0x4e, 0xe1, 0x61, 0xff, 0x50,
+	0x87, 0x16, 0xa2, 0xe4, 0x06, 0x74, 0x11, 0x77, 0xdf, 0xb0, 0x1c, 0x17, 0x07, 0x84, 0xbe, 0x61,
+	0x39, 0xb9, 0x07, 0x3b, 0x21, 0x8b, 0xfc, 0x20, 0x1a, 0xbb, 0x7e, 0x30, 0x0b, 0xd2, 0x80, 0x47,
+	0xc8, 0xe0, 0x1c, 0xe2, 0x8f, 0x11, 0x96, 0x44, 0x7d, 0x36, 0xa2, 0x59, 0x28, 0x34, 0xd1, 0x86,
+	0x26, 0x8a, 0x98, 0x22, 0x7a, 0x0b, 0xb6, 0xcc, 0x72, 0xca, 0x45, 0xcb, 0x61, 0x28, 0x28, 0x97,
+	0x1b, 0xd0, 0xa5, 0x53, 0x9e, 0x45, 0xe8, 0xa1, 0x55, 0x01, 0x0d, 0x99, 0x18, 0xe8, 0x30, 0x0a,
+	0x58, 0xe8, 0xa3, 0x30, 0x38, 0xe9, 0x89, 0x84, 0x64, 0x0c, 0x6f, 0x42, 0xa3, 0x31, 0x73, 0x47,
+	0x21, 0x1d, 0xa3, 0x36, 0xa0, 0xa1, 0x27, 0x21, 0x1d, 0xdb, 0x8f, 0xe1, 0x1c, 0xd6, 0x62, 0xea,
+	0xb0, 0xb7, 0x19, 0x4b, 0x45, 0xa9, 0x46, 0x6a, 0xe5, 0x1a, 0xb1, 0xa0, 0xed, 0x53, 0x41, 0x87,
+	0x34, 0x35, 0x99, 0x2f, 0x6c, 0xfb, 0x2b, 0xd8, 0x59, 0x44, 0x49, 0x63, 0x1e, 0xa5, 0x4a, 0x0a,
+	0xcc, 0x7c, 0xda, 0xaf, 0x55, 0xa4, 0x40, 0x67, 0xa7, 0xf0, 0xb0, 0x87, 0xb0, 0x6d, 0x40, 0xa4,
+	0x71, 0x4a, 0x6b, 0x2c, 0x58, 0xd6, 0x8f, 0x63, 0xd9, 0xa8, 0xb0, 0xfc, 0xa2, 0xd8, 0x6b, 0x41,
+	0xf2, 0x03, 0x30, 0x6d, 0xa9, 0x56, 0x58, 0xc7, 0xb1, 0xe8, 0xdb, 0xb7, 0xd0, 0x1b, 0x4c, 0x63,
+	0x9e, 0x08, 0xc3, 0xf0, 0x4c, 0x3b, 0x3c, 0x29, 0x7f, 0xe4, 0x32, 0x6c, 0xce, 0x93, 0x40, 0xb0,
+	0x04, 0x39, 0xa3, 0x65, 0xef, 0xc0, 0xb6, 0x59, 0x52, 0x13, 0xb6, 0x7f, 0xaf, 0x43, 0xef, 0x39,
+	0xf7, 0x83, 0x51, 0xfe, 0xcf, 0xf2, 0xb4, 0xdc, 0x6a, 0x8d, 0x6a, 0xab, 0x55, 0xba, 0x61, 0xe3,
+	0x9d, 0xba, 0xa1, 0xb9, 0xbe, 0x1b, 0x2a, 0x35, 0xd8, 0xad, 0xd6, 0xe0, 0x4a, 0x2f, 0x6c, 0x9e,
+	0xda, 0x0b, 0xad, 0x53, 0x7b, 0xa1, 0xbd, 0xda, 0x0b, 0x65, 0x01, 0x3a, 0xc7, 0x0a, 0x00, 0x55,
+	0x01, 0x4c, 0xb6, 0x51, 0x80, 0x9f, 0x1b, 0x70, 0x65, 0xdf, 0xf7, 0x1f, 0xf3, 0x79, 0x14, 0x72,
+	0xea, 0xbf, 0x64, 0x42, 0x04, 0xd1, 0xf8, 0x94, 0xde, 0xb9, 0x01, 0xdd, 0x90, 0xe6, 0x3c, 0x13,
+	0xe5, 0x83, 0x0b, 0x34, 0xa4, 0xe8, 0xdf, 0x86, 0x9e, 0x37, 0xa1, 0x89, 0xcb, 0x22, 0x8f, 0xcb,
+	0xe4, 0xa1, 0x22, 0x5b, 0x12, 0x3c, 0x44, 0x4c, 0x6a, 0x36, 0x61, 0xd4, 0x67, 0x89, 0x9b, 0xf0,
+	0x39, 0x6a, 0xd2, 0xd1, 0x88, 0xc3, 0xe7, 0xe4, 0x3d, 0xd8, 0x4e, 0x59, 0x4c, 0x13, 0x2a, 0x78,
+	0xe2, 0xca, 0x89, 0x28, 0x48, 0xaf, 0x40, 0x1f, 0x4d, 0x68, 0xa2, 0xb8, 0x04, 0x11, 0x73, 0x87,
+	0x09, 0xa3, 0x6f, 0x52, 0x4c, 0x36, 0x48, 0xe8, 0x40, 0x21, 0x32, 0x95, 0xa3, 0xe0, 0x88, 0xf9,
+	0x6e, 0xc8, 0xa2, 0xb1, 0x98, 0xa8, 0x64, 0xb7, 0x9d, 0xae, 0xc2, 0x9e, 0x29, 0x48, 0xba, 0x44,
+	0xd9, 0x74, 0xc8, 0x12, 0x37, 0x10, 0x6c, 0x9a, 0xaa, 0x6c, 0x37, 0x9c, 0xae, 0xc6, 0x06, 0x12,
+	0x92, 0x64, 0x67, 0x34, 0x0c, 0x7c, 0x2d, 0xba, 0xce, 0x77, 0x47, 0x21, 0x4a, 0xf3, 0x8f, 0x01,
+	0x94, 0x50, 0x6e, 0x92, 0x85, 0xac, 0x0f, 0xaa, 0x7b, 0x48, 0xd1, 0x3d, 0x4a, 0x30, 0x27, 0x0b,
+	0x99, 0xd3, 0x19, 0x99, 0xcf, 0x25, 0xfd, 0xba, 0x95, 0xd6, 0xbe, 0x0a, 0xd6, 0x3a, 0x51, 0x50,
+	0xb3, 0x3f, 0x6a, 0xd0, 0x29, 0x42, 0xca, 0x5c, 0xfb, 0xe8, 0xa8, 0xe5, 0xd0, 0x52, 0x6d, 0x19,
+	0xd0, 0xd4, 0x13, 0xf3, 0x03, 0xe1, 0x7a, 0x3c, 0x12, 0x2c, 0x12, 0xe6, 0xae, 0x91, 0xd8, 0x23,
+	0x0d, 0x91, 0x2b, 0xd0, 0xd6, 0x5b, 0x28, 0x1a, 0xa8, 0xa5, 0xec, 0x81, 0xaf, 0xa5, 0x50, 0x1c,
+	0xdc, 0x29, 0x13, 0x13, 0x6e, 0xae, 0xdb, 0x1e, 0xa2, 0xcf, 0x15, 0x28, 0x73, 0xa4, 0x23, 0x88,
+	0x3c, 0x36, 0x07, 0xbc, 0xde, 0xf0, 0xab, 0x3c, 0x56, 0x1c, 0xe4, 0x06, 0x53, 0xc1, 0x13, 0x26,
+	0x17, 0xc1, 0xbe, 0x28, 0xb0, 0x81, 0x2f, 0xeb, 0x76, 0xc4, 0x93, 0x69, 0x71, 0xed, 0xa2, 0x65,
+	0x7f, 0x07, 0xd6, 0x93, 0x20, 0x3a, 0x63, 0x95, 0x9e, 0x74, 0xc2, 0xff, 0x59, 0x87, 0xff, 0xaf,
+	0x8d, 0x88, 0x07, 0xe9, 0x7f, 0x85, 0xff, 0xaf, 0x15, 0xbe, 0xfd, 0x4b, 0x0d, 0xda, 0x87, 0x47,
+	0xf2, 0x1a, 0x60, 0x09, 0x21, 0xb0, 0x51, 0x2a, 0x5a, 0xf5, 0xad, 0x0a, 0x25, 0x48, 0xe3, 0x90,
+	0xe6, 0x4b, 0x0f, 0x23, 0xc4, 0xde, 0x3d, 0xcf, 0xab, 0x89, 0xdc, 0x58, 0x97, 0xc8, 0xab, 0xd0,
+	0x61, 0x47, 0x82, 0x45, 0xa5, 0x43, 0x7f, 0x01, 0xd8, 0x97, 0xe1, 0xa2, 0x2c, 0x14, 0x43, 0xd8,
+	0x3c, 0x2b, 0xec, 0xaf, 0xe1, 0x52, 0x05, 0xc7, 0xd2, 0xf9, 0x48, 0x86, 0x43, 0x10, 0xef, 0xd1,
+	0xf3, 0x45, 0x42, 0x8c, 0xbb, 0xb3, 0xf0, 0xb1, 0xbf, 0x07, 0xd0, 0xf0, 0xb3, 0x20, 0x62, 0xe4,
+	0x53, 0x68, 0x6a, 0x2d, 0xf4, 0xd4, 0xeb, 0x95, 0xa9, 0xd2, 0x67, 0x4f, 0x29, 0x73, 0x18, 0x89,
+	0x24, 0x77, 0xb4, 0xb3, 0xf5, 0x10, 0x60, 0x01, 0x92, 0x1d, 0x68, 0x2c, 0x1e, 0x7d, 0xf2, 0x93,
+	0x5c, 0x84, 0xe6, 0x8c, 0x86, 0x99, 0xc9, 0xa5, 0x36, 0x3e, 0xab, 0x3f, 0xac, 0xd9, 0xbf, 0x36,
+	0xa0, 0xa7, 0x43, 0x9b, 0x76, 0xb2, 0xa0, 0x6d, 0xc8, 0x61, 0x88, 0xc2, 0x26, 0xf7, 0xa0, 0x29,
+	0x2b, 0x2c, 0xed, 0xd7, 0x15, 0xbb, 0x0b, 0x6b, 0xd8, 0x39, 0xda, 0x83, 0x3c, 0x87, 0x1e, 0xf5,
+	0x3c, 0x75, 0x87, 0x79, 0xdc, 0x67, 0xe6, 0x01, 0x7b, 0xb7, 0x32, 0x05, 0x57, 0xdd, 0xdb, 0xd7,
+	0xbe, 0x8f, 0xa4, 0xab, 0xde, 0xda, 0x16, 0x2d, 0x41, 0x64, 0x1f, 0x3a, 0x82, 0x1e, 0x61, 0xa8,
+	0x0d, 0x15, 0xea, 0xce, 0x31, 0xa1, 0x5e, 0xd1, 0xa3, 0x52, 0x98, 0xb6, 0x40, 0x53, 0xd6, 0x95,
+	0xc7, 0xa7, 0x31, 0x8d, 0x72, 0x15, 0x06, 0xb5, 0xee, 0x22, 0x26, 0x7d, 0xe4, 0xde, 0xbd, 0x2c,
+	0x49, 0x58, 0xe4, 0x99, 0x1f, 0x06, 0x85, 0x4d, 0xfa, 0xd0, 0x8a, 0x13, 0x36, 0x0b, 0xd8, 0x5c,
+	0xb5, 0x52, 0xc3, 0x31, 0xa6, 0xf5, 0x25, 0x9c, 0x5f, 0xa1, 0x7f, 0x16, 0x11, 0xac, 0xcf, 0xa1,
+	0xb7, 0x44, 0xfa, 0x4c, 0x0a, 0xde, 0x82, 0x0e, 0xee, 0x9f, 0xcf, 0xa5, 0x9b, 0xc7, 0x42, 0x7c,
+	0xc1, 0x75, 0x1c, 0x6d, 0xd8, 0x3f, 0xd6, 0xa0, 0xab, 0x7d, 0x06, 0x69, 0x9a, 0x31, 0xe9, 0x15,
+	0xb2, 0x19, 0x0b, 0xcd, 0xe9, 0xa6, 0x0c, 0xd9, 0x08, 0xe9, 0x24, 0x98, 0xd3, 0x37, 0x2c, 0xe2,
+	0xb8, 0xcc, 0x02, 0x90, 0xdb, 0x9f, 0xf1, 0xcc, 0x9b, 0xe0, 0xab, 0xae, 0xe1, 0x18, 0x53, 0xf6,
+	0xb0, 0x94, 0x5c, 0x75, 0x57, 0xc3, 0x51, 0xdf, 0xd2, 0x7b, 0xca, 0xd2, 0x94, 0x8e, 0x4d, 0x9a,
+	0x8d, 0x69, 0xff, 0x56, 0x83, 0x6d, 0xa3, 0x17, 0xb6, 0xcc, 0x87, 0x95, 0x8a, 0x5b, 0xdb, 0x31,
+	0x8b, 0x22, 0x7c, 0x1f, 0x36, 0x12, 0x3e, 0x37, 0x35, 0x48, 0xaa, 0x55, 0xc0, 0xe7, 0x8e, 0x1a,
+	0x97, 0x27, 0xe4, 0xe2, 0x9c, 0x4d, 0x91, 0x35, 0x14, 0x07, 0xad, 0x7a, 0xc3, 0xe2, 0x1e, 0x52,
+	0x24, 0x5f, 0xd8, 0x2a, 0x45, 0xaa, 0xd2, 0x9b, 0x6a, 0x40, 0x1b, 0xe4, 0x3e, 0x6c, 0x06, 0x32,
+	0x83, 0xf2, 0xbc, 0x95, 0x8b, 0x5f, 0xac, 0x2c, 0xae, 0xd2, 0xeb, 0xa0, 0xcf, 0x83, 0xbf, 0x36,
+	0x8a, 0x9f, 0x01, 0x2f, 0x59, 0x32, 0x0b, 0x3c, 0x46, 0x0e, 0x61, 0x4b, 0x1e, 0x1b, 0x4f, 0xcd,
+	0x33, 0xba, 0x5f, 0x7d, 0x62, 0x9b, 0x03, 0xc6, 0xba, 0xb2, 0x66, 0x04, 0x1f, 0x00, 0xff, 0x23,
+	0x07, 0xd0, 0x2d, 0x85, 0x21, 0xbb, 0x55, 0x5f, 0x13, 0xa4, 0xbf, 0x3a, 0x50, 0x8a, 0x81, 0x3f,
+	0x00, 0x4c, 0x94, 0xcb, 0x85, 0xf3, 0xd2, 0x0f, 0x03, 0x6b, 0x77, 0x05, 0x2f, 0xc7, 0xd0, 0x0f,
+	0xca, 0xd5, 0x18, 0x4b, 0xcf, 0x7a, 0x6b, 0x77, 0x05, 0x2f, 0x62, 0xb8, 0x40, 0x56, 0x1f, 0x3b,
+	0xc4, 0x2e, 0x26, 0x1c, 0xfb, 0x3c, 0xb5, 0x6e, 0x9f, 0xe8, 0x53, 0x2c, 0x30, 0x84, 0x0b, 0x6b,
+	0xee, 0x7a, 0x72, 0xbb, 0x74, 0x4d, 0x1d, 0xf7, 0xb6, 0xb0, 0xee, 0x9c, 0xec, 0x54, 0xac, 0xf1,
+	0x02, 0x7a, 0x4b, 0xd7, 0x01, 0xb9, 0xb6, 0x34, 0xb1, 0x7a, 0x7d, 0x58, 0xd7, 0x8f, 0x1b, 0x2e,
+	0xa7, 0x56, 0xc3, 0xab, 0xa9, 0x5d, 0x3a, 0xee, 0xac, 0xdd, 0x15, 0xdc, 0xc4, 0x18, 0x6e, 0xaa,
+	0xff, 0x6a, 0x3e, 0xf9, 0x7b, 0x00, 0x8f, 0xdb, 0xed, 0xfa, 0xbc, 0x11, 0x00, 0x00,
 }
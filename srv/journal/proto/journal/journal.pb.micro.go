@@ -48,6 +48,8 @@ type JournalService interface {
 	ModifyJournal(ctx context.Context, in *ModifyRequest, opts ...client.CallOption) (*ModifyResponse, error)
 	AddDownloadSetting(ctx context.Context, in *AddDownloadSettingRequest, opts ...client.CallOption) (*AddDownloadSettingResponse, error)
 	FindDownloadSetting(ctx context.Context, in *FindDownloadSettingRequest, opts ...client.CallOption) (*FindDownloadSettingResponse, error)
+	FindExporters(ctx context.Context, in *FindExportersRequest, opts ...client.CallOption) (*FindExportersResponse, error)
+	ExportJournal(ctx context.Context, in *ExportRequest, opts ...client.CallOption) (*ExportResponse, error)
 }
 
 type journalService struct {
@@ -122,6 +124,26 @@ func (c *journalService) FindDownloadSetting(ctx context.Context, in *FindDownlo
 	return out, nil
 }
 
+func (c *journalService) FindExporters(ctx context.Context, in *FindExportersRequest, opts ...client.CallOption) (*FindExportersResponse, error) {
+	req := c.c.NewRequest(c.name, "JournalService.FindExporters", in)
+	out := new(FindExportersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *journalService) ExportJournal(ctx context.Context, in *ExportRequest, opts ...client.CallOption) (*ExportResponse, error) {
+	req := c.c.NewRequest(c.name, "JournalService.ExportJournal", in)
+	out := new(ExportResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for JournalService service
 
 type JournalServiceHandler interface {
@@ -131,6 +153,8 @@ type JournalServiceHandler interface {
 	ModifyJournal(context.Context, *ModifyRequest, *ModifyResponse) error
 	AddDownloadSetting(context.Context, *AddDownloadSettingRequest, *AddDownloadSettingResponse) error
 	FindDownloadSetting(context.Context, *FindDownloadSettingRequest, *FindDownloadSettingResponse) error
+	FindExporters(context.Context, *FindExportersRequest, *FindExportersResponse) error
+	ExportJournal(context.Context, *ExportRequest, *ExportResponse) error
 }
 
 func RegisterJournalServiceHandler(s server.Server, hdlr JournalServiceHandler, opts ...server.HandlerOption) error {
@@ -141,6 +165,8 @@ func RegisterJournalServiceHandler(s server.Server, hdlr JournalServiceHandler,
 		ModifyJournal(ctx context.Context, in *ModifyRequest, out *ModifyResponse) error
 		AddDownloadSetting(ctx context.Context, in *AddDownloadSettingRequest, out *AddDownloadSettingResponse) error
 		FindDownloadSetting(ctx context.Context, in *FindDownloadSettingRequest, out *FindDownloadSettingResponse) error
+		FindExporters(ctx context.Context, in *FindExportersRequest, out *FindExportersResponse) error
+		ExportJournal(ctx context.Context, in *ExportRequest, out *ExportResponse) error
 	}
 	type JournalService struct {
 		journalService
@@ -176,3 +202,11 @@ func (h *journalServiceHandler) AddDownloadSetting(ctx context.Context, in *AddD
 func (h *journalServiceHandler) FindDownloadSetting(ctx context.Context, in *FindDownloadSettingRequest, out *FindDownloadSettingResponse) error {
 	return h.JournalServiceHandler.FindDownloadSetting(ctx, in, out)
 }
+
+func (h *journalServiceHandler) FindExporters(ctx context.Context, in *FindExportersRequest, out *FindExportersResponse) error {
+	return h.JournalServiceHandler.FindExporters(ctx, in, out)
+}
+
+func (h *journalServiceHandler) ExportJournal(ctx context.Context, in *ExportRequest, out *ExportResponse) error {
+	return h.JournalServiceHandler.ExportJournal(ctx, in, out)
+}
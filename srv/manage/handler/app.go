@@ -28,7 +28,10 @@ const (
 	ActionHardDeleteApps    = "HardDeleteApps"
 	ActionRecoverSelectApps = "RecoverSelectApps"
 	ActionNextMonth         = "NextMonth"
+	ActionReopenMonth       = "ReopenMonth"
+	ActionFindMonthCloses   = "FindMonthCloses"
 	ActionModifySwkSetting  = "ModifySwkSetting   "
+	ActionModifyAppBooks    = "ModifyAppBooks"
 )
 
 // FindApps 查找多个APP记录
@@ -110,12 +113,15 @@ func (a *App) AddApp(ctx context.Context, req *app.AddAppRequest, rsp *app.AddAp
 		UpdatedBy:    req.GetWriter(),
 	}
 	params.Configs = model.Configs{
-		Special:         req.GetConfigs().GetSpecial(),
-		SyoriYm:         req.GetConfigs().GetSyoriYm(),
-		ShortLeases:     req.GetConfigs().GetShortLeases(),
-		KishuYm:         req.GetConfigs().GetKishuYm(),
-		MinorBaseAmount: req.GetConfigs().GetMinorBaseAmount(),
-		CheckStartDate:  req.GetConfigs().GetCheckStartDate(),
+		Special:            req.GetConfigs().GetSpecial(),
+		SyoriYm:            req.GetConfigs().GetSyoriYm(),
+		ShortLeases:        req.GetConfigs().GetShortLeases(),
+		KishuYm:            req.GetConfigs().GetKishuYm(),
+		MinorBaseAmount:    req.GetConfigs().GetMinorBaseAmount(),
+		RoundingMode:       req.GetConfigs().GetRoundingMode(),
+		CostModel:          req.GetConfigs().GetCostModel(),
+		FunctionalCurrency: req.GetConfigs().GetFunctionalCurrency(),
+		CheckStartDate:     req.GetConfigs().GetCheckStartDate(),
 	}
 
 	id, err := model.AddApp(ctx, req.GetDatabase(), &params)
@@ -166,12 +172,15 @@ func (a *App) ModifyAppConfigs(ctx context.Context, req *app.ModifyConfigsReques
 	utils.InfoLog(ActionModifyAppConfigs, utils.MsgProcessStarted)
 
 	config := model.Configs{
-		Special:         req.GetConfigs().GetSpecial(),
-		SyoriYm:         req.GetConfigs().GetSyoriYm(),
-		ShortLeases:     req.GetConfigs().GetShortLeases(),
-		KishuYm:         req.GetConfigs().GetKishuYm(),
-		MinorBaseAmount: req.GetConfigs().GetMinorBaseAmount(),
-		CheckStartDate:  req.GetConfigs().GetCheckStartDate(),
+		Special:            req.GetConfigs().GetSpecial(),
+		SyoriYm:            req.GetConfigs().GetSyoriYm(),
+		ShortLeases:        req.GetConfigs().GetShortLeases(),
+		KishuYm:            req.GetConfigs().GetKishuYm(),
+		MinorBaseAmount:    req.GetConfigs().GetMinorBaseAmount(),
+		RoundingMode:       req.GetConfigs().GetRoundingMode(),
+		CostModel:          req.GetConfigs().GetCostModel(),
+		FunctionalCurrency: req.GetConfigs().GetFunctionalCurrency(),
+		CheckStartDate:     req.GetConfigs().GetCheckStartDate(),
 	}
 	err := model.ModifyAppConfigs(ctx, req.GetDatabase(), req.GetAppId(), config)
 	if err != nil {
@@ -270,8 +279,9 @@ func (a *App) NextMonth(ctx context.Context, req *app.NextMonthRequest, rsp *app
 	utils.InfoLog(ActionNextMonth, utils.MsgProcessStarted)
 
 	param := model.Config{
-		AppID: req.GetAppId(),
-		Value: req.GetValue(),
+		AppID:  req.GetAppId(),
+		Value:  req.GetValue(),
+		Writer: req.GetWriter(),
 	}
 
 	err := model.NextMonth(ctx, req.GetDatabase(), param)
@@ -284,6 +294,43 @@ func (a *App) NextMonth(ctx context.Context, req *app.NextMonthRequest, rsp *app
 	return nil
 }
 
+// ReopenMonth 重开最近关闭的月度
+func (a *App) ReopenMonth(ctx context.Context, req *app.ReopenMonthRequest, rsp *app.ReopenMonthResponse) error {
+	utils.InfoLog(ActionReopenMonth, utils.MsgProcessStarted)
+
+	res, err := model.ReopenMonth(ctx, req.GetDatabase(), req.GetAppId(), req.GetWriter(), req.GetForce())
+	if err != nil {
+		utils.ErrorLog(ActionReopenMonth, err.Error())
+		return err
+	}
+
+	rsp.MonthClose = res.ToProto()
+
+	utils.InfoLog(ActionReopenMonth, utils.MsgProcessEnded)
+	return nil
+}
+
+// FindMonthCloses 获取月度关闭记录
+func (a *App) FindMonthCloses(ctx context.Context, req *app.FindMonthClosesRequest, rsp *app.FindMonthClosesResponse) error {
+	utils.InfoLog(ActionFindMonthCloses, utils.MsgProcessStarted)
+
+	closes, err := model.FindMonthCloses(ctx, req.GetDatabase(), req.GetAppId())
+	if err != nil {
+		utils.ErrorLog(ActionFindMonthCloses, err.Error())
+		return err
+	}
+
+	res := &app.FindMonthClosesResponse{}
+	for _, mc := range closes {
+		res.MonthCloses = append(res.MonthCloses, mc.ToProto())
+	}
+
+	*rsp = *res
+
+	utils.InfoLog(ActionFindMonthCloses, utils.MsgProcessEnded)
+	return nil
+}
+
 // ModifySwkSetting 更新基本设定
 func (a *App) ModifySwkSetting(ctx context.Context, req *app.ModifySwkSettingRequest, rsp *app.ModifySwkSettingResponse) error {
 	utils.InfoLog(ActionModifySwkSetting, utils.MsgProcessStarted)
@@ -297,3 +344,31 @@ func (a *App) ModifySwkSetting(ctx context.Context, req *app.ModifySwkSettingReq
 	utils.InfoLog(ActionModifySwkSetting, utils.MsgProcessEnded)
 	return nil
 }
+
+// ModifyAppBooks 更新会计账簿
+func (a *App) ModifyAppBooks(ctx context.Context, req *app.ModifyAppBooksRequest, rsp *app.ModifyAppBooksResponse) error {
+	utils.InfoLog(ActionModifyAppBooks, utils.MsgProcessStarted)
+
+	var books []*model.Book
+	for _, b := range req.GetBooks() {
+		books = append(books, &model.Book{
+			BookID:                b.GetBookId(),
+			BookName:              b.GetBookName(),
+			Standard:              b.GetStandard(),
+			ShortLeases:           b.GetShortLeases(),
+			MinorBaseAmount:       b.GetMinorBaseAmount(),
+			RateSource:            b.GetRateSource(),
+			CurveMethod:           b.GetCurveMethod(),
+			OperatingStraightLine: b.GetOperatingStraightLine(),
+		})
+	}
+
+	err := model.ModifyAppBooks(ctx, req.GetDatabase(), req.GetAppId(), req.GetWriter(), books)
+	if err != nil {
+		utils.ErrorLog(ActionModifyAppBooks, err.Error())
+		return err
+	}
+
+	utils.InfoLog(ActionModifyAppBooks, utils.MsgProcessEnded)
+	return nil
+}
@@ -26,8 +26,9 @@ var (
 
 // Config 顾客配置情报
 type Config struct {
-	AppID string `json:"app_id" bson:"app_id"`
-	Value string `json:"value" bson:"value"`
+	AppID  string `json:"app_id" bson:"app_id"`
+	Value  string `json:"value" bson:"value"`
+	Writer string `json:"writer" bson:"writer"`
 }
 
 // App 应用程序
@@ -48,6 +49,8 @@ type App struct {
 	Configs      Configs            `json:"configs" bson:"configs"`
 	SwkControl   bool               `json:"swk_control" bson:"swk_control"`
 	ConfimMethod string             `json:"confim_method" bson:"confim_method"`
+	ClosedYm     string             `json:"closed_ym" bson:"closed_ym"`
+	Books        []*Book            `json:"books" bson:"books"`
 	CreatedAt    time.Time          `json:"created_at" bson:"created_at"`
 	CreatedBy    string             `json:"created_by" bson:"created_by"`
 	UpdatedAt    time.Time          `json:"updated_at" bson:"updated_at"`
@@ -56,12 +59,27 @@ type App struct {
 	DeletedBy    string             `json:"deleted_by" bson:"deleted_by"`
 }
 type Configs struct {
-	Special         string `json:"special" bson:"special"`
-	CheckStartDate  string `json:"check_start_date" bson:"check_start_date"`
-	SyoriYm         string `json:"syori_ym" bson:"syori_ym"`
-	ShortLeases     string `json:"short_leases" bson:"short_leases"`
-	KishuYm         string `json:"kishu_ym" bson:"kishu_ym"`
-	MinorBaseAmount string `json:"minor_base_amount" bson:"minor_base_amount"`
+	Special            string `json:"special" bson:"special"`
+	CheckStartDate     string `json:"check_start_date" bson:"check_start_date"`
+	SyoriYm            string `json:"syori_ym" bson:"syori_ym"`
+	ShortLeases        string `json:"short_leases" bson:"short_leases"`
+	KishuYm            string `json:"kishu_ym" bson:"kishu_ym"`
+	MinorBaseAmount    string `json:"minor_base_amount" bson:"minor_base_amount"`
+	RoundingMode       string `json:"rounding_mode" bson:"rounding_mode"`
+	CostModel          string `json:"cost_model" bson:"cost_model"`
+	FunctionalCurrency string `json:"functional_currency" bson:"functional_currency"`
+}
+
+// Book 会计账簿(主账簿以外)
+type Book struct {
+	BookID                string `json:"book_id" bson:"book_id"`
+	BookName              string `json:"book_name" bson:"book_name"`
+	Standard              string `json:"standard" bson:"standard"`
+	ShortLeases           string `json:"short_leases" bson:"short_leases"`
+	MinorBaseAmount       string `json:"minor_base_amount" bson:"minor_base_amount"`
+	RateSource            string `json:"rate_source" bson:"rate_source"`
+	CurveMethod           string `json:"curve_method" bson:"curve_method"`
+	OperatingStraightLine bool   `json:"operating_straight_line" bson:"operating_straight_line"`
 }
 
 // Max 最大顺
@@ -87,6 +105,7 @@ func (a *App) ToProto() *app.App {
 		Remarks:      a.Remarks,
 		SwkControl:   a.SwkControl,
 		ConfimMethod: a.ConfimMethod,
+		ClosedYm:     a.ClosedYm,
 		CreatedAt:    a.CreatedAt.String(),
 		CreatedBy:    a.CreatedBy,
 		UpdatedAt:    a.UpdatedAt.String(),
@@ -95,17 +114,37 @@ func (a *App) ToProto() *app.App {
 		DeletedBy:    a.DeletedBy,
 	}
 	config := app.Configs{
-		Special:         a.Configs.Special,
-		CheckStartDate:  a.Configs.CheckStartDate,
-		KishuYm:         a.Configs.KishuYm,
-		SyoriYm:         a.Configs.SyoriYm,
-		ShortLeases:     a.Configs.ShortLeases,
-		MinorBaseAmount: a.Configs.MinorBaseAmount,
+		Special:            a.Configs.Special,
+		CheckStartDate:     a.Configs.CheckStartDate,
+		KishuYm:            a.Configs.KishuYm,
+		SyoriYm:            a.Configs.SyoriYm,
+		ShortLeases:        a.Configs.ShortLeases,
+		MinorBaseAmount:    a.Configs.MinorBaseAmount,
+		RoundingMode:       a.Configs.RoundingMode,
+		CostModel:          a.Configs.CostModel,
+		FunctionalCurrency: a.Configs.FunctionalCurrency,
 	}
 	apps.Configs = &config
+	for _, b := range a.Books {
+		apps.Books = append(apps.Books, b.ToProto())
+	}
 	return &apps
 }
 
+// ToProto 转换为proto数据
+func (b *Book) ToProto() *app.Book {
+	return &app.Book{
+		BookId:                b.BookID,
+		BookName:              b.BookName,
+		Standard:              b.Standard,
+		ShortLeases:           b.ShortLeases,
+		MinorBaseAmount:       b.MinorBaseAmount,
+		RateSource:            b.RateSource,
+		CurveMethod:           b.CurveMethod,
+		OperatingStraightLine: b.OperatingStraightLine,
+	}
+}
+
 // FindAppsByIds 根据APPID数组查询
 func FindAppsByIds(ctx context.Context, db, domain string, appIDlist []string) (a []App, err error) {
 	// _, sc := database.BeginMongo()
@@ -1106,7 +1145,7 @@ func RecoverSelectApps(ctx context.Context, db string, appIDList []string, userI
 	return nil
 }
 
-// NextMonth 下一月度处理
+// NextMonth 下一月度处理(关闭当前处理月度)
 func NextMonth(ctx context.Context, db string, conf Config) (err error) {
 	client := database.New()
 	c := client.Database(database.GetDBName(db)).Collection(AppsCollection)
@@ -1133,14 +1172,58 @@ func NextMonth(ctx context.Context, db string, conf Config) (err error) {
 	}
 
 	cr := client.Database(database.GetDBName(db)).Collection(GetItemCollectionName(result.DatastoreID))
+	cm := client.Database(database.GetDBName(db)).Collection(MonthClosesCollection)
+
+	// 关闭的处理月度
+	current, err := FindApp(ctx, db, conf.AppID)
+	if err != nil {
+		utils.ErrorLog("NextMonth", err.Error())
+		return err
+	}
+	syoriYm := current.Configs.SyoriYm
+
+	// 保存关闭时的台账快照(重开月度时恢复)
+	closeID := primitive.NewObjectID()
+	snapshots, err := takeSnapshots(ctx, db, conf.AppID, closeID.Hex())
+	if err != nil {
+		utils.ErrorLog("NextMonth", err.Error())
+		return err
+	}
+
+	// 月度关闭记录(事务中不能新建集合,先以关闭中状态登录,事务中确定)
+	mc := MonthClose{
+		ID:        closeID,
+		CloseID:   closeID.Hex(),
+		AppID:     conf.AppID,
+		SyoriYm:   syoriYm,
+		NextYm:    conf.Value,
+		Status:    MonthClosing,
+		Snapshots: snapshots,
+		ClosedAt:  time.Now(),
+		ClosedBy:  conf.Writer,
+	}
+	if _, err := cm.InsertOne(ctx, mc); err != nil {
+		dropSnapshots(ctx, db, snapshots)
+		utils.ErrorLog("NextMonth", err.Error())
+		return err
+	}
+	// 关闭失败的场合,删除快照和关闭记录
+	rollback := func() {
+		dropSnapshots(ctx, db, snapshots)
+		if _, err := cm.DeleteOne(ctx, bson.M{"close_id": closeID.Hex()}); err != nil {
+			utils.ErrorLog("error NextMonth", err.Error())
+		}
+	}
 
 	// 开启事务,更新契约履历和数据
 	session, err := client.StartSession()
 	if err != nil {
+		rollback()
 		utils.ErrorLog("error NextMonth", err.Error())
 		return err
 	}
 	if err = session.StartTransaction(); err != nil {
+		rollback()
 		utils.ErrorLog("error NextMonth", err.Error())
 		return err
 	}
@@ -1151,6 +1234,7 @@ func NextMonth(ctx context.Context, db string, conf Config) (err error) {
 		}
 		config := bson.M{}
 		config["configs.syori_ym"] = conf.Value
+		config["closed_ym"] = syoriYm
 		update := bson.M{
 			"$set": config,
 		}
@@ -1195,6 +1279,20 @@ func NextMonth(ctx context.Context, db string, conf Config) (err error) {
 			return err
 		}
 
+		// 月度关闭记录确定
+		query2 := bson.M{
+			"close_id": closeID.Hex(),
+		}
+		update2 := bson.M{
+			"$set": bson.M{
+				"status": MonthClosed,
+			},
+		}
+		if _, err := cm.UpdateOne(sc, query2, update2); err != nil {
+			utils.ErrorLog("error NextMonth", err.Error())
+			return err
+		}
+
 		if err = session.CommitTransaction(sc); err != nil {
 			if err != nil {
 				session.AbortTransaction(ctx)
@@ -1205,6 +1303,7 @@ func NextMonth(ctx context.Context, db string, conf Config) (err error) {
 		return nil
 	}); err != nil {
 		session.AbortTransaction(ctx)
+		rollback()
 		utils.ErrorLog("error NextMonth", err.Error())
 		return err
 	}
@@ -1238,3 +1337,35 @@ func ModifySwkSetting(ctx context.Context, db string, appID string, handleMonth
 
 	return nil
 }
+
+// ModifyAppBooks 更新APP的会计账簿
+func ModifyAppBooks(ctx context.Context, db, appID, writer string, books []*Book) (err error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(AppsCollection)
+
+	query := bson.M{
+		"app_id": appID,
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"books":      books,
+			"updated_at": time.Now(),
+			"updated_by": writer,
+		},
+	}
+
+	queryJSON, _ := json.Marshal(query)
+	utils.DebugLog("ModifyAppBooks", fmt.Sprintf("query: [ %s ]", queryJSON))
+
+	updateSON, _ := json.Marshal(update)
+	utils.DebugLog("ModifyAppBooks", fmt.Sprintf("update: [ %s ]", updateSON))
+
+	_, err = c.UpdateOne(ctx, query, update)
+	if err != nil {
+		utils.ErrorLog("error ModifyAppBooks", err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,384 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"rxcsoft.cn/pit3/srv/manage/proto/app"
+	"rxcsoft.cn/pit3/srv/manage/utils"
+
+	database "rxcsoft.cn/utils/mongo"
+)
+
+// 集合
+var (
+	MonthClosesCollection = "month_closes"
+	SnapshotCollection    = "month_snapshot_"
+)
+
+// 月度关闭状态
+const (
+	MonthClosing  = "closing"
+	MonthClosed   = "closed"
+	MonthReopened = "reopened"
+)
+
+// snapshotKeys 月度关闭时需要保存快照的台账(契约,履历,支付,利息,偿还,分录)
+var snapshotKeys = []string{"keiyakudaicho", "rireki", "paymentStatus", "paymentInterest", "repayment", "shiwake"}
+
+// MonthClose 月度关闭记录
+type MonthClose struct {
+	ID         primitive.ObjectID `json:"id" bson:"_id"`
+	CloseID    string             `json:"close_id" bson:"close_id"`
+	AppID      string             `json:"app_id" bson:"app_id"`
+	SyoriYm    string             `json:"syori_ym" bson:"syori_ym"`   // 关闭的处理月度
+	NextYm     string             `json:"next_ym" bson:"next_ym"`     // 关闭后的处理月度
+	Status     string             `json:"status" bson:"status"`       // closing/closed/reopened
+	Snapshots  []*Snapshot        `json:"snapshots" bson:"snapshots"` // 台账快照
+	ClosedAt   time.Time          `json:"closed_at" bson:"closed_at"`
+	ClosedBy   string             `json:"closed_by" bson:"closed_by"`
+	ReopenedAt time.Time          `json:"reopened_at" bson:"reopened_at"`
+	ReopenedBy string             `json:"reopened_by" bson:"reopened_by"`
+}
+
+// Snapshot 台账快照
+type Snapshot struct {
+	ApiKey      string `json:"api_key" bson:"api_key"`
+	DatastoreID string `json:"datastore_id" bson:"datastore_id"`
+	Collection  string `json:"collection" bson:"collection"`
+	Count       int64  `json:"count" bson:"count"`
+}
+
+// ToProto 转换为proto数据
+func (m *MonthClose) ToProto() *app.MonthClose {
+	var snapshots []*app.Snapshot
+	for _, s := range m.Snapshots {
+		snapshots = append(snapshots, s.ToProto())
+	}
+
+	return &app.MonthClose{
+		CloseId:    m.CloseID,
+		AppId:      m.AppID,
+		SyoriYm:    m.SyoriYm,
+		NextYm:     m.NextYm,
+		Status:     m.Status,
+		Snapshots:  snapshots,
+		ClosedAt:   m.ClosedAt.String(),
+		ClosedBy:   m.ClosedBy,
+		ReopenedAt: m.ReopenedAt.String(),
+		ReopenedBy: m.ReopenedBy,
+	}
+}
+
+// ToProto 转换为proto数据
+func (s *Snapshot) ToProto() *app.Snapshot {
+	return &app.Snapshot{
+		ApiKey:      s.ApiKey,
+		DatastoreId: s.DatastoreID,
+		Count:       s.Count,
+	}
+}
+
+// getSnapshotCollectionName 获取快照集合名
+func getSnapshotCollectionName(closeID, datastoreID string) string {
+	return SnapshotCollection + closeID + "_" + datastoreID
+}
+
+// FindMonthCloses 获取APP的月度关闭记录(新的在前)
+func FindMonthCloses(ctx context.Context, db, appID string) (items []MonthClose, err error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(MonthClosesCollection)
+
+	query := bson.M{
+		"app_id": appID,
+	}
+
+	queryJSON, _ := json.Marshal(query)
+	utils.DebugLog("FindMonthCloses", fmt.Sprintf("query: [ %s ]", queryJSON))
+
+	opts := options.Find().SetSort(bson.D{
+		{Key: "closed_at", Value: -1},
+	})
+
+	var result []MonthClose
+	cur, err := c.Find(ctx, query, opts)
+	if err != nil {
+		utils.ErrorLog("error FindMonthCloses", err.Error())
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	for cur.Next(ctx) {
+		var mc MonthClose
+		err := cur.Decode(&mc)
+		if err != nil {
+			utils.ErrorLog("error FindMonthCloses", err.Error())
+			return nil, err
+		}
+		result = append(result, mc)
+	}
+
+	return result, nil
+}
+
+// takeSnapshots 保存月度关闭时的台账快照
+func takeSnapshots(ctx context.Context, db, appID, closeID string) (snapshots []*Snapshot, err error) {
+	client := database.New()
+	cd := client.Database(database.GetDBName(db)).Collection(DataStoresCollection)
+
+	// Datastore Datastore信息
+	type Datastore struct {
+		DatastoreID string `json:"datastore_id" bson:"datastore_id"`
+		ApiKey      string `json:"api_key" bson:"api_key"`
+	}
+
+	query := bson.M{
+		"deleted_by": "",
+		"app_id":     appID,
+		"api_key": bson.M{
+			"$in": snapshotKeys,
+		},
+	}
+
+	queryJSON, _ := json.Marshal(query)
+	utils.DebugLog("takeSnapshots", fmt.Sprintf("query: [ %s ]", queryJSON))
+
+	cur, err := cd.Find(ctx, query)
+	if err != nil {
+		utils.ErrorLog("error takeSnapshots", err.Error())
+		return nil, err
+	}
+	defer cur.Close(ctx)
+
+	var datastores []Datastore
+	if err := cur.All(ctx, &datastores); err != nil {
+		utils.ErrorLog("error takeSnapshots", err.Error())
+		return nil, err
+	}
+
+	for _, ds := range datastores {
+		s := &Snapshot{
+			ApiKey:      ds.ApiKey,
+			DatastoreID: ds.DatastoreID,
+			Collection:  getSnapshotCollectionName(closeID, ds.DatastoreID),
+		}
+
+		// $out不能在事务中使用,快照在事务外做成
+		c := client.Database(database.GetDBName(db)).Collection(GetItemCollectionName(ds.DatastoreID))
+		pipe := []bson.M{
+			{"$match": bson.M{}},
+			{"$out": s.Collection},
+		}
+		opts := options.Aggregate().SetAllowDiskUse(true)
+		out, err := c.Aggregate(ctx, pipe, opts)
+		if err != nil {
+			utils.ErrorLog("error takeSnapshots", err.Error())
+			dropSnapshots(ctx, db, append(snapshots, s))
+			return nil, err
+		}
+		out.Close(ctx)
+
+		count, err := client.Database(database.GetDBName(db)).Collection(s.Collection).CountDocuments(ctx, bson.M{})
+		if err != nil {
+			utils.ErrorLog("error takeSnapshots", err.Error())
+			dropSnapshots(ctx, db, append(snapshots, s))
+			return nil, err
+		}
+		s.Count = count
+
+		snapshots = append(snapshots, s)
+	}
+
+	return snapshots, nil
+}
+
+// dropSnapshots 删除台账快照
+func dropSnapshots(ctx context.Context, db string, snapshots []*Snapshot) {
+	client := database.New()
+	for _, s := range snapshots {
+		if err := client.Database(database.GetDBName(db)).Collection(s.Collection).Drop(ctx); err != nil {
+			utils.ErrorLog("error dropSnapshots", err.Error())
+		}
+	}
+}
+
+// changedSince 统计关闭后新建或更新的数据件数,件数与快照不一致(有删除)的场合也视为有变更
+func changedSince(ctx context.Context, db string, s *Snapshot, closedAt time.Time) (int64, error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(GetItemCollectionName(s.DatastoreID))
+
+	query := bson.M{
+		"$or": []bson.M{
+			{"created_at": bson.M{"$gt": closedAt}},
+			{"updated_at": bson.M{"$gt": closedAt}},
+		},
+	}
+
+	changed, err := c.CountDocuments(ctx, query)
+	if err != nil {
+		return 0, err
+	}
+	if changed > 0 {
+		return changed, nil
+	}
+
+	total, err := c.CountDocuments(ctx, bson.M{})
+	if err != nil {
+		return 0, err
+	}
+	if total != s.Count {
+		if total > s.Count {
+			return total - s.Count, nil
+		}
+		return s.Count - total, nil
+	}
+
+	return 0, nil
+}
+
+// ReopenMonth 重开最近关闭的月度(台账恢复到关闭时的快照,处理月度退回),
+// 关闭后有变更的数据时,恢复会丢弃这些变更,因此只有指定force时才执行
+func ReopenMonth(ctx context.Context, db, appID, writer string, force bool) (mc MonthClose, err error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(AppsCollection)
+	cm := client.Database(database.GetDBName(db)).Collection(MonthClosesCollection)
+
+	closes, err := FindMonthCloses(ctx, db, appID)
+	if err != nil {
+		utils.ErrorLog("error ReopenMonth", err.Error())
+		return mc, err
+	}
+
+	// 最近关闭的月度和其之前关闭的月度
+	var last *MonthClose
+	prevYm := ""
+	for i := range closes {
+		if closes[i].Status != MonthClosed {
+			continue
+		}
+		if last == nil {
+			last = &closes[i]
+			continue
+		}
+		prevYm = closes[i].SyoriYm
+		break
+	}
+	if last == nil {
+		return mc, errors.New("再開できる締め済みの月度がありません")
+	}
+
+	// 关闭后的变更检查
+	if !force {
+		var changes []string
+		for _, s := range last.Snapshots {
+			count, err := changedSince(ctx, db, s, last.ClosedAt)
+			if err != nil {
+				utils.ErrorLog("error ReopenMonth", err.Error())
+				return mc, err
+			}
+			if count > 0 {
+				changes = append(changes, fmt.Sprintf("%s(%d件)", s.ApiKey, count))
+			}
+		}
+		if len(changes) > 0 {
+			return mc, fmt.Errorf("締め後に変更されたデータがあります[%s]。再開すると変更が失われるため、確認のうえ強制再開してください", strings.Join(changes, ","))
+		}
+	}
+
+	// 台账恢复($out保留原集合的索引,并原子替换集合内容)
+	// 恢复途中失败的场合,记录仍为关闭状态,可以再次执行
+	for _, s := range last.Snapshots {
+		cs := client.Database(database.GetDBName(db)).Collection(s.Collection)
+		pipe := []bson.M{
+			{"$match": bson.M{}},
+			{"$out": GetItemCollectionName(s.DatastoreID)},
+		}
+		opts := options.Aggregate().SetAllowDiskUse(true)
+		out, err := cs.Aggregate(ctx, pipe, opts)
+		if err != nil {
+			utils.ErrorLog("error ReopenMonth", err.Error())
+			return mc, err
+		}
+		out.Close(ctx)
+	}
+
+	now := time.Now()
+
+	// 开启事务,更新处理月度和关闭记录
+	session, err := client.StartSession()
+	if err != nil {
+		utils.ErrorLog("error ReopenMonth", err.Error())
+		return mc, err
+	}
+	if err = session.StartTransaction(); err != nil {
+		utils.ErrorLog("error ReopenMonth", err.Error())
+		return mc, err
+	}
+	if err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		query := bson.M{
+			"app_id": appID,
+		}
+		update := bson.M{
+			"$set": bson.M{
+				"configs.syori_ym": last.SyoriYm,
+				"closed_ym":        prevYm,
+			},
+		}
+
+		queryJSON, _ := json.Marshal(query)
+		utils.DebugLog("ReopenMonth", fmt.Sprintf("query: [ %s ]", queryJSON))
+
+		updateSON, _ := json.Marshal(update)
+		utils.DebugLog("ReopenMonth", fmt.Sprintf("update: [ %s ]", updateSON))
+
+		if _, err := c.UpdateOne(sc, query, update); err != nil {
+			utils.ErrorLog("error ReopenMonth", err.Error())
+			return err
+		}
+
+		query1 := bson.M{
+			"close_id": last.CloseID,
+		}
+		update1 := bson.M{
+			"$set": bson.M{
+				"status":      MonthReopened,
+				"reopened_at": now,
+				"reopened_by": writer,
+			},
+		}
+
+		if _, err := cm.UpdateOne(sc, query1, update1); err != nil {
+			utils.ErrorLog("error ReopenMonth", err.Error())
+			return err
+		}
+
+		if err = session.CommitTransaction(sc); err != nil {
+			session.AbortTransaction(ctx)
+			utils.ErrorLog("error ReopenMonth", err.Error())
+			return err
+		}
+		return nil
+	}); err != nil {
+		session.AbortTransaction(ctx)
+		utils.ErrorLog("error ReopenMonth", err.Error())
+		return mc, err
+	}
+	session.EndSession(ctx)
+
+	// 快照已恢复,删除
+	dropSnapshots(ctx, db, last.Snapshots)
+
+	last.Status = MonthReopened
+	last.ReopenedAt = now
+	last.ReopenedBy = writer
+
+	return *last, nil
+}
@@ -54,7 +54,10 @@ type AppService interface {
 	HardDeleteApps(ctx context.Context, in *HardDeleteAppsRequest, opts ...client.CallOption) (*HardDeleteAppsResponse, error)
 	RecoverSelectApps(ctx context.Context, in *RecoverSelectAppsRequest, opts ...client.CallOption) (*RecoverSelectAppsResponse, error)
 	NextMonth(ctx context.Context, in *NextMonthRequest, opts ...client.CallOption) (*NextMonthResponse, error)
+	ReopenMonth(ctx context.Context, in *ReopenMonthRequest, opts ...client.CallOption) (*ReopenMonthResponse, error)
+	FindMonthCloses(ctx context.Context, in *FindMonthClosesRequest, opts ...client.CallOption) (*FindMonthClosesResponse, error)
 	ModifySwkSetting(ctx context.Context, in *ModifySwkSettingRequest, opts ...client.CallOption) (*ModifySwkSettingResponse, error)
+	ModifyAppBooks(ctx context.Context, in *ModifyAppBooksRequest, opts ...client.CallOption) (*ModifyAppBooksResponse, error)
 }
 
 type appService struct {
@@ -189,6 +192,26 @@ func (c *appService) NextMonth(ctx context.Context, in *NextMonthRequest, opts .
 	return out, nil
 }
 
+func (c *appService) ReopenMonth(ctx context.Context, in *ReopenMonthRequest, opts ...client.CallOption) (*ReopenMonthResponse, error) {
+	req := c.c.NewRequest(c.name, "AppService.ReopenMonth", in)
+	out := new(ReopenMonthResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *appService) FindMonthCloses(ctx context.Context, in *FindMonthClosesRequest, opts ...client.CallOption) (*FindMonthClosesResponse, error) {
+	req := c.c.NewRequest(c.name, "AppService.FindMonthCloses", in)
+	out := new(FindMonthClosesResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *appService) ModifySwkSetting(ctx context.Context, in *ModifySwkSettingRequest, opts ...client.CallOption) (*ModifySwkSettingResponse, error) {
 	req := c.c.NewRequest(c.name, "AppService.ModifySwkSetting", in)
 	out := new(ModifySwkSettingResponse)
@@ -199,6 +222,16 @@ func (c *appService) ModifySwkSetting(ctx context.Context, in *ModifySwkSettingR
 	return out, nil
 }
 
+func (c *appService) ModifyAppBooks(ctx context.Context, in *ModifyAppBooksRequest, opts ...client.CallOption) (*ModifyAppBooksResponse, error) {
+	req := c.c.NewRequest(c.name, "AppService.ModifyAppBooks", in)
+	out := new(ModifyAppBooksResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AppService service
 
 type AppServiceHandler interface {
@@ -214,7 +247,10 @@ type AppServiceHandler interface {
 	HardDeleteApps(context.Context, *HardDeleteAppsRequest, *HardDeleteAppsResponse) error
 	RecoverSelectApps(context.Context, *RecoverSelectAppsRequest, *RecoverSelectAppsResponse) error
 	NextMonth(context.Context, *NextMonthRequest, *NextMonthResponse) error
+	ReopenMonth(context.Context, *ReopenMonthRequest, *ReopenMonthResponse) error
+	FindMonthCloses(context.Context, *FindMonthClosesRequest, *FindMonthClosesResponse) error
 	ModifySwkSetting(context.Context, *ModifySwkSettingRequest, *ModifySwkSettingResponse) error
+	ModifyAppBooks(context.Context, *ModifyAppBooksRequest, *ModifyAppBooksResponse) error
 }
 
 func RegisterAppServiceHandler(s server.Server, hdlr AppServiceHandler, opts ...server.HandlerOption) error {
@@ -231,7 +267,10 @@ func RegisterAppServiceHandler(s server.Server, hdlr AppServiceHandler, opts ...
 		HardDeleteApps(ctx context.Context, in *HardDeleteAppsRequest, out *HardDeleteAppsResponse) error
 		RecoverSelectApps(ctx context.Context, in *RecoverSelectAppsRequest, out *RecoverSelectAppsResponse) error
 		NextMonth(ctx context.Context, in *NextMonthRequest, out *NextMonthResponse) error
+		ReopenMonth(ctx context.Context, in *ReopenMonthRequest, out *ReopenMonthResponse) error
+		FindMonthCloses(ctx context.Context, in *FindMonthClosesRequest, out *FindMonthClosesResponse) error
 		ModifySwkSetting(ctx context.Context, in *ModifySwkSettingRequest, out *ModifySwkSettingResponse) error
+		ModifyAppBooks(ctx context.Context, in *ModifyAppBooksRequest, out *ModifyAppBooksResponse) error
 	}
 	type AppService struct {
 		appService
@@ -292,7 +331,19 @@ func (h *appServiceHandler) NextMonth(ctx context.Context, in *NextMonthRequest,
 	return h.AppServiceHandler.NextMonth(ctx, in, out)
 }
 
+func (h *appServiceHandler) ReopenMonth(ctx context.Context, in *ReopenMonthRequest, out *ReopenMonthResponse) error {
+	return h.AppServiceHandler.ReopenMonth(ctx, in, out)
+}
+
+func (h *appServiceHandler) FindMonthCloses(ctx context.Context, in *FindMonthClosesRequest, out *FindMonthClosesResponse) error {
+	return h.AppServiceHandler.FindMonthCloses(ctx, in, out)
+}
+
 func (h *appServiceHandler) ModifySwkSetting(ctx context.Context, in *ModifySwkSettingRequest, out *ModifySwkSettingResponse) error {
 	return h.AppServiceHandler.ModifySwkSetting(ctx, in, out)
 }
 
+func (h *appServiceHandler) ModifyAppBooks(ctx context.Context, in *ModifyAppBooksRequest, out *ModifyAppBooksResponse) error {
+	return h.AppServiceHandler.ModifyAppBooks(ctx, in, out)
+}
+
@@ -43,6 +43,8 @@ type App struct {
 	Configs              *Configs `protobuf:"bytes,19,opt,name=configs,proto3" json:"configs"`
 	SwkControl           bool     `protobuf:"varint,20,opt,name=swk_control,json=swkControl,proto3" json:"swk_control"`
 	ConfimMethod         string   `protobuf:"bytes,21,opt,name=confim_method,json=confimMethod,proto3" json:"confim_method"`
+	ClosedYm             string   `protobuf:"bytes,22,opt,name=closed_ym,json=closedYm,proto3" json:"closed_ym"`
+	Books                []*Book  `protobuf:"bytes,23,rep,name=books,proto3" json:"books"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -220,6 +222,116 @@ func (m *App) GetConfimMethod() string {
 	return ""
 }
 
+func (m *App) GetClosedYm() string {
+	if m != nil {
+		return m.ClosedYm
+	}
+	return ""
+}
+
+func (m *App) GetBooks() []*Book {
+	if m != nil {
+		return m.Books
+	}
+	return nil
+}
+
+// 会计账簿
+type Book struct {
+	BookId                string   `protobuf:"bytes,1,opt,name=book_id,json=bookId,proto3" json:"book_id"`
+	BookName              string   `protobuf:"bytes,2,opt,name=book_name,json=bookName,proto3" json:"book_name"`
+	Standard              string   `protobuf:"bytes,3,opt,name=standard,proto3" json:"standard"`
+	ShortLeases           string   `protobuf:"bytes,4,opt,name=short_leases,json=shortLeases,proto3" json:"short_leases"`
+	MinorBaseAmount       string   `protobuf:"bytes,5,opt,name=minor_base_amount,json=minorBaseAmount,proto3" json:"minor_base_amount"`
+	RateSource            string   `protobuf:"bytes,6,opt,name=rate_source,json=rateSource,proto3" json:"rate_source"`
+	CurveMethod           string   `protobuf:"bytes,7,opt,name=curve_method,json=curveMethod,proto3" json:"curve_method"`
+	OperatingStraightLine bool     `protobuf:"varint,8,opt,name=operating_straight_line,json=operatingStraightLine,proto3" json:"operating_straight_line"`
+	XXX_NoUnkeyedLiteral  struct{} `json:"-"`
+	XXX_unrecognized      []byte   `json:"-"`
+	XXX_sizecache         int32    `json:"-"`
+}
+
+func (m *Book) Reset()         { *m = Book{} }
+func (m *Book) String() string { return proto.CompactTextString(m) }
+func (*Book) ProtoMessage()    {}
+func (*Book) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{1}
+}
+
+func (m *Book) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Book.Unmarshal(m, b)
+}
+func (m *Book) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Book.Marshal(b, m, deterministic)
+}
+func (m *Book) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Book.Merge(m, src)
+}
+func (m *Book) XXX_Size() int {
+	return xxx_messageInfo_Book.Size(m)
+}
+func (m *Book) XXX_DiscardUnknown() {
+	xxx_messageInfo_Book.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Book proto.InternalMessageInfo
+
+func (m *Book) GetBookId() string {
+	if m != nil {
+		return m.BookId
+	}
+	return ""
+}
+
+func (m *Book) GetBookName() string {
+	if m != nil {
+		return m.BookName
+	}
+	return ""
+}
+
+func (m *Book) GetStandard() string {
+	if m != nil {
+		return m.Standard
+	}
+	return ""
+}
+
+func (m *Book) GetShortLeases() string {
+	if m != nil {
+		return m.ShortLeases
+	}
+	return ""
+}
+
+func (m *Book) GetMinorBaseAmount() string {
+	if m != nil {
+		return m.MinorBaseAmount
+	}
+	return ""
+}
+
+func (m *Book) GetRateSource() string {
+	if m != nil {
+		return m.RateSource
+	}
+	return ""
+}
+
+func (m *Book) GetCurveMethod() string {
+	if m != nil {
+		return m.CurveMethod
+	}
+	return ""
+}
+
+func (m *Book) GetOperatingStraightLine() bool {
+	if m != nil {
+		return m.OperatingStraightLine
+	}
+	return false
+}
+
 // AppConfigs
 type Configs struct {
 	Special              string   `protobuf:"bytes,1,opt,name=special,proto3" json:"special"`
@@ -228,6 +340,9 @@ type Configs struct {
 	ShortLeases          string   `protobuf:"bytes,4,opt,name=short_leases,json=shortLeases,proto3" json:"short_leases"`
 	KishuYm              string   `protobuf:"bytes,5,opt,name=kishu_ym,json=kishuYm,proto3" json:"kishu_ym"`
 	MinorBaseAmount      string   `protobuf:"bytes,6,opt,name=minor_base_amount,json=minorBaseAmount,proto3" json:"minor_base_amount"`
+	RoundingMode         string   `protobuf:"bytes,7,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode"`
+	CostModel            string   `protobuf:"bytes,8,opt,name=cost_model,json=costModel,proto3" json:"cost_model"`
+	FunctionalCurrency   string   `protobuf:"bytes,9,opt,name=functional_currency,json=functionalCurrency,proto3" json:"functional_currency"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -237,7 +352,7 @@ func (m *Configs) Reset()         { *m = Configs{} }
 func (m *Configs) String() string { return proto.CompactTextString(m) }
 func (*Configs) ProtoMessage()    {}
 func (*Configs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{1}
+	return fileDescriptor_e0f9056a14b86d47, []int{2}
 }
 
 func (m *Configs) XXX_Unmarshal(b []byte) error {
@@ -300,6 +415,27 @@ func (m *Configs) GetMinorBaseAmount() string {
 	return ""
 }
 
+func (m *Configs) GetRoundingMode() string {
+	if m != nil {
+		return m.RoundingMode
+	}
+	return ""
+}
+
+func (m *Configs) GetCostModel() string {
+	if m != nil {
+		return m.CostModel
+	}
+	return ""
+}
+
+func (m *Configs) GetFunctionalCurrency() string {
+	if m != nil {
+		return m.FunctionalCurrency
+	}
+	return ""
+}
+
 type ModifyConfigsRequest struct {
 	AppId                string   `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id"`
 	Database             string   `protobuf:"bytes,3,opt,name=database,proto3" json:"database"`
@@ -313,7 +449,7 @@ func (m *ModifyConfigsRequest) Reset()         { *m = ModifyConfigsRequest{} }
 func (m *ModifyConfigsRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyConfigsRequest) ProtoMessage()    {}
 func (*ModifyConfigsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{2}
+	return fileDescriptor_e0f9056a14b86d47, []int{3}
 }
 
 func (m *ModifyConfigsRequest) XXX_Unmarshal(b []byte) error {
@@ -365,7 +501,7 @@ func (m *ModifyConfigsResponse) Reset()         { *m = ModifyConfigsResponse{} }
 func (m *ModifyConfigsResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyConfigsResponse) ProtoMessage()    {}
 func (*ModifyConfigsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{3}
+	return fileDescriptor_e0f9056a14b86d47, []int{4}
 }
 
 func (m *ModifyConfigsResponse) XXX_Unmarshal(b []byte) error {
@@ -405,7 +541,7 @@ func (m *FindAppsRequest) Reset()         { *m = FindAppsRequest{} }
 func (m *FindAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*FindAppsRequest) ProtoMessage()    {}
 func (*FindAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{4}
+	return fileDescriptor_e0f9056a14b86d47, []int{5}
 }
 
 func (m *FindAppsRequest) XXX_Unmarshal(b []byte) error {
@@ -500,7 +636,7 @@ func (m *FindAppsResponse) Reset()         { *m = FindAppsResponse{} }
 func (m *FindAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*FindAppsResponse) ProtoMessage()    {}
 func (*FindAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{5}
+	return fileDescriptor_e0f9056a14b86d47, []int{6}
 }
 
 func (m *FindAppsResponse) XXX_Unmarshal(b []byte) error {
@@ -541,7 +677,7 @@ func (m *FindAppsByIdsRequest) Reset()         { *m = FindAppsByIdsRequest{} }
 func (m *FindAppsByIdsRequest) String() string { return proto.CompactTextString(m) }
 func (*FindAppsByIdsRequest) ProtoMessage()    {}
 func (*FindAppsByIdsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{6}
+	return fileDescriptor_e0f9056a14b86d47, []int{7}
 }
 
 func (m *FindAppsByIdsRequest) XXX_Unmarshal(b []byte) error {
@@ -594,7 +730,7 @@ func (m *FindAppsByIdsResponse) Reset()         { *m = FindAppsByIdsResponse{} }
 func (m *FindAppsByIdsResponse) String() string { return proto.CompactTextString(m) }
 func (*FindAppsByIdsResponse) ProtoMessage()    {}
 func (*FindAppsByIdsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{7}
+	return fileDescriptor_e0f9056a14b86d47, []int{8}
 }
 
 func (m *FindAppsByIdsResponse) XXX_Unmarshal(b []byte) error {
@@ -634,7 +770,7 @@ func (m *FindAppRequest) Reset()         { *m = FindAppRequest{} }
 func (m *FindAppRequest) String() string { return proto.CompactTextString(m) }
 func (*FindAppRequest) ProtoMessage()    {}
 func (*FindAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{8}
+	return fileDescriptor_e0f9056a14b86d47, []int{9}
 }
 
 func (m *FindAppRequest) XXX_Unmarshal(b []byte) error {
@@ -680,7 +816,7 @@ func (m *FindAppResponse) Reset()         { *m = FindAppResponse{} }
 func (m *FindAppResponse) String() string { return proto.CompactTextString(m) }
 func (*FindAppResponse) ProtoMessage()    {}
 func (*FindAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{9}
+	return fileDescriptor_e0f9056a14b86d47, []int{10}
 }
 
 func (m *FindAppResponse) XXX_Unmarshal(b []byte) error {
@@ -734,7 +870,7 @@ func (m *AddAppRequest) Reset()         { *m = AddAppRequest{} }
 func (m *AddAppRequest) String() string { return proto.CompactTextString(m) }
 func (*AddAppRequest) ProtoMessage()    {}
 func (*AddAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{10}
+	return fileDescriptor_e0f9056a14b86d47, []int{11}
 }
 
 func (m *AddAppRequest) XXX_Unmarshal(b []byte) error {
@@ -878,7 +1014,7 @@ func (m *AddAppResponse) Reset()         { *m = AddAppResponse{} }
 func (m *AddAppResponse) String() string { return proto.CompactTextString(m) }
 func (*AddAppResponse) ProtoMessage()    {}
 func (*AddAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{11}
+	return fileDescriptor_e0f9056a14b86d47, []int{12}
 }
 
 func (m *AddAppResponse) XXX_Unmarshal(b []byte) error {
@@ -925,7 +1061,7 @@ func (m *ModifyAppRequest) Reset()         { *m = ModifyAppRequest{} }
 func (m *ModifyAppRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyAppRequest) ProtoMessage()    {}
 func (*ModifyAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{12}
+	return fileDescriptor_e0f9056a14b86d47, []int{13}
 }
 
 func (m *ModifyAppRequest) XXX_Unmarshal(b []byte) error {
@@ -1019,7 +1155,7 @@ func (m *ModifyAppResponse) Reset()         { *m = ModifyAppResponse{} }
 func (m *ModifyAppResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyAppResponse) ProtoMessage()    {}
 func (*ModifyAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{13}
+	return fileDescriptor_e0f9056a14b86d47, []int{14}
 }
 
 func (m *ModifyAppResponse) XXX_Unmarshal(b []byte) error {
@@ -1053,7 +1189,7 @@ func (m *ModifyAppSortRequest) Reset()         { *m = ModifyAppSortRequest{} }
 func (m *ModifyAppSortRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyAppSortRequest) ProtoMessage()    {}
 func (*ModifyAppSortRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{14}
+	return fileDescriptor_e0f9056a14b86d47, []int{15}
 }
 
 func (m *ModifyAppSortRequest) XXX_Unmarshal(b []byte) error {
@@ -1105,7 +1241,7 @@ func (m *ModifyAppSortResponse) Reset()         { *m = ModifyAppSortResponse{} }
 func (m *ModifyAppSortResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyAppSortResponse) ProtoMessage()    {}
 func (*ModifyAppSortResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{15}
+	return fileDescriptor_e0f9056a14b86d47, []int{16}
 }
 
 func (m *ModifyAppSortResponse) XXX_Unmarshal(b []byte) error {
@@ -1139,7 +1275,7 @@ func (m *DeleteAppRequest) Reset()         { *m = DeleteAppRequest{} }
 func (m *DeleteAppRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteAppRequest) ProtoMessage()    {}
 func (*DeleteAppRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{16}
+	return fileDescriptor_e0f9056a14b86d47, []int{17}
 }
 
 func (m *DeleteAppRequest) XXX_Unmarshal(b []byte) error {
@@ -1191,7 +1327,7 @@ func (m *DeleteAppResponse) Reset()         { *m = DeleteAppResponse{} }
 func (m *DeleteAppResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteAppResponse) ProtoMessage()    {}
 func (*DeleteAppResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{17}
+	return fileDescriptor_e0f9056a14b86d47, []int{18}
 }
 
 func (m *DeleteAppResponse) XXX_Unmarshal(b []byte) error {
@@ -1225,7 +1361,7 @@ func (m *DeleteSelectAppsRequest) Reset()         { *m = DeleteSelectAppsRequest
 func (m *DeleteSelectAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteSelectAppsRequest) ProtoMessage()    {}
 func (*DeleteSelectAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{18}
+	return fileDescriptor_e0f9056a14b86d47, []int{19}
 }
 
 func (m *DeleteSelectAppsRequest) XXX_Unmarshal(b []byte) error {
@@ -1277,7 +1413,7 @@ func (m *DeleteSelectAppsResponse) Reset()         { *m = DeleteSelectAppsRespon
 func (m *DeleteSelectAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteSelectAppsResponse) ProtoMessage()    {}
 func (*DeleteSelectAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{19}
+	return fileDescriptor_e0f9056a14b86d47, []int{20}
 }
 
 func (m *DeleteSelectAppsResponse) XXX_Unmarshal(b []byte) error {
@@ -1310,7 +1446,7 @@ func (m *HardDeleteAppsRequest) Reset()         { *m = HardDeleteAppsRequest{} }
 func (m *HardDeleteAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*HardDeleteAppsRequest) ProtoMessage()    {}
 func (*HardDeleteAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{20}
+	return fileDescriptor_e0f9056a14b86d47, []int{21}
 }
 
 func (m *HardDeleteAppsRequest) XXX_Unmarshal(b []byte) error {
@@ -1355,7 +1491,7 @@ func (m *HardDeleteAppsResponse) Reset()         { *m = HardDeleteAppsResponse{}
 func (m *HardDeleteAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*HardDeleteAppsResponse) ProtoMessage()    {}
 func (*HardDeleteAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{21}
+	return fileDescriptor_e0f9056a14b86d47, []int{22}
 }
 
 func (m *HardDeleteAppsResponse) XXX_Unmarshal(b []byte) error {
@@ -1389,7 +1525,7 @@ func (m *RecoverSelectAppsRequest) Reset()         { *m = RecoverSelectAppsReque
 func (m *RecoverSelectAppsRequest) String() string { return proto.CompactTextString(m) }
 func (*RecoverSelectAppsRequest) ProtoMessage()    {}
 func (*RecoverSelectAppsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{22}
+	return fileDescriptor_e0f9056a14b86d47, []int{23}
 }
 
 func (m *RecoverSelectAppsRequest) XXX_Unmarshal(b []byte) error {
@@ -1441,7 +1577,7 @@ func (m *RecoverSelectAppsResponse) Reset()         { *m = RecoverSelectAppsResp
 func (m *RecoverSelectAppsResponse) String() string { return proto.CompactTextString(m) }
 func (*RecoverSelectAppsResponse) ProtoMessage()    {}
 func (*RecoverSelectAppsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{23}
+	return fileDescriptor_e0f9056a14b86d47, []int{24}
 }
 
 func (m *RecoverSelectAppsResponse) XXX_Unmarshal(b []byte) error {
@@ -1466,6 +1602,7 @@ type NextMonthRequest struct {
 	AppId                string   `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id"`
 	Database             string   `protobuf:"bytes,2,opt,name=Database,proto3" json:"Database"`
 	Value                string   `protobuf:"bytes,4,opt,name=value,proto3" json:"value"`
+	Writer               string   `protobuf:"bytes,5,opt,name=writer,proto3" json:"writer"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1475,7 +1612,7 @@ func (m *NextMonthRequest) Reset()         { *m = NextMonthRequest{} }
 func (m *NextMonthRequest) String() string { return proto.CompactTextString(m) }
 func (*NextMonthRequest) ProtoMessage()    {}
 func (*NextMonthRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{24}
+	return fileDescriptor_e0f9056a14b86d47, []int{25}
 }
 
 func (m *NextMonthRequest) XXX_Unmarshal(b []byte) error {
@@ -1517,6 +1654,13 @@ func (m *NextMonthRequest) GetValue() string {
 	return ""
 }
 
+func (m *NextMonthRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
 type NextMonthResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1527,7 +1671,7 @@ func (m *NextMonthResponse) Reset()         { *m = NextMonthResponse{} }
 func (m *NextMonthResponse) String() string { return proto.CompactTextString(m) }
 func (*NextMonthResponse) ProtoMessage()    {}
 func (*NextMonthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{25}
+	return fileDescriptor_e0f9056a14b86d47, []int{26}
 }
 
 func (m *NextMonthResponse) XXX_Unmarshal(b []byte) error {
@@ -1548,6 +1692,362 @@ func (m *NextMonthResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_NextMonthResponse proto.InternalMessageInfo
 
+// 月度关闭记录
+type MonthClose struct {
+	CloseId              string      `protobuf:"bytes,1,opt,name=close_id,json=closeId,proto3" json:"close_id"`
+	AppId                string      `protobuf:"bytes,2,opt,name=app_id,json=appId,proto3" json:"app_id"`
+	SyoriYm              string      `protobuf:"bytes,3,opt,name=syori_ym,json=syoriYm,proto3" json:"syori_ym"`
+	NextYm               string      `protobuf:"bytes,4,opt,name=next_ym,json=nextYm,proto3" json:"next_ym"`
+	Status               string      `protobuf:"bytes,5,opt,name=status,proto3" json:"status"`
+	Snapshots            []*Snapshot `protobuf:"bytes,6,rep,name=snapshots,proto3" json:"snapshots"`
+	ClosedAt             string      `protobuf:"bytes,7,opt,name=closed_at,json=closedAt,proto3" json:"closed_at"`
+	ClosedBy             string      `protobuf:"bytes,8,opt,name=closed_by,json=closedBy,proto3" json:"closed_by"`
+	ReopenedAt           string      `protobuf:"bytes,9,opt,name=reopened_at,json=reopenedAt,proto3" json:"reopened_at"`
+	ReopenedBy           string      `protobuf:"bytes,10,opt,name=reopened_by,json=reopenedBy,proto3" json:"reopened_by"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *MonthClose) Reset()         { *m = MonthClose{} }
+func (m *MonthClose) String() string { return proto.CompactTextString(m) }
+func (*MonthClose) ProtoMessage()    {}
+func (*MonthClose) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{27}
+}
+
+func (m *MonthClose) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MonthClose.Unmarshal(m, b)
+}
+func (m *MonthClose) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MonthClose.Marshal(b, m, deterministic)
+}
+func (m *MonthClose) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MonthClose.Merge(m, src)
+}
+func (m *MonthClose) XXX_Size() int {
+	return xxx_messageInfo_MonthClose.Size(m)
+}
+func (m *MonthClose) XXX_DiscardUnknown() {
+	xxx_messageInfo_MonthClose.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MonthClose proto.InternalMessageInfo
+
+func (m *MonthClose) GetCloseId() string {
+	if m != nil {
+		return m.CloseId
+	}
+	return ""
+}
+
+func (m *MonthClose) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *MonthClose) GetSyoriYm() string {
+	if m != nil {
+		return m.SyoriYm
+	}
+	return ""
+}
+
+func (m *MonthClose) GetNextYm() string {
+	if m != nil {
+		return m.NextYm
+	}
+	return ""
+}
+
+func (m *MonthClose) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *MonthClose) GetSnapshots() []*Snapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *MonthClose) GetClosedAt() string {
+	if m != nil {
+		return m.ClosedAt
+	}
+	return ""
+}
+
+func (m *MonthClose) GetClosedBy() string {
+	if m != nil {
+		return m.ClosedBy
+	}
+	return ""
+}
+
+func (m *MonthClose) GetReopenedAt() string {
+	if m != nil {
+		return m.ReopenedAt
+	}
+	return ""
+}
+
+func (m *MonthClose) GetReopenedBy() string {
+	if m != nil {
+		return m.ReopenedBy
+	}
+	return ""
+}
+
+// 台账快照
+type Snapshot struct {
+	ApiKey               string   `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key"`
+	DatastoreId          string   `protobuf:"bytes,2,opt,name=datastore_id,json=datastoreId,proto3" json:"datastore_id"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Snapshot) Reset()         { *m = Snapshot{} }
+func (m *Snapshot) String() string { return proto.CompactTextString(m) }
+func (*Snapshot) ProtoMessage()    {}
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{28}
+}
+
+func (m *Snapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Snapshot.Unmarshal(m, b)
+}
+func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
+}
+func (m *Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snapshot.Merge(m, src)
+}
+func (m *Snapshot) XXX_Size() int {
+	return xxx_messageInfo_Snapshot.Size(m)
+}
+func (m *Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Snapshot proto.InternalMessageInfo
+
+func (m *Snapshot) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+func (m *Snapshot) GetDatastoreId() string {
+	if m != nil {
+		return m.DatastoreId
+	}
+	return ""
+}
+
+func (m *Snapshot) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type ReopenMonthRequest struct {
+	AppId                string   `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id"`
+	Writer               string   `protobuf:"bytes,2,opt,name=writer,proto3" json:"writer"`
+	Database             string   `protobuf:"bytes,3,opt,name=database,proto3" json:"database"`
+	Force                bool     `protobuf:"varint,4,opt,name=force,proto3" json:"force"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReopenMonthRequest) Reset()         { *m = ReopenMonthRequest{} }
+func (m *ReopenMonthRequest) String() string { return proto.CompactTextString(m) }
+func (*ReopenMonthRequest) ProtoMessage()    {}
+func (*ReopenMonthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{29}
+}
+
+func (m *ReopenMonthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReopenMonthRequest.Unmarshal(m, b)
+}
+func (m *ReopenMonthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReopenMonthRequest.Marshal(b, m, deterministic)
+}
+func (m *ReopenMonthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReopenMonthRequest.Merge(m, src)
+}
+func (m *ReopenMonthRequest) XXX_Size() int {
+	return xxx_messageInfo_ReopenMonthRequest.Size(m)
+}
+func (m *ReopenMonthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReopenMonthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReopenMonthRequest proto.InternalMessageInfo
+
+func (m *ReopenMonthRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *ReopenMonthRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+func (m *ReopenMonthRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *ReopenMonthRequest) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type ReopenMonthResponse struct {
+	MonthClose           *MonthClose `protobuf:"bytes,1,opt,name=month_close,json=monthClose,proto3" json:"month_close"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReopenMonthResponse) Reset()         { *m = ReopenMonthResponse{} }
+func (m *ReopenMonthResponse) String() string { return proto.CompactTextString(m) }
+func (*ReopenMonthResponse) ProtoMessage()    {}
+func (*ReopenMonthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{30}
+}
+
+func (m *ReopenMonthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReopenMonthResponse.Unmarshal(m, b)
+}
+func (m *ReopenMonthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReopenMonthResponse.Marshal(b, m, deterministic)
+}
+func (m *ReopenMonthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReopenMonthResponse.Merge(m, src)
+}
+func (m *ReopenMonthResponse) XXX_Size() int {
+	return xxx_messageInfo_ReopenMonthResponse.Size(m)
+}
+func (m *ReopenMonthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReopenMonthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReopenMonthResponse proto.InternalMessageInfo
+
+func (m *ReopenMonthResponse) GetMonthClose() *MonthClose {
+	if m != nil {
+		return m.MonthClose
+	}
+	return nil
+}
+
+type FindMonthClosesRequest struct {
+	AppId                string   `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id"`
+	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FindMonthClosesRequest) Reset()         { *m = FindMonthClosesRequest{} }
+func (m *FindMonthClosesRequest) String() string { return proto.CompactTextString(m) }
+func (*FindMonthClosesRequest) ProtoMessage()    {}
+func (*FindMonthClosesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{31}
+}
+
+func (m *FindMonthClosesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindMonthClosesRequest.Unmarshal(m, b)
+}
+func (m *FindMonthClosesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindMonthClosesRequest.Marshal(b, m, deterministic)
+}
+func (m *FindMonthClosesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindMonthClosesRequest.Merge(m, src)
+}
+func (m *FindMonthClosesRequest) XXX_Size() int {
+	return xxx_messageInfo_FindMonthClosesRequest.Size(m)
+}
+func (m *FindMonthClosesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindMonthClosesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindMonthClosesRequest proto.InternalMessageInfo
+
+func (m *FindMonthClosesRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *FindMonthClosesRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+type FindMonthClosesResponse struct {
+	MonthCloses          []*MonthClose `protobuf:"bytes,1,rep,name=month_closes,json=monthCloses,proto3" json:"month_closes"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *FindMonthClosesResponse) Reset()         { *m = FindMonthClosesResponse{} }
+func (m *FindMonthClosesResponse) String() string { return proto.CompactTextString(m) }
+func (*FindMonthClosesResponse) ProtoMessage()    {}
+func (*FindMonthClosesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{32}
+}
+
+func (m *FindMonthClosesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FindMonthClosesResponse.Unmarshal(m, b)
+}
+func (m *FindMonthClosesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FindMonthClosesResponse.Marshal(b, m, deterministic)
+}
+func (m *FindMonthClosesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindMonthClosesResponse.Merge(m, src)
+}
+func (m *FindMonthClosesResponse) XXX_Size() int {
+	return xxx_messageInfo_FindMonthClosesResponse.Size(m)
+}
+func (m *FindMonthClosesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindMonthClosesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FindMonthClosesResponse proto.InternalMessageInfo
+
+func (m *FindMonthClosesResponse) GetMonthCloses() []*MonthClose {
+	if m != nil {
+		return m.MonthCloses
+	}
+	return nil
+}
+
 type ModifySwkSettingRequest struct {
 	AppId                string   `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id"`
 	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database"`
@@ -1563,7 +2063,7 @@ func (m *ModifySwkSettingRequest) Reset()         { *m = ModifySwkSettingRequest
 func (m *ModifySwkSettingRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifySwkSettingRequest) ProtoMessage()    {}
 func (*ModifySwkSettingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{26}
+	return fileDescriptor_e0f9056a14b86d47, []int{33}
 }
 
 func (m *ModifySwkSettingRequest) XXX_Unmarshal(b []byte) error {
@@ -1629,7 +2129,7 @@ func (m *ModifySwkSettingResponse) Reset()         { *m = ModifySwkSettingRespon
 func (m *ModifySwkSettingResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifySwkSettingResponse) ProtoMessage()    {}
 func (*ModifySwkSettingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0f9056a14b86d47, []int{27}
+	return fileDescriptor_e0f9056a14b86d47, []int{34}
 }
 
 func (m *ModifySwkSettingResponse) XXX_Unmarshal(b []byte) error {
@@ -1650,8 +2150,103 @@ func (m *ModifySwkSettingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModifySwkSettingResponse proto.InternalMessageInfo
 
+type ModifyAppBooksRequest struct {
+	AppId                string   `protobuf:"bytes,1,opt,name=app_id,json=appId,proto3" json:"app_id"`
+	Books                []*Book  `protobuf:"bytes,2,rep,name=books,proto3" json:"books"`
+	Writer               string   `protobuf:"bytes,3,opt,name=writer,proto3" json:"writer"`
+	Database             string   `protobuf:"bytes,4,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyAppBooksRequest) Reset()         { *m = ModifyAppBooksRequest{} }
+func (m *ModifyAppBooksRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyAppBooksRequest) ProtoMessage()    {}
+func (*ModifyAppBooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{35}
+}
+
+func (m *ModifyAppBooksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyAppBooksRequest.Unmarshal(m, b)
+}
+func (m *ModifyAppBooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyAppBooksRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyAppBooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyAppBooksRequest.Merge(m, src)
+}
+func (m *ModifyAppBooksRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyAppBooksRequest.Size(m)
+}
+func (m *ModifyAppBooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyAppBooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyAppBooksRequest proto.InternalMessageInfo
+
+func (m *ModifyAppBooksRequest) GetAppId() string {
+	if m != nil {
+		return m.AppId
+	}
+	return ""
+}
+
+func (m *ModifyAppBooksRequest) GetBooks() []*Book {
+	if m != nil {
+		return m.Books
+	}
+	return nil
+}
+
+func (m *ModifyAppBooksRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+func (m *ModifyAppBooksRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+type ModifyAppBooksResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyAppBooksResponse) Reset()         { *m = ModifyAppBooksResponse{} }
+func (m *ModifyAppBooksResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyAppBooksResponse) ProtoMessage()    {}
+func (*ModifyAppBooksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f9056a14b86d47, []int{36}
+}
+
+func (m *ModifyAppBooksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyAppBooksResponse.Unmarshal(m, b)
+}
+func (m *ModifyAppBooksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyAppBooksResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyAppBooksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyAppBooksResponse.Merge(m, src)
+}
+func (m *ModifyAppBooksResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyAppBooksResponse.Size(m)
+}
+func (m *ModifyAppBooksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyAppBooksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyAppBooksResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*App)(nil), "app.App")
+	proto.RegisterType((*Book)(nil), "app.Book")
 	proto.RegisterType((*Configs)(nil), "app.Configs")
 	proto.RegisterType((*ModifyConfigsRequest)(nil), "app.ModifyConfigsRequest")
 	proto.RegisterType((*ModifyConfigsResponse)(nil), "app.ModifyConfigsResponse")
@@ -1677,96 +2272,136 @@ func init() {
 	proto.RegisterType((*RecoverSelectAppsResponse)(nil), "app.RecoverSelectAppsResponse")
 	proto.RegisterType((*NextMonthRequest)(nil), "app.NextMonthRequest")
 	proto.RegisterType((*NextMonthResponse)(nil), "app.NextMonthResponse")
+	proto.RegisterType((*MonthClose)(nil), "app.MonthClose")
+	proto.RegisterType((*Snapshot)(nil), "app.Snapshot")
+	proto.RegisterType((*ReopenMonthRequest)(nil), "app.ReopenMonthRequest")
+	proto.RegisterType((*ReopenMonthResponse)(nil), "app.ReopenMonthResponse")
+	proto.RegisterType((*FindMonthClosesRequest)(nil), "app.FindMonthClosesRequest")
+	proto.RegisterType((*FindMonthClosesResponse)(nil), "app.FindMonthClosesResponse")
 	proto.RegisterType((*ModifySwkSettingRequest)(nil), "app.ModifySwkSettingRequest")
 	proto.RegisterType((*ModifySwkSettingResponse)(nil), "app.ModifySwkSettingResponse")
+	proto.RegisterType((*ModifyAppBooksRequest)(nil), "app.ModifyAppBooksRequest")
+	proto.RegisterType((*ModifyAppBooksResponse)(nil), "app.ModifyAppBooksResponse")
 }
 
 func init() { proto.RegisterFile("app.proto", fileDescriptor_e0f9056a14b86d47) }
 
 var fileDescriptor_e0f9056a14b86d47 = []byte{
-	// 1333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x36, 0x25, 0x4b, 0xa2, 0x46, 0xb6, 0x22, 0xaf, 0x7f, 0xc2, 0xc8, 0x49, 0xea, 0x2a, 0x68,
-	0x6b, 0x14, 0x68, 0x50, 0x24, 0x68, 0x81, 0x02, 0xbd, 0xc8, 0x31, 0x82, 0x18, 0x4d, 0x52, 0x54,
-	0xf6, 0x25, 0x28, 0x0a, 0x62, 0x2d, 0xae, 0xa3, 0xad, 0xf9, 0xb3, 0x21, 0xd7, 0x76, 0x79, 0xee,
-	0x2b, 0xf4, 0xd0, 0x07, 0xe9, 0x93, 0xf4, 0x19, 0xfa, 0x1e, 0x2d, 0xf6, 0x87, 0xf4, 0x72, 0x25,
-	0x51, 0x81, 0x0f, 0x3d, 0xee, 0x8c, 0x66, 0x67, 0xf6, 0x9b, 0x6f, 0xbe, 0xa1, 0x0d, 0x5d, 0xcc,
-	0xd8, 0x53, 0x96, 0x26, 0x3c, 0x41, 0x4d, 0xcc, 0xd8, 0xe8, 0xf7, 0x16, 0x34, 0xc7, 0x8c, 0xa1,
-	0x5d, 0x68, 0x63, 0xc6, 0x7c, 0x1a, 0x78, 0xce, 0x81, 0x73, 0xd8, 0x9d, 0xb4, 0x30, 0x63, 0x27,
-	0x01, 0x7a, 0x00, 0xae, 0x30, 0xc7, 0x38, 0x22, 0x5e, 0x43, 0x3a, 0x3a, 0x98, 0xb1, 0xb7, 0x38,
-	0x22, 0xe8, 0x09, 0x6c, 0x06, 0x34, 0x63, 0x21, 0xce, 0xfd, 0x24, 0x0d, 0x48, 0xea, 0x35, 0x0f,
-	0x9c, 0xc3, 0xe6, 0x64, 0x43, 0x1b, 0x7f, 0x14, 0x36, 0xf4, 0x09, 0xf4, 0x38, 0x89, 0x58, 0x88,
-	0x39, 0x11, 0x77, 0xaf, 0xcb, 0x2b, 0xa0, 0x30, 0x9d, 0x04, 0x68, 0x0f, 0xda, 0x41, 0x12, 0x61,
-	0x1a, 0x7b, 0x2d, 0xe9, 0xd3, 0x27, 0xf4, 0x08, 0x60, 0x9a, 0x12, 0xcc, 0x49, 0xe0, 0x63, 0xee,
-	0xb5, 0xa5, 0xaf, 0xab, 0x2d, 0x63, 0x6e, 0xba, 0xcf, 0x73, 0xaf, 0x53, 0x71, 0x1f, 0xe5, 0xc2,
-	0x7d, 0xc5, 0x82, 0x22, 0xda, 0x55, 0x6e, 0x6d, 0x51, 0xd1, 0x85, 0xfb, 0x3c, 0xf7, 0xba, 0x15,
-	0xb7, 0x8a, 0x0e, 0x48, 0x48, 0x74, 0x34, 0x28, 0xb7, 0xb6, 0xa8, 0xe8, 0xc2, 0x7d, 0x9e, 0x7b,
-	0xbd, 0x8a, 0xfb, 0x28, 0x17, 0x90, 0xd1, 0xcc, 0xe7, 0x29, 0xc5, 0xa1, 0xb7, 0x71, 0xe0, 0x1c,
-	0xba, 0x93, 0x0e, 0xcd, 0xce, 0xc4, 0x51, 0x44, 0x5e, 0x24, 0x61, 0x98, 0xdc, 0xf8, 0x98, 0x31,
-	0x0f, 0xa9, 0x48, 0x65, 0x11, 0x3d, 0x78, 0x04, 0x90, 0x71, 0x9c, 0x72, 0x9f, 0xd3, 0x88, 0x78,
-	0x9b, 0xca, 0x2d, 0x2d, 0x67, 0x34, 0x22, 0xe2, 0x62, 0x12, 0x07, 0xca, 0xd9, 0x57, 0xbd, 0x20,
-	0x71, 0x20, 0x5d, 0xfb, 0xd0, 0x9d, 0x26, 0x2c, 0xf7, 0x2f, 0xd2, 0x24, 0xf2, 0xee, 0x49, 0x9f,
-	0x2b, 0x0c, 0x2f, 0xd3, 0x24, 0x42, 0x1e, 0x74, 0x52, 0x12, 0xe1, 0xf4, 0x32, 0xf3, 0x06, 0x2a,
-	0x4c, 0x1f, 0x8b, 0xee, 0xf2, 0x9c, 0x11, 0x6f, 0xab, 0xec, 0xee, 0x59, 0xce, 0x08, 0xfa, 0x1c,
-	0x3a, 0xd3, 0x24, 0xbe, 0xa0, 0xef, 0x33, 0x6f, 0xfb, 0xc0, 0x39, 0xec, 0x3d, 0xdb, 0x78, 0x2a,
-	0x98, 0xf3, 0x42, 0xd9, 0x26, 0x85, 0x53, 0x34, 0x38, 0xbb, 0xb9, 0xf4, 0xa7, 0x49, 0xcc, 0xd3,
-	0x24, 0xf4, 0x76, 0xe4, 0x83, 0x21, 0xbb, 0xb9, 0x7c, 0xa1, 0x2c, 0x82, 0x26, 0xf2, 0xb7, 0x91,
-	0x1f, 0x11, 0x3e, 0x4b, 0x02, 0x6f, 0x57, 0x26, 0xda, 0x50, 0xc6, 0x37, 0xd2, 0x36, 0xfa, 0xdb,
-	0x81, 0x8e, 0xbe, 0x5a, 0x94, 0x9b, 0x31, 0x32, 0x15, 0xf0, 0x29, 0x2a, 0x16, 0x47, 0x74, 0x08,
-	0x83, 0xe9, 0x8c, 0x4c, 0x2f, 0x7d, 0x85, 0x92, 0x68, 0x97, 0x26, 0x65, 0x5f, 0xda, 0x4f, 0x85,
-	0xf9, 0x18, 0x73, 0x09, 0x55, 0x96, 0x27, 0x29, 0xf5, 0xf3, 0x48, 0xd2, 0x52, 0x5c, 0x22, 0xce,
-	0xef, 0x22, 0xf4, 0x29, 0x6c, 0x64, 0xb3, 0x24, 0xe5, 0x7e, 0x48, 0x70, 0x46, 0x32, 0x4d, 0xc9,
-	0x9e, 0xb4, 0xbd, 0x96, 0x26, 0x11, 0x7d, 0x49, 0xb3, 0xd9, 0x95, 0x88, 0x56, 0xac, 0xec, 0xc8,
-	0xf3, 0xbb, 0x08, 0x7d, 0x09, 0x5b, 0x11, 0x8d, 0x93, 0xd4, 0x3f, 0xc7, 0x19, 0xf1, 0x71, 0x94,
-	0x5c, 0xc5, 0x05, 0x3b, 0xef, 0x49, 0xc7, 0x11, 0xce, 0xc8, 0x58, 0x9a, 0x47, 0x1f, 0x60, 0xe7,
-	0x4d, 0x12, 0xd0, 0x8b, 0xbc, 0x00, 0x8d, 0x7c, 0xb8, 0x22, 0x19, 0x37, 0x46, 0xad, 0x61, 0x8e,
-	0xda, 0x10, 0xdc, 0x00, 0x73, 0x2c, 0x2e, 0xd6, 0x35, 0x97, 0x67, 0xb3, 0x1b, 0x4e, 0x4d, 0x37,
-	0x46, 0xf7, 0x61, 0xd7, 0x4a, 0x99, 0xb1, 0x24, 0xce, 0xc8, 0xe8, 0x8f, 0x06, 0xdc, 0x7b, 0x49,
-	0xe3, 0x60, 0xcc, 0x58, 0x59, 0xc7, 0xed, 0xe8, 0x39, 0x95, 0xd1, 0xab, 0x99, 0xf9, 0xcf, 0xa0,
-	0x4f, 0xe3, 0x6b, 0x1c, 0x52, 0x35, 0x3c, 0x34, 0xd6, 0x95, 0x6e, 0x1a, 0xd6, 0x93, 0xb8, 0x32,
-	0x02, 0x0a, 0x5f, 0x73, 0x04, 0x0c, 0x8e, 0xb7, 0xea, 0x38, 0xde, 0xae, 0xe1, 0xb8, 0x6b, 0x71,
-	0xdc, 0x04, 0xaf, 0x63, 0x81, 0x67, 0xb2, 0xbc, 0x5b, 0x61, 0xf9, 0xe8, 0x6b, 0x18, 0xdc, 0xa2,
-	0xa2, 0xa0, 0x42, 0x0f, 0x61, 0x1d, 0x33, 0x26, 0x80, 0x6e, 0x1e, 0xf6, 0x9e, 0xb9, 0x12, 0xe8,
-	0x31, 0x63, 0x13, 0x69, 0x1d, 0xfd, 0x0a, 0x3b, 0x45, 0xc4, 0x51, 0x7e, 0x12, 0xac, 0x04, 0xf3,
-	0x31, 0xf4, 0x54, 0xb3, 0xfd, 0x90, 0x66, 0xdc, 0x6b, 0x1c, 0x34, 0xc5, 0x83, 0x65, 0xc7, 0x5f,
-	0xd3, 0x8c, 0xd7, 0x75, 0x7d, 0xf4, 0x0d, 0xec, 0x5a, 0xb9, 0x3e, 0xaa, 0xc4, 0x17, 0xd0, 0xd7,
-	0x61, 0xf3, 0x8c, 0x73, 0x96, 0x31, 0xae, 0x61, 0xe5, 0xfe, 0xaa, 0xe4, 0x4b, 0x99, 0x75, 0x08,
-	0x62, 0x63, 0x68, 0x02, 0xde, 0x26, 0x95, 0x6b, 0xe4, 0x9f, 0x26, 0x6c, 0x8e, 0x03, 0x33, 0xa7,
-	0xc9, 0x22, 0x67, 0xc5, 0xe6, 0x68, 0xac, 0xde, 0x1c, 0xcd, 0x9a, 0xcd, 0xb1, 0x6e, 0xd3, 0xb7,
-	0x24, 0x5f, 0x7b, 0x4e, 0x7f, 0x0d, 0xf2, 0x75, 0x6c, 0xf2, 0x19, 0x53, 0x36, 0xa8, 0xd3, 0x3c,
-	0x93, 0xa4, 0x6e, 0x0d, 0x49, 0xc1, 0x22, 0x69, 0x55, 0xfe, 0xfb, 0xb6, 0xfc, 0x1b, 0x3a, 0xdd,
-	0xab, 0xea, 0xf4, 0x1e, 0xb4, 0x6f, 0x52, 0xca, 0x49, 0x5a, 0x2c, 0x49, 0x75, 0xaa, 0x34, 0xb0,
-	0x5b, 0xc3, 0xfa, 0x8d, 0xaa, 0xb6, 0xef, 0x43, 0xf7, 0x86, 0xf2, 0x99, 0x10, 0x50, 0x2c, 0xd7,
-	0x8c, 0x3b, 0x71, 0x85, 0xe1, 0x18, 0x73, 0x5c, 0x3a, 0x2f, 0x68, 0x48, 0xe4, 0x2a, 0xd1, 0xce,
-	0x97, 0x34, 0x24, 0xa3, 0x2f, 0xa0, 0x5f, 0x74, 0x59, 0x93, 0x62, 0x31, 0xb5, 0x46, 0xff, 0x3a,
-	0x30, 0x50, 0x4a, 0xb4, 0x9a, 0x86, 0x35, 0x7a, 0x73, 0xdb, 0xe3, 0xe6, 0xd2, 0x1e, 0xb7, 0xea,
-	0x04, 0xa6, 0x5d, 0x27, 0x30, 0x9d, 0x6a, 0xef, 0x0c, 0xfc, 0xbb, 0xcb, 0xf0, 0x5f, 0x5f, 0x8a,
-	0xbf, 0x6b, 0x0d, 0xd0, 0x36, 0x6c, 0x19, 0x00, 0x68, 0x19, 0x4e, 0x8a, 0x95, 0x30, 0x66, 0xec,
-	0x34, 0x49, 0x79, 0x81, 0xcc, 0x13, 0x05, 0x81, 0x94, 0x08, 0x7b, 0xa8, 0x05, 0x18, 0x52, 0x2a,
-	0x6e, 0xab, 0x68, 0x2c, 0xad, 0xc2, 0x96, 0x90, 0x72, 0x21, 0x94, 0x09, 0x75, 0x25, 0xbf, 0xc0,
-	0xe0, 0x58, 0x7e, 0xb2, 0xac, 0xee, 0xcf, 0x5d, 0xf2, 0x6e, 0xc3, 0x96, 0x71, 0xbd, 0xce, 0x19,
-	0xc1, 0x7d, 0x65, 0x3c, 0x25, 0x21, 0x99, 0x72, 0x73, 0x17, 0x59, 0x32, 0xe9, 0xd8, 0x32, 0x79,
-	0x97, 0x1a, 0x86, 0xe0, 0xcd, 0xa7, 0xd3, 0xa5, 0x9c, 0xc2, 0xee, 0x2b, 0x9c, 0x06, 0x65, 0x8d,
-	0x1f, 0x5d, 0x48, 0x9d, 0x66, 0x7a, 0xb0, 0x67, 0x5f, 0xaa, 0xd3, 0xc5, 0xe0, 0x4d, 0xc8, 0x34,
-	0xb9, 0x26, 0xe9, 0xff, 0xf3, 0xf4, 0x7d, 0x78, 0xb0, 0x20, 0x9f, 0x2e, 0xe6, 0x67, 0x18, 0xbc,
-	0x25, 0xbf, 0xf1, 0x37, 0x49, 0xcc, 0x67, 0xab, 0x37, 0xc4, 0xb1, 0xf5, 0xda, 0xe2, 0x8c, 0x76,
-	0xa0, 0x75, 0x8d, 0xc3, 0x2b, 0xa2, 0x67, 0x42, 0x1d, 0x44, 0xe3, 0x8d, 0xcb, 0x75, 0xc6, 0xbf,
-	0x1c, 0xb8, 0xaf, 0x68, 0x78, 0x7a, 0x73, 0x79, 0x4a, 0x38, 0xa7, 0xf1, 0xfb, 0xbb, 0xef, 0x26,
-	0x74, 0x00, 0xbd, 0x19, 0x8e, 0x83, 0x90, 0xc8, 0x2c, 0xfa, 0xf1, 0xa6, 0xc9, 0xfe, 0x2a, 0x5d,
-	0x5f, 0xfd, 0x55, 0xda, 0x5a, 0xf0, 0x55, 0x3a, 0x04, 0x6f, 0xbe, 0x6a, 0xf5, 0xa4, 0x67, 0x7f,
-	0x76, 0x00, 0xc4, 0x4c, 0x91, 0xf4, 0x9a, 0x4e, 0x09, 0x7a, 0x05, 0x9b, 0x95, 0x55, 0x8d, 0x1e,
-	0xc8, 0xf9, 0x5d, 0xf4, 0xa9, 0x30, 0x1c, 0x2e, 0x72, 0x69, 0xa4, 0xd6, 0xd0, 0x77, 0xe0, 0x16,
-	0x2e, 0xb4, 0x53, 0xf9, 0x65, 0x11, 0xbf, 0x6b, 0x59, 0xcb, 0xd0, 0x6f, 0xa1, 0xa3, 0xad, 0x68,
-	0xdb, 0xfc, 0x4d, 0x11, 0xb8, 0x53, 0x35, 0x96, 0x71, 0xcf, 0xa1, 0xad, 0x54, 0x1d, 0x21, 0xa5,
-	0x3a, 0xe6, 0x22, 0x1f, 0x6e, 0x57, 0x6c, 0x65, 0xd0, 0xf7, 0xd0, 0x2d, 0x95, 0x05, 0xa9, 0x92,
-	0x6c, 0xc1, 0x1f, 0xee, 0xd9, 0xe6, 0x32, 0xfa, 0x15, 0x6c, 0x56, 0x74, 0x49, 0xe3, 0xb5, 0x48,
-	0x1c, 0x35, 0x5e, 0x8b, 0x65, 0x6c, 0x0d, 0xfd, 0x60, 0x2c, 0x9a, 0xe2, 0x4f, 0x08, 0xf3, 0xb2,
-	0xea, 0xc7, 0x77, 0xe5, 0x32, 0xfb, 0x23, 0x59, 0x3e, 0xaa, 0x9c, 0x5e, 0xfd, 0x28, 0x5b, 0x25,
-	0xf5, 0xa3, 0xe6, 0xd5, 0x6d, 0x0d, 0xfd, 0x54, 0x68, 0xea, 0xed, 0xd0, 0xa1, 0x87, 0xc6, 0xaf,
-	0xe7, 0x66, 0x7f, 0xf8, 0x68, 0x89, 0xd7, 0x78, 0x5d, 0xbf, 0x2a, 0x29, 0x48, 0x3d, 0x60, 0xa1,
-	0x78, 0x0d, 0xf7, 0x17, 0xfa, 0xca, 0xcb, 0xce, 0x60, 0x6b, 0x4e, 0x15, 0x90, 0x2a, 0x61, 0x99,
-	0x3a, 0x0d, 0x1f, 0x2f, 0x73, 0x9b, 0x98, 0x95, 0x13, 0xaf, 0x31, 0xb3, 0xe5, 0x45, 0x63, 0x36,
-	0x2f, 0x0c, 0x12, 0x33, 0x7b, 0xc6, 0x34, 0x66, 0x4b, 0x04, 0x43, 0x63, 0xb6, 0x6c, 0x30, 0x47,
-	0x6b, 0xe7, 0x6d, 0xf9, 0xef, 0x8d, 0xe7, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x1b, 0xb8, 0x0b,
-	0xf0, 0xeb, 0x10, 0x00, 0x00,
+	// 1853 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x6e, 0xdb, 0xc8,
+	0x15, 0xae, 0x24, 0x5b, 0xa2, 0x8e, 0xfc, 0x3b, 0xfe, 0x11, 0x43, 0x27, 0xbb, 0x2e, 0x83, 0xb6,
+	0x46, 0x8b, 0x6e, 0x17, 0x59, 0x74, 0x81, 0x02, 0xbd, 0x91, 0x1d, 0xa4, 0x31, 0x12, 0xa7, 0xa8,
+	0x94, 0x9b, 0x00, 0x2d, 0x88, 0x31, 0x39, 0x8e, 0x58, 0x91, 0x9c, 0x59, 0xce, 0xc8, 0x5e, 0x5e,
+	0xf7, 0x15, 0xfa, 0x06, 0x7d, 0x84, 0xf6, 0x05, 0x7a, 0xd5, 0x17, 0xe9, 0x4b, 0xf4, 0xaa, 0xc5,
+	0xfc, 0x90, 0x1a, 0x52, 0x3f, 0x0e, 0x52, 0x60, 0xef, 0x34, 0xdf, 0xe1, 0xcc, 0x9c, 0x39, 0xe7,
+	0x9b, 0xef, 0x9c, 0x11, 0xf4, 0x31, 0x63, 0x5f, 0xb1, 0x9c, 0x0a, 0x8a, 0x3a, 0x98, 0x31, 0xff,
+	0x9f, 0xdb, 0xd0, 0x19, 0x31, 0x86, 0x4e, 0xa0, 0x8b, 0x19, 0x0b, 0xe2, 0xc8, 0x6d, 0x9d, 0xb7,
+	0x2e, 0xfa, 0xe3, 0x6d, 0xcc, 0xd8, 0x75, 0x84, 0x9e, 0x80, 0x23, 0xe1, 0x0c, 0xa7, 0xc4, 0x6d,
+	0x2b, 0x43, 0x0f, 0x33, 0xf6, 0x0e, 0xa7, 0x04, 0x3d, 0x87, 0xdd, 0x28, 0xe6, 0x2c, 0xc1, 0x45,
+	0x40, 0xf3, 0x88, 0xe4, 0x6e, 0xe7, 0xbc, 0x75, 0xd1, 0x19, 0xef, 0x18, 0xf0, 0xf7, 0x12, 0x43,
+	0x5f, 0xc2, 0x40, 0x90, 0x94, 0x25, 0x58, 0x10, 0xb9, 0xf6, 0x96, 0x5a, 0x02, 0x4a, 0xe8, 0x3a,
+	0x42, 0xa7, 0xd0, 0x8d, 0x68, 0x8a, 0xe3, 0xcc, 0xdd, 0x56, 0x36, 0x33, 0x42, 0xcf, 0x00, 0xc2,
+	0x9c, 0x60, 0x41, 0xa2, 0x00, 0x0b, 0xb7, 0xab, 0x6c, 0x7d, 0x83, 0x8c, 0x84, 0x6d, 0xbe, 0x2d,
+	0xdc, 0x5e, 0xcd, 0x7c, 0x59, 0x48, 0xf3, 0x9c, 0x45, 0xe5, 0x6c, 0x47, 0x9b, 0x0d, 0xa2, 0x67,
+	0x97, 0xe6, 0xdb, 0xc2, 0xed, 0xd7, 0xcc, 0x7a, 0x76, 0x44, 0x12, 0x62, 0x66, 0x83, 0x36, 0x1b,
+	0x44, 0xcf, 0x2e, 0xcd, 0xb7, 0x85, 0x3b, 0xa8, 0x99, 0x2f, 0x0b, 0x19, 0xb2, 0x98, 0x07, 0x22,
+	0x8f, 0x71, 0xe2, 0xee, 0x9c, 0xb7, 0x2e, 0x9c, 0x71, 0x2f, 0xe6, 0xef, 0xe5, 0x50, 0xce, 0xbc,
+	0xa3, 0x49, 0x42, 0x1f, 0x02, 0xcc, 0x98, 0x8b, 0xf4, 0x4c, 0x8d, 0xc8, 0x1c, 0x3c, 0x03, 0xe0,
+	0x02, 0xe7, 0x22, 0x10, 0x71, 0x4a, 0xdc, 0x5d, 0x6d, 0x56, 0xc8, 0xfb, 0x38, 0x25, 0x72, 0x61,
+	0x92, 0x45, 0xda, 0xb8, 0xa7, 0x73, 0x41, 0xb2, 0x48, 0x99, 0xce, 0xa0, 0x1f, 0x52, 0x56, 0x04,
+	0x77, 0x39, 0x4d, 0xdd, 0x7d, 0x65, 0x73, 0x24, 0xf0, 0x2a, 0xa7, 0x29, 0x72, 0xa1, 0x97, 0x93,
+	0x14, 0xe7, 0x33, 0xee, 0x1e, 0xe8, 0x69, 0x66, 0x58, 0x66, 0x57, 0x14, 0x8c, 0xb8, 0x87, 0x55,
+	0x76, 0xdf, 0x17, 0x8c, 0xa0, 0x9f, 0x42, 0x2f, 0xa4, 0xd9, 0x5d, 0xfc, 0x91, 0xbb, 0x47, 0xe7,
+	0xad, 0x8b, 0xc1, 0x8b, 0x9d, 0xaf, 0x24, 0x73, 0xae, 0x34, 0x36, 0x2e, 0x8d, 0x32, 0xc1, 0xfc,
+	0x61, 0x16, 0x84, 0x34, 0x13, 0x39, 0x4d, 0xdc, 0x63, 0x75, 0x60, 0xe0, 0x0f, 0xb3, 0x2b, 0x8d,
+	0x48, 0x9a, 0xa8, 0x6f, 0xd3, 0x20, 0x25, 0x62, 0x4a, 0x23, 0xf7, 0x44, 0x6d, 0xb4, 0xa3, 0xc1,
+	0x1b, 0x85, 0x29, 0xff, 0x13, 0xca, 0x49, 0x14, 0x14, 0xa9, 0x7b, 0x6a, 0xfc, 0x57, 0xc0, 0x87,
+	0x14, 0x7d, 0x09, 0xdb, 0xb7, 0x94, 0xce, 0xb8, 0x3b, 0x3c, 0xef, 0x5c, 0x0c, 0x5e, 0xf4, 0x95,
+	0x23, 0x97, 0x94, 0xce, 0xc6, 0x1a, 0xf7, 0xff, 0xd6, 0x86, 0x2d, 0x39, 0x46, 0x43, 0xe8, 0x49,
+	0x64, 0xc1, 0xe2, 0xae, 0x1c, 0x5e, 0xab, 0xf5, 0x95, 0xc1, 0xe2, 0xb1, 0x23, 0x01, 0x45, 0x64,
+	0x0f, 0x1c, 0x2e, 0x70, 0x16, 0xe1, 0x3c, 0x52, 0x1c, 0xee, 0x8f, 0xab, 0x31, 0xfa, 0x31, 0xec,
+	0xf0, 0x29, 0xcd, 0x45, 0x90, 0x10, 0xcc, 0x09, 0x37, 0x04, 0x1e, 0x28, 0xec, 0xad, 0x82, 0xd0,
+	0xcf, 0xe1, 0x30, 0x8d, 0x33, 0x9a, 0x07, 0xb7, 0x98, 0x93, 0x00, 0xa7, 0x74, 0x9e, 0x09, 0x43,
+	0xe6, 0x7d, 0x65, 0xb8, 0xc4, 0x9c, 0x8c, 0x14, 0x2c, 0xa3, 0x95, 0xcb, 0xab, 0xc0, 0xe9, 0x3c,
+	0x0f, 0x89, 0xa1, 0x35, 0x48, 0x68, 0xa2, 0x10, 0xb9, 0x5f, 0x38, 0xcf, 0xef, 0x49, 0x19, 0x2c,
+	0xcd, 0xec, 0x81, 0xc2, 0x4c, 0xac, 0xbe, 0x85, 0x21, 0x65, 0x24, 0xc7, 0x22, 0xce, 0x3e, 0x06,
+	0x5c, 0xe4, 0x38, 0xfe, 0x38, 0x15, 0x41, 0x12, 0x67, 0x44, 0x11, 0xdd, 0x19, 0x9f, 0x54, 0xe6,
+	0x89, 0xb1, 0xbe, 0x8d, 0x33, 0xe2, 0xff, 0xab, 0x0d, 0x3d, 0x93, 0x3e, 0x49, 0x09, 0xce, 0x48,
+	0x28, 0x29, 0xaa, 0x03, 0x55, 0x0e, 0xd1, 0x05, 0x1c, 0x84, 0x53, 0x12, 0xce, 0x02, 0xcd, 0x44,
+	0x79, 0x25, 0x4c, 0xc0, 0xf6, 0x14, 0x3e, 0x91, 0xf0, 0x4b, 0x2c, 0x14, 0x1d, 0x79, 0x41, 0xf3,
+	0x58, 0xa6, 0xac, 0x63, 0x16, 0x91, 0xe3, 0x0f, 0xe9, 0xa7, 0x44, 0xed, 0x09, 0x38, 0xb3, 0x98,
+	0x4f, 0xe7, 0x72, 0xb6, 0x0e, 0x56, 0x4f, 0x8d, 0x3f, 0xa4, 0xab, 0x03, 0xda, 0x5d, 0x1d, 0xd0,
+	0xe7, 0xb0, 0x9b, 0xd3, 0x79, 0x16, 0xc9, 0x58, 0xa4, 0x34, 0x22, 0x26, 0x60, 0x3b, 0x25, 0x78,
+	0x43, 0x23, 0xa2, 0xc4, 0x82, 0x72, 0xa1, 0x3e, 0x48, 0x4a, 0x35, 0x90, 0x88, 0xb4, 0x26, 0xe8,
+	0x57, 0x70, 0x74, 0x37, 0xcf, 0x42, 0x11, 0xd3, 0x0c, 0x27, 0x41, 0x38, 0xcf, 0x73, 0x92, 0x85,
+	0xa5, 0x2c, 0xa0, 0x85, 0xe9, 0xca, 0x58, 0xfc, 0xef, 0xe0, 0xf8, 0x86, 0x46, 0xf1, 0x5d, 0x51,
+	0xde, 0x06, 0xf2, 0xdd, 0x9c, 0x70, 0x61, 0x69, 0x68, 0xdb, 0xd6, 0x50, 0x0f, 0x9c, 0x08, 0x0b,
+	0x2c, 0x4f, 0x53, 0xf2, 0xab, 0x1c, 0xdb, 0xd7, 0xac, 0xb5, 0xe1, 0x9a, 0xf9, 0x43, 0x38, 0x69,
+	0x6c, 0xc9, 0x19, 0xcd, 0x38, 0xf1, 0xff, 0xda, 0x86, 0xfd, 0x57, 0x71, 0x16, 0x8d, 0x18, 0xab,
+	0xfc, 0x58, 0x68, 0x6a, 0xab, 0xa6, 0xa9, 0x1b, 0xc4, 0xfc, 0x27, 0xb0, 0x17, 0x67, 0xf7, 0x38,
+	0x89, 0xb5, 0x2a, 0xc6, 0x99, 0xf1, 0x74, 0xd7, 0x42, 0xaf, 0xb3, 0x9a, 0xb6, 0xe9, 0xa4, 0xda,
+	0xda, 0x66, 0x89, 0xd7, 0xf6, 0x26, 0xf1, 0xea, 0x6e, 0x10, 0x2f, 0xa7, 0x21, 0x5e, 0x76, 0xf0,
+	0x7a, 0x8d, 0xe0, 0xd9, 0xf2, 0xd5, 0xaf, 0xc9, 0x97, 0xff, 0x35, 0x1c, 0x2c, 0xa2, 0xa2, 0x43,
+	0x85, 0x9e, 0xc2, 0x16, 0x66, 0x4c, 0x06, 0x5a, 0xca, 0x88, 0xa3, 0x02, 0x3d, 0x62, 0x6c, 0xac,
+	0x50, 0xff, 0xcf, 0x70, 0x5c, 0xce, 0xb8, 0x2c, 0xae, 0xa3, 0x47, 0x83, 0xf9, 0x05, 0x0c, 0x74,
+	0xb2, 0x83, 0x24, 0xe6, 0xc2, 0x6d, 0x9f, 0x77, 0xe4, 0x81, 0x55, 0xc6, 0xdf, 0xc6, 0x5c, 0x6c,
+	0xca, 0xba, 0xff, 0x6b, 0x38, 0x69, 0xec, 0xf5, 0x49, 0x2e, 0x5e, 0xc1, 0x9e, 0x99, 0xb6, 0xcc,
+	0xb8, 0xd6, 0x3a, 0xc6, 0xb5, 0x1b, 0x7b, 0xff, 0xb2, 0xe2, 0x4b, 0xb5, 0xab, 0x07, 0xb2, 0x15,
+	0x30, 0x04, 0x5c, 0x6c, 0x2a, 0x41, 0xff, 0xdf, 0x1d, 0xd8, 0x1d, 0x45, 0xf6, 0x9e, 0x36, 0x8b,
+	0x5a, 0x8f, 0xb4, 0x04, 0xed, 0xc7, 0x5b, 0x82, 0xce, 0x86, 0x96, 0x60, 0xab, 0x49, 0xdf, 0x8a,
+	0x7c, 0xdd, 0xa5, 0xc2, 0x6a, 0x91, 0xaf, 0xd7, 0x24, 0x9f, 0x75, 0xcb, 0x0e, 0x36, 0x15, 0x33,
+	0x9b, 0xa4, 0xce, 0x06, 0x92, 0x42, 0x83, 0xa4, 0xf5, 0xba, 0xbe, 0xd7, 0xac, 0xeb, 0x56, 0x01,
+	0x1e, 0xd4, 0x0b, 0xf0, 0x29, 0x74, 0x1f, 0xf2, 0x58, 0x90, 0xbc, 0xec, 0x7e, 0xf4, 0xa8, 0x96,
+	0xc0, 0xfe, 0x06, 0xd6, 0xef, 0xd4, 0x8b, 0xf6, 0x19, 0xf4, 0x1f, 0x62, 0x31, 0x95, 0xaa, 0x8d,
+	0x55, 0xff, 0xe0, 0x8c, 0x1d, 0x09, 0xbc, 0xc4, 0x02, 0x57, 0xc6, 0xbb, 0x38, 0x21, 0xee, 0xfe,
+	0xc2, 0xf8, 0x2a, 0x4e, 0x88, 0xff, 0x33, 0xd8, 0x1b, 0x45, 0x35, 0x52, 0xac, 0xa6, 0x96, 0xff,
+	0xdf, 0x16, 0x1c, 0x68, 0x25, 0x7a, 0x9c, 0x86, 0x1b, 0xf4, 0x66, 0x91, 0xe3, 0xce, 0xda, 0x1c,
+	0x6f, 0x6f, 0x12, 0x98, 0xee, 0x26, 0x81, 0xe9, 0xd5, 0x73, 0x67, 0xc5, 0xbf, 0xbf, 0x2e, 0xfe,
+	0x5b, 0x6b, 0xe3, 0xef, 0x34, 0x2e, 0xd0, 0x11, 0x1c, 0x5a, 0x01, 0x30, 0x32, 0x4c, 0xcb, 0x92,
+	0x30, 0x62, 0x6c, 0x42, 0x73, 0x51, 0x46, 0xe6, 0xb9, 0x0e, 0x81, 0x92, 0x88, 0xe6, 0xa5, 0x96,
+	0xc1, 0x50, 0x52, 0xb1, 0xf0, 0xa2, 0xbd, 0xd6, 0x8b, 0xa6, 0x84, 0x54, 0x05, 0xa1, 0xda, 0xd0,
+	0x78, 0xf2, 0x27, 0x38, 0x78, 0xa9, 0x7a, 0xd1, 0xc7, 0xf3, 0xf3, 0x39, 0xfb, 0x1e, 0xc1, 0xa1,
+	0xb5, 0xbc, 0xd9, 0x33, 0x85, 0xa1, 0x06, 0x27, 0x24, 0x21, 0xa1, 0xb0, 0x6b, 0x51, 0x43, 0x26,
+	0x5b, 0x4d, 0x99, 0xfc, 0x1c, 0x1f, 0x3c, 0x70, 0x97, 0xb7, 0x33, 0xae, 0x4c, 0xe0, 0xe4, 0x35,
+	0xce, 0xa3, 0xca, 0xc7, 0x4f, 0x76, 0x64, 0x93, 0x66, 0xba, 0x70, 0xda, 0x5c, 0xd4, 0x6c, 0x97,
+	0x81, 0x3b, 0x26, 0x21, 0xbd, 0x27, 0xf9, 0x0f, 0x73, 0xf4, 0x33, 0x78, 0xb2, 0x62, 0x3f, 0xe3,
+	0x0c, 0x87, 0x83, 0x77, 0xe4, 0x7b, 0x71, 0x43, 0x33, 0x31, 0x7d, 0xbc, 0x42, 0xbc, 0x6c, 0x9c,
+	0xb6, 0x1c, 0xa3, 0x63, 0xd8, 0xbe, 0xc7, 0xc9, 0x9c, 0x98, 0x3b, 0xa1, 0x07, 0xeb, 0xa4, 0x4a,
+	0x12, 0xc2, 0xda, 0xd4, 0x78, 0xf2, 0xf7, 0x36, 0x80, 0x42, 0xae, 0x64, 0x13, 0x2f, 0xef, 0xa6,
+	0xea, 0xe6, 0x17, 0x6e, 0xf4, 0xd4, 0xf8, 0x3a, 0x5a, 0xd7, 0x33, 0x6d, 0x68, 0x2e, 0x87, 0xd0,
+	0xcb, 0xc8, 0xf7, 0x42, 0x5a, 0xcc, 0xa5, 0x95, 0xc3, 0x0f, 0xa9, 0xf4, 0x90, 0x0b, 0x2c, 0xe6,
+	0xbc, 0xf4, 0x50, 0x8f, 0xd0, 0x2f, 0xa0, 0xcf, 0x33, 0xcc, 0xf8, 0x94, 0x0a, 0xee, 0x76, 0xd5,
+	0x25, 0xdc, 0x55, 0x97, 0x70, 0x62, 0xd0, 0xf1, 0xc2, 0x6e, 0xbd, 0x44, 0xb0, 0x28, 0x1b, 0x0e,
+	0x0d, 0x8c, 0x84, 0x65, 0xbc, 0x2d, 0xaa, 0x4e, 0x45, 0x01, 0x97, 0x85, 0xea, 0xed, 0x09, 0x65,
+	0x24, 0xd3, 0x73, 0xfb, 0xa6, 0xb7, 0x37, 0xd0, 0x48, 0xd4, 0x3e, 0xb8, 0x2d, 0x5c, 0xa8, 0x7f,
+	0x70, 0x59, 0xf8, 0x7f, 0x04, 0xa7, 0x74, 0x49, 0x9e, 0x12, 0xb3, 0x38, 0x98, 0x91, 0xa2, 0xec,
+	0x3b, 0x30, 0x8b, 0xdf, 0x90, 0x42, 0xf6, 0xd6, 0x92, 0x0d, 0x5c, 0xd0, 0x9c, 0x2c, 0xc2, 0x36,
+	0xa8, 0xb0, 0xeb, 0x48, 0x26, 0x30, 0x54, 0x4d, 0xb3, 0x7e, 0x91, 0xeb, 0x81, 0x3f, 0x07, 0x34,
+	0x56, 0x7b, 0x7d, 0x0a, 0x3f, 0x3e, 0x83, 0x9b, 0x72, 0xdb, 0x3b, 0x2a, 0x9f, 0x35, 0x5b, 0xaa,
+	0xb8, 0xe8, 0x81, 0xff, 0x3b, 0x38, 0xaa, 0x6d, 0x6b, 0xca, 0xcb, 0xd7, 0x30, 0x48, 0x25, 0x10,
+	0xa8, 0xf8, 0x99, 0xde, 0x63, 0x5f, 0xa5, 0x65, 0x41, 0x9c, 0x31, 0xa4, 0xd5, 0x6f, 0xff, 0x0d,
+	0x9c, 0xca, 0xc6, 0x65, 0x61, 0xe5, 0xff, 0x47, 0x17, 0x74, 0x03, 0xc3, 0xa5, 0xc5, 0x8c, 0x67,
+	0x2f, 0x60, 0xc7, 0xf2, 0xac, 0xec, 0xc5, 0x96, 0x5c, 0x1b, 0x2c, 0x5c, 0xe3, 0xfe, 0x3f, 0x5a,
+	0x30, 0xd4, 0x72, 0x3c, 0x79, 0x98, 0x4d, 0x88, 0x90, 0x8f, 0xaf, 0xcf, 0xf7, 0x0e, 0x9d, 0xc3,
+	0x60, 0x8a, 0xb3, 0x28, 0x21, 0x6a, 0x3f, 0x13, 0x68, 0x1b, 0x6a, 0x3e, 0xbb, 0xb7, 0x1e, 0x7f,
+	0x76, 0x6f, 0x2f, 0x3f, 0xbb, 0xa5, 0x90, 0x2e, 0x7b, 0x6d, 0xae, 0xf0, 0x5f, 0x5a, 0x56, 0x85,
+	0x91, 0xaf, 0xeb, 0xc7, 0xc2, 0x5d, 0x3d, 0xd3, 0xdb, 0xab, 0x9f, 0xe9, 0x16, 0xa7, 0x3a, 0x6b,
+	0x39, 0xb5, 0xb5, 0xac, 0xbc, 0x4d, 0x27, 0xb4, 0x7f, 0x2f, 0xfe, 0xe3, 0x00, 0xc8, 0xda, 0x47,
+	0xf2, 0xfb, 0x38, 0x24, 0xe8, 0x35, 0xec, 0xd6, 0x5a, 0x6a, 0xf4, 0x44, 0xed, 0xbf, 0xaa, 0xa5,
+	0xf7, 0xbc, 0x55, 0x26, 0x73, 0xec, 0x1f, 0xa1, 0xdf, 0x80, 0x53, 0x9a, 0xd0, 0x71, 0xed, 0xcb,
+	0x72, 0xfe, 0x49, 0x03, 0xad, 0xa6, 0x7e, 0x0b, 0x3d, 0x83, 0xa2, 0x23, 0xfb, 0x9b, 0x72, 0xe2,
+	0x71, 0x1d, 0xac, 0xe6, 0x7d, 0x03, 0x5d, 0xdd, 0x7d, 0x21, 0xa4, 0xbe, 0xa8, 0x35, 0xdc, 0xde,
+	0x51, 0x0d, 0xab, 0x26, 0xfd, 0x16, 0xfa, 0x55, 0x68, 0xd0, 0x89, 0xa1, 0x67, 0xbd, 0x31, 0xf3,
+	0x4e, 0x9b, 0x70, 0x35, 0xfb, 0x35, 0xec, 0xd6, 0xfa, 0x07, 0x13, 0xaf, 0x55, 0x4d, 0x8c, 0xe7,
+	0xad, 0x32, 0x55, 0x2b, 0xbd, 0xb1, 0x1a, 0xc2, 0xf2, 0xff, 0x05, 0x7b, 0xb1, 0xfa, 0x23, 0xd9,
+	0xf3, 0x56, 0x99, 0xec, 0x43, 0x55, 0x55, 0xd6, 0x1c, 0xaa, 0xd9, 0xcd, 0x78, 0xa7, 0x4d, 0xb8,
+	0x9a, 0xfd, 0x87, 0xb2, 0xf7, 0x59, 0x14, 0x47, 0xf4, 0xd4, 0xfa, 0x7a, 0xa9, 0x46, 0x7b, 0xcf,
+	0xd6, 0x58, 0xad, 0xd3, 0xed, 0xd5, 0x4b, 0x3f, 0xd2, 0x07, 0x58, 0xd9, 0x64, 0x78, 0x67, 0x2b,
+	0x6d, 0xd5, 0x62, 0xef, 0xe1, 0x70, 0xa9, 0x7a, 0x23, 0xed, 0xc2, 0xba, 0x2e, 0xc2, 0xfb, 0x62,
+	0x9d, 0xd9, 0x8e, 0x59, 0x55, 0x81, 0x4d, 0xcc, 0x9a, 0x6d, 0x80, 0x77, 0xda, 0x84, 0xab, 0xd9,
+	0x97, 0x30, 0xb0, 0xf4, 0x19, 0x0d, 0xcd, 0x76, 0xcd, 0x42, 0xe1, 0xb9, 0xcb, 0x86, 0x6a, 0x8d,
+	0x77, 0xfa, 0x4d, 0x69, 0xa9, 0x29, 0x3a, 0xab, 0xa8, 0xbe, 0x2c, 0xd8, 0xde, 0xd3, 0xd5, 0x46,
+	0x3b, 0x8f, 0x4d, 0x5d, 0x32, 0x79, 0x5c, 0x23, 0xb2, 0xde, 0xb3, 0x35, 0x56, 0x3b, 0x8f, 0x75,
+	0x21, 0x41, 0x0d, 0x56, 0xdb, 0x12, 0xe7, 0x9d, 0xad, 0xb4, 0x95, 0x8b, 0xdd, 0x76, 0xd5, 0x1f,
+	0xe8, 0xdf, 0xfc, 0x6f, 0x00, 0xee, 0x75, 0x5a, 0xbf, 0x4d, 0x17, 0x00, 0x00,
 }
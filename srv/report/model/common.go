@@ -316,12 +316,15 @@ func findConfig(db, appID string) (cs *Config, e error) {
 	}
 
 	return &Config{
-		Special:         result.Configs.Special,
-		SyoriYm:         result.Configs.SyoriYm,
-		ShortLeases:     result.Configs.ShortLeases,
-		CheckStartDate:  result.Configs.CheckStartDate,
-		KishuYm:         result.Configs.KishuYm,
-		MinorBaseAmount: result.Configs.MinorBaseAmount,
+		Special:            result.Configs.Special,
+		SyoriYm:            result.Configs.SyoriYm,
+		ShortLeases:        result.Configs.ShortLeases,
+		CheckStartDate:     result.Configs.CheckStartDate,
+		KishuYm:            result.Configs.KishuYm,
+		MinorBaseAmount:    result.Configs.MinorBaseAmount,
+		RoundingMode:       result.Configs.RoundingMode,
+		CostModel:          result.Configs.CostModel,
+		FunctionalCurrency: result.Configs.FunctionalCurrency,
 	}, nil
 }
 
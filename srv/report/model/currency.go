@@ -0,0 +1,188 @@
+package model
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"rxcsoft.cn/pit3/srv/report/utils"
+	database "rxcsoft.cn/utils/mongo"
+)
+
+const (
+	// fxDatastoreKey 为替汇率台账的apikey
+	fxDatastoreKey = "ds_fxrate"
+	// functionalSuffix 功能通货换算字段的后缀(例:leasesaimu => leasesaimu_functional)
+	functionalSuffix = "_functional"
+)
+
+// historicalFields 按取得时汇率换算的字段(使用权资产相关,不按月末汇率再换算)
+var historicalFields = map[string]bool{
+	"kisyuboka":       true,
+	"boka":            true,
+	"endboka":         true,
+	"syokyaku":        true,
+	"sykshisankeisan": true,
+}
+
+// findFxDatastoreID 查找为替汇率台账(未设定的场合返回空)
+func findFxDatastoreID(db, appID string) (string, error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(DataStoresCollection)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	query := bson.M{
+		"deleted_by": "",
+		"app_id":     appID,
+		"api_key":    fxDatastoreKey,
+	}
+
+	var result struct {
+		DatastoreID string `bson:"datastore_id"`
+	}
+	if err := c.FindOne(ctx, query).Decode(&result); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return "", nil
+		}
+		utils.ErrorLog("findFxDatastoreID", err.Error())
+		return "", err
+	}
+
+	return result.DatastoreID, nil
+}
+
+// firstNotNull 依次取得第一个非空的值(当前数据优先,其次为关联台账的数据)
+func firstNotNull(fieldID string, ds *Datastore) interface{} {
+	var expr interface{} = "$items." + fieldID + ".value"
+	for _, relation := range ds.Relations {
+		expr = bson.M{
+			"$ifNull": []interface{}{expr, "$relations." + relation.RelationId + "." + fieldID + ".value"},
+		}
+	}
+	return expr
+}
+
+// lookupFxRate 取得指定年月以前最新的汇率
+func lookupFxRate(fxDatastoreID string, currency, ym interface{}, as string) bson.M {
+	pp := []bson.M{
+		{
+			"$match": bson.M{
+				"$expr": bson.M{
+					"$and": []bson.M{
+						{
+							"$eq": []interface{}{"$items.currency.value", "$$currency"},
+						},
+						{
+							"$lte": []interface{}{"$items.rateym.value", "$$ym"},
+						},
+					},
+				},
+			},
+		},
+		{
+			"$sort": bson.M{
+				"items.rateym.value": -1,
+			},
+		},
+		{
+			"$limit": 1,
+		},
+	}
+
+	return bson.M{
+		"$lookup": bson.M{
+			"from": GetItemCollectionName(fxDatastoreID),
+			"let": bson.M{
+				"currency": currency,
+				"ym":       ym,
+			},
+			"pipeline": pp,
+			"as":       as,
+		},
+	}
+}
+
+// buildFunctionalStages 外币数据的功能通货换算
+// 台账(或关联台账)有通货字段的场合,各数值字段追加"<字段ID>_functional"(功能通货金额)和汇率字段fxrate,
+// 租赁负债等按处理月度的月末汇率换算,使用权资产相关字段按租赁开始月的汇率换算;
+// 通货未设定或与功能通货相同的场合汇率为1
+func buildFunctionalStages(fxDatastoreID, functional, handleMonth string, ds *Datastore, fields []*Field) (pipe []bson.M) {
+	if len(fxDatastoreID) == 0 {
+		return nil
+	}
+	hasCurrency := len(ds.Relations) > 0
+	for _, f := range fields {
+		if f.FieldID == "currency" {
+			hasCurrency = true
+		}
+	}
+	if !hasCurrency {
+		return nil
+	}
+
+	currency := firstNotNull("currency", ds)
+	// 租赁开始月(取得时汇率用)
+	startYm := bson.M{
+		"$dateToString": bson.M{
+			"format": "%Y-%m",
+			"date":   firstNotNull("leasestymd", ds),
+		},
+	}
+
+	pipe = append(pipe, lookupFxRate(fxDatastoreID, currency, handleMonth, "fx_closing"))
+	pipe = append(pipe, lookupFxRate(fxDatastoreID, currency, startYm, "fx_historical"))
+
+	// 外币以外的场合汇率为1
+	rateOf := func(as string) bson.M {
+		return bson.M{
+			"$cond": bson.M{
+				"if": bson.M{
+					"$in": []interface{}{bson.M{"$ifNull": []interface{}{currency, ""}}, []string{"", functional}},
+				},
+				"then": 1,
+				"else": bson.M{
+					"$arrayElemAt": []interface{}{"$" + as + ".items.rate.value", 0},
+				},
+			},
+		}
+	}
+	closing := rateOf("fx_closing")
+	historical := rateOf("fx_historical")
+
+	addFields := bson.M{
+		"items.fxrate": bson.M{
+			"value":     closing,
+			"data_type": "number",
+		},
+	}
+	for _, f := range fields {
+		if f.FieldType != "number" {
+			continue
+		}
+		rate := closing
+		if historicalFields[f.FieldID] {
+			rate = historical
+		}
+		addFields["items."+f.FieldID+functionalSuffix] = bson.M{
+			"value": bson.M{
+				"$multiply": []interface{}{"$items." + f.FieldID + ".value", rate},
+			},
+			"data_type": "number",
+		}
+	}
+
+	pipe = append(pipe, bson.M{
+		"$addFields": addFields,
+	})
+	pipe = append(pipe, bson.M{
+		"$project": bson.M{
+			"fx_closing":    0,
+			"fx_historical": 0,
+		},
+	})
+
+	return pipe
+}
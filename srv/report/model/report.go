@@ -152,12 +152,15 @@ type (
 
 	// Config 顾客配置情报
 	Config struct {
-		Special         string `json:"special" bson:"special"`
-		CheckStartDate  string `json:"check_start_date" bson:"check_start_date"`
-		SyoriYm         string `json:"syori_ym" bson:"syori_ym"`
-		ShortLeases     string `json:"short_leases" bson:"short_leases"`
-		KishuYm         string `json:"kishu_ym" bson:"kishu_ym"`
-		MinorBaseAmount string `json:"minor_base_amount" bson:"minor_base_amount"`
+		Special            string `json:"special" bson:"special"`
+		CheckStartDate     string `json:"check_start_date" bson:"check_start_date"`
+		SyoriYm            string `json:"syori_ym" bson:"syori_ym"`
+		ShortLeases        string `json:"short_leases" bson:"short_leases"`
+		KishuYm            string `json:"kishu_ym" bson:"kishu_ym"`
+		MinorBaseAmount    string `json:"minor_base_amount" bson:"minor_base_amount"`
+		RoundingMode       string `json:"rounding_mode" bson:"rounding_mode"`
+		CostModel          string `json:"cost_model" bson:"cost_model"`
+		FunctionalCurrency string `json:"functional_currency" bson:"functional_currency"`
 	}
 
 	TotalResult struct {
@@ -1375,6 +1378,14 @@ func GenerateReportData(db, reportId string) (err error) {
 		"$project": project,
 	})
 
+	// 外币数据追加功能通货换算的金额
+	fxDatastoreID, err := findFxDatastoreID(db, reportInfo.AppID)
+	if err != nil {
+		utils.ErrorLog("error GenerateReportData", err.Error())
+		return err
+	}
+	pipe = append(pipe, buildFunctionalStages(fxDatastoreID, config.FunctionalCurrency, handleMonth, ds, fields)...)
+
 	// 使用集计的场合
 	if reportInfo.IsUseGroup {
 
@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"rxcsoft.cn/pit3/srv/workflow/model"
+	"rxcsoft.cn/pit3/srv/workflow/proto/delegation"
+	"rxcsoft.cn/pit3/srv/workflow/utils"
+)
+
+// Delegation 审批代理
+type Delegation struct{}
+
+// log出力使用
+const (
+	DelegationProcessName = "Delegation"
+
+	ActionFindDelegations   = "FindDelegations"
+	ActionAddDelegation     = "AddDelegation"
+	ActionDeleteDelegations = "DeleteDelegations"
+)
+
+// FindDelegations 获取审批代理
+func (f *Delegation) FindDelegations(ctx context.Context, req *delegation.DelegationsRequest, rsp *delegation.DelegationsResponse) error {
+	utils.InfoLog(ActionFindDelegations, utils.MsgProcessStarted)
+
+	items, err := model.FindDelegations(req.GetDatabase(), req.GetUserId(), req.GetDelegate(), req.GetWfId(), req.GetActiveOn())
+	if err != nil {
+		utils.ErrorLog(ActionFindDelegations, err.Error())
+		return err
+	}
+
+	res := &delegation.DelegationsResponse{}
+	for _, d := range items {
+		res.Delegations = append(res.Delegations, d.ToProto())
+	}
+
+	*rsp = *res
+
+	utils.InfoLog(ActionFindDelegations, utils.MsgProcessEnded)
+	return nil
+}
+
+// AddDelegation 添加审批代理
+func (f *Delegation) AddDelegation(ctx context.Context, req *delegation.AddRequest, rsp *delegation.AddResponse) error {
+	utils.InfoLog(ActionAddDelegation, utils.MsgProcessStarted)
+
+	param := model.Delegation{
+		UserID:     req.GetUserId(),
+		Delegate:   req.GetDelegate(),
+		WorkflowID: req.GetWfId(),
+		StartDate:  req.GetStartDate(),
+		EndDate:    req.GetEndDate(),
+		Reason:     req.GetReason(),
+		CreatedAt:  time.Now(),
+		CreatedBy:  req.GetWriter(),
+	}
+
+	id, err := model.AddDelegation(req.GetDatabase(), &param)
+	if err != nil {
+		utils.ErrorLog(ActionAddDelegation, err.Error())
+		return err
+	}
+
+	rsp.DelegationId = id
+
+	utils.InfoLog(ActionAddDelegation, utils.MsgProcessEnded)
+	return nil
+}
+
+// DeleteDelegations 删除审批代理
+func (f *Delegation) DeleteDelegations(ctx context.Context, req *delegation.DeleteRequest, rsp *delegation.DeleteResponse) error {
+	utils.InfoLog(ActionDeleteDelegations, utils.MsgProcessStarted)
+
+	err := model.DeleteDelegations(req.GetDatabase(), req.GetUserId(), req.GetDelegationIds())
+	if err != nil {
+		utils.ErrorLog(ActionDeleteDelegations, err.Error())
+		return err
+	}
+
+	utils.InfoLog(ActionDeleteDelegations, utils.MsgProcessEnded)
+	return nil
+}
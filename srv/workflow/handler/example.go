@@ -21,6 +21,14 @@ const (
 	ActionAddExample    = "AddExample"
 	ActionModifyExample = "ModifyExample"
 	ActionDeleteExample = "DeleteExample"
+	ActionSubmit        = "Submit"
+	ActionApprove       = "Approve"
+	ActionReject        = "Reject"
+	ActionWithdraw      = "Withdraw"
+	ActionReassign      = "Reassign"
+	ActionReturn        = "Return"
+	ActionResubmit      = "Resubmit"
+	ActionRevert        = "Revert"
 )
 
 // FindExamples 获取多个流程实例
@@ -115,3 +123,144 @@ func (f *Example) DeleteExample(ctx context.Context, req *example.DeleteRequest,
 	utils.InfoLog(ActionDeleteExample, utils.MsgProcessEnded)
 	return nil
 }
+
+// transitionProto 状态迁移的结果转换为proto数据
+func transitionProto(t *model.Transition, rsp *example.TransitionResponse) {
+	rsp.Example = t.Example.ToProto()
+	rsp.Event = t.Event
+	rsp.ProId = t.ProcessID
+	rsp.NotifyUsers = t.Notify
+}
+
+// Submit 申请,生成第一个审批节点的进程
+func (f *Example) Submit(ctx context.Context, req *example.SubmitRequest, rsp *example.TransitionResponse) error {
+	utils.InfoLog(ActionSubmit, utils.MsgProcessStarted)
+
+	approvers := make(map[string][]string)
+	for _, a := range req.GetApprovers() {
+		approvers[a.GetNodeId()] = append(approvers[a.GetNodeId()], a.GetUserIds()...)
+	}
+
+	t, err := model.Submit(req.GetDatabase(), req.GetExId(), req.GetWriter(), approvers, req.GetFacts(), req.GetVersion())
+	if err != nil {
+		utils.ErrorLog(ActionSubmit, err.Error())
+		return err
+	}
+
+	transitionProto(t, rsp)
+
+	utils.InfoLog(ActionSubmit, utils.MsgProcessEnded)
+	return nil
+}
+
+// Approve 承认
+func (f *Example) Approve(ctx context.Context, req *example.ApproveRequest, rsp *example.TransitionResponse) error {
+	utils.InfoLog(ActionApprove, utils.MsgProcessStarted)
+
+	t, err := model.Approve(req.GetDatabase(), req.GetExId(), req.GetUserId(), req.GetWriter(), req.GetComment(), req.GetVersion())
+	if err != nil {
+		utils.ErrorLog(ActionApprove, err.Error())
+		return err
+	}
+
+	transitionProto(t, rsp)
+
+	utils.InfoLog(ActionApprove, utils.MsgProcessEnded)
+	return nil
+}
+
+// Reject 却下
+func (f *Example) Reject(ctx context.Context, req *example.RejectRequest, rsp *example.TransitionResponse) error {
+	utils.InfoLog(ActionReject, utils.MsgProcessStarted)
+
+	t, err := model.Reject(req.GetDatabase(), req.GetExId(), req.GetUserId(), req.GetWriter(), req.GetComment(), req.GetVersion())
+	if err != nil {
+		utils.ErrorLog(ActionReject, err.Error())
+		return err
+	}
+
+	transitionProto(t, rsp)
+
+	utils.InfoLog(ActionReject, utils.MsgProcessEnded)
+	return nil
+}
+
+// Withdraw 申请者取消
+func (f *Example) Withdraw(ctx context.Context, req *example.WithdrawRequest, rsp *example.TransitionResponse) error {
+	utils.InfoLog(ActionWithdraw, utils.MsgProcessStarted)
+
+	t, err := model.Withdraw(req.GetDatabase(), req.GetExId(), req.GetUserId(), req.GetComment(), req.GetVersion())
+	if err != nil {
+		utils.ErrorLog(ActionWithdraw, err.Error())
+		return err
+	}
+
+	transitionProto(t, rsp)
+
+	utils.InfoLog(ActionWithdraw, utils.MsgProcessEnded)
+	return nil
+}
+
+// Return 退回修改
+func (f *Example) Return(ctx context.Context, req *example.ReturnRequest, rsp *example.TransitionResponse) error {
+	utils.InfoLog(ActionReturn, utils.MsgProcessStarted)
+
+	t, err := model.Return(req.GetDatabase(), req.GetExId(), req.GetUserId(), req.GetWriter(), req.GetComment(), req.GetVersion())
+	if err != nil {
+		utils.ErrorLog(ActionReturn, err.Error())
+		return err
+	}
+
+	transitionProto(t, rsp)
+
+	utils.InfoLog(ActionReturn, utils.MsgProcessEnded)
+	return nil
+}
+
+// Resubmit 退回后再申请
+func (f *Example) Resubmit(ctx context.Context, req *example.ResubmitRequest, rsp *example.TransitionResponse) error {
+	utils.InfoLog(ActionResubmit, utils.MsgProcessStarted)
+
+	t, err := model.Resubmit(req.GetDatabase(), req.GetExId(), req.GetUserId(), req.GetFacts(), req.GetVersion())
+	if err != nil {
+		utils.ErrorLog(ActionResubmit, err.Error())
+		return err
+	}
+
+	transitionProto(t, rsp)
+
+	utils.InfoLog(ActionResubmit, utils.MsgProcessEnded)
+	return nil
+}
+
+// Revert 撤销最终承认
+func (f *Example) Revert(ctx context.Context, req *example.RevertRequest, rsp *example.TransitionResponse) error {
+	utils.InfoLog(ActionRevert, utils.MsgProcessStarted)
+
+	t, err := model.Revert(req.GetDatabase(), req.GetExId(), req.GetWriter(), req.GetVersion())
+	if err != nil {
+		utils.ErrorLog(ActionRevert, err.Error())
+		return err
+	}
+
+	transitionProto(t, rsp)
+
+	utils.InfoLog(ActionRevert, utils.MsgProcessEnded)
+	return nil
+}
+
+// Reassign 审批者转交
+func (f *Example) Reassign(ctx context.Context, req *example.ReassignRequest, rsp *example.TransitionResponse) error {
+	utils.InfoLog(ActionReassign, utils.MsgProcessStarted)
+
+	t, err := model.Reassign(req.GetDatabase(), req.GetExId(), req.GetFromUser(), req.GetToUser(), req.GetWriter(), req.GetComment(), req.GetEscalate(), req.GetVersion())
+	if err != nil {
+		utils.ErrorLog(ActionReassign, err.Error())
+		return err
+	}
+
+	transitionProto(t, rsp)
+
+	utils.InfoLog(ActionReassign, utils.MsgProcessEnded)
+	return nil
+}
@@ -64,16 +64,37 @@ func (f *Node) FindNode(ctx context.Context, req *node.NodeRequest, rsp *node.No
 func (f *Node) AddNode(ctx context.Context, req *node.AddRequest, rsp *node.AddResponse) error {
 	utils.InfoLog(ActionAddNode, utils.MsgProcessStarted)
 
+	var routes []model.Route
+	for _, r := range req.GetRoutes() {
+		routes = append(routes, model.Route{
+			Guard:    r.GetGuard(),
+			NextNode: r.GetNextNode(),
+		})
+	}
+
+	sla := model.SLA{
+		RemindHours:   req.GetRemindHours(),
+		EscalateHours: req.GetEscalateHours(),
+		ExpireHours:   req.GetExpireHours(),
+		ExpireAction:  req.GetExpireAction(),
+	}
+	if err := sla.Validate(); err != nil {
+		utils.ErrorLog(ActionAddNode, err.Error())
+		return err
+	}
+
 	param := model.Node{
 		NodeID:      req.GetNodeId(),
 		NodeName:    req.GetNodeName(),
 		WorkflowID:  req.GetWfId(),
-		NodeType:    req.GetNextNode(),
+		NodeType:    req.GetNodeType(),
 		PrevNode:    req.GetPrevNode(),
 		NextNode:    req.GetNextNode(),
 		Assignees:   req.GetAssignees(),
 		ActType:     req.GetActType(),
 		NodeGroupId: req.GetNodeGroupId(),
+		Routes:      routes,
+		SLA:         sla,
 		CreatedAt:   time.Now(),
 		CreatedBy:   req.GetWriter(),
 		UpdatedAt:   time.Now(),
@@ -21,6 +21,9 @@ const (
 	ActionAddProcess    = "AddProcess"
 	ActionModifyProcess = "ModifyProcess"
 	ActionDeleteProcess = "DeleteProcess"
+	ActionFindOverdue   = "FindOverdueProcesses"
+	ActionMarkReminded  = "MarkReminded"
+	ActionMarkEscalated = "MarkEscalated"
 )
 
 // FindProcesses 获取多个流程的进程
@@ -121,3 +124,58 @@ func (f *Process) DeleteProcess(ctx context.Context, req *process.DeleteRequest,
 	utils.InfoLog(ActionDeleteProcess, utils.MsgProcessEnded)
 	return nil
 }
+
+// FindOverdueProcesses 获取超过处理期限的进程
+func (f *Process) FindOverdueProcesses(ctx context.Context, req *process.OverdueRequest, rsp *process.OverdueResponse) error {
+	utils.InfoLog(ActionFindOverdue, utils.MsgProcessStarted)
+
+	items, err := model.FindOverdue(req.GetDatabase(), time.Now())
+	if err != nil {
+		utils.ErrorLog(ActionFindOverdue, err.Error())
+		return err
+	}
+
+	for _, o := range items {
+		rsp.Processes = append(rsp.Processes, &process.Overdue{
+			ProId:       o.ProcessID,
+			ExId:        o.ExampleID,
+			WfId:        o.WorkflowID,
+			CurrentNode: o.NodeID,
+			UserId:      o.UserID,
+			Applicant:   o.Applicant,
+			Action:      o.Action,
+			Version:     o.Version,
+		})
+	}
+
+	utils.InfoLog(ActionFindOverdue, utils.MsgProcessEnded)
+	return nil
+}
+
+// MarkReminded 记录已经提醒审批者
+func (f *Process) MarkReminded(ctx context.Context, req *process.RemindedRequest, rsp *process.RemindedResponse) error {
+	utils.InfoLog(ActionMarkReminded, utils.MsgProcessStarted)
+
+	err := model.MarkReminded(req.GetDatabase(), req.GetProIds(), time.Now())
+	if err != nil {
+		utils.ErrorLog(ActionMarkReminded, err.Error())
+		return err
+	}
+
+	utils.InfoLog(ActionMarkReminded, utils.MsgProcessEnded)
+	return nil
+}
+
+// MarkEscalated 记录已经转交
+func (f *Process) MarkEscalated(ctx context.Context, req *process.EscalatedRequest, rsp *process.EscalatedResponse) error {
+	utils.InfoLog(ActionMarkEscalated, utils.MsgProcessStarted)
+
+	err := model.MarkEscalated(req.GetDatabase(), req.GetProIds())
+	if err != nil {
+		utils.ErrorLog(ActionMarkEscalated, err.Error())
+		return err
+	}
+
+	utils.InfoLog(ActionMarkEscalated, utils.MsgProcessEnded)
+	return nil
+}
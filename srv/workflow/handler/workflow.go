@@ -86,6 +86,30 @@ func (f *Workflow) FindWorkflow(ctx context.Context, req *workflow.WorkflowReque
 func (f *Workflow) AddWorkflow(ctx context.Context, req *workflow.AddRequest, rsp *workflow.AddResponse) error {
 	utils.InfoLog(ActionAddWorkflow, utils.MsgProcessStarted)
 
+	// 检查流程图(无法到达的节点、循环、条件式)
+	if len(req.GetNodes()) > 0 {
+		var nodes []model.Node
+		for _, n := range req.GetNodes() {
+			var routes []model.Route
+			for _, r := range n.GetRoutes() {
+				routes = append(routes, model.Route{
+					Guard:    r.GetGuard(),
+					NextNode: r.GetNextNode(),
+				})
+			}
+			nodes = append(nodes, model.Node{
+				NodeID:   n.GetNodeId(),
+				NodeType: n.GetNodeType(),
+				NextNode: n.GetNextNode(),
+				Routes:   routes,
+			})
+		}
+		if err := model.ValidateGraph(nodes); err != nil {
+			utils.ErrorLog(ActionAddWorkflow, err.Error())
+			return err
+		}
+	}
+
 	param := model.Workflow{
 		WorkflowName:    req.GetWfName(),
 		MenuName:        req.GetMenuName(),
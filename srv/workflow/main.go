@@ -8,6 +8,7 @@ import (
 	lg "github.com/micro/go-plugins/logger/logrus/v2"
 
 	"rxcsoft.cn/pit3/srv/workflow/handler"
+	"rxcsoft.cn/pit3/srv/workflow/proto/delegation"
 	"rxcsoft.cn/pit3/srv/workflow/proto/example"
 	"rxcsoft.cn/pit3/srv/workflow/proto/node"
 	"rxcsoft.cn/pit3/srv/workflow/proto/process"
@@ -49,6 +50,7 @@ func main() {
 	process.RegisterProcessServiceHandler(service.Server(), new(handler.Process))
 	workflow.RegisterWfServiceHandler(service.Server(), new(handler.Workflow))
 	relation.RegisterRelationServiceHandler(service.Server(), new(handler.Relation))
+	delegation.RegisterDelegationServiceHandler(service.Server(), new(handler.Delegation))
 
 	// 运行服务
 	if err := service.Run(); err != nil {
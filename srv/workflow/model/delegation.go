@@ -0,0 +1,214 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"rxcsoft.cn/pit3/srv/workflow/proto/delegation"
+	"rxcsoft.cn/pit3/srv/workflow/utils"
+	database "rxcsoft.cn/utils/mongo"
+)
+
+const (
+	// DelegationCollection delegation collection
+	DelegationCollection = "wf_delegations"
+	// 代理者也在休假时继续查找代理者的最大次数
+	maxDelegation = 3
+)
+
+type (
+	// Delegation 审批代理
+	Delegation struct {
+		ID           primitive.ObjectID `json:"id" bson:"_id"`
+		DelegationID string             `json:"delegation_id" bson:"delegation_id"`
+		UserID       string             `json:"user_id" bson:"user_id"`
+		Delegate     string             `json:"delegate" bson:"delegate"`
+		WorkflowID   string             `json:"wf_id" bson:"wf_id"`
+		StartDate    string             `json:"start_date" bson:"start_date"`
+		EndDate      string             `json:"end_date" bson:"end_date"`
+		Reason       string             `json:"reason" bson:"reason"`
+		CreatedAt    time.Time          `json:"created_at" bson:"created_at"`
+		CreatedBy    string             `json:"created_by" bson:"created_by"`
+	}
+)
+
+// ToProto 转换为proto数据
+func (d *Delegation) ToProto() *delegation.Delegation {
+	return &delegation.Delegation{
+		DelegationId: d.DelegationID,
+		UserId:       d.UserID,
+		Delegate:     d.Delegate,
+		WfId:         d.WorkflowID,
+		StartDate:    d.StartDate,
+		EndDate:      d.EndDate,
+		Reason:       d.Reason,
+		CreatedAt:    d.CreatedAt.String(),
+		CreatedBy:    d.CreatedBy,
+	}
+}
+
+// activeQuery 用户在某日有效的代理(指定流程的代理和全部流程的代理)
+func activeQuery(userID, wfID, date string) bson.M {
+	return bson.M{
+		"user_id":    userID,
+		"wf_id":      bson.M{"$in": []string{wfID, ""}},
+		"start_date": bson.M{"$lte": date},
+		"end_date":   bson.M{"$gte": date},
+	}
+}
+
+// FindDelegations 获取审批代理数据
+func FindDelegations(db, userID, delegate, wfID, activeOn string) (items []Delegation, err error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(DelegationCollection)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	query := bson.M{}
+	if len(userID) > 0 {
+		query["user_id"] = userID
+	}
+	if len(delegate) > 0 {
+		query["delegate"] = delegate
+	}
+	if len(wfID) > 0 {
+		query["wf_id"] = wfID
+	}
+	if len(activeOn) > 0 {
+		query["start_date"] = bson.M{"$lte": activeOn}
+		query["end_date"] = bson.M{"$gte": activeOn}
+	}
+
+	queryJSON, _ := json.Marshal(query)
+	utils.DebugLog("FindDelegations", fmt.Sprintf("query: [ %s ]", queryJSON))
+
+	var result []Delegation
+	opts := options.Find().SetSort(bson.D{{Key: "start_date", Value: 1}})
+	cur, err := c.Find(ctx, query, opts)
+	if err != nil {
+		utils.ErrorLog("error FindDelegations", err.Error())
+		return nil, err
+	}
+	if err := cur.All(ctx, &result); err != nil {
+		utils.ErrorLog("error FindDelegations", err.Error())
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// AddDelegation 添加审批代理数据(同一流程的期间不能重复)
+func AddDelegation(db string, d *Delegation) (id string, err error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(DelegationCollection)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if len(d.UserID) == 0 || len(d.Delegate) == 0 {
+		return "", errors.New("承認者と代理者を指定してください")
+	}
+	if d.UserID == d.Delegate {
+		return "", errors.New("自分自身を代理者に設定することはできません")
+	}
+	start, err := time.Parse(DateFormat, d.StartDate)
+	if err != nil {
+		return "", fmt.Errorf("開始日[%s]が不正です", d.StartDate)
+	}
+	end, err := time.Parse(DateFormat, d.EndDate)
+	if err != nil {
+		return "", fmt.Errorf("終了日[%s]が不正です", d.EndDate)
+	}
+	if end.Before(start) {
+		return "", errors.New("終了日は開始日以降の日付を指定してください")
+	}
+
+	query := bson.M{
+		"user_id":    d.UserID,
+		"wf_id":      d.WorkflowID,
+		"start_date": bson.M{"$lte": d.EndDate},
+		"end_date":   bson.M{"$gte": d.StartDate},
+	}
+	count, err := c.CountDocuments(ctx, query)
+	if err != nil {
+		utils.ErrorLog("error AddDelegation", err.Error())
+		return "", err
+	}
+	if count > 0 {
+		return "", errors.New("期間が重複する代理設定が既に存在します")
+	}
+
+	d.ID = primitive.NewObjectID()
+	d.DelegationID = d.ID.Hex()
+
+	_, err = c.InsertOne(ctx, d)
+	if err != nil {
+		utils.ErrorLog("error AddDelegation", err.Error())
+		return "", err
+	}
+
+	return d.DelegationID, nil
+}
+
+// DeleteDelegations 删除审批代理数据,指定用户时只删除该用户的代理
+func DeleteDelegations(db, userID string, ids []string) (err error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(DelegationCollection)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	query := bson.M{
+		"delegation_id": bson.M{"$in": ids},
+	}
+	if len(userID) > 0 {
+		query["user_id"] = userID
+	}
+
+	queryJSON, _ := json.Marshal(query)
+	utils.DebugLog("DeleteDelegations", fmt.Sprintf("query: [ %s ]", queryJSON))
+
+	_, err = c.DeleteMany(ctx, query)
+	if err != nil {
+		utils.ErrorLog("error DeleteDelegations", err.Error())
+		return err
+	}
+	return nil
+}
+
+// delegateOf 获取审批者当前有效的代理者(指定流程的代理优先),
+// 代理者也在代理期间的场合继续查找,没有代理时返回空
+func (m *machine) delegateOf(userID string) (string, error) {
+	c := database.New().Database(database.GetDBName(m.db)).Collection(DelegationCollection)
+	date := m.now.Format(DateFormat)
+
+	seen := map[string]bool{userID: true}
+	current := userID
+	for i := 0; i < maxDelegation; i++ {
+		var d Delegation
+		opts := options.FindOne().SetSort(bson.D{{Key: "wf_id", Value: -1}})
+		err := c.FindOne(m.sc, activeQuery(current, m.ex.WorkflowID, date), opts).Decode(&d)
+		if err == mongo.ErrNoDocuments {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+		// 代理关系循环的场合使用最后一个代理者
+		if seen[d.Delegate] {
+			break
+		}
+		seen[d.Delegate] = true
+		current = d.Delegate
+	}
+
+	if current == userID {
+		return "", nil
+	}
+	return current, nil
+}
@@ -0,0 +1,822 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"rxcsoft.cn/pit3/srv/workflow/utils"
+	database "rxcsoft.cn/utils/mongo"
+)
+
+// 实例状态
+const (
+	ExampleRunning   int64 = 1 // 正在审批
+	ExampleApproved  int64 = 2 // 承认
+	ExampleRejected  int64 = 3 // 却下
+	ExampleWithdrawn int64 = 4 // 申请者取消
+	ExampleReturned  int64 = 5 // 退回修改(申请者修改后再申请)
+)
+
+// 进程状态
+const (
+	ProcessPending    int64 = 0 // 待审批
+	ProcessApproved   int64 = 1 // 承认
+	ProcessRejected   int64 = 2 // 却下
+	ProcessWithdrawn  int64 = 3 // 申请者取消
+	ProcessReassigned int64 = 4 // 转交给其他审批者
+	ProcessReturned   int64 = 5 // 退回修改
+)
+
+// 状态迁移的事件
+const (
+	EventSubmitted   = "submitted"   // 申请,第一个审批节点待审批
+	EventWaiting     = "waiting"     // 承认,当前节点还有其他审批者待审批
+	EventAdvanced    = "advanced"    // 承认,进入下一个审批节点
+	EventApproved    = "approved"    // 最终承认
+	EventRejected    = "rejected"    // 却下
+	EventWithdrawn   = "withdrawn"   // 申请者取消
+	EventReassigned  = "reassigned"  // 审批者转交
+	EventReturned    = "returned"    // 退回修改
+	EventResubmitted = "resubmitted" // 退回后再申请,第一个审批节点待审批
+	EventReverted    = "reverted"    // 台账数据更新失败,撤销最终承认
+)
+
+const (
+	// SystemUser 节点没有审批者时代为处理的用户
+	SystemUser = "system"
+	// 默认过期天数,如果5天未处理则自动回退
+	expireDays = 5
+	// 未指定版本时冲突的重试次数
+	maxRetry = 3
+	// 节点结束标志
+	endNode = "0"
+)
+
+var (
+	// ErrConflict 实例已被其他操作更新(网关按该消息判断冲突,参照wfclient.IsConflict)
+	ErrConflict = errors.New("申請データは他のユーザーにより更新されました。最新の状態を確認してください")
+	// ErrNotRunning 实例不是审批中状态
+	ErrNotRunning = errors.New("この申請は既に完了しています")
+	// ErrNotApprover 当前用户没有待审批的进程
+	ErrNotApprover = errors.New("承認者ではないか、既に処理済みです")
+)
+
+// Transition 状态迁移的结果
+type Transition struct {
+	Example   Example
+	Event     string
+	ProcessID string   // 操作者的进程ID
+	Notify    []string // 需要通知的用户(新的待审批者或被取消的审批者)
+}
+
+// machine 一个事务内的状态迁移
+type machine struct {
+	sc      mongo.SessionContext
+	db      string
+	writer  string
+	now     time.Time
+	ex      Example
+	wf      Workflow
+	graph   *Graph
+	version int64
+	result  Transition
+	// 本次迁移中完成的待审批进程和添加的进程(最终承认时记录,撤销用)
+	done     []primitive.ObjectID
+	inserted []primitive.ObjectID
+	undo     *Undo
+}
+
+// Submit 申请,按审批者计划和审批数据的字段值(条件路由使用)生成开始节点的进程
+func Submit(db, exID, writer string, approvers map[string][]string, facts map[string]string, version int64) (*Transition, error) {
+	return transit(db, exID, writer, version, func(m *machine) error {
+		if m.ex.Status != ExampleRunning || len(m.ex.ActiveNodes) > 0 {
+			return ErrNotRunning
+		}
+
+		start, ok := m.graph.Start()
+		if !ok {
+			return fmt.Errorf("ワークフロー[%s]にノードが設定されていません", m.ex.WorkflowID)
+		}
+
+		// 去除重复用户和申请者自己
+		plan := make(map[string][]string, len(approvers))
+		for nodeID, users := range approvers {
+			seen := make(map[string]bool)
+			for _, u := range users {
+				if len(u) == 0 || u == m.ex.UserID || seen[u] {
+					continue
+				}
+				seen[u] = true
+				plan[nodeID] = append(plan[nodeID], u)
+			}
+		}
+		m.ex.Approvers = plan
+		m.ex.Facts = facts
+
+		m.result.Event = EventSubmitted
+		if err := m.enter(start.NodeID); err != nil {
+			return err
+		}
+		return m.settle()
+	})
+}
+
+// Approve 承认,当前节点完成的场合进入下一个节点;writer为空时是审批者本人
+func Approve(db, exID, userID, writer, comment string, version int64) (*Transition, error) {
+	if len(writer) == 0 {
+		writer = userID
+	}
+	return transit(db, exID, writer, version, func(m *machine) error {
+		own, rest, err := m.ownProcess(userID)
+		if err != nil {
+			return err
+		}
+		active := append([]string(nil), m.ex.ActiveNodes...)
+		if err := m.setProcess(own, ProcessApproved, comment, writer); err != nil {
+			return err
+		}
+		m.result.ProcessID = own.ProcessID
+
+		n, _ := m.graph.Node(own.CurrentNode)
+		// and节点需要全部审批者承认
+		if n.ActType != "or" && len(rest) > 0 {
+			m.result.Event = EventWaiting
+			return nil
+		}
+
+		// 更新当前节点的剩余未审批的进程为完成状态
+		for _, p := range rest {
+			if err := m.setProcess(p, ProcessApproved, "Approved by other approvers", "SYSTEM"); err != nil {
+				return err
+			}
+		}
+
+		m.result.Event = EventAdvanced
+		if err := m.leave(n); err != nil {
+			return err
+		}
+		if err := m.settle(); err != nil {
+			return err
+		}
+		// 最终承认的场合记录操作者的节点和承认前的状态(台账数据更新失败时撤销)
+		if m.ex.Status == ExampleApproved {
+			m.ex.CurrentNode = own.CurrentNode
+			m.undo = &Undo{
+				ActiveNodes: active,
+				Processes:   m.done,
+				Inserted:    m.inserted,
+			}
+		}
+		return nil
+	})
+}
+
+// Revert 最终承认后台账数据更新失败时,在事务内恢复到最终承认前的状态;
+// 必须指定最终承认后的版本,其他操作已经更新实例的场合返回冲突
+func Revert(db, exID, writer string, version int64) (*Transition, error) {
+	if version == 0 {
+		return nil, errors.New("取り消す申請のバージョンを指定してください")
+	}
+	return transit(db, exID, writer, version, func(m *machine) error {
+		if m.ex.Status != ExampleApproved || m.ex.Undo == nil {
+			return errors.New("この申請は承認を取り消すことができません")
+		}
+
+		c := database.New().Database(database.GetDBName(m.db)).Collection(ProcessCollection)
+
+		if len(m.ex.Undo.Processes) > 0 {
+			query := bson.M{
+				"_id": bson.M{"$in": m.ex.Undo.Processes},
+			}
+			update := bson.M{
+				"$set": bson.M{
+					"status":     ProcessPending,
+					"comment":    "",
+					"updated_at": m.now,
+					"updated_by": writer,
+				},
+			}
+			if _, err := c.UpdateMany(m.sc, query, update); err != nil {
+				return err
+			}
+		}
+		if len(m.ex.Undo.Inserted) > 0 {
+			if _, err := c.DeleteMany(m.sc, bson.M{"_id": bson.M{"$in": m.ex.Undo.Inserted}}); err != nil {
+				return err
+			}
+		}
+
+		m.ex.Status = ExampleRunning
+		m.ex.ActiveNodes = m.ex.Undo.ActiveNodes
+		m.ex.CurrentNode = ""
+		if len(m.ex.ActiveNodes) > 0 {
+			m.ex.CurrentNode = m.ex.ActiveNodes[0]
+		}
+		m.result.Event = EventReverted
+		return nil
+	})
+}
+
+// Reject 却下,审批中的其他进程(包括并行分支)一并却下;writer为空时是审批者本人
+func Reject(db, exID, userID, writer, comment string, version int64) (*Transition, error) {
+	if len(writer) == 0 {
+		writer = userID
+	}
+	return transit(db, exID, writer, version, func(m *machine) error {
+		own, _, err := m.ownProcess(userID)
+		if err != nil {
+			return err
+		}
+		if err := m.setProcess(own, ProcessRejected, comment, writer); err != nil {
+			return err
+		}
+		m.result.ProcessID = own.ProcessID
+
+		if err := m.reject(); err != nil {
+			return err
+		}
+		return m.settle()
+	})
+}
+
+// Withdraw 申请者取消审批中的申请
+func Withdraw(db, exID, userID, comment string, version int64) (*Transition, error) {
+	return transit(db, exID, userID, version, func(m *machine) error {
+		if m.ex.Status != ExampleRunning && m.ex.Status != ExampleReturned {
+			return ErrNotRunning
+		}
+		if m.ex.UserID != userID {
+			return errors.New("申請者以外は申請を取り消すことができません")
+		}
+
+		pending, err := m.pending()
+		if err != nil {
+			return err
+		}
+		for _, p := range pending {
+			if err := m.setProcess(p, ProcessWithdrawn, comment, userID); err != nil {
+				return err
+			}
+			m.result.Notify = append(m.result.Notify, p.UserID)
+		}
+
+		m.ex.Status = ExampleWithdrawn
+		m.result.Event = EventWithdrawn
+		return m.settle()
+	})
+}
+
+// Return 退回修改,审批中的其他进程一并结束;申请者修改后可以用同一实例再申请
+func Return(db, exID, userID, writer, comment string, version int64) (*Transition, error) {
+	if len(writer) == 0 {
+		writer = userID
+	}
+	return transit(db, exID, writer, version, func(m *machine) error {
+		own, _, err := m.ownProcess(userID)
+		if err != nil {
+			return err
+		}
+		if len(comment) == 0 {
+			return errors.New("差し戻しの理由を入力してください")
+		}
+		if err := m.setProcess(own, ProcessReturned, comment, writer); err != nil {
+			return err
+		}
+		m.result.ProcessID = own.ProcessID
+
+		pending, err := m.pending()
+		if err != nil {
+			return err
+		}
+		for _, p := range pending {
+			if err := m.setProcess(p, ProcessReturned, "Returned by other approvers", "SYSTEM"); err != nil {
+				return err
+			}
+			m.result.Notify = append(m.result.Notify, p.UserID)
+		}
+
+		m.ex.Status = ExampleReturned
+		m.result.Event = EventReturned
+		return m.settle()
+	})
+}
+
+// Resubmit 申请者修改退回的申请后再申请,按最初的审批者计划从开始节点重新审批
+func Resubmit(db, exID, userID string, facts map[string]string, version int64) (*Transition, error) {
+	return transit(db, exID, userID, version, func(m *machine) error {
+		if m.ex.Status != ExampleReturned {
+			return errors.New("差し戻された申請ではないため、再申請できません")
+		}
+		if m.ex.UserID != userID {
+			return errors.New("申請者以外は再申請できません")
+		}
+
+		start, ok := m.graph.Start()
+		if !ok {
+			return fmt.Errorf("ワークフロー[%s]にノードが設定されていません", m.ex.WorkflowID)
+		}
+
+		m.ex.Status = ExampleRunning
+		m.ex.ActiveNodes = nil
+		m.ex.Revision++
+		if facts != nil {
+			m.ex.Facts = facts
+		}
+
+		m.result.Event = EventResubmitted
+		if err := m.enter(start.NodeID); err != nil {
+			return err
+		}
+		return m.settle()
+	})
+}
+
+// Reassign 当前节点的审批者转交给其他用户;escalate为true时是超过处理期限后转交给上级组织的审批者
+func Reassign(db, exID, fromUser, toUser, writer, comment string, escalate bool, version int64) (*Transition, error) {
+	return transit(db, exID, writer, version, func(m *machine) error {
+		own, rest, err := m.ownProcess(fromUser)
+		if err != nil {
+			return err
+		}
+		if len(toUser) == 0 || toUser == fromUser {
+			return errors.New("転送先の承認者を指定してください")
+		}
+		if toUser == m.ex.UserID {
+			return errors.New("申請者に承認を転送することはできません")
+		}
+		for _, p := range rest {
+			if p.UserID == toUser {
+				return fmt.Errorf("ユーザー[%s]は既にこの申請の承認者です", toUser)
+			}
+		}
+
+		if err := m.setProcess(own, ProcessReassigned, comment, writer); err != nil {
+			return err
+		}
+		// 转交后的进程沿用原来的过期日和进入节点的时间,转交时已经通知所以不再提醒
+		p := m.newProcess(own.CurrentNode, toUser, ProcessPending, "")
+		p.ExpireDate = own.ExpireDate
+		p.EnteredAt = own.EnteredAt
+		p.RemindedAt = m.now
+		p.Escalated = own.Escalated || escalate
+		if err := m.insertProcess(p); err != nil {
+			return err
+		}
+		m.result.ProcessID = own.ProcessID
+
+		// 审批者计划中替换为转交后的用户
+		var users []string
+		for _, u := range m.ex.Approvers[own.CurrentNode] {
+			if u == fromUser {
+				u = toUser
+			}
+			users = append(users, u)
+		}
+		if m.ex.Approvers == nil {
+			m.ex.Approvers = make(map[string][]string)
+		}
+		m.ex.Approvers[own.CurrentNode] = users
+
+		m.result.Notify = []string{toUser}
+		m.result.Event = EventReassigned
+		return nil
+	})
+}
+
+// transit 在事务内执行状态迁移;
+// version大于0的场合与实例的版本不一致时返回冲突,等于0的场合冲突时重新读取实例再执行
+func transit(db, exID, writer string, version int64, fn func(m *machine) error) (*Transition, error) {
+	for i := 0; ; i++ {
+		t, err := transitOnce(db, exID, writer, version, fn)
+		if err == ErrConflict && version == 0 && i < maxRetry {
+			utils.DebugLog("transit", fmt.Sprintf("example [ %s ] conflict, retry %d", exID, i+1))
+			continue
+		}
+		return t, err
+	}
+}
+
+func transitOnce(db, exID, writer string, version int64, fn func(m *machine) error) (*Transition, error) {
+	client := database.New()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	session, err := client.StartSession()
+	if err != nil {
+		utils.ErrorLog("transit", err.Error())
+		return nil, err
+	}
+	defer session.EndSession(ctx)
+
+	if err = session.StartTransaction(); err != nil {
+		utils.ErrorLog("transit", err.Error())
+		return nil, err
+	}
+
+	m := &machine{
+		db:     db,
+		writer: writer,
+		now:    time.Now(),
+	}
+
+	if err = mongo.WithSession(ctx, session, func(sc mongo.SessionContext) error {
+		m.sc = sc
+
+		if err := m.load(exID); err != nil {
+			return err
+		}
+		if version > 0 && m.ex.Version != version {
+			return ErrConflict
+		}
+		if err := fn(m); err != nil {
+			return err
+		}
+		if err := m.save(); err != nil {
+			return err
+		}
+
+		return session.CommitTransaction(sc)
+	}); err != nil {
+		session.AbortTransaction(ctx)
+		if isConflict(err) {
+			err = ErrConflict
+		}
+		utils.ErrorLog("transit", err.Error())
+		return nil, err
+	}
+
+	m.result.Example = m.ex
+	return &m.result, nil
+}
+
+// isConflict 判断是否为版本冲突或事务的写入冲突
+func isConflict(err error) bool {
+	if err == ErrConflict {
+		return true
+	}
+	var le interface{ HasErrorLabel(string) bool }
+	if errors.As(err, &le) {
+		return le.HasErrorLabel("TransientTransactionError")
+	}
+	return false
+}
+
+// load 读取实例和流程
+func (m *machine) load(exID string) error {
+	c := database.New().Database(database.GetDBName(m.db)).Collection(ExampleCollection)
+
+	if err := c.FindOne(m.sc, bson.M{"ex_id": exID}).Decode(&m.ex); err != nil {
+		return err
+	}
+	m.version = m.ex.Version
+	// 没有并行分支时的旧数据
+	if len(m.ex.ActiveNodes) == 0 && len(m.ex.CurrentNode) > 0 && m.ex.Status == ExampleRunning {
+		m.ex.ActiveNodes = []string{m.ex.CurrentNode}
+	}
+
+	wf, err := FindWorkflow(m.db, m.ex.WorkflowID)
+	if err != nil {
+		return err
+	}
+	m.wf = wf
+
+	nodes, err := FindNodes(m.db, m.ex.WorkflowID)
+	if err != nil {
+		return err
+	}
+	m.graph = NewGraph(nodes)
+
+	return nil
+}
+
+// save 按读取时的版本更新实例,版本不一致的场合返回冲突
+func (m *machine) save() error {
+	c := database.New().Database(database.GetDBName(m.db)).Collection(ExampleCollection)
+
+	query := bson.M{
+		"_id":     m.ex.ID,
+		"version": m.version,
+	}
+	// 旧数据没有版本字段
+	if m.version == 0 {
+		query["version"] = bson.M{"$in": bson.A{0, nil}}
+	}
+
+	update := bson.M{
+		"$set": bson.M{
+			"status":       m.ex.Status,
+			"current_node": m.ex.CurrentNode,
+			"active_nodes": m.ex.ActiveNodes,
+			"approvers":    m.ex.Approvers,
+			"facts":        m.ex.Facts,
+			"revision":     m.ex.Revision,
+			"undo":         m.undo,
+			"updated_at":   m.now,
+			"updated_by":   m.writer,
+		},
+		"$inc": bson.M{
+			"version": 1,
+		},
+	}
+
+	updateJSON, _ := json.Marshal(update)
+	utils.DebugLog("transit", fmt.Sprintf("update: [ %s ]", updateJSON))
+
+	res, err := c.UpdateOne(m.sc, query, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return ErrConflict
+	}
+
+	m.ex.Version = m.version + 1
+	m.ex.UpdatedAt = m.now
+	m.ex.UpdatedBy = m.writer
+	return nil
+}
+
+// pending 获取审批中节点的待审批进程
+func (m *machine) pending() ([]Process, error) {
+	if len(m.ex.ActiveNodes) == 0 {
+		return nil, nil
+	}
+
+	c := database.New().Database(database.GetDBName(m.db)).Collection(ProcessCollection)
+
+	query := bson.M{
+		"ex_id":        m.ex.ExampleID,
+		"current_node": bson.M{"$in": m.ex.ActiveNodes},
+		"status":       ProcessPending,
+	}
+
+	cur, err := c.Find(m.sc, query)
+	if err != nil {
+		return nil, err
+	}
+	var result []Process
+	if err := cur.All(m.sc, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ownProcess 获取用户的待审批进程和同一节点的其他待审批进程
+func (m *machine) ownProcess(userID string) (own Process, rest []Process, err error) {
+	if m.ex.Status != ExampleRunning {
+		return own, nil, ErrNotRunning
+	}
+
+	pending, err := m.pending()
+	if err != nil {
+		return own, nil, err
+	}
+
+	found := false
+	for _, p := range pending {
+		if p.UserID == userID {
+			own = p
+			found = true
+			break
+		}
+	}
+	if !found {
+		return own, nil, ErrNotApprover
+	}
+
+	for _, p := range pending {
+		if p.CurrentNode == own.CurrentNode && p.ProcessID != own.ProcessID {
+			rest = append(rest, p)
+		}
+	}
+	return own, rest, nil
+}
+
+// setProcess 更新进程状态
+func (m *machine) setProcess(p Process, status int64, comment, writer string) error {
+	c := database.New().Database(database.GetDBName(m.db)).Collection(ProcessCollection)
+
+	update := bson.M{
+		"$set": bson.M{
+			"status":     status,
+			"comment":    comment,
+			"updated_at": m.now,
+			"updated_by": writer,
+		},
+	}
+
+	if _, err := c.UpdateOne(m.sc, bson.M{"_id": p.ID}, update); err != nil {
+		return err
+	}
+	if p.Status == ProcessPending && status != ProcessPending {
+		m.done = append(m.done, p.ID)
+	}
+	return nil
+}
+
+// addProcess 在节点添加进程,过期日按节点的处理期限计算
+func (m *machine) addProcess(nodeID, userID string, status int64, comment string) error {
+	return m.insertProcess(m.newProcess(nodeID, userID, status, comment))
+}
+
+// newProcess 生成节点的进程(进入节点的时间为当前时间)
+func (m *machine) newProcess(nodeID, userID string, status int64, comment string) Process {
+	n, _ := m.graph.Node(nodeID)
+
+	p := Process{
+		ID:          primitive.NewObjectID(),
+		ExampleID:   m.ex.ExampleID,
+		CurrentNode: nodeID,
+		UserID:      userID,
+		ExpireDate:  n.SLA.expireDate(m.now),
+		Comment:     comment,
+		Status:      status,
+		CreatedAt:   m.now,
+		CreatedBy:   m.writer,
+		UpdatedAt:   m.now,
+		UpdatedBy:   m.writer,
+		EnteredAt:   m.now,
+		Revision:    m.ex.Revision,
+	}
+	p.ProcessID = p.ID.Hex()
+	return p
+}
+
+// insertProcess 添加进程
+func (m *machine) insertProcess(p Process) error {
+	c := database.New().Database(database.GetDBName(m.db)).Collection(ProcessCollection)
+
+	if _, err := c.InsertOne(m.sc, p); err != nil {
+		return err
+	}
+	m.inserted = append(m.inserted, p.ID)
+	return nil
+}
+
+// reject 却下实例,审批中的其他进程一并却下
+func (m *machine) reject() error {
+	pending, err := m.pending()
+	if err != nil {
+		return err
+	}
+	for _, p := range pending {
+		if err := m.setProcess(p, ProcessRejected, "Rejected by other approvers", "SYSTEM"); err != nil {
+			return err
+		}
+	}
+
+	m.ex.Status = ExampleRejected
+	m.result.Event = EventRejected
+	return nil
+}
+
+// enter 进入节点;
+// 分支节点直接进入条件成立的各个路由,合流节点在settle中等待其他分支,
+// 审批节点生成审批者的进程,没有审批者的场合按流程的设定由系统承认(离开节点)或却下
+func (m *machine) enter(nodeID string) error {
+	if m.ex.Status != ExampleRunning || nodeID == endNode {
+		return nil
+	}
+
+	n, ok := m.graph.Node(nodeID)
+	if !ok {
+		return fmt.Errorf("ノード[%s]が存在しません", nodeID)
+	}
+
+	if n.NodeType == NodeFork {
+		next, err := m.graph.Route(n, m.ex.Facts)
+		if err != nil {
+			return err
+		}
+		for _, id := range next {
+			if err := m.enter(id); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	m.ex.ActiveNodes = append(m.ex.ActiveNodes, nodeID)
+	if n.NodeType == NodeJoin {
+		return nil
+	}
+
+	if users := m.ex.Approvers[nodeID]; len(users) > 0 {
+		assigned := make(map[string]bool, len(users))
+		for _, u := range users {
+			assigned[u] = true
+		}
+		for _, u := range users {
+			// 代理期间的审批者由代理者审批;代理者是申请者或同一节点的审批者时不代理
+			delegate, err := m.delegateOf(u)
+			if err != nil {
+				return err
+			}
+			p := m.newProcess(nodeID, u, ProcessPending, "")
+			if len(delegate) > 0 && delegate != m.ex.UserID && !assigned[delegate] {
+				assigned[delegate] = true
+				p.UserID = delegate
+				p.OnBehalfOf = u
+			}
+			if err := m.insertProcess(p); err != nil {
+				return err
+			}
+			m.result.Notify = append(m.result.Notify, p.UserID)
+		}
+		return nil
+	}
+
+	if !m.wf.AcceptOrDismiss {
+		if err := m.addProcess(nodeID, SystemUser, ProcessRejected, "この組織には承認者がいないため、システムは却下プロセスを実行しました。"); err != nil {
+			return err
+		}
+		return m.reject()
+	}
+
+	if err := m.addProcess(nodeID, SystemUser, ProcessApproved, "この組織には承認者がいないため、システムは承認プロセスを実行しました。"); err != nil {
+		return err
+	}
+	return m.leave(n)
+}
+
+// leave 节点完成,移除该节点并进入条件成立的下级节点
+func (m *machine) leave(n Node) error {
+	for i, id := range m.ex.ActiveNodes {
+		if id == n.NodeID {
+			m.ex.ActiveNodes = append(m.ex.ActiveNodes[:i:i], m.ex.ActiveNodes[i+1:]...)
+			break
+		}
+	}
+
+	next, err := m.graph.Route(n, m.ex.Facts)
+	if err != nil {
+		return err
+	}
+	for _, id := range next {
+		if err := m.enter(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// settle 合流节点没有其他分支能够到达的场合合流并离开;
+// 没有审批中节点的场合流程结束(承认)
+func (m *machine) settle() error {
+	for m.ex.Status == ExampleRunning {
+		joined := false
+		for _, id := range m.ex.ActiveNodes {
+			n, _ := m.graph.Node(id)
+			if n.NodeType != NodeJoin || m.awaited(id) {
+				continue
+			}
+
+			// 到达该合流节点的分支合为一个
+			var active []string
+			for _, a := range m.ex.ActiveNodes {
+				if a != id {
+					active = append(active, a)
+				}
+			}
+			m.ex.ActiveNodes = append(active, id)
+			if err := m.leave(n); err != nil {
+				return err
+			}
+			joined = true
+			break
+		}
+		if !joined {
+			break
+		}
+	}
+
+	switch {
+	case m.ex.Status != ExampleRunning:
+		m.ex.ActiveNodes = nil
+	case len(m.ex.ActiveNodes) == 0:
+		m.ex.Status = ExampleApproved
+		m.result.Event = EventApproved
+	}
+
+	m.ex.CurrentNode = ""
+	if len(m.ex.ActiveNodes) > 0 {
+		m.ex.CurrentNode = m.ex.ActiveNodes[0]
+	}
+	return nil
+}
+
+// awaited 判断是否还有其他分支能够到达合流节点
+func (m *machine) awaited(joinID string) bool {
+	for _, id := range m.ex.ActiveNodes {
+		if id != joinID && m.graph.Reachable(id, joinID) {
+			return true
+		}
+	}
+	return false
+}
@@ -23,31 +23,49 @@ const (
 type (
 	// Example 流程实例
 	Example struct {
-		ID          primitive.ObjectID `json:"id" bson:"_id"`
-		ExampleID   string             `json:"ex_id" bson:"ex_id"`
-		WorkflowID  string             `json:"wf_id" bson:"wf_id"`
-		ExampleName string             `json:"ex_name" bson:"ex_name"`
-		UserID      string             `json:"user_id" bson:"user_id"`
-		Status      int64              `json:"status" bson:"status"`
-		CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
-		CreatedBy   string             `json:"created_by" bson:"created_by"`
-		UpdatedAt   time.Time          `json:"updated_at" bson:"updated_at"`
-		UpdatedBy   string             `json:"updated_by" bson:"updated_by"`
+		ID          primitive.ObjectID  `json:"id" bson:"_id"`
+		ExampleID   string              `json:"ex_id" bson:"ex_id"`
+		WorkflowID  string              `json:"wf_id" bson:"wf_id"`
+		ExampleName string              `json:"ex_name" bson:"ex_name"`
+		UserID      string              `json:"user_id" bson:"user_id"`
+		Status      int64               `json:"status" bson:"status"`
+		CurrentNode string              `json:"current_node" bson:"current_node"`
+		ActiveNodes []string            `json:"active_nodes" bson:"active_nodes"`
+		Approvers   map[string][]string `json:"approvers" bson:"approvers"`
+		Facts       map[string]string   `json:"facts" bson:"facts"`
+		Version     int64               `json:"version" bson:"version"`
+		Revision    int64               `json:"revision" bson:"revision"`
+		Undo        *Undo               `json:"undo" bson:"undo"`
+		CreatedAt   time.Time           `json:"created_at" bson:"created_at"`
+		CreatedBy   string              `json:"created_by" bson:"created_by"`
+		UpdatedAt   time.Time           `json:"updated_at" bson:"updated_at"`
+		UpdatedBy   string              `json:"updated_by" bson:"updated_by"`
+	}
+
+	// Undo 最终承认前的状态(台账数据更新失败时撤销最终承认用)
+	Undo struct {
+		ActiveNodes []string             `json:"active_nodes" bson:"active_nodes"` // 审批中的节点
+		Processes   []primitive.ObjectID `json:"processes" bson:"processes"`       // 从待审批变为完成的进程
+		Inserted    []primitive.ObjectID `json:"inserted" bson:"inserted"`         // 系统代为处理而添加的进程
 	}
 )
 
 // ToProto 转换为proto数据
 func (w *Example) ToProto() *example.Example {
 	return &example.Example{
-		ExId:      w.ExampleID,
-		WfId:      w.WorkflowID,
-		ExName:    w.ExampleName,
-		UserId:    w.UserID,
-		Status:    w.Status,
-		CreatedAt: w.CreatedAt.String(),
-		CreatedBy: w.CreatedBy,
-		UpdatedAt: w.UpdatedAt.String(),
-		UpdatedBy: w.UpdatedBy,
+		ExId:        w.ExampleID,
+		WfId:        w.WorkflowID,
+		ExName:      w.ExampleName,
+		UserId:      w.UserID,
+		Status:      w.Status,
+		CurrentNode: w.CurrentNode,
+		ActiveNodes: w.ActiveNodes,
+		Version:     w.Version,
+		Revision:    w.Revision,
+		CreatedAt:   w.CreatedAt.String(),
+		CreatedBy:   w.CreatedBy,
+		UpdatedAt:   w.UpdatedAt.String(),
+		UpdatedBy:   w.UpdatedBy,
 	}
 }
 
@@ -150,8 +168,12 @@ func ModifyExample(db, exID, status, writer string) (err error) {
 		"updated_by": writer,
 	}
 
+	// 更新版本,使并行的状态迁移检测到冲突
 	update := bson.M{
 		"$set": change,
+		"$inc": bson.M{
+			"version": 1,
+		},
 	}
 
 	queryJSON, _ := json.Marshal(query)
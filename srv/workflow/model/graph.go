@@ -0,0 +1,197 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// 节点类型
+const (
+	NodeFork = "fork" // 并行分支节点(进入全部条件成立的路由)
+	NodeJoin = "join" // 合流节点(等待全部到达的分支)
+)
+
+// Graph 流程图
+type Graph struct {
+	Nodes []Node
+	index map[string]int
+}
+
+// NewGraph 生成流程图(节点按节点ID排序)
+func NewGraph(nodes []Node) *Graph {
+	g := &Graph{
+		Nodes: nodes,
+		index: make(map[string]int, len(nodes)),
+	}
+	for i, n := range nodes {
+		g.index[n.NodeID] = i
+	}
+	return g
+}
+
+// Node 获取节点
+func (g *Graph) Node(nodeID string) (Node, bool) {
+	i, ok := g.index[nodeID]
+	if !ok {
+		return Node{}, false
+	}
+	return g.Nodes[i], true
+}
+
+// Next 节点的全部下级节点(不判断条件),包括全部路由不成立时的下级节点
+func (g *Graph) Next(n Node) []string {
+	var next []string
+	for _, r := range n.Routes {
+		next = append(next, r.NextNode)
+	}
+	return append(next, n.NextNode)
+}
+
+// Start 开始节点(没有被其他节点连接的第一个节点)
+func (g *Graph) Start() (Node, bool) {
+	if len(g.Nodes) == 0 {
+		return Node{}, false
+	}
+
+	targeted := make(map[string]bool)
+	for _, n := range g.Nodes {
+		for _, id := range g.Next(n) {
+			targeted[id] = true
+		}
+	}
+	for _, n := range g.Nodes {
+		if !targeted[n.NodeID] {
+			return n, true
+		}
+	}
+	return g.Nodes[0], true
+}
+
+// Reachable 判断from节点是否能到达to节点
+func (g *Graph) Reachable(from, to string) bool {
+	seen := make(map[string]bool)
+	stack := []string{from}
+	for len(stack) > 0 {
+		id := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if id == to {
+			return true
+		}
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		if n, ok := g.Node(id); ok {
+			stack = append(stack, g.Next(n)...)
+		}
+	}
+	return false
+}
+
+// Route 节点完成后进入的下级节点;
+// 审批节点和合流节点选择第一个条件成立的路由,都不成立时为下级节点;分支节点进入全部条件成立的路由
+func (g *Graph) Route(n Node, facts map[string]string) ([]string, error) {
+	var next []string
+	for _, r := range n.Routes {
+		ok, err := evalGuard(r.Guard, facts)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		if n.NodeType != NodeFork {
+			return []string{r.NextNode}, nil
+		}
+		next = append(next, r.NextNode)
+	}
+	if len(next) == 0 {
+		// 没有下级节点时进入任何节点都不会生成进程,流程会被误判为承认
+		if len(n.NextNode) == 0 {
+			return nil, fmt.Errorf("ノード[%s]の条件に一致する分岐先がなく、既定の次ノードも設定されていません", n.NodeID)
+		}
+		next = []string{n.NextNode}
+	}
+	return next, nil
+}
+
+// ValidateGraph 检查流程图(节点和连接的定义、条件式、循环、无法到达的节点)
+func ValidateGraph(nodes []Node) error {
+	if len(nodes) == 0 {
+		return errors.New("ワークフローにノードが設定されていません")
+	}
+
+	g := NewGraph(nodes)
+	if len(g.index) != len(nodes) {
+		return errors.New("ノードIDが重複しています")
+	}
+
+	for _, n := range nodes {
+		if len(n.NodeID) == 0 || n.NodeID == endNode {
+			return fmt.Errorf("ノードID[%s]は使用できません", n.NodeID)
+		}
+		if n.NodeType == NodeFork && len(n.Routes) == 0 {
+			return fmt.Errorf("分岐ノード[%s]に分岐先が設定されていません", n.NodeID)
+		}
+		if n.NodeType == NodeFork && len(n.NextNode) == 0 {
+			return fmt.Errorf("分岐ノード[%s]にどの条件にも一致しない場合の次ノードが設定されていません", n.NodeID)
+		}
+		for _, r := range n.Routes {
+			if _, err := ParseGuard(r.Guard); err != nil {
+				return fmt.Errorf("ノード[%s]の条件式が不正です: %v", n.NodeID, err)
+			}
+		}
+		for _, id := range g.Next(n) {
+			if id == endNode {
+				continue
+			}
+			if _, ok := g.Node(id); !ok {
+				return fmt.Errorf("ノード[%s]の次ノード[%s]が存在しません", n.NodeID, id)
+			}
+		}
+	}
+
+	// 循环检查(深度优先,处理中的节点再次出现的场合为循环)
+	const (
+		visiting = 1
+		done     = 2
+	)
+	state := make(map[string]int)
+	var visit func(id string) error
+	visit = func(id string) error {
+		if id == endNode {
+			return nil
+		}
+		switch state[id] {
+		case visiting:
+			return fmt.Errorf("ノード[%s]を含む循環があります", id)
+		case done:
+			return nil
+		}
+		state[id] = visiting
+		n, _ := g.Node(id)
+		for _, next := range g.Next(n) {
+			if err := visit(next); err != nil {
+				return err
+			}
+		}
+		state[id] = done
+		return nil
+	}
+
+	start, _ := g.Start()
+	if err := visit(start.NodeID); err != nil {
+		return err
+	}
+	for _, n := range nodes {
+		// 开始节点无法到达的节点
+		if state[n.NodeID] != done {
+			if err := visit(n.NodeID); err != nil {
+				return err
+			}
+			return fmt.Errorf("ノード[%s]は開始ノード[%s]から到達できません", n.NodeID, start.NodeID)
+		}
+	}
+
+	return nil
+}
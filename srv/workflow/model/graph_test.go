@@ -0,0 +1,142 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+// testNodes 申请金额超过5000万时经由CFO;法务和经理并行审批后合流
+func testNodes() []Node {
+	return []Node{
+		{NodeID: "1", NextNode: "2", Routes: []Route{{Guard: "payment_total > 50000000", NextNode: "cfo"}}},
+		{NodeID: "cfo", NextNode: "2"},
+		{NodeID: "2", NodeType: NodeFork, NextNode: "manager", Routes: []Route{
+			{Guard: "bunruicd == A01", NextNode: "legal"},
+			{Guard: "leasekikan > 0", NextNode: "manager"},
+		}},
+		{NodeID: "legal", NextNode: "3"},
+		{NodeID: "manager", NextNode: "3"},
+		{NodeID: "3", NodeType: NodeJoin, NextNode: endNode},
+	}
+}
+
+func TestValidateGraph(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(nodes []Node) []Node
+		wantErr bool
+	}{
+		{name: "valid", modify: func(nodes []Node) []Node { return nodes }},
+		{name: "no nodes", modify: func(nodes []Node) []Node { return nil }, wantErr: true},
+		{name: "duplicate node id", modify: func(nodes []Node) []Node {
+			return append(nodes, Node{NodeID: "1", NextNode: endNode})
+		}, wantErr: true},
+		{name: "reserved node id", modify: func(nodes []Node) []Node {
+			return append(nodes, Node{NodeID: endNode, NextNode: endNode})
+		}, wantErr: true},
+		{name: "fork without routes", modify: func(nodes []Node) []Node {
+			nodes[2].Routes = nil
+			return nodes
+		}, wantErr: true},
+		{name: "fork without default", modify: func(nodes []Node) []Node {
+			nodes[2].NextNode = ""
+			return nodes
+		}, wantErr: true},
+		{name: "invalid guard", modify: func(nodes []Node) []Node {
+			nodes[0].Routes[0].Guard = "payment_total >"
+			return nodes
+		}, wantErr: true},
+		{name: "unknown next node", modify: func(nodes []Node) []Node {
+			nodes[1].NextNode = "x"
+			return nodes
+		}, wantErr: true},
+		{name: "missing next node", modify: func(nodes []Node) []Node {
+			nodes[1].NextNode = ""
+			return nodes
+		}, wantErr: true},
+		{name: "cycle", modify: func(nodes []Node) []Node {
+			nodes[4].NextNode = "2"
+			return nodes
+		}, wantErr: true},
+		{name: "unreachable node", modify: func(nodes []Node) []Node {
+			return append(nodes, Node{NodeID: "4", NextNode: "3"})
+		}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateGraph(tt.modify(testNodes()))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateGraph() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGraphNext(t *testing.T) {
+	g := NewGraph(testNodes())
+
+	tests := []struct {
+		nodeID string
+		want   []string
+	}{
+		{nodeID: "1", want: []string{"cfo", "2"}},
+		{nodeID: "2", want: []string{"legal", "manager", "manager"}},
+		{nodeID: "3", want: []string{endNode}},
+	}
+
+	for _, tt := range tests {
+		n, _ := g.Node(tt.nodeID)
+		if got := g.Next(n); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Next(%s) = %v, want %v", tt.nodeID, got, tt.want)
+		}
+	}
+
+	if start, ok := g.Start(); !ok || start.NodeID != "1" {
+		t.Errorf("Start() = %v, %v, want 1", start.NodeID, ok)
+	}
+	if !g.Reachable("legal", "3") || g.Reachable("legal", "manager") {
+		t.Errorf("Reachable() from legal is wrong")
+	}
+}
+
+func TestGraphRoute(t *testing.T) {
+	noDefault := testNodes()
+	noDefault[2].NextNode = ""
+
+	tests := []struct {
+		name    string
+		nodes   []Node
+		nodeID  string
+		facts   map[string]string
+		want    []string
+		wantErr bool
+	}{
+		{name: "guard matched", nodes: testNodes(), nodeID: "1", facts: map[string]string{"payment_total": "60000000"}, want: []string{"cfo"}},
+		{name: "guard not matched", nodes: testNodes(), nodeID: "1", facts: map[string]string{"payment_total": "100"}, want: []string{"2"}},
+		// 字段取得失败的场合不进入金额条件的路由
+		{name: "missing fact", nodes: testNodes(), nodeID: "1", facts: nil, want: []string{"2"}},
+		{name: "no routes", nodes: testNodes(), nodeID: "cfo", want: []string{"2"}},
+		{name: "fork all matched", nodes: testNodes(), nodeID: "2", facts: map[string]string{"bunruicd": "A01", "leasekikan": "60"}, want: []string{"legal", "manager"}},
+		{name: "fork one matched", nodes: testNodes(), nodeID: "2", facts: map[string]string{"bunruicd": "B01", "leasekikan": "60"}, want: []string{"manager"}},
+		{name: "fork default", nodes: testNodes(), nodeID: "2", facts: map[string]string{"bunruicd": "B01"}, want: []string{"manager"}},
+		// 没有下级节点时不能进入任何节点,不能因此判定为承认
+		{name: "fork without default", nodes: noDefault, nodeID: "2", facts: map[string]string{"bunruicd": "B01"}, wantErr: true},
+		{name: "join", nodes: testNodes(), nodeID: "3", want: []string{endNode}},
+		{name: "invalid guard", nodes: []Node{{NodeID: "1", NextNode: endNode, Routes: []Route{{Guard: "(a > 1", NextNode: "2"}}}}, nodeID: "1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGraph(tt.nodes)
+			n, _ := g.Node(tt.nodeID)
+			got, err := g.Route(n, tt.facts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Route(%s) error = %v, wantErr %v", tt.nodeID, err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Route(%s) = %v, want %v", tt.nodeID, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package model
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// 条件表达式的语法:
+//   expr  = and { "||" and }
+//   and   = term { "&&" term }
+//   term  = "(" expr ")" | field op value
+//   op    = "==" | "!=" | ">" | ">=" | "<" | "<="
+// 值是数值的场合按数值比较,否则按字符串比较;
+// 大小比较(> >= < <=)的字段不存在或值不是数值的场合不成立。
+// 例: payment_total > 50000000 || leasekikan > 120
+
+type (
+	// Guard 节点路由的条件
+	Guard interface {
+		Eval(facts map[string]string) bool
+	}
+
+	orGuard  []Guard
+	andGuard []Guard
+
+	cmpGuard struct {
+		field string
+		op    string
+		value string
+	}
+)
+
+// Eval 任一条件成立
+func (g orGuard) Eval(facts map[string]string) bool {
+	for _, c := range g {
+		if c.Eval(facts) {
+			return true
+		}
+	}
+	return false
+}
+
+// Eval 全部条件成立
+func (g andGuard) Eval(facts map[string]string) bool {
+	for _, c := range g {
+		if !c.Eval(facts) {
+			return false
+		}
+	}
+	return true
+}
+
+// Eval 比较字段的值
+func (g cmpGuard) Eval(facts map[string]string) bool {
+	left, exist := facts[g.field]
+
+	cmp := strings.Compare(left, g.value)
+	numeric := false
+	if l, err := parseNumber(left); err == nil {
+		if r, err := parseNumber(g.value); err == nil {
+			numeric = true
+			switch {
+			case l < r:
+				cmp = -1
+			case l > r:
+				cmp = 1
+			default:
+				cmp = 0
+			}
+		}
+	}
+
+	// 大小比较只在双方都是数值时成立(字段不存在时不能按空字符串比较)
+	switch g.op {
+	case ">", ">=", "<", "<=":
+		if !exist || !numeric {
+			return false
+		}
+	}
+
+	switch g.op {
+	case "==":
+		return cmp == 0
+	case "!=":
+		return cmp != 0
+	case ">":
+		return cmp > 0
+	case ">=":
+		return cmp >= 0
+	case "<":
+		return cmp < 0
+	case "<=":
+		return cmp <= 0
+	}
+	return false
+}
+
+// parseNumber 解析数值(允许千位分隔符)
+func parseNumber(s string) (float64, error) {
+	return strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(s), ",", ""), 64)
+}
+
+// ParseGuard 解析条件表达式,空的表达式返回nil(总是成立)
+func ParseGuard(expr string) (Guard, error) {
+	if len(strings.TrimSpace(expr)) == 0 {
+		return nil, nil
+	}
+
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &guardParser{tokens: tokens}
+	g, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("条件式[%s]が不正です: [%s]の位置", expr, p.tokens[p.pos].text)
+	}
+	return g, nil
+}
+
+// evalGuard 判断条件是否成立,空的条件总是成立
+func evalGuard(expr string, facts map[string]string) (bool, error) {
+	g, err := ParseGuard(expr)
+	if err != nil {
+		return false, err
+	}
+	if g == nil {
+		return true, nil
+	}
+	return g.Eval(facts), nil
+}
+
+// guardToken 字段名和值为literal,运算符和括号不是
+type guardToken struct {
+	text    string
+	literal bool
+}
+
+// tokenize 分割条件表达式
+func tokenize(expr string) ([]guardToken, error) {
+	var tokens []guardToken
+	rs := []rune(expr)
+
+	for i := 0; i < len(rs); {
+		r := rs[i]
+		switch {
+		case r == ' ' || r == '\t':
+			i++
+		case r == '(' || r == ')':
+			tokens = append(tokens, guardToken{text: string(r)})
+			i++
+		case r == '\'' || r == '"':
+			end := i + 1
+			for end < len(rs) && rs[end] != r {
+				end++
+			}
+			if end >= len(rs) {
+				return nil, fmt.Errorf("条件式[%s]の引用符が閉じられていません", expr)
+			}
+			tokens = append(tokens, guardToken{text: string(rs[i+1 : end]), literal: true})
+			i = end + 1
+		case strings.ContainsRune("&|=!<>", r):
+			end := i + 1
+			if end < len(rs) && strings.ContainsRune("&|=", rs[end]) {
+				end++
+			}
+			op := string(rs[i:end])
+			switch op {
+			case "&&", "||", "==", "!=", ">", ">=", "<", "<=":
+			default:
+				return nil, fmt.Errorf("条件式[%s]の演算子[%s]が不正です", expr, op)
+			}
+			tokens = append(tokens, guardToken{text: op})
+			i = end
+		default:
+			end := i
+			for end < len(rs) && !strings.ContainsRune(" \t()'\"&|=!<>", rs[end]) {
+				end++
+			}
+			tokens = append(tokens, guardToken{text: string(rs[i:end]), literal: true})
+			i = end
+		}
+	}
+
+	return tokens, nil
+}
+
+type guardParser struct {
+	tokens []guardToken
+	pos    int
+}
+
+// peek 下一个运算符或括号
+func (p *guardParser) peek() string {
+	if p.pos < len(p.tokens) && !p.tokens[p.pos].literal {
+		return p.tokens[p.pos].text
+	}
+	return ""
+}
+
+func (p *guardParser) or() (Guard, error) {
+	var g orGuard
+	for {
+		c, err := p.and()
+		if err != nil {
+			return nil, err
+		}
+		g = append(g, c)
+		if p.peek() != "||" {
+			break
+		}
+		p.pos++
+	}
+	if len(g) == 1 {
+		return g[0], nil
+	}
+	return g, nil
+}
+
+func (p *guardParser) and() (Guard, error) {
+	var g andGuard
+	for {
+		c, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		g = append(g, c)
+		if p.peek() != "&&" {
+			break
+		}
+		p.pos++
+	}
+	if len(g) == 1 {
+		return g[0], nil
+	}
+	return g, nil
+}
+
+func (p *guardParser) term() (Guard, error) {
+	if p.peek() == "(" {
+		p.pos++
+		g, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ")" {
+			return nil, fmt.Errorf("条件式の括弧が閉じられていません")
+		}
+		p.pos++
+		return g, nil
+	}
+
+	if p.pos+3 > len(p.tokens) {
+		return nil, fmt.Errorf("条件式が不完全です")
+	}
+	field, op, value := p.tokens[p.pos], p.tokens[p.pos+1], p.tokens[p.pos+2]
+	if !field.literal || op.literal || !value.literal {
+		return nil, fmt.Errorf("条件式は「項目 演算子 値」の形式で指定してください")
+	}
+	switch op.text {
+	case "==", "!=", ">", ">=", "<", "<=":
+	default:
+		return nil, fmt.Errorf("条件式の比較演算子[%s]が不正です", op.text)
+	}
+	p.pos += 3
+
+	return cmpGuard{field: field.text, op: op.text, value: value.text}, nil
+}
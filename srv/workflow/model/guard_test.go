@@ -0,0 +1,84 @@
+package model
+
+import (
+	"testing"
+)
+
+func TestParseGuard(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		wantNil bool
+		wantErr bool
+	}{
+		{name: "empty", expr: "  ", wantNil: true},
+		{name: "compare", expr: "payment_total > 50000000"},
+		{name: "and or", expr: "payment_total > 50000000 || leasekikan > 120 && bunruicd == 'A01'"},
+		{name: "parentheses", expr: "(payment_total >= 100 || leasekikan < 12) && currency != \"JPY\""},
+		{name: "no spaces", expr: "leasekikan<=60"},
+		{name: "missing value", expr: "payment_total >", wantErr: true},
+		{name: "missing operator", expr: "payment_total 100", wantErr: true},
+		{name: "unknown operator", expr: "payment_total => 100", wantErr: true},
+		{name: "single equal", expr: "payment_total = 100", wantErr: true},
+		{name: "unclosed parenthesis", expr: "(payment_total > 100", wantErr: true},
+		{name: "unclosed quote", expr: "bunruicd == 'A01", wantErr: true},
+		{name: "trailing token", expr: "payment_total > 100 leasekikan", wantErr: true},
+		{name: "dangling and", expr: "payment_total > 100 &&", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, err := ParseGuard(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseGuard(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+			if !tt.wantErr && (g == nil) != tt.wantNil {
+				t.Errorf("ParseGuard(%q) = %v, wantNil %v", tt.expr, g, tt.wantNil)
+			}
+		})
+	}
+}
+
+func TestEvalGuard(t *testing.T) {
+	facts := map[string]string{
+		"payment_total": "60,000,000",
+		"leasekikan":    "60",
+		"bunruicd":      "A01",
+		"memo":          "abc",
+	}
+
+	tests := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		{name: "empty always true", expr: "", want: true},
+		{name: "numeric with separator", expr: "payment_total > 50000000", want: true},
+		{name: "numeric not string order", expr: "leasekikan < 120", want: true},
+		{name: "numeric equal", expr: "leasekikan == 60.0", want: true},
+		{name: "string equal", expr: "bunruicd == 'A01'", want: true},
+		{name: "string not equal", expr: "bunruicd != A01", want: false},
+		{name: "and", expr: "payment_total > 50000000 && leasekikan > 120", want: false},
+		{name: "or", expr: "payment_total > 50000000 || leasekikan > 120", want: true},
+		{name: "parentheses", expr: "(bunruicd == B01 || leasekikan >= 60) && memo == abc", want: true},
+		// 字段不存在或不是数值的场合,大小比较不成立
+		{name: "missing field greater", expr: "amount > 0", want: false},
+		{name: "missing field less", expr: "amount < 100", want: false},
+		{name: "missing field less or equal", expr: "amount <= 0", want: false},
+		{name: "non numeric field", expr: "memo > 0", want: false},
+		{name: "non numeric value", expr: "leasekikan >= abc", want: false},
+		{name: "missing field equal", expr: "amount == 0", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := evalGuard(tt.expr, facts)
+			if err != nil {
+				t.Fatalf("evalGuard(%q) error = %v", tt.expr, err)
+			}
+			if got != tt.want {
+				t.Errorf("evalGuard(%q) = %v, want %v", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
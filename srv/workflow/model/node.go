@@ -32,28 +32,61 @@ type (
 		Assignees   []string           `json:"assignees" bson:"assignees"`
 		ActType     string             `json:"act_type" bson:"act_type"`
 		NodeGroupId string             `json:"node_group_id" bson:"node_group_id"`
+		Routes      []Route            `json:"routes" bson:"routes"`
+		SLA         SLA                `json:"sla" bson:"sla"`
 		CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
 		CreatedBy   string             `json:"created_by" bson:"created_by"`
 		UpdatedAt   time.Time          `json:"updated_at" bson:"updated_at"`
 		UpdatedBy   string             `json:"updated_by" bson:"updated_by"`
 	}
+
+	// Route 节点的条件路由(条件成立时进入下一节点)
+	Route struct {
+		Guard    string `json:"guard" bson:"guard"`
+		NextNode string `json:"next_node" bson:"next_node"`
+	}
+
+	// SLA 审批节点的处理期限(进入节点后的小时数,0表示不处理)
+	SLA struct {
+		RemindHours   int64  `json:"remind_hours" bson:"remind_hours"`
+		EscalateHours int64  `json:"escalate_hours" bson:"escalate_hours"`
+		ExpireHours   int64  `json:"expire_hours" bson:"expire_hours"`
+		ExpireAction  string `json:"expire_action" bson:"expire_action"`
+	}
 )
 
 // ToProto 转换为proto数据
 func (n *Node) ToProto() *node.Node {
 	return &node.Node{
-		NodeId:      n.NodeID,
-		NodeName:    n.NodeName,
-		WfId:        n.WorkflowID,
-		NodeType:    n.NodeType,
-		PrevNode:    n.PrevNode,
-		NextNode:    n.NextNode,
-		Assignees:   n.Assignees,
-		ActType:     n.ActType,
-		NodeGroupId: n.NodeGroupId,
-		CreatedAt:   n.CreatedAt.String(),
-		CreatedBy:   n.CreatedBy,
+		NodeId:        n.NodeID,
+		NodeName:      n.NodeName,
+		WfId:          n.WorkflowID,
+		NodeType:      n.NodeType,
+		PrevNode:      n.PrevNode,
+		NextNode:      n.NextNode,
+		Assignees:     n.Assignees,
+		ActType:       n.ActType,
+		NodeGroupId:   n.NodeGroupId,
+		Routes:        routesProto(n.Routes),
+		RemindHours:   n.SLA.RemindHours,
+		EscalateHours: n.SLA.EscalateHours,
+		ExpireHours:   n.SLA.ExpireHours,
+		ExpireAction:  n.SLA.ExpireAction,
+		CreatedAt:     n.CreatedAt.String(),
+		CreatedBy:     n.CreatedBy,
+	}
+}
+
+// routesProto 条件路由转换为proto数据
+func routesProto(routes []Route) []*node.Route {
+	var result []*node.Route
+	for _, r := range routes {
+		result = append(result, &node.Route{
+			Guard:    r.Guard,
+			NextNode: r.NextNode,
+		})
 	}
+	return result
 }
 
 // FindNodes 获取流程节点数据
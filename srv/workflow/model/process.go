@@ -36,6 +36,11 @@ type (
 		CreatedBy   string             `json:"created_by" bson:"created_by"`
 		UpdatedAt   time.Time          `json:"updated_at" bson:"updated_at"`
 		UpdatedBy   string             `json:"updated_by" bson:"updated_by"`
+		EnteredAt   time.Time          `json:"entered_at" bson:"entered_at"`
+		RemindedAt  time.Time          `json:"reminded_at" bson:"reminded_at"`
+		Escalated   bool               `json:"escalated" bson:"escalated"`
+		OnBehalfOf  string             `json:"on_behalf_of" bson:"on_behalf_of"`
+		Revision    int64              `json:"revision" bson:"revision"`
 	}
 )
 
@@ -53,6 +58,11 @@ func (p *Process) ToProto() *process.Process {
 		CreatedBy:   p.CreatedBy,
 		UpdatedAt:   p.UpdatedAt.String(),
 		UpdatedBy:   p.UpdatedBy,
+		EnteredAt:   p.EnteredAt.String(),
+		RemindedAt:  p.RemindedAt.String(),
+		Escalated:   p.Escalated,
+		OnBehalfOf:  p.OnBehalfOf,
+		Revision:    p.Revision,
 	}
 }
 
@@ -0,0 +1,208 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"rxcsoft.cn/pit3/srv/workflow/utils"
+	database "rxcsoft.cn/utils/mongo"
+)
+
+// 过期时的处理
+const (
+	ExpireApprove = "approve" // 自动承认
+	ExpireReject  = "reject"  // 自动却下
+)
+
+// 超过处理期限时需要执行的处理
+const (
+	OverdueRemind   = "remind"   // 提醒审批者
+	OverdueEscalate = "escalate" // 转交给上级组织的审批者
+)
+
+type (
+	// Overdue 超过处理期限的进程
+	Overdue struct {
+		ProcessID  string
+		ExampleID  string
+		WorkflowID string
+		NodeID     string
+		UserID     string
+		Applicant  string
+		Action     string
+		Version    int64
+	}
+)
+
+// Validate 检查处理期限的设定
+func (s SLA) Validate() error {
+	if s.RemindHours < 0 || s.EscalateHours < 0 || s.ExpireHours < 0 {
+		return errors.New("承認期限の時間数は0以上で指定してください")
+	}
+	switch s.ExpireAction {
+	case "", ExpireApprove, ExpireReject:
+	default:
+		return errors.New("期限切れ時の処理はapproveまたはrejectで指定してください")
+	}
+	if len(s.ExpireAction) > 0 && s.ExpireHours == 0 {
+		return errors.New("期限切れ時の処理を指定する場合は期限の時間数を指定してください")
+	}
+	return nil
+}
+
+// expireDate 节点的过期日(未设定过期小时数的场合为默认的过期天数)
+func (s SLA) expireDate(now time.Time) string {
+	if s.ExpireHours > 0 {
+		return now.Add(time.Duration(s.ExpireHours) * time.Hour).Format(DateFormat)
+	}
+	return now.AddDate(0, 0, expireDays).Format(DateFormat)
+}
+
+// action 按进入节点后经过的时间判断需要执行的处理(过期 > 转交 > 提醒),不需要处理时返回空
+func (s SLA) action(p Process, now time.Time) string {
+	since := p.EnteredAt
+	if since.IsZero() {
+		since = p.CreatedAt
+	}
+	elapsed := now.Sub(since)
+	over := func(hours int64) bool {
+		return hours > 0 && elapsed >= time.Duration(hours)*time.Hour
+	}
+
+	if len(s.ExpireAction) > 0 && over(s.ExpireHours) {
+		return s.ExpireAction
+	}
+	if !p.Escalated && over(s.EscalateHours) {
+		return OverdueEscalate
+	}
+	if p.RemindedAt.IsZero() && over(s.RemindHours) {
+		return OverdueRemind
+	}
+	return ""
+}
+
+// FindOverdue 获取超过处理期限的待审批进程
+func FindOverdue(db string, now time.Time) (items []Overdue, err error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(ProcessCollection)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	query := bson.M{
+		"status":  ProcessPending,
+		"user_id": bson.M{"$ne": SystemUser},
+	}
+
+	cur, err := c.Find(ctx, query)
+	if err != nil {
+		utils.ErrorLog("error FindOverdue", err.Error())
+		return nil, err
+	}
+	var processes []Process
+	if err := cur.All(ctx, &processes); err != nil {
+		utils.ErrorLog("error FindOverdue", err.Error())
+		return nil, err
+	}
+
+	// 实例和流程节点按ID缓存
+	examples := make(map[string]Example)
+	graphs := make(map[string]*Graph)
+
+	var result []Overdue
+	for _, p := range processes {
+		ex, ok := examples[p.ExampleID]
+		if !ok {
+			ex, err = FindExample(db, p.ExampleID)
+			if err != nil {
+				utils.ErrorLog("error FindOverdue", err.Error())
+				continue
+			}
+			examples[p.ExampleID] = ex
+		}
+		if ex.Status != ExampleRunning {
+			continue
+		}
+
+		g, ok := graphs[ex.WorkflowID]
+		if !ok {
+			nodes, err := FindNodes(db, ex.WorkflowID)
+			if err != nil {
+				utils.ErrorLog("error FindOverdue", err.Error())
+				continue
+			}
+			g = NewGraph(nodes)
+			graphs[ex.WorkflowID] = g
+		}
+
+		n, ok := g.Node(p.CurrentNode)
+		if !ok {
+			continue
+		}
+		action := n.SLA.action(p, now)
+		if len(action) == 0 {
+			continue
+		}
+
+		result = append(result, Overdue{
+			ProcessID:  p.ProcessID,
+			ExampleID:  p.ExampleID,
+			WorkflowID: ex.WorkflowID,
+			NodeID:     p.CurrentNode,
+			UserID:     p.UserID,
+			Applicant:  ex.UserID,
+			Action:     action,
+			Version:    ex.Version,
+		})
+	}
+
+	return result, nil
+}
+
+// MarkReminded 记录已经提醒审批者
+func MarkReminded(db string, proIDs []string, now time.Time) (err error) {
+	return markProcesses(db, proIDs, bson.M{"reminded_at": now}, "MarkReminded")
+}
+
+// MarkEscalated 记录已经转交(没有可以转交的上级审批者时也记录,不再重复转交)
+func MarkEscalated(db string, proIDs []string) (err error) {
+	return markProcesses(db, proIDs, bson.M{"escalated": true}, "MarkEscalated")
+}
+
+// markProcesses 更新进程的期限处理记录
+func markProcesses(db string, proIDs []string, change bson.M, action string) (err error) {
+	client := database.New()
+	c := client.Database(database.GetDBName(db)).Collection(ProcessCollection)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	var ids []primitive.ObjectID
+	for _, id := range proIDs {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			utils.ErrorLog("error "+action, err.Error())
+			return err
+		}
+		ids = append(ids, objectID)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query := bson.M{
+		"_id": bson.M{"$in": ids},
+	}
+	update := bson.M{
+		"$set": change,
+	}
+
+	_, err = c.UpdateMany(ctx, query, update)
+	if err != nil {
+		utils.ErrorLog("error "+action, err.Error())
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+// Code generated by protoc-gen-micro. DO NOT EDIT.
+// source: delegation.proto
+
+package delegation
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+import (
+	context "context"
+	api "github.com/micro/go-micro/v2/api"
+	client "github.com/micro/go-micro/v2/client"
+	server "github.com/micro/go-micro/v2/server"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ api.Endpoint
+var _ context.Context
+var _ client.Option
+var _ server.Option
+
+// Api Endpoints for DelegationService service
+
+func NewDelegationServiceEndpoints() []*api.Endpoint {
+	return []*api.Endpoint{}
+}
+
+// Client API for DelegationService service
+
+type DelegationService interface {
+	FindDelegations(ctx context.Context, in *DelegationsRequest, opts ...client.CallOption) (*DelegationsResponse, error)
+	AddDelegation(ctx context.Context, in *AddRequest, opts ...client.CallOption) (*AddResponse, error)
+	DeleteDelegations(ctx context.Context, in *DeleteRequest, opts ...client.CallOption) (*DeleteResponse, error)
+}
+
+type delegationService struct {
+	c    client.Client
+	name string
+}
+
+func NewDelegationService(name string, c client.Client) DelegationService {
+	return &delegationService{
+		c:    c,
+		name: name,
+	}
+}
+
+func (c *delegationService) FindDelegations(ctx context.Context, in *DelegationsRequest, opts ...client.CallOption) (*DelegationsResponse, error) {
+	req := c.c.NewRequest(c.name, "DelegationService.FindDelegations", in)
+	out := new(DelegationsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *delegationService) AddDelegation(ctx context.Context, in *AddRequest, opts ...client.CallOption) (*AddResponse, error) {
+	req := c.c.NewRequest(c.name, "DelegationService.AddDelegation", in)
+	out := new(AddResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *delegationService) DeleteDelegations(ctx context.Context, in *DeleteRequest, opts ...client.CallOption) (*DeleteResponse, error) {
+	req := c.c.NewRequest(c.name, "DelegationService.DeleteDelegations", in)
+	out := new(DeleteResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for DelegationService service
+
+type DelegationServiceHandler interface {
+	FindDelegations(context.Context, *DelegationsRequest, *DelegationsResponse) error
+	AddDelegation(context.Context, *AddRequest, *AddResponse) error
+	DeleteDelegations(context.Context, *DeleteRequest, *DeleteResponse) error
+}
+
+func RegisterDelegationServiceHandler(s server.Server, hdlr DelegationServiceHandler, opts ...server.HandlerOption) error {
+	type delegationService interface {
+		FindDelegations(ctx context.Context, in *DelegationsRequest, out *DelegationsResponse) error
+		AddDelegation(ctx context.Context, in *AddRequest, out *AddResponse) error
+		DeleteDelegations(ctx context.Context, in *DeleteRequest, out *DeleteResponse) error
+	}
+	type DelegationService struct {
+		delegationService
+	}
+	h := &delegationServiceHandler{hdlr}
+	return s.Handle(s.NewHandler(&DelegationService{h}, opts...))
+}
+
+type delegationServiceHandler struct {
+	DelegationServiceHandler
+}
+
+func (h *delegationServiceHandler) FindDelegations(ctx context.Context, in *DelegationsRequest, out *DelegationsResponse) error {
+	return h.DelegationServiceHandler.FindDelegations(ctx, in, out)
+}
+
+func (h *delegationServiceHandler) AddDelegation(ctx context.Context, in *AddRequest, out *AddResponse) error {
+	return h.DelegationServiceHandler.AddDelegation(ctx, in, out)
+}
+
+func (h *delegationServiceHandler) DeleteDelegations(ctx context.Context, in *DeleteRequest, out *DeleteResponse) error {
+	return h.DelegationServiceHandler.DeleteDelegations(ctx, in, out)
+}
@@ -0,0 +1,503 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: delegation.proto
+
+package delegation
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// 审批代理（期间内新生成的进程由代理者审批）
+type Delegation struct {
+	DelegationId         string   `protobuf:"bytes,1,opt,name=delegation_id,json=delegationId,proto3" json:"delegation_id"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Delegate             string   `protobuf:"bytes,3,opt,name=delegate,proto3" json:"delegate"`
+	WfId                 string   `protobuf:"bytes,4,opt,name=wf_id,json=wfId,proto3" json:"wf_id"`
+	StartDate            string   `protobuf:"bytes,5,opt,name=start_date,json=startDate,proto3" json:"start_date"`
+	EndDate              string   `protobuf:"bytes,6,opt,name=end_date,json=endDate,proto3" json:"end_date"`
+	Reason               string   `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason"`
+	CreatedAt            string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	CreatedBy            string   `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{0}
+}
+
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Delegation.Unmarshal(m, b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+}
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return xxx_messageInfo_Delegation.Size(m)
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetDelegationId() string {
+	if m != nil {
+		return m.DelegationId
+	}
+	return ""
+}
+
+func (m *Delegation) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Delegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *Delegation) GetWfId() string {
+	if m != nil {
+		return m.WfId
+	}
+	return ""
+}
+
+func (m *Delegation) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *Delegation) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *Delegation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Delegation) GetCreatedAt() string {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return ""
+}
+
+func (m *Delegation) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+// 查找多条记录
+type DelegationsRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Delegate             string   `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate"`
+	WfId                 string   `protobuf:"bytes,3,opt,name=wf_id,json=wfId,proto3" json:"wf_id"`
+	ActiveOn             string   `protobuf:"bytes,4,opt,name=active_on,json=activeOn,proto3" json:"active_on"`
+	Database             string   `protobuf:"bytes,5,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelegationsRequest) Reset()         { *m = DelegationsRequest{} }
+func (m *DelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*DelegationsRequest) ProtoMessage()    {}
+func (*DelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{1}
+}
+
+func (m *DelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegationsRequest.Unmarshal(m, b)
+}
+func (m *DelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegationsRequest.Marshal(b, m, deterministic)
+}
+func (m *DelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationsRequest.Merge(m, src)
+}
+func (m *DelegationsRequest) XXX_Size() int {
+	return xxx_messageInfo_DelegationsRequest.Size(m)
+}
+func (m *DelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationsRequest proto.InternalMessageInfo
+
+func (m *DelegationsRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *DelegationsRequest) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *DelegationsRequest) GetWfId() string {
+	if m != nil {
+		return m.WfId
+	}
+	return ""
+}
+
+func (m *DelegationsRequest) GetActiveOn() string {
+	if m != nil {
+		return m.ActiveOn
+	}
+	return ""
+}
+
+func (m *DelegationsRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+type DelegationsResponse struct {
+	Delegations          []*Delegation `protobuf:"bytes,1,rep,name=delegations,proto3" json:"delegations"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *DelegationsResponse) Reset()         { *m = DelegationsResponse{} }
+func (m *DelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegationsResponse) ProtoMessage()    {}
+func (*DelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{2}
+}
+
+func (m *DelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelegationsResponse.Unmarshal(m, b)
+}
+func (m *DelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelegationsResponse.Marshal(b, m, deterministic)
+}
+func (m *DelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationsResponse.Merge(m, src)
+}
+func (m *DelegationsResponse) XXX_Size() int {
+	return xxx_messageInfo_DelegationsResponse.Size(m)
+}
+func (m *DelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationsResponse proto.InternalMessageInfo
+
+func (m *DelegationsResponse) GetDelegations() []*Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+// 添加数据
+type AddRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Delegate             string   `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate"`
+	WfId                 string   `protobuf:"bytes,3,opt,name=wf_id,json=wfId,proto3" json:"wf_id"`
+	StartDate            string   `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date"`
+	EndDate              string   `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date"`
+	Reason               string   `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason"`
+	Database             string   `protobuf:"bytes,7,opt,name=database,proto3" json:"database"`
+	Writer               string   `protobuf:"bytes,8,opt,name=writer,proto3" json:"writer"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddRequest) Reset()         { *m = AddRequest{} }
+func (m *AddRequest) String() string { return proto.CompactTextString(m) }
+func (*AddRequest) ProtoMessage()    {}
+func (*AddRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{3}
+}
+
+func (m *AddRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddRequest.Unmarshal(m, b)
+}
+func (m *AddRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddRequest.Marshal(b, m, deterministic)
+}
+func (m *AddRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddRequest.Merge(m, src)
+}
+func (m *AddRequest) XXX_Size() int {
+	return xxx_messageInfo_AddRequest.Size(m)
+}
+func (m *AddRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddRequest proto.InternalMessageInfo
+
+func (m *AddRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *AddRequest) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *AddRequest) GetWfId() string {
+	if m != nil {
+		return m.WfId
+	}
+	return ""
+}
+
+func (m *AddRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *AddRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *AddRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *AddRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *AddRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+type AddResponse struct {
+	DelegationId         string   `protobuf:"bytes,1,opt,name=delegation_id,json=delegationId,proto3" json:"delegation_id"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddResponse) Reset()         { *m = AddResponse{} }
+func (m *AddResponse) String() string { return proto.CompactTextString(m) }
+func (*AddResponse) ProtoMessage()    {}
+func (*AddResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{4}
+}
+
+func (m *AddResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddResponse.Unmarshal(m, b)
+}
+func (m *AddResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddResponse.Marshal(b, m, deterministic)
+}
+func (m *AddResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddResponse.Merge(m, src)
+}
+func (m *AddResponse) XXX_Size() int {
+	return xxx_messageInfo_AddResponse.Size(m)
+}
+func (m *AddResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddResponse proto.InternalMessageInfo
+
+func (m *AddResponse) GetDelegationId() string {
+	if m != nil {
+		return m.DelegationId
+	}
+	return ""
+}
+
+// 删除数据记录
+type DeleteRequest struct {
+	DelegationIds        []string `protobuf:"bytes,1,rep,name=delegation_ids,json=delegationIds,proto3" json:"delegation_ids"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Database             string   `protobuf:"bytes,3,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{5}
+}
+
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+}
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
+}
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
+}
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+
+func (m *DeleteRequest) GetDelegationIds() []string {
+	if m != nil {
+		return m.DelegationIds
+	}
+	return nil
+}
+
+func (m *DeleteRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *DeleteRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+type DeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
+func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteResponse) ProtoMessage()    {}
+func (*DeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b823c7d67e95582e, []int{6}
+}
+
+func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
+}
+func (m *DeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteResponse.Merge(m, src)
+}
+func (m *DeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteResponse.Size(m)
+}
+func (m *DeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Delegation)(nil), "delegation.Delegation")
+	proto.RegisterType((*DelegationsRequest)(nil), "delegation.DelegationsRequest")
+	proto.RegisterType((*DelegationsResponse)(nil), "delegation.DelegationsResponse")
+	proto.RegisterType((*AddRequest)(nil), "delegation.AddRequest")
+	proto.RegisterType((*AddResponse)(nil), "delegation.AddResponse")
+	proto.RegisterType((*DeleteRequest)(nil), "delegation.DeleteRequest")
+	proto.RegisterType((*DeleteResponse)(nil), "delegation.DeleteResponse")
+}
+
+func init() { proto.RegisterFile("delegation.proto", fileDescriptor_b823c7d67e95582e) }
+
+var fileDescriptor_b823c7d67e95582e = []byte{
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xcd, 0x6e, 0xd4, 0x30,
+	0x10, 0xc7, 0xc9, 0x7e, 0xe4, 0x63, 0x96, 0x94, 0xe2, 0x4a, 0x6d, 0x1a, 0x04, 0x54, 0x41, 0x48,
+	0x3d, 0xf5, 0xb0, 0x5c, 0xb8, 0x6e, 0x55, 0x21, 0xad, 0x84, 0x54, 0x29, 0x70, 0x8f, 0xbc, 0xeb,
+	0x29, 0x8a, 0x40, 0x4e, 0xb1, 0xdd, 0xae, 0xfa, 0x0c, 0x3c, 0x00, 0x2f, 0xc7, 0x9b, 0x70, 0x41,
+	0xb6, 0x93, 0xd8, 0x29, 0x4d, 0xc5, 0x81, 0xe3, 0xcc, 0x2f, 0x33, 0x9e, 0xf9, 0xff, 0x67, 0x17,
+	0xf6, 0x19, 0x7e, 0xc3, 0x2f, 0x54, 0xd5, 0x0d, 0x3f, 0xbb, 0x16, 0x8d, 0x6a, 0x08, 0xb8, 0x4c,
+	0xf1, 0x63, 0x02, 0x70, 0xd1, 0x87, 0xe4, 0x0d, 0xa4, 0x0e, 0x56, 0x35, 0xcb, 0x82, 0x93, 0xe0,
+	0x34, 0x29, 0x9f, 0xba, 0xe4, 0x9a, 0x91, 0x23, 0x88, 0x6e, 0x24, 0x0a, 0x8d, 0x27, 0x06, 0x87,
+	0x3a, 0x5c, 0x33, 0x92, 0x43, 0xdc, 0x7e, 0x88, 0xd9, 0xd4, 0x90, 0x3e, 0x26, 0x07, 0x30, 0xdf,
+	0x5d, 0xe9, 0x92, 0x99, 0x01, 0xb3, 0xdd, 0xd5, 0x9a, 0x91, 0x97, 0x00, 0x52, 0x51, 0xa1, 0x2a,
+	0xa6, 0x4b, 0xe6, 0x86, 0x24, 0x26, 0x73, 0xa1, 0x6b, 0x8e, 0x21, 0x46, 0xce, 0x2c, 0x0c, 0x0d,
+	0x8c, 0x90, 0x33, 0x83, 0x0e, 0x21, 0x14, 0x48, 0x65, 0xc3, 0xb3, 0xc8, 0x8e, 0x60, 0x23, 0xdd,
+	0x71, 0x2b, 0x90, 0x2a, 0x64, 0x15, 0x55, 0x59, 0x6c, 0x3b, 0xb6, 0x99, 0x95, 0xf2, 0xf1, 0xe6,
+	0x2e, 0x4b, 0x06, 0xf8, 0xfc, 0xae, 0xf8, 0x19, 0x00, 0x71, 0x6a, 0xc8, 0x12, 0xbf, 0xdf, 0xa0,
+	0x54, 0xfe, 0xc2, 0xc1, 0xe8, 0xc2, 0x93, 0xb1, 0x85, 0xa7, 0xde, 0xc2, 0x2f, 0x20, 0xa1, 0x5b,
+	0x55, 0xdf, 0x62, 0xd5, 0xf0, 0x56, 0x89, 0xd8, 0x26, 0x2e, 0xb9, 0xe9, 0x46, 0x15, 0xdd, 0x50,
+	0xd9, 0x69, 0xd1, 0xc7, 0xc5, 0x25, 0x1c, 0x0c, 0x06, 0x93, 0xd7, 0x0d, 0x97, 0x48, 0xde, 0xc3,
+	0xc2, 0x59, 0x23, 0xb3, 0xe0, 0x64, 0x7a, 0xba, 0x58, 0x1e, 0x9e, 0x79, 0x96, 0xbb, 0xaa, 0xd2,
+	0xff, 0xb4, 0xf8, 0x15, 0x00, 0xac, 0x18, 0xfb, 0xff, 0x2b, 0x0e, 0x3d, 0x9d, 0x3d, 0xe6, 0xe9,
+	0x7c, 0xcc, 0xd3, 0x70, 0xe0, 0xa9, 0xaf, 0x4b, 0x34, 0xd4, 0x45, 0xd7, 0xec, 0x44, 0xad, 0x50,
+	0xb4, 0x5e, 0xb7, 0x51, 0xb1, 0x84, 0x85, 0xd9, 0xae, 0xd5, 0xe9, 0x5f, 0xee, 0xba, 0xf8, 0x0a,
+	0xa9, 0x56, 0x4b, 0x61, 0x27, 0xca, 0x5b, 0xd8, 0x1b, 0x54, 0x59, 0x81, 0x93, 0x32, 0xf5, 0xcb,
+	0xe4, 0xe3, 0xbf, 0x87, 0x6e, 0xf0, 0xe9, 0x3d, 0x43, 0xf7, 0x61, 0xaf, 0x7b, 0xcc, 0xce, 0xb8,
+	0xfc, 0x1d, 0xc0, 0x73, 0xe7, 0xd6, 0x27, 0x14, 0xb7, 0xf5, 0x16, 0xc9, 0x67, 0x78, 0xf6, 0xa1,
+	0xe6, 0xcc, 0x01, 0x49, 0x5e, 0x3d, 0xec, 0x6f, 0x77, 0xae, 0xf9, 0xeb, 0x51, 0x6e, 0x5f, 0x2a,
+	0x9e, 0x90, 0x73, 0x48, 0x57, 0xcc, 0x6b, 0x4a, 0x06, 0x37, 0xe3, 0xee, 0x22, 0x3f, 0xfa, 0x2b,
+	0xdf, 0xf7, 0xf8, 0x68, 0xc7, 0x55, 0xe8, 0xcf, 0x76, 0x7c, 0xff, 0xed, 0x5e, 0xcd, 0x3c, 0x7f,
+	0x08, 0x75, 0xdd, 0x36, 0xa1, 0xf9, 0x6f, 0x7a, 0xf7, 0x67, 0x00, 0x50, 0x55, 0xcf, 0xae, 0xaf,
+	0x04, 0x00, 0x00,
+}
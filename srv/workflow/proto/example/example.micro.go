@@ -47,6 +47,15 @@ type ExampleService interface {
 	AddExample(ctx context.Context, in *AddRequest, opts ...client.CallOption) (*AddResponse, error)
 	ModifyExample(ctx context.Context, in *ModifyRequest, opts ...client.CallOption) (*ModifyResponse, error)
 	DeleteExample(ctx context.Context, in *DeleteRequest, opts ...client.CallOption) (*DeleteResponse, error)
+	// 状态迁移(事务内执行,按实例的版本进行乐观锁)
+	Submit(ctx context.Context, in *SubmitRequest, opts ...client.CallOption) (*TransitionResponse, error)
+	Approve(ctx context.Context, in *ApproveRequest, opts ...client.CallOption) (*TransitionResponse, error)
+	Reject(ctx context.Context, in *RejectRequest, opts ...client.CallOption) (*TransitionResponse, error)
+	Withdraw(ctx context.Context, in *WithdrawRequest, opts ...client.CallOption) (*TransitionResponse, error)
+	Reassign(ctx context.Context, in *ReassignRequest, opts ...client.CallOption) (*TransitionResponse, error)
+	Return(ctx context.Context, in *ReturnRequest, opts ...client.CallOption) (*TransitionResponse, error)
+	Resubmit(ctx context.Context, in *ResubmitRequest, opts ...client.CallOption) (*TransitionResponse, error)
+	Revert(ctx context.Context, in *RevertRequest, opts ...client.CallOption) (*TransitionResponse, error)
 }
 
 type exampleService struct {
@@ -111,6 +120,86 @@ func (c *exampleService) DeleteExample(ctx context.Context, in *DeleteRequest, o
 	return out, nil
 }
 
+func (c *exampleService) Submit(ctx context.Context, in *SubmitRequest, opts ...client.CallOption) (*TransitionResponse, error) {
+	req := c.c.NewRequest(c.name, "ExampleService.Submit", in)
+	out := new(TransitionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleService) Approve(ctx context.Context, in *ApproveRequest, opts ...client.CallOption) (*TransitionResponse, error) {
+	req := c.c.NewRequest(c.name, "ExampleService.Approve", in)
+	out := new(TransitionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleService) Reject(ctx context.Context, in *RejectRequest, opts ...client.CallOption) (*TransitionResponse, error) {
+	req := c.c.NewRequest(c.name, "ExampleService.Reject", in)
+	out := new(TransitionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleService) Withdraw(ctx context.Context, in *WithdrawRequest, opts ...client.CallOption) (*TransitionResponse, error) {
+	req := c.c.NewRequest(c.name, "ExampleService.Withdraw", in)
+	out := new(TransitionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleService) Reassign(ctx context.Context, in *ReassignRequest, opts ...client.CallOption) (*TransitionResponse, error) {
+	req := c.c.NewRequest(c.name, "ExampleService.Reassign", in)
+	out := new(TransitionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleService) Return(ctx context.Context, in *ReturnRequest, opts ...client.CallOption) (*TransitionResponse, error) {
+	req := c.c.NewRequest(c.name, "ExampleService.Return", in)
+	out := new(TransitionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleService) Resubmit(ctx context.Context, in *ResubmitRequest, opts ...client.CallOption) (*TransitionResponse, error) {
+	req := c.c.NewRequest(c.name, "ExampleService.Resubmit", in)
+	out := new(TransitionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *exampleService) Revert(ctx context.Context, in *RevertRequest, opts ...client.CallOption) (*TransitionResponse, error) {
+	req := c.c.NewRequest(c.name, "ExampleService.Revert", in)
+	out := new(TransitionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ExampleService service
 
 type ExampleServiceHandler interface {
@@ -119,6 +208,15 @@ type ExampleServiceHandler interface {
 	AddExample(context.Context, *AddRequest, *AddResponse) error
 	ModifyExample(context.Context, *ModifyRequest, *ModifyResponse) error
 	DeleteExample(context.Context, *DeleteRequest, *DeleteResponse) error
+	// 状态迁移(事务内执行,按实例的版本进行乐观锁)
+	Submit(context.Context, *SubmitRequest, *TransitionResponse) error
+	Approve(context.Context, *ApproveRequest, *TransitionResponse) error
+	Reject(context.Context, *RejectRequest, *TransitionResponse) error
+	Withdraw(context.Context, *WithdrawRequest, *TransitionResponse) error
+	Reassign(context.Context, *ReassignRequest, *TransitionResponse) error
+	Return(context.Context, *ReturnRequest, *TransitionResponse) error
+	Resubmit(context.Context, *ResubmitRequest, *TransitionResponse) error
+	Revert(context.Context, *RevertRequest, *TransitionResponse) error
 }
 
 func RegisterExampleServiceHandler(s server.Server, hdlr ExampleServiceHandler, opts ...server.HandlerOption) error {
@@ -128,6 +226,14 @@ func RegisterExampleServiceHandler(s server.Server, hdlr ExampleServiceHandler,
 		AddExample(ctx context.Context, in *AddRequest, out *AddResponse) error
 		ModifyExample(ctx context.Context, in *ModifyRequest, out *ModifyResponse) error
 		DeleteExample(ctx context.Context, in *DeleteRequest, out *DeleteResponse) error
+		Submit(ctx context.Context, in *SubmitRequest, out *TransitionResponse) error
+		Approve(ctx context.Context, in *ApproveRequest, out *TransitionResponse) error
+		Reject(ctx context.Context, in *RejectRequest, out *TransitionResponse) error
+		Withdraw(ctx context.Context, in *WithdrawRequest, out *TransitionResponse) error
+		Reassign(ctx context.Context, in *ReassignRequest, out *TransitionResponse) error
+		Return(ctx context.Context, in *ReturnRequest, out *TransitionResponse) error
+		Resubmit(ctx context.Context, in *ResubmitRequest, out *TransitionResponse) error
+		Revert(ctx context.Context, in *RevertRequest, out *TransitionResponse) error
 	}
 	type ExampleService struct {
 		exampleService
@@ -159,3 +265,35 @@ func (h *exampleServiceHandler) ModifyExample(ctx context.Context, in *ModifyReq
 func (h *exampleServiceHandler) DeleteExample(ctx context.Context, in *DeleteRequest, out *DeleteResponse) error {
 	return h.ExampleServiceHandler.DeleteExample(ctx, in, out)
 }
+
+func (h *exampleServiceHandler) Submit(ctx context.Context, in *SubmitRequest, out *TransitionResponse) error {
+	return h.ExampleServiceHandler.Submit(ctx, in, out)
+}
+
+func (h *exampleServiceHandler) Approve(ctx context.Context, in *ApproveRequest, out *TransitionResponse) error {
+	return h.ExampleServiceHandler.Approve(ctx, in, out)
+}
+
+func (h *exampleServiceHandler) Reject(ctx context.Context, in *RejectRequest, out *TransitionResponse) error {
+	return h.ExampleServiceHandler.Reject(ctx, in, out)
+}
+
+func (h *exampleServiceHandler) Withdraw(ctx context.Context, in *WithdrawRequest, out *TransitionResponse) error {
+	return h.ExampleServiceHandler.Withdraw(ctx, in, out)
+}
+
+func (h *exampleServiceHandler) Reassign(ctx context.Context, in *ReassignRequest, out *TransitionResponse) error {
+	return h.ExampleServiceHandler.Reassign(ctx, in, out)
+}
+
+func (h *exampleServiceHandler) Return(ctx context.Context, in *ReturnRequest, out *TransitionResponse) error {
+	return h.ExampleServiceHandler.Return(ctx, in, out)
+}
+
+func (h *exampleServiceHandler) Resubmit(ctx context.Context, in *ResubmitRequest, out *TransitionResponse) error {
+	return h.ExampleServiceHandler.Resubmit(ctx, in, out)
+}
+
+func (h *exampleServiceHandler) Revert(ctx context.Context, in *RevertRequest, out *TransitionResponse) error {
+	return h.ExampleServiceHandler.Revert(ctx, in, out)
+}
@@ -31,6 +31,10 @@ type Example struct {
 	CreatedBy            string   `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by"`
 	UpdatedAt            string   `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	UpdatedBy            string   `protobuf:"bytes,10,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by"`
+	CurrentNode          string   `protobuf:"bytes,11,opt,name=current_node,json=currentNode,proto3" json:"current_node"`
+	Version              int64    `protobuf:"varint,12,opt,name=version,proto3" json:"version"`
+	ActiveNodes          []string `protobuf:"bytes,13,rep,name=active_nodes,json=activeNodes,proto3" json:"active_nodes"`
+	Revision             int64    `protobuf:"varint,14,opt,name=revision,proto3" json:"revision"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -124,6 +128,34 @@ func (m *Example) GetUpdatedBy() string {
 	return ""
 }
 
+func (m *Example) GetCurrentNode() string {
+	if m != nil {
+		return m.CurrentNode
+	}
+	return ""
+}
+
+func (m *Example) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *Example) GetActiveNodes() []string {
+	if m != nil {
+		return m.ActiveNodes
+	}
+	return nil
+}
+
+func (m *Example) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
 // 查找多条记录
 type ExamplesRequest struct {
 	WfId                 string   `protobuf:"bytes,2,opt,name=wf_id,json=wfId,proto3" json:"wf_id"`
@@ -591,6 +623,742 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
+// 节点的审批者
+type NodeApprovers struct {
+	NodeId               string   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id"`
+	UserIds              []string `protobuf:"bytes,2,rep,name=user_ids,json=userIds,proto3" json:"user_ids"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeApprovers) Reset()         { *m = NodeApprovers{} }
+func (m *NodeApprovers) String() string { return proto.CompactTextString(m) }
+func (*NodeApprovers) ProtoMessage()    {}
+func (*NodeApprovers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a1dc8d40dadaa6, []int{11}
+}
+
+func (m *NodeApprovers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeApprovers.Unmarshal(m, b)
+}
+func (m *NodeApprovers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeApprovers.Marshal(b, m, deterministic)
+}
+func (m *NodeApprovers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeApprovers.Merge(m, src)
+}
+func (m *NodeApprovers) XXX_Size() int {
+	return xxx_messageInfo_NodeApprovers.Size(m)
+}
+func (m *NodeApprovers) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeApprovers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeApprovers proto.InternalMessageInfo
+
+func (m *NodeApprovers) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *NodeApprovers) GetUserIds() []string {
+	if m != nil {
+		return m.UserIds
+	}
+	return nil
+}
+
+// 申请
+type SubmitRequest struct {
+	ExId                 string            `protobuf:"bytes,1,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
+	Approvers            []*NodeApprovers  `protobuf:"bytes,2,rep,name=approvers,proto3" json:"approvers"`
+	Version              int64             `protobuf:"varint,3,opt,name=version,proto3" json:"version"`
+	Database             string            `protobuf:"bytes,4,opt,name=database,proto3" json:"database"`
+	Writer               string            `protobuf:"bytes,5,opt,name=writer,proto3" json:"writer"`
+	Facts                map[string]string `protobuf:"bytes,6,rep,name=facts,proto3" json:"facts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SubmitRequest) Reset()         { *m = SubmitRequest{} }
+func (m *SubmitRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitRequest) ProtoMessage()    {}
+func (*SubmitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a1dc8d40dadaa6, []int{12}
+}
+
+func (m *SubmitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitRequest.Unmarshal(m, b)
+}
+func (m *SubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitRequest.Marshal(b, m, deterministic)
+}
+func (m *SubmitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitRequest.Merge(m, src)
+}
+func (m *SubmitRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitRequest.Size(m)
+}
+func (m *SubmitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitRequest proto.InternalMessageInfo
+
+func (m *SubmitRequest) GetExId() string {
+	if m != nil {
+		return m.ExId
+	}
+	return ""
+}
+
+func (m *SubmitRequest) GetApprovers() []*NodeApprovers {
+	if m != nil {
+		return m.Approvers
+	}
+	return nil
+}
+
+func (m *SubmitRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SubmitRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *SubmitRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+func (m *SubmitRequest) GetFacts() map[string]string {
+	if m != nil {
+		return m.Facts
+	}
+	return nil
+}
+
+// 承认
+type ApproveRequest struct {
+	ExId                 string   `protobuf:"bytes,1,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Comment              string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment"`
+	Version              int64    `protobuf:"varint,4,opt,name=version,proto3" json:"version"`
+	Database             string   `protobuf:"bytes,5,opt,name=database,proto3" json:"database"`
+	Writer               string   `protobuf:"bytes,6,opt,name=writer,proto3" json:"writer"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApproveRequest) Reset()         { *m = ApproveRequest{} }
+func (m *ApproveRequest) String() string { return proto.CompactTextString(m) }
+func (*ApproveRequest) ProtoMessage()    {}
+func (*ApproveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a1dc8d40dadaa6, []int{13}
+}
+
+func (m *ApproveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveRequest.Unmarshal(m, b)
+}
+func (m *ApproveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveRequest.Marshal(b, m, deterministic)
+}
+func (m *ApproveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveRequest.Merge(m, src)
+}
+func (m *ApproveRequest) XXX_Size() int {
+	return xxx_messageInfo_ApproveRequest.Size(m)
+}
+func (m *ApproveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveRequest proto.InternalMessageInfo
+
+func (m *ApproveRequest) GetExId() string {
+	if m != nil {
+		return m.ExId
+	}
+	return ""
+}
+
+func (m *ApproveRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ApproveRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *ApproveRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ApproveRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *ApproveRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+// 却下
+type RejectRequest struct {
+	ExId                 string   `protobuf:"bytes,1,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Comment              string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment"`
+	Version              int64    `protobuf:"varint,4,opt,name=version,proto3" json:"version"`
+	Database             string   `protobuf:"bytes,5,opt,name=database,proto3" json:"database"`
+	Writer               string   `protobuf:"bytes,6,opt,name=writer,proto3" json:"writer"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RejectRequest) Reset()         { *m = RejectRequest{} }
+func (m *RejectRequest) String() string { return proto.CompactTextString(m) }
+func (*RejectRequest) ProtoMessage()    {}
+func (*RejectRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a1dc8d40dadaa6, []int{14}
+}
+
+func (m *RejectRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RejectRequest.Unmarshal(m, b)
+}
+func (m *RejectRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RejectRequest.Marshal(b, m, deterministic)
+}
+func (m *RejectRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RejectRequest.Merge(m, src)
+}
+func (m *RejectRequest) XXX_Size() int {
+	return xxx_messageInfo_RejectRequest.Size(m)
+}
+func (m *RejectRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RejectRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RejectRequest proto.InternalMessageInfo
+
+func (m *RejectRequest) GetExId() string {
+	if m != nil {
+		return m.ExId
+	}
+	return ""
+}
+
+func (m *RejectRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *RejectRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *RejectRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RejectRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *RejectRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+// 申请者取消
+type WithdrawRequest struct {
+	ExId                 string   `protobuf:"bytes,1,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Comment              string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment"`
+	Version              int64    `protobuf:"varint,4,opt,name=version,proto3" json:"version"`
+	Database             string   `protobuf:"bytes,5,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WithdrawRequest) Reset()         { *m = WithdrawRequest{} }
+func (m *WithdrawRequest) String() string { return proto.CompactTextString(m) }
+func (*WithdrawRequest) ProtoMessage()    {}
+func (*WithdrawRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a1dc8d40dadaa6, []int{15}
+}
+
+func (m *WithdrawRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WithdrawRequest.Unmarshal(m, b)
+}
+func (m *WithdrawRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WithdrawRequest.Marshal(b, m, deterministic)
+}
+func (m *WithdrawRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WithdrawRequest.Merge(m, src)
+}
+func (m *WithdrawRequest) XXX_Size() int {
+	return xxx_messageInfo_WithdrawRequest.Size(m)
+}
+func (m *WithdrawRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WithdrawRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WithdrawRequest proto.InternalMessageInfo
+
+func (m *WithdrawRequest) GetExId() string {
+	if m != nil {
+		return m.ExId
+	}
+	return ""
+}
+
+func (m *WithdrawRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *WithdrawRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *WithdrawRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *WithdrawRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+// 审批者转交
+type ReassignRequest struct {
+	ExId                 string   `protobuf:"bytes,1,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
+	FromUser             string   `protobuf:"bytes,2,opt,name=from_user,json=fromUser,proto3" json:"from_user"`
+	ToUser               string   `protobuf:"bytes,3,opt,name=to_user,json=toUser,proto3" json:"to_user"`
+	Comment              string   `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment"`
+	Version              int64    `protobuf:"varint,5,opt,name=version,proto3" json:"version"`
+	Database             string   `protobuf:"bytes,6,opt,name=database,proto3" json:"database"`
+	Writer               string   `protobuf:"bytes,7,opt,name=writer,proto3" json:"writer"`
+	Escalate             bool     `protobuf:"varint,8,opt,name=escalate,proto3" json:"escalate"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReassignRequest) Reset()         { *m = ReassignRequest{} }
+func (m *ReassignRequest) String() string { return proto.CompactTextString(m) }
+func (*ReassignRequest) ProtoMessage()    {}
+func (*ReassignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a1dc8d40dadaa6, []int{16}
+}
+
+func (m *ReassignRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReassignRequest.Unmarshal(m, b)
+}
+func (m *ReassignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReassignRequest.Marshal(b, m, deterministic)
+}
+func (m *ReassignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReassignRequest.Merge(m, src)
+}
+func (m *ReassignRequest) XXX_Size() int {
+	return xxx_messageInfo_ReassignRequest.Size(m)
+}
+func (m *ReassignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReassignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReassignRequest proto.InternalMessageInfo
+
+func (m *ReassignRequest) GetExId() string {
+	if m != nil {
+		return m.ExId
+	}
+	return ""
+}
+
+func (m *ReassignRequest) GetFromUser() string {
+	if m != nil {
+		return m.FromUser
+	}
+	return ""
+}
+
+func (m *ReassignRequest) GetToUser() string {
+	if m != nil {
+		return m.ToUser
+	}
+	return ""
+}
+
+func (m *ReassignRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *ReassignRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ReassignRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *ReassignRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+func (m *ReassignRequest) GetEscalate() bool {
+	if m != nil {
+		return m.Escalate
+	}
+	return false
+}
+
+// 退回修改
+type ReturnRequest struct {
+	ExId                 string   `protobuf:"bytes,1,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Comment              string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment"`
+	Version              int64    `protobuf:"varint,4,opt,name=version,proto3" json:"version"`
+	Database             string   `protobuf:"bytes,5,opt,name=database,proto3" json:"database"`
+	Writer               string   `protobuf:"bytes,6,opt,name=writer,proto3" json:"writer"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReturnRequest) Reset()         { *m = ReturnRequest{} }
+func (m *ReturnRequest) String() string { return proto.CompactTextString(m) }
+func (*ReturnRequest) ProtoMessage()    {}
+func (*ReturnRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a1dc8d40dadaa6, []int{17}
+}
+
+func (m *ReturnRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReturnRequest.Unmarshal(m, b)
+}
+func (m *ReturnRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReturnRequest.Marshal(b, m, deterministic)
+}
+func (m *ReturnRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReturnRequest.Merge(m, src)
+}
+func (m *ReturnRequest) XXX_Size() int {
+	return xxx_messageInfo_ReturnRequest.Size(m)
+}
+func (m *ReturnRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReturnRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReturnRequest proto.InternalMessageInfo
+
+func (m *ReturnRequest) GetExId() string {
+	if m != nil {
+		return m.ExId
+	}
+	return ""
+}
+
+func (m *ReturnRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ReturnRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *ReturnRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ReturnRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+func (m *ReturnRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+// 退回后再申请
+type ResubmitRequest struct {
+	ExId                 string            `protobuf:"bytes,1,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
+	UserId               string            `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Facts                map[string]string `protobuf:"bytes,3,rep,name=facts,proto3" json:"facts" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Version              int64             `protobuf:"varint,4,opt,name=version,proto3" json:"version"`
+	Database             string            `protobuf:"bytes,5,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResubmitRequest) Reset()         { *m = ResubmitRequest{} }
+func (m *ResubmitRequest) String() string { return proto.CompactTextString(m) }
+func (*ResubmitRequest) ProtoMessage()    {}
+func (*ResubmitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a1dc8d40dadaa6, []int{18}
+}
+
+func (m *ResubmitRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResubmitRequest.Unmarshal(m, b)
+}
+func (m *ResubmitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResubmitRequest.Marshal(b, m, deterministic)
+}
+func (m *ResubmitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResubmitRequest.Merge(m, src)
+}
+func (m *ResubmitRequest) XXX_Size() int {
+	return xxx_messageInfo_ResubmitRequest.Size(m)
+}
+func (m *ResubmitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResubmitRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResubmitRequest proto.InternalMessageInfo
+
+func (m *ResubmitRequest) GetExId() string {
+	if m != nil {
+		return m.ExId
+	}
+	return ""
+}
+
+func (m *ResubmitRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *ResubmitRequest) GetFacts() map[string]string {
+	if m != nil {
+		return m.Facts
+	}
+	return nil
+}
+
+func (m *ResubmitRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ResubmitRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+// 最终承认后台账数据更新失败时,撤销最终承认
+type RevertRequest struct {
+	ExId                 string   `protobuf:"bytes,1,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
+	Writer               string   `protobuf:"bytes,2,opt,name=writer,proto3" json:"writer"`
+	Version              int64    `protobuf:"varint,3,opt,name=version,proto3" json:"version"`
+	Database             string   `protobuf:"bytes,4,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevertRequest) Reset()         { *m = RevertRequest{} }
+func (m *RevertRequest) String() string { return proto.CompactTextString(m) }
+func (*RevertRequest) ProtoMessage()    {}
+func (*RevertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a1dc8d40dadaa6, []int{19}
+}
+
+func (m *RevertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevertRequest.Unmarshal(m, b)
+}
+func (m *RevertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevertRequest.Marshal(b, m, deterministic)
+}
+func (m *RevertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertRequest.Merge(m, src)
+}
+func (m *RevertRequest) XXX_Size() int {
+	return xxx_messageInfo_RevertRequest.Size(m)
+}
+func (m *RevertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertRequest proto.InternalMessageInfo
+
+func (m *RevertRequest) GetExId() string {
+	if m != nil {
+		return m.ExId
+	}
+	return ""
+}
+
+func (m *RevertRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+func (m *RevertRequest) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RevertRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+// 状态迁移的结果
+type TransitionResponse struct {
+	Example              *Example `protobuf:"bytes,1,opt,name=example,proto3" json:"example"`
+	Event                string   `protobuf:"bytes,2,opt,name=event,proto3" json:"event"`
+	ProId                string   `protobuf:"bytes,3,opt,name=pro_id,json=proId,proto3" json:"pro_id"`
+	NotifyUsers          []string `protobuf:"bytes,4,rep,name=notify_users,json=notifyUsers,proto3" json:"notify_users"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransitionResponse) Reset()         { *m = TransitionResponse{} }
+func (m *TransitionResponse) String() string { return proto.CompactTextString(m) }
+func (*TransitionResponse) ProtoMessage()    {}
+func (*TransitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15a1dc8d40dadaa6, []int{20}
+}
+
+func (m *TransitionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransitionResponse.Unmarshal(m, b)
+}
+func (m *TransitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransitionResponse.Marshal(b, m, deterministic)
+}
+func (m *TransitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransitionResponse.Merge(m, src)
+}
+func (m *TransitionResponse) XXX_Size() int {
+	return xxx_messageInfo_TransitionResponse.Size(m)
+}
+func (m *TransitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransitionResponse proto.InternalMessageInfo
+
+func (m *TransitionResponse) GetExample() *Example {
+	if m != nil {
+		return m.Example
+	}
+	return nil
+}
+
+func (m *TransitionResponse) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *TransitionResponse) GetProId() string {
+	if m != nil {
+		return m.ProId
+	}
+	return ""
+}
+
+func (m *TransitionResponse) GetNotifyUsers() []string {
+	if m != nil {
+		return m.NotifyUsers
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Example)(nil), "example.Example")
 	proto.RegisterType((*ExamplesRequest)(nil), "example.ExamplesRequest")
@@ -603,41 +1371,86 @@ func init() {
 	proto.RegisterType((*ModifyResponse)(nil), "example.ModifyResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "example.DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "example.DeleteResponse")
+	proto.RegisterType((*NodeApprovers)(nil), "example.NodeApprovers")
+	proto.RegisterType((*SubmitRequest)(nil), "example.SubmitRequest")
+	proto.RegisterMapType((map[string]string)(nil), "example.SubmitRequest.FactsEntry")
+	proto.RegisterType((*ApproveRequest)(nil), "example.ApproveRequest")
+	proto.RegisterType((*RejectRequest)(nil), "example.RejectRequest")
+	proto.RegisterType((*WithdrawRequest)(nil), "example.WithdrawRequest")
+	proto.RegisterType((*ReassignRequest)(nil), "example.ReassignRequest")
+	proto.RegisterType((*ReturnRequest)(nil), "example.ReturnRequest")
+	proto.RegisterType((*ResubmitRequest)(nil), "example.ResubmitRequest")
+	proto.RegisterMapType((map[string]string)(nil), "example.ResubmitRequest.FactsEntry")
+	proto.RegisterType((*RevertRequest)(nil), "example.RevertRequest")
+	proto.RegisterType((*TransitionResponse)(nil), "example.TransitionResponse")
 }
 
 func init() { proto.RegisterFile("example.proto", fileDescriptor_15a1dc8d40dadaa6) }
 
 var fileDescriptor_15a1dc8d40dadaa6 = []byte{
-	// 482 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x8e, 0xd3, 0x30,
-	0x10, 0xde, 0x34, 0xfd, 0x9d, 0xd2, 0x52, 0x19, 0xb4, 0x35, 0x95, 0x90, 0x2a, 0x9f, 0x2a, 0x84,
-	0xf6, 0xb0, 0x9c, 0x38, 0x20, 0x6d, 0x2b, 0x16, 0xa9, 0x07, 0x38, 0x84, 0x07, 0xa8, 0xdc, 0xf5,
-	0x54, 0x8a, 0xb4, 0x6d, 0x43, 0xec, 0xd2, 0xe4, 0x69, 0x78, 0x33, 0xde, 0x83, 0x1b, 0x4a, 0x62,
-	0x3b, 0x71, 0x93, 0x72, 0xe0, 0x96, 0x99, 0x6f, 0xe6, 0xcb, 0x37, 0xf3, 0x8d, 0x0c, 0x23, 0x4c,
-	0xf8, 0x3e, 0x7a, 0xc6, 0xbb, 0x28, 0x3e, 0xaa, 0x23, 0xe9, 0xe9, 0x90, 0xfd, 0xf1, 0xa0, 0xf7,
-	0x58, 0x7c, 0x93, 0x57, 0xd0, 0xc1, 0x64, 0x13, 0x0a, 0xea, 0xcd, 0xbd, 0xc5, 0x20, 0x68, 0x63,
-	0xb2, 0x16, 0x59, 0xf2, 0xbc, 0xcb, 0x92, 0xad, 0x22, 0x79, 0xde, 0xad, 0x05, 0x99, 0x42, 0x0f,
-	0x93, 0xcd, 0x81, 0xef, 0x91, 0xfa, 0x79, 0xba, 0x8b, 0xc9, 0x37, 0xbe, 0xc7, 0x0c, 0x38, 0x49,
-	0x8c, 0xb3, 0xfa, 0x4e, 0x01, 0x64, 0xe1, 0x5a, 0x90, 0x5b, 0xe8, 0x4a, 0xc5, 0xd5, 0x49, 0xd2,
-	0xee, 0xdc, 0x5b, 0xf8, 0x81, 0x8e, 0xc8, 0x5b, 0x80, 0xa7, 0x18, 0xb9, 0x42, 0xb1, 0xe1, 0x8a,
-	0xf6, 0xf2, 0x9e, 0x81, 0xce, 0x2c, 0x55, 0x15, 0xde, 0xa6, 0xb4, 0xef, 0xc0, 0xab, 0x34, 0x83,
-	0x4f, 0x91, 0x30, 0xdd, 0x83, 0x02, 0xd6, 0x99, 0xa2, 0xdb, 0xc0, 0xdb, 0x94, 0x82, 0x03, 0xaf,
-	0x52, 0xb6, 0x82, 0x97, 0x7a, 0x74, 0x19, 0xe0, 0x8f, 0x13, 0x4a, 0xd5, 0x3c, 0xed, 0x0c, 0xfa,
-	0x82, 0x2b, 0xbe, 0xe5, 0xd2, 0x8c, 0x6b, 0x63, 0xf6, 0x00, 0x93, 0x92, 0x43, 0x46, 0xc7, 0x83,
-	0x44, 0xf2, 0x1e, 0xfa, 0x7a, 0xbd, 0x92, 0x7a, 0x73, 0x7f, 0x31, 0xbc, 0x9f, 0xdc, 0x99, 0xf5,
-	0xeb, 0xe2, 0xc0, 0x56, 0xb0, 0x25, 0x8c, 0x4d, 0xb2, 0x14, 0x51, 0xf7, 0xa1, 0x2a, 0xa2, 0x75,
-	0x21, 0xe2, 0x93, 0x1d, 0xc4, 0x6a, 0x78, 0x07, 0xc6, 0xe2, 0x9c, 0xa5, 0x49, 0x82, 0xbd, 0x81,
-	0x5f, 0x1e, 0xc0, 0x52, 0x88, 0xda, 0x0e, 0xbc, 0x66, 0xc7, 0x5b, 0xd7, 0x1c, 0xf7, 0xaf, 0x38,
-	0xde, 0x76, 0x1c, 0xaf, 0x0e, 0xd2, 0x71, 0x07, 0xc9, 0x7a, 0xce, 0x71, 0xa8, 0x30, 0xce, 0xaf,
-	0x64, 0x10, 0xe8, 0x88, 0x31, 0x18, 0xe6, 0x02, 0xf5, 0x70, 0x4d, 0x0b, 0x62, 0x11, 0x8c, 0xbe,
-	0x1e, 0x45, 0xb8, 0x4b, 0xff, 0xb9, 0xc6, 0x52, 0x95, 0x1e, 0xa3, 0x41, 0x95, 0x7f, 0x55, 0x55,
-	0xdb, 0x51, 0x35, 0x81, 0xb1, 0xf9, 0x63, 0x21, 0x8c, 0x3d, 0xc0, 0xe8, 0x33, 0x3e, 0xa3, 0xfa,
-	0x7f, 0x2b, 0x27, 0x30, 0x36, 0x0c, 0x05, 0xe7, 0xfd, 0xef, 0x96, 0x3d, 0x90, 0xef, 0x18, 0xff,
-	0x0c, 0x9f, 0x90, 0x3c, 0xc2, 0x8b, 0x2f, 0xe1, 0x41, 0x98, 0xc3, 0x23, 0xf4, 0xd2, 0x5b, 0x73,
-	0xcf, 0xb3, 0x37, 0x0d, 0x88, 0xd6, 0x7a, 0x43, 0x56, 0x30, 0xac, 0xd0, 0x90, 0x69, 0xed, 0x42,
-	0x34, 0x09, 0xad, 0x03, 0x96, 0xe3, 0x63, 0x7e, 0x3a, 0xf6, 0x05, 0xb1, 0x95, 0xe5, 0x3d, 0xcd,
-	0x5e, 0xbb, 0xc9, 0xca, 0xef, 0xb5, 0x61, 0xa6, 0xfb, 0xd6, 0x16, 0x3a, 0x46, 0xce, 0xa6, 0xb5,
-	0x7c, 0x95, 0xa3, 0x58, 0x57, 0x9d, 0xc3, 0x31, 0xa2, 0xc2, 0xe1, 0xae, 0x97, 0xdd, 0x6c, 0xbb,
-	0xf9, 0x93, 0xf8, 0xe1, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0e, 0x2c, 0xb4, 0xe3, 0x23, 0x05,
-	0x00, 0x00,
+	// 1017 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcd, 0x8e, 0xe3, 0x44,
+	0x10, 0x5e, 0xe7, 0xc7, 0x49, 0x2a, 0x9b, 0x4c, 0xd4, 0x2c, 0x3b, 0x5e, 0xaf, 0x90, 0x66, 0xcd,
+	0x65, 0x84, 0xd0, 0x1c, 0x06, 0x24, 0x76, 0x91, 0xd0, 0x6e, 0x66, 0x99, 0x95, 0x72, 0x60, 0x0f,
+	0x5e, 0x10, 0xc7, 0xa8, 0x13, 0x77, 0xc0, 0x30, 0xb1, 0x4d, 0x77, 0x27, 0x93, 0x3c, 0x05, 0xdc,
+	0x39, 0x70, 0x82, 0xe7, 0xe2, 0xcc, 0x19, 0xee, 0xa8, 0xdb, 0xdd, 0x76, 0x77, 0x1c, 0x4f, 0x86,
+	0xe1, 0x32, 0xb7, 0x54, 0x55, 0x57, 0xf9, 0xab, 0xea, 0xaf, 0xab, 0x2a, 0x30, 0x20, 0x1b, 0xbc,
+	0xcc, 0xae, 0xc8, 0x59, 0x46, 0x53, 0x9e, 0xa2, 0x8e, 0x12, 0x83, 0x7f, 0x1a, 0xd0, 0xb9, 0xcc,
+	0x7f, 0xa3, 0xf7, 0xa0, 0x4d, 0x36, 0xd3, 0x38, 0xf2, 0x9c, 0x13, 0xe7, 0xb4, 0x17, 0xb6, 0xc8,
+	0x66, 0x12, 0x09, 0xe5, 0xf5, 0x42, 0x28, 0x1b, 0xb9, 0xf2, 0x7a, 0x31, 0x89, 0xd0, 0x31, 0x74,
+	0xc8, 0x66, 0x9a, 0xe0, 0x25, 0xf1, 0x9a, 0x52, 0xed, 0x92, 0xcd, 0x5b, 0xbc, 0x24, 0xc2, 0xb0,
+	0x62, 0x84, 0x8a, 0xf3, 0xed, 0xdc, 0x20, 0xc4, 0x49, 0x84, 0x1e, 0x83, 0xcb, 0x38, 0xe6, 0x2b,
+	0xe6, 0xb9, 0x27, 0xce, 0x69, 0x33, 0x54, 0x12, 0xfa, 0x00, 0x60, 0x4e, 0x09, 0xe6, 0x24, 0x9a,
+	0x62, 0xee, 0x75, 0xa4, 0x4f, 0x4f, 0x69, 0xc6, 0xdc, 0x34, 0xcf, 0xb6, 0x5e, 0xd7, 0x32, 0x5f,
+	0x6c, 0x85, 0x79, 0x95, 0x45, 0xda, 0xbb, 0x97, 0x9b, 0x95, 0x26, 0xf7, 0xd6, 0xe6, 0xd9, 0xd6,
+	0x03, 0xcb, 0x7c, 0xb1, 0x45, 0xcf, 0xe0, 0xe1, 0x7c, 0x45, 0x29, 0x49, 0xf8, 0x34, 0x49, 0x23,
+	0xe2, 0xf5, 0xe5, 0x81, 0xbe, 0xd2, 0xbd, 0x4d, 0x23, 0x82, 0x3c, 0xe8, 0xac, 0x09, 0x65, 0x71,
+	0x9a, 0x78, 0x0f, 0x25, 0x6e, 0x2d, 0x0a, 0x67, 0x3c, 0xe7, 0xf1, 0x9a, 0x48, 0x5f, 0xe6, 0x0d,
+	0x4e, 0x9a, 0xc2, 0x39, 0xd7, 0x09, 0x5f, 0x86, 0x7c, 0xe8, 0x52, 0xb2, 0x8e, 0xa5, 0xf7, 0x50,
+	0x7a, 0x17, 0x72, 0x70, 0x01, 0x47, 0xaa, 0xec, 0x2c, 0x24, 0x3f, 0xad, 0x08, 0xe3, 0xfb, 0x2b,
+	0xed, 0x43, 0x37, 0xc2, 0x1c, 0xcf, 0x30, 0xd3, 0xa5, 0x2e, 0xe4, 0xe0, 0x15, 0x8c, 0xca, 0x18,
+	0x2c, 0x4b, 0x13, 0x46, 0xd0, 0xc7, 0xd0, 0x55, 0x57, 0xcb, 0x3c, 0xe7, 0xa4, 0x79, 0xda, 0x3f,
+	0x1f, 0x9d, 0xe9, 0xab, 0x57, 0x87, 0xc3, 0xe2, 0x44, 0x30, 0x86, 0xa1, 0x56, 0x96, 0x20, 0xaa,
+	0x1c, 0x30, 0x41, 0x34, 0x76, 0x40, 0x7c, 0x51, 0x24, 0x52, 0x60, 0xf8, 0x08, 0x34, 0xbd, 0x64,
+	0x94, 0x7d, 0x10, 0x0a, 0xfe, 0xfd, 0xe6, 0x00, 0x8c, 0xa3, 0xa8, 0x52, 0x03, 0x67, 0x3f, 0xdb,
+	0x1a, 0x75, 0x6c, 0x6b, 0xd6, 0xb0, 0xad, 0x65, 0xb1, 0xcd, 0x4c, 0xa4, 0x6d, 0x27, 0x22, 0x7c,
+	0xae, 0x69, 0xcc, 0x09, 0x95, 0x0c, 0xed, 0x85, 0x4a, 0x0a, 0x02, 0xe8, 0x4b, 0x80, 0x2a, 0xb9,
+	0x7d, 0x05, 0x0a, 0x32, 0x18, 0x7c, 0x95, 0x46, 0xf1, 0x62, 0x7b, 0x63, 0x19, 0x4b, 0x54, 0x2a,
+	0x8d, 0x3d, 0xa8, 0x9a, 0xb5, 0xa8, 0x5a, 0x16, 0xaa, 0x11, 0x0c, 0xf5, 0x17, 0x73, 0x60, 0xc1,
+	0x2b, 0x18, 0x7c, 0x49, 0xae, 0x08, 0xbf, 0xfb, 0x55, 0x8e, 0x60, 0xa8, 0x23, 0xa8, 0x98, 0xaf,
+	0x61, 0x20, 0xa8, 0x3c, 0xce, 0x32, 0x9a, 0x0a, 0xe2, 0x8b, 0x8a, 0x0b, 0xba, 0x97, 0x51, 0x5d,
+	0x21, 0x4e, 0x22, 0xf4, 0x04, 0xba, 0xea, 0x2a, 0x44, 0x76, 0xe2, 0x29, 0x74, 0xf2, 0xbb, 0x60,
+	0xc1, 0xaf, 0x0d, 0x18, 0xbc, 0x5b, 0xcd, 0x96, 0x31, 0xbf, 0x11, 0xd9, 0xa7, 0xd0, 0xc3, 0xfa,
+	0x3b, 0x32, 0x44, 0xff, 0xfc, 0x71, 0xc1, 0x1b, 0x0b, 0x45, 0x58, 0x1e, 0x34, 0x1f, 0x68, 0xd3,
+	0x7e, 0xa0, 0x66, 0xa6, 0xad, 0xda, 0xaa, 0xb6, 0xcd, 0xaa, 0xa2, 0xcf, 0xa0, 0xbd, 0xc0, 0x73,
+	0x2e, 0x9a, 0x94, 0xf8, 0xfe, 0xb3, 0xe2, 0xfb, 0x16, 0xfe, 0xb3, 0x37, 0xe2, 0xcc, 0x65, 0xc2,
+	0xe9, 0x36, 0xcc, 0xcf, 0xfb, 0xcf, 0x01, 0x4a, 0x25, 0x1a, 0x41, 0xf3, 0x47, 0xb2, 0x55, 0xd9,
+	0x89, 0x9f, 0xe8, 0x11, 0xb4, 0xd7, 0xf8, 0x6a, 0xa5, 0x6b, 0x9e, 0x0b, 0x9f, 0x37, 0x9e, 0x3b,
+	0xc1, 0x1f, 0x0e, 0x0c, 0x55, 0x66, 0x37, 0x96, 0xc7, 0xe0, 0x7a, 0xc3, 0xe2, 0xba, 0x07, 0x9d,
+	0x79, 0xba, 0x5c, 0x92, 0x84, 0x2b, 0xf2, 0x68, 0xd1, 0xac, 0x4d, 0xab, 0xbe, 0x36, 0xb7, 0x7d,
+	0x07, 0xbf, 0x3b, 0x30, 0x08, 0xc9, 0x0f, 0x64, 0xce, 0xef, 0x37, 0xce, 0x9f, 0x1d, 0x38, 0xfa,
+	0x36, 0xe6, 0xdf, 0x47, 0x14, 0x5f, 0xdf, 0x0b, 0xa4, 0xc1, 0x9f, 0x0e, 0x1c, 0x85, 0x04, 0x33,
+	0x16, 0x7f, 0x97, 0xdc, 0x88, 0xe8, 0x29, 0xf4, 0x16, 0x34, 0x5d, 0x4e, 0x05, 0x0e, 0xfd, 0x3a,
+	0x85, 0xe2, 0x1b, 0x46, 0xa8, 0x80, 0xcb, 0xd3, 0xdc, 0xa4, 0x9a, 0x1d, 0x4f, 0xa5, 0xc1, 0x80,
+	0xdb, 0xaa, 0x85, 0xdb, 0xae, 0x87, 0xeb, 0xd6, 0x16, 0xb6, 0x63, 0x3d, 0x0e, 0x1f, 0xba, 0x84,
+	0xcd, 0xf1, 0x15, 0xe6, 0x44, 0x4e, 0xe2, 0x6e, 0x58, 0xc8, 0x8a, 0x1c, 0x7c, 0x45, 0x93, 0xfb,
+	0x4d, 0x8e, 0xbf, 0xe4, 0x55, 0xb0, 0xc3, 0xdd, 0xa8, 0x16, 0xe9, 0x0b, 0xdd, 0x22, 0x9a, 0xb2,
+	0x45, 0x7c, 0x58, 0xb4, 0x88, 0x9d, 0xb0, 0xd5, 0x26, 0x71, 0xb7, 0x54, 0xfe, 0x47, 0x6b, 0xa1,
+	0xe2, 0x4e, 0xd6, 0x84, 0xf2, 0x43, 0x53, 0x49, 0x95, 0xaa, 0x61, 0x5d, 0xf7, 0x9d, 0x3a, 0x6b,
+	0xf0, 0x8b, 0x03, 0xe8, 0x6b, 0x8a, 0x13, 0x16, 0xf3, 0x38, 0x4d, 0xee, 0xb2, 0x12, 0x88, 0x84,
+	0xc8, 0x5a, 0x30, 0x41, 0x25, 0x24, 0x05, 0xf4, 0x3e, 0xb8, 0x19, 0x4d, 0xcb, 0x51, 0xdf, 0xce,
+	0x68, 0x3a, 0x89, 0xc4, 0x1a, 0x96, 0xa4, 0x3c, 0x5e, 0x6c, 0xe5, 0xcb, 0x10, 0xf3, 0x5e, 0xae,
+	0x61, 0xb9, 0x4e, 0x3c, 0x0f, 0x76, 0xfe, 0xb7, 0x5b, 0x6c, 0x39, 0xef, 0x08, 0x5d, 0xc7, 0x73,
+	0x82, 0x2e, 0xe1, 0xe1, 0x9b, 0x38, 0x89, 0x94, 0x96, 0x21, 0x6f, 0x17, 0x8d, 0x5e, 0xca, 0xfc,
+	0x27, 0x7b, 0x2c, 0x6a, 0x38, 0x3e, 0x40, 0x17, 0xd0, 0x37, 0xc2, 0xa0, 0xe3, 0x4a, 0x4e, 0x2a,
+	0x88, 0x57, 0x35, 0x14, 0x31, 0x5e, 0xc8, 0xfd, 0xa7, 0x58, 0xc1, 0x8b, 0x93, 0xe5, 0x52, 0xe4,
+	0x3f, 0xb2, 0x95, 0xc6, 0xe7, 0xd5, 0xd6, 0xa1, 0xbd, 0xcb, 0x79, 0x69, 0x6d, 0x23, 0xfe, 0x71,
+	0x45, 0x6f, 0xc6, 0xc8, 0x67, 0x7e, 0x35, 0x86, 0xb5, 0x4d, 0xf8, 0xc7, 0x15, 0x7d, 0x11, 0xe3,
+	0x25, 0xb8, 0xf9, 0x7c, 0x34, 0x9c, 0xad, 0x81, 0xe9, 0x3f, 0x2d, 0xf4, 0x55, 0x6e, 0x04, 0x0f,
+	0xd0, 0x18, 0x3a, 0x6a, 0x04, 0x1a, 0x35, 0xb4, 0x87, 0xe2, 0xa1, 0x10, 0x2f, 0xc1, 0xcd, 0x87,
+	0x93, 0x81, 0xc1, 0x9a, 0x56, 0x87, 0x02, 0xbc, 0x86, 0xae, 0x9e, 0x1a, 0x06, 0x1d, 0x76, 0x06,
+	0xc9, 0x2d, 0x82, 0xe8, 0x46, 0x6f, 0x04, 0xd9, 0xe9, 0xfd, 0xb7, 0x4a, 0x45, 0xb4, 0x52, 0x2b,
+	0x15, 0xa3, 0xb7, 0xde, 0x0a, 0x45, 0xde, 0x8c, 0x2c, 0x14, 0xec, 0xbf, 0xdc, 0x89, 0x44, 0x21,
+	0x9a, 0x87, 0x85, 0xc2, 0xe8, 0x26, 0x07, 0x02, 0xcc, 0x5c, 0xf9, 0x4f, 0xf3, 0x93, 0x7f, 0x07,
+	0x00, 0x32, 0xca, 0xa9, 0x17, 0x7a, 0x0e, 0x00, 0x00,
 }
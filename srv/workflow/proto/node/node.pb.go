@@ -20,6 +20,54 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// 条件路由（例：payment_total > 50000000 || leasekikan > 120，空表示总是成立）
+type Route struct {
+	Guard                string   `protobuf:"bytes,1,opt,name=guard,proto3" json:"guard"`
+	NextNode             string   `protobuf:"bytes,2,opt,name=next_node,json=nextNode,proto3" json:"next_node"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Route) Reset()         { *m = Route{} }
+func (m *Route) String() string { return proto.CompactTextString(m) }
+func (*Route) ProtoMessage()    {}
+func (*Route) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c843d59d2d938e7, []int{0}
+}
+
+func (m *Route) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Route.Unmarshal(m, b)
+}
+func (m *Route) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Route.Marshal(b, m, deterministic)
+}
+func (m *Route) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Route.Merge(m, src)
+}
+func (m *Route) XXX_Size() int {
+	return xxx_messageInfo_Route.Size(m)
+}
+func (m *Route) XXX_DiscardUnknown() {
+	xxx_messageInfo_Route.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Route proto.InternalMessageInfo
+
+func (m *Route) GetGuard() string {
+	if m != nil {
+		return m.Guard
+	}
+	return ""
+}
+
+func (m *Route) GetNextNode() string {
+	if m != nil {
+		return m.NextNode
+	}
+	return ""
+}
+
 // 流程定义
 type Node struct {
 	NodeId   string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id"`
@@ -32,6 +80,11 @@ type Node struct {
 	Assignees            []string `protobuf:"bytes,7,rep,name=assignees,proto3" json:"assignees"`
 	ActType              string   `protobuf:"bytes,8,opt,name=act_type,json=actType,proto3" json:"act_type"`
 	NodeGroupId          string   `protobuf:"bytes,11,opt,name=node_group_id,json=nodeGroupId,proto3" json:"node_group_id"`
+	Routes               []*Route `protobuf:"bytes,12,rep,name=routes,proto3" json:"routes"`
+	RemindHours          int64    `protobuf:"varint,13,opt,name=remind_hours,json=remindHours,proto3" json:"remind_hours"`
+	EscalateHours        int64    `protobuf:"varint,14,opt,name=escalate_hours,json=escalateHours,proto3" json:"escalate_hours"`
+	ExpireHours          int64    `protobuf:"varint,15,opt,name=expire_hours,json=expireHours,proto3" json:"expire_hours"`
+	ExpireAction         string   `protobuf:"bytes,16,opt,name=expire_action,json=expireAction,proto3" json:"expire_action"`
 	CreatedAt            string   `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
 	CreatedBy            string   `protobuf:"bytes,10,opt,name=created_by,json=createdBy,proto3" json:"created_by"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -43,7 +96,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c843d59d2d938e7, []int{0}
+	return fileDescriptor_0c843d59d2d938e7, []int{1}
 }
 
 func (m *Node) XXX_Unmarshal(b []byte) error {
@@ -127,6 +180,41 @@ func (m *Node) GetNodeGroupId() string {
 	return ""
 }
 
+func (m *Node) GetRoutes() []*Route {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *Node) GetRemindHours() int64 {
+	if m != nil {
+		return m.RemindHours
+	}
+	return 0
+}
+
+func (m *Node) GetEscalateHours() int64 {
+	if m != nil {
+		return m.EscalateHours
+	}
+	return 0
+}
+
+func (m *Node) GetExpireHours() int64 {
+	if m != nil {
+		return m.ExpireHours
+	}
+	return 0
+}
+
+func (m *Node) GetExpireAction() string {
+	if m != nil {
+		return m.ExpireAction
+	}
+	return ""
+}
+
 func (m *Node) GetCreatedAt() string {
 	if m != nil {
 		return m.CreatedAt
@@ -154,7 +242,7 @@ func (m *NodesRequest) Reset()         { *m = NodesRequest{} }
 func (m *NodesRequest) String() string { return proto.CompactTextString(m) }
 func (*NodesRequest) ProtoMessage()    {}
 func (*NodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c843d59d2d938e7, []int{1}
+	return fileDescriptor_0c843d59d2d938e7, []int{2}
 }
 
 func (m *NodesRequest) XXX_Unmarshal(b []byte) error {
@@ -200,7 +288,7 @@ func (m *NodesResponse) Reset()         { *m = NodesResponse{} }
 func (m *NodesResponse) String() string { return proto.CompactTextString(m) }
 func (*NodesResponse) ProtoMessage()    {}
 func (*NodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c843d59d2d938e7, []int{2}
+	return fileDescriptor_0c843d59d2d938e7, []int{3}
 }
 
 func (m *NodesResponse) XXX_Unmarshal(b []byte) error {
@@ -242,7 +330,7 @@ func (m *NodeRequest) Reset()         { *m = NodeRequest{} }
 func (m *NodeRequest) String() string { return proto.CompactTextString(m) }
 func (*NodeRequest) ProtoMessage()    {}
 func (*NodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c843d59d2d938e7, []int{3}
+	return fileDescriptor_0c843d59d2d938e7, []int{4}
 }
 
 func (m *NodeRequest) XXX_Unmarshal(b []byte) error {
@@ -295,7 +383,7 @@ func (m *NodeResponse) Reset()         { *m = NodeResponse{} }
 func (m *NodeResponse) String() string { return proto.CompactTextString(m) }
 func (*NodeResponse) ProtoMessage()    {}
 func (*NodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c843d59d2d938e7, []int{4}
+	return fileDescriptor_0c843d59d2d938e7, []int{5}
 }
 
 func (m *NodeResponse) XXX_Unmarshal(b []byte) error {
@@ -335,6 +423,11 @@ type AddRequest struct {
 	Assignees            []string `protobuf:"bytes,7,rep,name=assignees,proto3" json:"assignees"`
 	ActType              string   `protobuf:"bytes,8,opt,name=act_type,json=actType,proto3" json:"act_type"`
 	NodeGroupId          string   `protobuf:"bytes,11,opt,name=node_group_id,json=nodeGroupId,proto3" json:"node_group_id"`
+	Routes               []*Route `protobuf:"bytes,12,rep,name=routes,proto3" json:"routes"`
+	RemindHours          int64    `protobuf:"varint,13,opt,name=remind_hours,json=remindHours,proto3" json:"remind_hours"`
+	EscalateHours        int64    `protobuf:"varint,14,opt,name=escalate_hours,json=escalateHours,proto3" json:"escalate_hours"`
+	ExpireHours          int64    `protobuf:"varint,15,opt,name=expire_hours,json=expireHours,proto3" json:"expire_hours"`
+	ExpireAction         string   `protobuf:"bytes,16,opt,name=expire_action,json=expireAction,proto3" json:"expire_action"`
 	Database             string   `protobuf:"bytes,9,opt,name=database,proto3" json:"database"`
 	Writer               string   `protobuf:"bytes,10,opt,name=writer,proto3" json:"writer"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -346,7 +439,7 @@ func (m *AddRequest) Reset()         { *m = AddRequest{} }
 func (m *AddRequest) String() string { return proto.CompactTextString(m) }
 func (*AddRequest) ProtoMessage()    {}
 func (*AddRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c843d59d2d938e7, []int{5}
+	return fileDescriptor_0c843d59d2d938e7, []int{6}
 }
 
 func (m *AddRequest) XXX_Unmarshal(b []byte) error {
@@ -430,6 +523,41 @@ func (m *AddRequest) GetNodeGroupId() string {
 	return ""
 }
 
+func (m *AddRequest) GetRoutes() []*Route {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func (m *AddRequest) GetRemindHours() int64 {
+	if m != nil {
+		return m.RemindHours
+	}
+	return 0
+}
+
+func (m *AddRequest) GetEscalateHours() int64 {
+	if m != nil {
+		return m.EscalateHours
+	}
+	return 0
+}
+
+func (m *AddRequest) GetExpireHours() int64 {
+	if m != nil {
+		return m.ExpireHours
+	}
+	return 0
+}
+
+func (m *AddRequest) GetExpireAction() string {
+	if m != nil {
+		return m.ExpireAction
+	}
+	return ""
+}
+
 func (m *AddRequest) GetDatabase() string {
 	if m != nil {
 		return m.Database
@@ -455,7 +583,7 @@ func (m *AddResponse) Reset()         { *m = AddResponse{} }
 func (m *AddResponse) String() string { return proto.CompactTextString(m) }
 func (*AddResponse) ProtoMessage()    {}
 func (*AddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c843d59d2d938e7, []int{6}
+	return fileDescriptor_0c843d59d2d938e7, []int{7}
 }
 
 func (m *AddResponse) XXX_Unmarshal(b []byte) error {
@@ -496,7 +624,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c843d59d2d938e7, []int{7}
+	return fileDescriptor_0c843d59d2d938e7, []int{8}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -541,7 +669,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0c843d59d2d938e7, []int{8}
+	return fileDescriptor_0c843d59d2d938e7, []int{9}
 }
 
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
@@ -563,6 +691,7 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*Route)(nil), "node.Route")
 	proto.RegisterType((*Node)(nil), "node.Node")
 	proto.RegisterType((*NodesRequest)(nil), "node.NodesRequest")
 	proto.RegisterType((*NodesResponse)(nil), "node.NodesResponse")
@@ -577,35 +706,42 @@ func init() {
 func init() { proto.RegisterFile("node.proto", fileDescriptor_0c843d59d2d938e7) }
 
 var fileDescriptor_0c843d59d2d938e7 = []byte{
-	// 475 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x54, 0x4d, 0x6f, 0x13, 0x31,
-	0x14, 0x64, 0xf3, 0xb9, 0x7e, 0x4b, 0x50, 0xeb, 0x22, 0x30, 0xe1, 0x43, 0x91, 0x0f, 0x28, 0xa7,
-	0x08, 0x5a, 0x09, 0x89, 0x13, 0x04, 0x21, 0x50, 0x2f, 0x3d, 0x04, 0x24, 0x8e, 0x91, 0x13, 0xbf,
-	0x56, 0x2b, 0xd1, 0xdd, 0x65, 0xed, 0x36, 0xcd, 0xaf, 0xe3, 0xc0, 0x8f, 0xe1, 0x6f, 0x20, 0x3f,
-	0x3b, 0x59, 0x2f, 0xa2, 0x42, 0xe2, 0xca, 0x2d, 0x9e, 0xf1, 0xf8, 0xcd, 0xce, 0x1b, 0x05, 0xa0,
-	0x28, 0x35, 0xce, 0xaa, 0xba, 0xb4, 0x25, 0xef, 0xb9, 0xdf, 0xf2, 0x47, 0x07, 0x7a, 0x67, 0xa5,
-	0x46, 0xfe, 0x10, 0x86, 0x0e, 0x58, 0xe6, 0x5a, 0x24, 0x93, 0x64, 0xca, 0x16, 0x03, 0x77, 0x3c,
-	0xd5, 0xfc, 0x31, 0x30, 0x22, 0x0a, 0x75, 0x89, 0xa2, 0x47, 0x54, 0xea, 0x80, 0x33, 0x75, 0x89,
-	0xfc, 0x08, 0xfa, 0x9b, 0x73, 0xa7, 0xe9, 0x10, 0xd1, 0xdb, 0x9c, 0x47, 0x0a, 0xbb, 0xad, 0x50,
-	0x74, 0x1b, 0xc5, 0xe7, 0x6d, 0x85, 0x8e, 0xac, 0x6a, 0xbc, 0x5e, 0x3a, 0x40, 0xf4, 0x3d, 0xe9,
-	0x00, 0x32, 0xe1, 0x94, 0x78, 0x63, 0x3d, 0x39, 0x08, 0x4a, 0xbc, 0xb1, 0x44, 0x3e, 0x01, 0xa6,
-	0x8c, 0xc9, 0x2f, 0x0a, 0x44, 0x23, 0x86, 0x93, 0xee, 0x94, 0x2d, 0x1a, 0x80, 0x3f, 0x82, 0x54,
-	0xad, 0xad, 0x9f, 0x99, 0x92, 0x72, 0xa8, 0xd6, 0x96, 0x46, 0x4a, 0x18, 0x91, 0x9f, 0x8b, 0xba,
-	0xbc, 0xaa, 0x9c, 0xd9, 0x8c, 0xf8, 0xcc, 0x81, 0x1f, 0x1d, 0x76, 0xaa, 0xf9, 0x53, 0x80, 0x75,
-	0x8d, 0xca, 0xa2, 0x5e, 0x2a, 0x2b, 0x18, 0x5d, 0x60, 0x01, 0x99, 0xdb, 0x98, 0x5e, 0x6d, 0x05,
-	0xb4, 0xe8, 0x77, 0x5b, 0xf9, 0x06, 0xee, 0x3a, 0x8b, 0x66, 0x81, 0xdf, 0xae, 0xd0, 0xd8, 0x26,
-	0x96, 0x24, 0x8a, 0x65, 0x0c, 0xa9, 0x56, 0x56, 0xad, 0x94, 0xc1, 0x10, 0xd7, 0xfe, 0x2c, 0x5f,
-	0xc2, 0x28, 0x3c, 0x60, 0xaa, 0xb2, 0x30, 0xc8, 0x27, 0xd0, 0x77, 0xf6, 0x8c, 0x48, 0x26, 0xdd,
-	0x69, 0x76, 0x0c, 0x33, 0xda, 0x9c, 0xbb, 0xb3, 0xf0, 0x84, 0xfc, 0x02, 0x19, 0x1d, 0xc3, 0xc8,
-	0x5b, 0xf7, 0xf7, 0xc7, 0x15, 0xc5, 0x5e, 0xba, 0xbf, 0x79, 0x99, 0xf9, 0x8f, 0xd9, 0x5b, 0x79,
-	0x06, 0x54, 0x15, 0x7a, 0xb6, 0xed, 0xc4, 0x57, 0xe8, 0x7b, 0x07, 0x60, 0xae, 0xf5, 0x5f, 0x8d,
-	0xfc, 0x1f, 0x45, 0x8a, 0x93, 0x65, 0xed, 0x64, 0xf9, 0x03, 0x18, 0x6c, 0xea, 0xdc, 0x62, 0x1d,
-	0x1a, 0x14, 0x4e, 0xf2, 0x39, 0x64, 0x14, 0x60, 0x08, 0xfc, 0xb6, 0x04, 0xe5, 0x5b, 0x18, 0xbd,
-	0xc7, 0xaf, 0x68, 0xf1, 0x9f, 0x7b, 0x76, 0x00, 0xf7, 0x76, 0x2f, 0xf8, 0x61, 0xc7, 0x3f, 0x13,
-	0xdf, 0xa3, 0x4f, 0x58, 0x5f, 0xe7, 0x6b, 0xe4, 0xaf, 0x80, 0x7d, 0xc8, 0x0b, 0x4d, 0x6d, 0xe4,
-	0xbc, 0x59, 0xf6, 0xae, 0xdb, 0xe3, 0xa3, 0x16, 0xe6, 0x5f, 0x91, 0x77, 0xf8, 0x09, 0xa4, 0x3b,
-	0x1d, 0x3f, 0x8c, 0x3a, 0x12, 0x54, 0x3c, 0x86, 0xf6, 0xa2, 0x17, 0x30, 0x9c, 0x6b, 0xaf, 0x39,
-	0xf0, 0x17, 0x9a, 0x22, 0x8d, 0x0f, 0x23, 0x64, 0xaf, 0x78, 0x0d, 0xe0, 0x3f, 0x80, 0x44, 0xc1,
-	0x4b, 0x2b, 0x94, 0xf1, 0xfd, 0x36, 0xb8, 0x93, 0xae, 0x06, 0xf4, 0xbf, 0x77, 0xf2, 0x2b, 0x00,
-	0x00, 0xff, 0xff, 0xda, 0x21, 0x15, 0xf8, 0x05, 0x05, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xc5, 0x38, 0x5f, 0x1e, 0xc7, 0x21, 0xdd, 0x56, 0x60, 0xc2, 0x87, 0x52, 0x57, 0xa0, 0x9c,
+	0x22, 0x68, 0x25, 0x24, 0xb8, 0x40, 0x10, 0x02, 0x7a, 0xe9, 0xc1, 0x20, 0x71, 0x8c, 0x36, 0xde,
+	0x69, 0xb0, 0xd4, 0xd8, 0x66, 0x77, 0xd3, 0x34, 0xbf, 0x98, 0x7f, 0xc0, 0x81, 0x13, 0xda, 0x0f,
+	0xc7, 0x36, 0xa2, 0x42, 0xe2, 0xc4, 0xa1, 0xb7, 0xcc, 0x9b, 0xf7, 0x66, 0x9f, 0x67, 0xdf, 0x2a,
+	0x00, 0x59, 0xce, 0x70, 0x5a, 0xf0, 0x5c, 0xe6, 0xa4, 0xa5, 0x7e, 0x47, 0xaf, 0xa0, 0x1d, 0xe7,
+	0x6b, 0x89, 0xe4, 0x00, 0xda, 0xcb, 0x35, 0xe5, 0x2c, 0x74, 0xc6, 0xce, 0xc4, 0x8b, 0x4d, 0x41,
+	0x1e, 0x80, 0x97, 0xe1, 0x95, 0x9c, 0x2b, 0x6e, 0x78, 0x5b, 0x77, 0x7a, 0x0a, 0x38, 0x53, 0xda,
+	0x9f, 0x2e, 0xb4, 0xd4, 0x0f, 0x72, 0x0f, 0xba, 0x8a, 0x30, 0x4f, 0x4b, 0x75, 0x47, 0x95, 0xa7,
+	0x46, 0xae, 0x1a, 0x19, 0x5d, 0x61, 0xd8, 0xb2, 0xf2, 0x9c, 0xe1, 0x19, 0x5d, 0x21, 0xd9, 0x87,
+	0xf6, 0xe6, 0x5c, 0x69, 0xcc, 0xdc, 0xd6, 0xe6, 0xbc, 0xa6, 0x90, 0xdb, 0x02, 0x43, 0xb7, 0x52,
+	0x7c, 0xde, 0x16, 0xa8, 0x9a, 0x05, 0xc7, 0x4b, 0xe3, 0xa6, 0x6d, 0x9a, 0x0a, 0xd0, 0x26, 0x1a,
+	0x56, 0x3b, 0x4d, 0xab, 0xe4, 0x21, 0x78, 0x54, 0x88, 0x74, 0x99, 0x21, 0x8a, 0xb0, 0x3b, 0x76,
+	0x27, 0x5e, 0x5c, 0x01, 0xe4, 0x3e, 0xf4, 0x68, 0x22, 0xcd, 0x99, 0x3d, 0xad, 0xec, 0xd2, 0x44,
+	0xea, 0x23, 0x23, 0x08, 0xb4, 0x9f, 0x25, 0xcf, 0xd7, 0x85, 0x32, 0xeb, 0xeb, 0xbe, 0xaf, 0xc0,
+	0x0f, 0x0a, 0x3b, 0x65, 0xe4, 0x08, 0x3a, 0x5c, 0xed, 0x50, 0x84, 0xfd, 0xb1, 0x3b, 0xf1, 0x8f,
+	0xfd, 0xa9, 0x5e, 0xb3, 0xde, 0x6b, 0x6c, 0x5b, 0xe4, 0x10, 0xfa, 0x1c, 0x57, 0x69, 0xc6, 0xe6,
+	0x5f, 0xf3, 0x35, 0x17, 0x61, 0x30, 0x76, 0x26, 0x6e, 0xec, 0x1b, 0xec, 0xa3, 0x82, 0xc8, 0x13,
+	0x18, 0xa0, 0x48, 0xe8, 0x05, 0x95, 0x68, 0x49, 0x03, 0x4d, 0x0a, 0x4a, 0xd4, 0xd0, 0x0e, 0xa1,
+	0x8f, 0x57, 0x45, 0xca, 0x4b, 0xd2, 0x1d, 0x33, 0xc9, 0x60, 0x86, 0x72, 0x04, 0x81, 0xa5, 0xd0,
+	0x44, 0xa6, 0x79, 0x16, 0x0e, 0xb5, 0x6b, 0xab, 0x9b, 0x69, 0x8c, 0x3c, 0x02, 0x48, 0x38, 0x52,
+	0x89, 0x6c, 0x4e, 0x65, 0xe8, 0x69, 0x86, 0x67, 0x91, 0x99, 0xac, 0xb7, 0x17, 0xdb, 0x10, 0x1a,
+	0xed, 0xb7, 0xdb, 0xe8, 0x35, 0xf4, 0xd5, 0x66, 0x45, 0x8c, 0xdf, 0xd6, 0x28, 0x64, 0x75, 0x9b,
+	0x4e, 0xed, 0x36, 0x47, 0xd0, 0x63, 0x54, 0xd2, 0x05, 0x15, 0xbb, 0xf4, 0x94, 0x75, 0xf4, 0x1c,
+	0x02, 0x3b, 0x40, 0x14, 0x79, 0x26, 0x90, 0x8c, 0xa1, 0xad, 0xf6, 0x26, 0x42, 0x47, 0x6f, 0x11,
+	0xcc, 0x16, 0x15, 0x27, 0x36, 0x8d, 0xe8, 0x0b, 0xf8, 0xba, 0xb4, 0x47, 0x5e, 0x1b, 0xbb, 0x3f,
+	0x26, 0xab, 0xee, 0xc5, 0xfd, 0xcd, 0xcb, 0xd4, 0x7c, 0xcc, 0xce, 0xca, 0x63, 0xd0, 0xaf, 0x43,
+	0x8f, 0x6d, 0x3a, 0x31, 0xaf, 0xe6, 0x87, 0x0b, 0x30, 0x63, 0xec, 0xaf, 0x46, 0x6e, 0xf2, 0xff,
+	0x1f, 0xe7, 0xbf, 0x1e, 0x08, 0xaf, 0x19, 0x08, 0x72, 0x17, 0x3a, 0x1b, 0x9e, 0x4a, 0xe4, 0x36,
+	0xf8, 0xb6, 0x8a, 0x9e, 0x82, 0xaf, 0xef, 0xdd, 0xe6, 0xe4, 0xba, 0x8b, 0x8f, 0xde, 0x40, 0xf0,
+	0x0e, 0x2f, 0x50, 0xe2, 0x3f, 0x3f, 0x8f, 0x21, 0x0c, 0xca, 0x09, 0xe6, 0xb0, 0xe3, 0xef, 0x8e,
+	0x89, 0xff, 0x27, 0xe4, 0x97, 0x69, 0x82, 0xe4, 0x05, 0x78, 0xef, 0xd3, 0x8c, 0x29, 0x48, 0x10,
+	0x52, 0x65, 0xb4, 0x7c, 0x92, 0xa3, 0xfd, 0x06, 0x66, 0xa6, 0x44, 0xb7, 0xc8, 0x09, 0xf4, 0x4a,
+	0x1d, 0xd9, 0xab, 0x28, 0xa5, 0x8a, 0xd4, 0xa1, 0x9d, 0xe8, 0x19, 0x74, 0x67, 0xcc, 0x68, 0x86,
+	0x86, 0x50, 0xe5, 0x7f, 0xb4, 0x57, 0x43, 0x76, 0x8a, 0x97, 0x00, 0xe6, 0x03, 0xb4, 0xc8, 0x7a,
+	0x69, 0x2c, 0x65, 0x74, 0xd0, 0x04, 0x4b, 0xe9, 0xa2, 0xa3, 0xff, 0xa1, 0x4e, 0x7e, 0x0d, 0x00,
+	0xe5, 0x5b, 0x70, 0x05, 0xaf, 0x06, 0x00, 0x00,
 }
@@ -47,6 +47,9 @@ type ProcessService interface {
 	AddProcess(ctx context.Context, in *AddRequest, opts ...client.CallOption) (*AddResponse, error)
 	ModifyProcess(ctx context.Context, in *ModifyRequest, opts ...client.CallOption) (*ModifyResponse, error)
 	DeleteProcess(ctx context.Context, in *DeleteRequest, opts ...client.CallOption) (*DeleteResponse, error)
+	FindOverdueProcesses(ctx context.Context, in *OverdueRequest, opts ...client.CallOption) (*OverdueResponse, error)
+	MarkReminded(ctx context.Context, in *RemindedRequest, opts ...client.CallOption) (*RemindedResponse, error)
+	MarkEscalated(ctx context.Context, in *EscalatedRequest, opts ...client.CallOption) (*EscalatedResponse, error)
 }
 
 type processService struct {
@@ -111,6 +114,36 @@ func (c *processService) DeleteProcess(ctx context.Context, in *DeleteRequest, o
 	return out, nil
 }
 
+func (c *processService) FindOverdueProcesses(ctx context.Context, in *OverdueRequest, opts ...client.CallOption) (*OverdueResponse, error) {
+	req := c.c.NewRequest(c.name, "ProcessService.FindOverdueProcesses", in)
+	out := new(OverdueResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processService) MarkReminded(ctx context.Context, in *RemindedRequest, opts ...client.CallOption) (*RemindedResponse, error) {
+	req := c.c.NewRequest(c.name, "ProcessService.MarkReminded", in)
+	out := new(RemindedResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *processService) MarkEscalated(ctx context.Context, in *EscalatedRequest, opts ...client.CallOption) (*EscalatedResponse, error) {
+	req := c.c.NewRequest(c.name, "ProcessService.MarkEscalated", in)
+	out := new(EscalatedResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for ProcessService service
 
 type ProcessServiceHandler interface {
@@ -119,6 +152,9 @@ type ProcessServiceHandler interface {
 	AddProcess(context.Context, *AddRequest, *AddResponse) error
 	ModifyProcess(context.Context, *ModifyRequest, *ModifyResponse) error
 	DeleteProcess(context.Context, *DeleteRequest, *DeleteResponse) error
+	FindOverdueProcesses(context.Context, *OverdueRequest, *OverdueResponse) error
+	MarkReminded(context.Context, *RemindedRequest, *RemindedResponse) error
+	MarkEscalated(context.Context, *EscalatedRequest, *EscalatedResponse) error
 }
 
 func RegisterProcessServiceHandler(s server.Server, hdlr ProcessServiceHandler, opts ...server.HandlerOption) error {
@@ -128,6 +164,9 @@ func RegisterProcessServiceHandler(s server.Server, hdlr ProcessServiceHandler,
 		AddProcess(ctx context.Context, in *AddRequest, out *AddResponse) error
 		ModifyProcess(ctx context.Context, in *ModifyRequest, out *ModifyResponse) error
 		DeleteProcess(ctx context.Context, in *DeleteRequest, out *DeleteResponse) error
+		FindOverdueProcesses(ctx context.Context, in *OverdueRequest, out *OverdueResponse) error
+		MarkReminded(ctx context.Context, in *RemindedRequest, out *RemindedResponse) error
+		MarkEscalated(ctx context.Context, in *EscalatedRequest, out *EscalatedResponse) error
 	}
 	type ProcessService struct {
 		processService
@@ -159,3 +198,15 @@ func (h *processServiceHandler) ModifyProcess(ctx context.Context, in *ModifyReq
 func (h *processServiceHandler) DeleteProcess(ctx context.Context, in *DeleteRequest, out *DeleteResponse) error {
 	return h.ProcessServiceHandler.DeleteProcess(ctx, in, out)
 }
+
+func (h *processServiceHandler) FindOverdueProcesses(ctx context.Context, in *OverdueRequest, out *OverdueResponse) error {
+	return h.ProcessServiceHandler.FindOverdueProcesses(ctx, in, out)
+}
+
+func (h *processServiceHandler) MarkReminded(ctx context.Context, in *RemindedRequest, out *RemindedResponse) error {
+	return h.ProcessServiceHandler.MarkReminded(ctx, in, out)
+}
+
+func (h *processServiceHandler) MarkEscalated(ctx context.Context, in *EscalatedRequest, out *EscalatedResponse) error {
+	return h.ProcessServiceHandler.MarkEscalated(ctx, in, out)
+}
@@ -33,6 +33,11 @@ type Process struct {
 	CreatedBy            string   `protobuf:"bytes,9,opt,name=created_by,json=createdBy,proto3" json:"created_by"`
 	UpdatedAt            string   `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
 	UpdatedBy            string   `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by"`
+	EnteredAt            string   `protobuf:"bytes,12,opt,name=entered_at,json=enteredAt,proto3" json:"entered_at"`
+	RemindedAt           string   `protobuf:"bytes,13,opt,name=reminded_at,json=remindedAt,proto3" json:"reminded_at"`
+	Escalated            bool     `protobuf:"varint,14,opt,name=escalated,proto3" json:"escalated"`
+	OnBehalfOf           string   `protobuf:"bytes,15,opt,name=on_behalf_of,json=onBehalfOf,proto3" json:"on_behalf_of"`
+	Revision             int64    `protobuf:"varint,16,opt,name=revision,proto3" json:"revision"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -140,6 +145,41 @@ func (m *Process) GetUpdatedBy() string {
 	return ""
 }
 
+func (m *Process) GetEnteredAt() string {
+	if m != nil {
+		return m.EnteredAt
+	}
+	return ""
+}
+
+func (m *Process) GetRemindedAt() string {
+	if m != nil {
+		return m.RemindedAt
+	}
+	return ""
+}
+
+func (m *Process) GetEscalated() bool {
+	if m != nil {
+		return m.Escalated
+	}
+	return false
+}
+
+func (m *Process) GetOnBehalfOf() string {
+	if m != nil {
+		return m.OnBehalfOf
+	}
+	return ""
+}
+
+func (m *Process) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
 // 查找多条记录
 type ProcessesRequest struct {
 	ExId                 string   `protobuf:"bytes,1,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
@@ -544,6 +584,339 @@ func (m *ModifyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ModifyResponse proto.InternalMessageInfo
 
+// 查找超过处理期限的进程
+type OverdueRequest struct {
+	Database             string   `protobuf:"bytes,1,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OverdueRequest) Reset()         { *m = OverdueRequest{} }
+func (m *OverdueRequest) String() string { return proto.CompactTextString(m) }
+func (*OverdueRequest) ProtoMessage()    {}
+func (*OverdueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c4d0e8c0aaf5c3, []int{9}
+}
+
+func (m *OverdueRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OverdueRequest.Unmarshal(m, b)
+}
+func (m *OverdueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OverdueRequest.Marshal(b, m, deterministic)
+}
+func (m *OverdueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverdueRequest.Merge(m, src)
+}
+func (m *OverdueRequest) XXX_Size() int {
+	return xxx_messageInfo_OverdueRequest.Size(m)
+}
+func (m *OverdueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverdueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverdueRequest proto.InternalMessageInfo
+
+func (m *OverdueRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+// 超过处理期限的进程和需要执行的处理
+type Overdue struct {
+	ProId                string   `protobuf:"bytes,1,opt,name=pro_id,json=proId,proto3" json:"pro_id"`
+	ExId                 string   `protobuf:"bytes,2,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
+	WfId                 string   `protobuf:"bytes,3,opt,name=wf_id,json=wfId,proto3" json:"wf_id"`
+	CurrentNode          string   `protobuf:"bytes,4,opt,name=current_node,json=currentNode,proto3" json:"current_node"`
+	UserId               string   `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id"`
+	Applicant            string   `protobuf:"bytes,6,opt,name=applicant,proto3" json:"applicant"`
+	Action               string   `protobuf:"bytes,7,opt,name=action,proto3" json:"action"`
+	Version              int64    `protobuf:"varint,8,opt,name=version,proto3" json:"version"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Overdue) Reset()         { *m = Overdue{} }
+func (m *Overdue) String() string { return proto.CompactTextString(m) }
+func (*Overdue) ProtoMessage()    {}
+func (*Overdue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c4d0e8c0aaf5c3, []int{10}
+}
+
+func (m *Overdue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Overdue.Unmarshal(m, b)
+}
+func (m *Overdue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Overdue.Marshal(b, m, deterministic)
+}
+func (m *Overdue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Overdue.Merge(m, src)
+}
+func (m *Overdue) XXX_Size() int {
+	return xxx_messageInfo_Overdue.Size(m)
+}
+func (m *Overdue) XXX_DiscardUnknown() {
+	xxx_messageInfo_Overdue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Overdue proto.InternalMessageInfo
+
+func (m *Overdue) GetProId() string {
+	if m != nil {
+		return m.ProId
+	}
+	return ""
+}
+
+func (m *Overdue) GetExId() string {
+	if m != nil {
+		return m.ExId
+	}
+	return ""
+}
+
+func (m *Overdue) GetWfId() string {
+	if m != nil {
+		return m.WfId
+	}
+	return ""
+}
+
+func (m *Overdue) GetCurrentNode() string {
+	if m != nil {
+		return m.CurrentNode
+	}
+	return ""
+}
+
+func (m *Overdue) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *Overdue) GetApplicant() string {
+	if m != nil {
+		return m.Applicant
+	}
+	return ""
+}
+
+func (m *Overdue) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *Overdue) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type OverdueResponse struct {
+	Processes            []*Overdue `protobuf:"bytes,1,rep,name=processes,proto3" json:"processes"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *OverdueResponse) Reset()         { *m = OverdueResponse{} }
+func (m *OverdueResponse) String() string { return proto.CompactTextString(m) }
+func (*OverdueResponse) ProtoMessage()    {}
+func (*OverdueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c4d0e8c0aaf5c3, []int{11}
+}
+
+func (m *OverdueResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OverdueResponse.Unmarshal(m, b)
+}
+func (m *OverdueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OverdueResponse.Marshal(b, m, deterministic)
+}
+func (m *OverdueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OverdueResponse.Merge(m, src)
+}
+func (m *OverdueResponse) XXX_Size() int {
+	return xxx_messageInfo_OverdueResponse.Size(m)
+}
+func (m *OverdueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_OverdueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OverdueResponse proto.InternalMessageInfo
+
+func (m *OverdueResponse) GetProcesses() []*Overdue {
+	if m != nil {
+		return m.Processes
+	}
+	return nil
+}
+
+// 记录已经提醒
+type RemindedRequest struct {
+	ProIds               []string `protobuf:"bytes,1,rep,name=pro_ids,json=proIds,proto3" json:"pro_ids"`
+	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemindedRequest) Reset()         { *m = RemindedRequest{} }
+func (m *RemindedRequest) String() string { return proto.CompactTextString(m) }
+func (*RemindedRequest) ProtoMessage()    {}
+func (*RemindedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c4d0e8c0aaf5c3, []int{12}
+}
+
+func (m *RemindedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemindedRequest.Unmarshal(m, b)
+}
+func (m *RemindedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemindedRequest.Marshal(b, m, deterministic)
+}
+func (m *RemindedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemindedRequest.Merge(m, src)
+}
+func (m *RemindedRequest) XXX_Size() int {
+	return xxx_messageInfo_RemindedRequest.Size(m)
+}
+func (m *RemindedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemindedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemindedRequest proto.InternalMessageInfo
+
+func (m *RemindedRequest) GetProIds() []string {
+	if m != nil {
+		return m.ProIds
+	}
+	return nil
+}
+
+func (m *RemindedRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+type RemindedResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemindedResponse) Reset()         { *m = RemindedResponse{} }
+func (m *RemindedResponse) String() string { return proto.CompactTextString(m) }
+func (*RemindedResponse) ProtoMessage()    {}
+func (*RemindedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c4d0e8c0aaf5c3, []int{13}
+}
+
+func (m *RemindedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemindedResponse.Unmarshal(m, b)
+}
+func (m *RemindedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemindedResponse.Marshal(b, m, deterministic)
+}
+func (m *RemindedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemindedResponse.Merge(m, src)
+}
+func (m *RemindedResponse) XXX_Size() int {
+	return xxx_messageInfo_RemindedResponse.Size(m)
+}
+func (m *RemindedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemindedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemindedResponse proto.InternalMessageInfo
+
+// 记录已经转交(没有可以转交的上级审批者时也记录)
+type EscalatedRequest struct {
+	ProIds               []string `protobuf:"bytes,1,rep,name=pro_ids,json=proIds,proto3" json:"pro_ids"`
+	Database             string   `protobuf:"bytes,2,opt,name=database,proto3" json:"database"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EscalatedRequest) Reset()         { *m = EscalatedRequest{} }
+func (m *EscalatedRequest) String() string { return proto.CompactTextString(m) }
+func (*EscalatedRequest) ProtoMessage()    {}
+func (*EscalatedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c4d0e8c0aaf5c3, []int{14}
+}
+
+func (m *EscalatedRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscalatedRequest.Unmarshal(m, b)
+}
+func (m *EscalatedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscalatedRequest.Marshal(b, m, deterministic)
+}
+func (m *EscalatedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscalatedRequest.Merge(m, src)
+}
+func (m *EscalatedRequest) XXX_Size() int {
+	return xxx_messageInfo_EscalatedRequest.Size(m)
+}
+func (m *EscalatedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscalatedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscalatedRequest proto.InternalMessageInfo
+
+func (m *EscalatedRequest) GetProIds() []string {
+	if m != nil {
+		return m.ProIds
+	}
+	return nil
+}
+
+func (m *EscalatedRequest) GetDatabase() string {
+	if m != nil {
+		return m.Database
+	}
+	return ""
+}
+
+type EscalatedResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EscalatedResponse) Reset()         { *m = EscalatedResponse{} }
+func (m *EscalatedResponse) String() string { return proto.CompactTextString(m) }
+func (*EscalatedResponse) ProtoMessage()    {}
+func (*EscalatedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_54c4d0e8c0aaf5c3, []int{15}
+}
+
+func (m *EscalatedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EscalatedResponse.Unmarshal(m, b)
+}
+func (m *EscalatedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EscalatedResponse.Marshal(b, m, deterministic)
+}
+func (m *EscalatedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscalatedResponse.Merge(m, src)
+}
+func (m *EscalatedResponse) XXX_Size() int {
+	return xxx_messageInfo_EscalatedResponse.Size(m)
+}
+func (m *EscalatedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscalatedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscalatedResponse proto.InternalMessageInfo
+
 // 删除数据记录
 type DeleteRequest struct {
 	ExId                 string   `protobuf:"bytes,1,opt,name=ex_id,json=exId,proto3" json:"ex_id"`
@@ -557,7 +930,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54c4d0e8c0aaf5c3, []int{9}
+	return fileDescriptor_54c4d0e8c0aaf5c3, []int{16}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -602,7 +975,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_54c4d0e8c0aaf5c3, []int{10}
+	return fileDescriptor_54c4d0e8c0aaf5c3, []int{17}
 }
 
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
@@ -633,6 +1006,13 @@ func init() {
 	proto.RegisterType((*AddResponse)(nil), "process.AddResponse")
 	proto.RegisterType((*ModifyRequest)(nil), "process.ModifyRequest")
 	proto.RegisterType((*ModifyResponse)(nil), "process.ModifyResponse")
+	proto.RegisterType((*OverdueRequest)(nil), "process.OverdueRequest")
+	proto.RegisterType((*Overdue)(nil), "process.Overdue")
+	proto.RegisterType((*OverdueResponse)(nil), "process.OverdueResponse")
+	proto.RegisterType((*RemindedRequest)(nil), "process.RemindedRequest")
+	proto.RegisterType((*RemindedResponse)(nil), "process.RemindedResponse")
+	proto.RegisterType((*EscalatedRequest)(nil), "process.EscalatedRequest")
+	proto.RegisterType((*EscalatedResponse)(nil), "process.EscalatedResponse")
 	proto.RegisterType((*DeleteRequest)(nil), "process.DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "process.DeleteResponse")
 }
@@ -640,39 +1020,55 @@ func init() {
 func init() { proto.RegisterFile("process.proto", fileDescriptor_54c4d0e8c0aaf5c3) }
 
 var fileDescriptor_54c4d0e8c0aaf5c3 = []byte{
-	// 537 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xad, 0xf3, 0x70, 0x9a, 0x1b, 0x12, 0x85, 0x29, 0x6d, 0x07, 0x4b, 0xd0, 0x60, 0xb1, 0xc8,
-	0x2a, 0x8b, 0xb2, 0x62, 0x47, 0xd3, 0x0a, 0x35, 0x12, 0x20, 0x94, 0x7e, 0x40, 0xe4, 0x78, 0x2e,
-	0x92, 0x25, 0xea, 0x31, 0x33, 0x63, 0x88, 0xff, 0x81, 0x8f, 0x62, 0xcd, 0xe7, 0xf0, 0x05, 0xc8,
-	0xf6, 0x8c, 0xe3, 0xc9, 0xc3, 0x8b, 0xee, 0x7c, 0xcf, 0x99, 0x7b, 0x74, 0xe7, 0xdc, 0x33, 0x86,
-	0x61, 0x22, 0x78, 0x88, 0x52, 0xce, 0x12, 0xc1, 0x15, 0x27, 0x3d, 0x5d, 0xfa, 0x7f, 0x5a, 0xd0,
-	0xfb, 0x5a, 0x7e, 0x93, 0x73, 0x70, 0x13, 0xc1, 0x57, 0x11, 0xa3, 0xce, 0xc4, 0x99, 0xf6, 0x97,
-	0xdd, 0x44, 0xf0, 0x05, 0x23, 0x67, 0xd0, 0xc5, 0x4d, 0x8e, 0xb6, 0x0a, 0xb4, 0x83, 0x9b, 0x05,
-	0x23, 0x6f, 0xe0, 0x59, 0x98, 0x0a, 0x81, 0xb1, 0x5a, 0xc5, 0x9c, 0x21, 0x6d, 0x17, 0xdc, 0x40,
-	0x63, 0x5f, 0x38, 0x43, 0x72, 0x09, 0xbd, 0x54, 0xa2, 0xc8, 0x3b, 0x3b, 0x05, 0xeb, 0xe6, 0xe5,
-	0x82, 0x91, 0x2b, 0x18, 0xe0, 0x26, 0x89, 0x04, 0xae, 0x58, 0xa0, 0x90, 0x76, 0x0b, 0x12, 0x4a,
-	0xe8, 0x2e, 0x50, 0x48, 0x2e, 0xc0, 0x95, 0x2a, 0x50, 0xa9, 0xa4, 0xee, 0xc4, 0x99, 0xb6, 0x97,
-	0xba, 0x22, 0x14, 0x7a, 0x21, 0x7f, 0x7c, 0xc4, 0x58, 0xd1, 0x5e, 0xd1, 0x64, 0x4a, 0xf2, 0x0a,
-	0x20, 0x14, 0x18, 0x28, 0x64, 0xab, 0x40, 0xd1, 0xd3, 0x82, 0xec, 0x6b, 0xe4, 0xc6, 0xa2, 0xd7,
-	0x19, 0xed, 0x5b, 0xf4, 0x3c, 0xcb, 0xe9, 0x34, 0x61, 0xa6, 0x1b, 0x4a, 0x5a, 0x23, 0x65, 0xb7,
-	0xa1, 0xd7, 0x19, 0x1d, 0x58, 0xf4, 0x3c, 0xf3, 0x6f, 0x61, 0xac, 0x1d, 0x44, 0xb9, 0xc4, 0x1f,
-	0x29, 0x4a, 0xb5, 0xf5, 0xcc, 0xa9, 0x79, 0xe6, 0xc1, 0x29, 0x0b, 0x54, 0xb0, 0x0e, 0x24, 0x6a,
-	0x2f, 0xab, 0xda, 0xff, 0x04, 0xe7, 0x1f, 0xa3, 0x98, 0xc9, 0x3d, 0xa5, 0x9a, 0x8b, 0x8e, 0xe5,
-	0x62, 0x93, 0xda, 0x2d, 0x3c, 0xaf, 0x09, 0xc9, 0x84, 0xc7, 0x12, 0xc9, 0x0c, 0xfa, 0x89, 0x01,
-	0xa9, 0x33, 0x69, 0x4f, 0x07, 0xd7, 0xe3, 0x99, 0x89, 0x85, 0x3e, 0xbe, 0xdc, 0x1e, 0xf1, 0xef,
-	0xe1, 0x62, 0x77, 0xa4, 0x27, 0x2a, 0xfd, 0x75, 0x00, 0x6e, 0x18, 0x6b, 0x34, 0x67, 0x37, 0x50,
-	0xad, 0xc6, 0x40, 0xb5, 0x9b, 0x02, 0xd5, 0x69, 0x08, 0x54, 0xd7, 0x0a, 0x54, 0xdd, 0x43, 0xd7,
-	0xf6, 0x30, 0xef, 0xf9, 0x25, 0x22, 0x85, 0x42, 0x67, 0x4d, 0x57, 0xfe, 0x5b, 0x18, 0x14, 0x77,
-	0xd1, 0x5e, 0x1c, 0x7e, 0x34, 0xfe, 0x6f, 0x07, 0x86, 0x9f, 0x39, 0x8b, 0xbe, 0x65, 0xe6, 0xd6,
-	0x47, 0x5e, 0xd7, 0x76, 0xb4, 0xf2, 0xc6, 0x07, 0xb2, 0xde, 0xb6, 0xb3, 0x5e, 0x1f, 0xba, 0x73,
-	0x74, 0xe8, 0xae, 0x35, 0xf4, 0x18, 0x46, 0x66, 0x9a, 0x72, 0x6e, 0xff, 0x03, 0x0c, 0xef, 0xf0,
-	0x3b, 0x2a, 0x7c, 0x72, 0x64, 0xc7, 0x30, 0x32, 0x0a, 0xa5, 0xe6, 0xf5, 0xbf, 0x16, 0x8c, 0xf4,
-	0xfa, 0x1f, 0x50, 0xfc, 0x8c, 0x42, 0x24, 0xf7, 0x30, 0xcc, 0x43, 0x54, 0x65, 0x88, 0xbc, 0xdc,
-	0x0d, 0x4a, 0x15, 0x75, 0xcf, 0x3b, 0x44, 0xe9, 0x71, 0x4f, 0xc8, 0x03, 0x8c, 0xec, 0x38, 0x92,
-	0xd7, 0xd5, 0xf9, 0x83, 0x4f, 0xc7, 0xbb, 0x3a, 0xca, 0x57, 0xa2, 0xef, 0x8b, 0x60, 0x9a, 0x1f,
-	0xe0, 0x59, 0xd5, 0xb0, 0x4d, 0xab, 0xf7, 0xc2, 0x06, 0xab, 0xd6, 0xb9, 0x59, 0xb0, 0xe9, 0xbe,
-	0xa8, 0x0e, 0x5a, 0x8b, 0xf7, 0x2e, 0xf7, 0xf0, 0xba, 0x46, 0x69, 0xe1, 0xbe, 0x86, 0xb5, 0x9c,
-	0x9a, 0x86, 0x6d, 0xb9, 0x7f, 0xb2, 0x76, 0x8b, 0x3f, 0xfa, 0xbb, 0xff, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x2b, 0xd6, 0xd6, 0xca, 0xe2, 0x05, 0x00, 0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcb, 0x72, 0xd3, 0x4a,
+	0x10, 0xbd, 0x8a, 0xdf, 0xed, 0x47, 0x9c, 0x71, 0x12, 0x2b, 0xaa, 0xdc, 0x1b, 0x5f, 0x15, 0x0b,
+	0x2f, 0xa8, 0x2c, 0xc2, 0x8a, 0x1d, 0xce, 0x8b, 0xa4, 0x20, 0x84, 0x72, 0x3e, 0xc0, 0x25, 0x6b,
+	0xda, 0x85, 0x0a, 0x47, 0x12, 0xa3, 0xb1, 0x13, 0xff, 0x03, 0x5f, 0xc6, 0x8a, 0x15, 0x2b, 0x3e,
+	0x86, 0x92, 0xe6, 0x21, 0xc9, 0x76, 0x5c, 0x10, 0x76, 0xea, 0x3e, 0xd3, 0xa7, 0xfa, 0x71, 0xba,
+	0x4b, 0xd0, 0x0c, 0x59, 0xe0, 0x62, 0x14, 0x1d, 0x87, 0x2c, 0xe0, 0x01, 0xa9, 0x48, 0xd3, 0xfe,
+	0x59, 0x80, 0xca, 0x47, 0xf1, 0x4d, 0xf6, 0xa0, 0x1c, 0xb2, 0x60, 0xe4, 0x51, 0xd3, 0xe8, 0x19,
+	0xfd, 0xda, 0xb0, 0x14, 0xb2, 0xe0, 0x9a, 0x92, 0x0e, 0x94, 0xf0, 0x31, 0xf6, 0x6e, 0x25, 0xde,
+	0x22, 0x3e, 0x5e, 0x53, 0xf2, 0x3f, 0x34, 0xdc, 0x19, 0x63, 0xe8, 0xf3, 0x91, 0x1f, 0x50, 0x34,
+	0x0b, 0x09, 0x56, 0x97, 0xbe, 0x0f, 0x01, 0x45, 0xd2, 0x85, 0xca, 0x2c, 0x42, 0x16, 0x47, 0x16,
+	0x13, 0xb4, 0x1c, 0x9b, 0xd7, 0x94, 0x1c, 0x41, 0x1d, 0x1f, 0x43, 0x8f, 0xe1, 0x88, 0x3a, 0x1c,
+	0xcd, 0x52, 0x02, 0x82, 0x70, 0x9d, 0x3b, 0x1c, 0xc9, 0x3e, 0x94, 0x23, 0xee, 0xf0, 0x59, 0x64,
+	0x96, 0x7b, 0x46, 0xbf, 0x30, 0x94, 0x16, 0x31, 0xa1, 0xe2, 0x06, 0xf7, 0xf7, 0xe8, 0x73, 0xb3,
+	0x92, 0x04, 0x29, 0x93, 0xfc, 0x0b, 0xe0, 0x32, 0x74, 0x38, 0xd2, 0x91, 0xc3, 0xcd, 0x6a, 0x02,
+	0xd6, 0xa4, 0x67, 0x90, 0x83, 0xc7, 0x0b, 0xb3, 0x96, 0x83, 0x4f, 0x17, 0x31, 0x3c, 0x0b, 0xa9,
+	0x8a, 0x06, 0x01, 0x4b, 0x8f, 0x88, 0x56, 0xf0, 0x78, 0x61, 0xd6, 0x73, 0xb0, 0x88, 0x46, 0x9f,
+	0x23, 0x13, 0xd1, 0x0d, 0x01, 0x4b, 0xcf, 0x80, 0xc7, 0xd5, 0x32, 0xbc, 0xf7, 0x7c, 0x2a, 0xf0,
+	0xa6, 0xa8, 0x56, 0xb9, 0x06, 0x9c, 0x1c, 0x42, 0x0d, 0x23, 0xd7, 0x99, 0xc6, 0x74, 0x66, 0xab,
+	0x67, 0xf4, 0xab, 0xc3, 0xd4, 0x41, 0x7a, 0xd0, 0x08, 0xfc, 0xd1, 0x18, 0x3f, 0x39, 0xd3, 0xc9,
+	0x28, 0x98, 0x98, 0xdb, 0x22, 0x3e, 0xf0, 0x4f, 0x13, 0xd7, 0xed, 0x84, 0x58, 0x50, 0x65, 0x38,
+	0xf7, 0x22, 0x2f, 0xf0, 0xcd, 0x76, 0xd2, 0x2f, 0x6d, 0xdb, 0x67, 0xd0, 0x96, 0xd3, 0xc5, 0x68,
+	0x88, 0x5f, 0x66, 0x18, 0xf1, 0x74, 0x9e, 0x46, 0x66, 0x9e, 0x16, 0x54, 0xa9, 0xc3, 0x9d, 0xb1,
+	0x13, 0xa1, 0x9c, 0xb3, 0xb6, 0xed, 0xf7, 0xb0, 0x77, 0xe9, 0xf9, 0x34, 0x5a, 0x61, 0xca, 0x4c,
+	0xd8, 0xc8, 0x4d, 0x78, 0x13, 0xdb, 0x19, 0xec, 0x64, 0x88, 0xa2, 0x30, 0xf0, 0x23, 0x24, 0xc7,
+	0x50, 0x0b, 0x95, 0xd3, 0x34, 0x7a, 0x85, 0x7e, 0xfd, 0xa4, 0x7d, 0xac, 0x24, 0x2b, 0x9f, 0x0f,
+	0xd3, 0x27, 0xf6, 0x15, 0xec, 0x2f, 0xa7, 0xf4, 0x4c, 0xa6, 0x6f, 0x06, 0xc0, 0x80, 0xd2, 0x8d,
+	0xcd, 0x59, 0x16, 0xfb, 0xd6, 0x46, 0xb1, 0x17, 0x36, 0x89, 0xbd, 0xb8, 0x41, 0xec, 0xa5, 0x9c,
+	0xd8, 0xb3, 0x3d, 0x2c, 0xe7, 0x7b, 0x18, 0xc7, 0x3c, 0x30, 0x8f, 0x23, 0x93, 0x7b, 0x20, 0x2d,
+	0xfb, 0x05, 0xd4, 0x93, 0x5a, 0x64, 0x2f, 0xd6, 0x2f, 0xb4, 0xfd, 0xd5, 0x80, 0xe6, 0x4d, 0x40,
+	0xbd, 0xc9, 0x42, 0x55, 0xfd, 0xc4, 0xe6, 0xa7, 0xa9, 0x89, 0x8a, 0xd7, 0xec, 0x61, 0x21, 0xbf,
+	0x87, 0xd9, 0xa4, 0x8b, 0x4f, 0x26, 0x5d, 0xca, 0x25, 0xdd, 0x86, 0x96, 0xca, 0x46, 0xe4, 0x6d,
+	0xbf, 0x84, 0xd6, 0xed, 0x1c, 0x19, 0x9d, 0xa1, 0x4a, 0x30, 0xcb, 0x6b, 0x2c, 0x09, 0xea, 0x87,
+	0x01, 0x15, 0xf9, 0xfc, 0x8f, 0x4e, 0x58, 0x07, 0x4a, 0x0f, 0x93, 0x74, 0x60, 0xc5, 0x87, 0xc9,
+	0x9a, 0x51, 0x17, 0x37, 0x8e, 0xba, 0x94, 0x1b, 0xf5, 0x21, 0xd4, 0x9c, 0x30, 0x9c, 0x7a, 0xae,
+	0xe3, 0x73, 0x39, 0xb2, 0xd4, 0x11, 0x97, 0xef, 0xb8, 0x3c, 0x5e, 0x52, 0x39, 0x33, 0x61, 0xc5,
+	0xcd, 0x9c, 0x23, 0x4b, 0xb6, 0xb7, 0x9a, 0x08, 0x40, 0x99, 0xf6, 0x00, 0xb6, 0x75, 0x1b, 0x7e,
+	0x47, 0xdd, 0xea, 0x71, 0x46, 0xdd, 0x97, 0xb0, 0x3d, 0x94, 0x97, 0x26, 0xb3, 0xb4, 0xa2, 0x45,
+	0x82, 0xa0, 0x36, 0x2c, 0x27, 0x3d, 0x8a, 0x36, 0x2e, 0x2d, 0x81, 0x76, 0xca, 0x23, 0xa7, 0xf4,
+	0x16, 0xda, 0x17, 0xea, 0x4c, 0xfd, 0x15, 0x79, 0x07, 0x76, 0x32, 0x44, 0x92, 0xfd, 0x0d, 0x34,
+	0xcf, 0x71, 0x8a, 0x1c, 0x9f, 0x7d, 0xb6, 0xda, 0xd0, 0x52, 0x0c, 0x82, 0xf3, 0xe4, 0x7b, 0x11,
+	0x5a, 0xf2, 0x04, 0xdc, 0x21, 0x9b, 0x7b, 0x2e, 0x92, 0x2b, 0x68, 0xc6, 0x87, 0x44, 0xdf, 0x11,
+	0x72, 0xb0, 0x7c, 0x2c, 0xf4, 0xb9, 0xb3, 0xac, 0x75, 0x90, 0x4c, 0xf7, 0x1f, 0x72, 0x07, 0xad,
+	0xfc, 0x49, 0x22, 0xff, 0xe9, 0xf7, 0x6b, 0xcf, 0xa7, 0x75, 0xf4, 0x24, 0xae, 0x49, 0x5f, 0x27,
+	0xc7, 0x49, 0x22, 0xa4, 0xa3, 0x03, 0xd2, 0x8b, 0x65, 0xed, 0xe6, 0x9d, 0x3a, 0xf4, 0x54, 0x2d,
+	0xb9, 0x8a, 0xde, 0xd7, 0x0f, 0x73, 0xcb, 0x6f, 0x75, 0x57, 0xfc, 0x59, 0x0e, 0xd1, 0xc2, 0x55,
+	0x8e, 0xdc, 0x70, 0xac, 0xee, 0x8a, 0x5f, 0x73, 0xbc, 0x83, 0xdd, 0xb8, 0x3c, 0x29, 0xce, 0xb4,
+	0x3b, 0xdd, 0x15, 0xdd, 0x4a, 0x2e, 0x73, 0x15, 0xd0, 0x64, 0x17, 0xd0, 0xb8, 0x71, 0xd8, 0x67,
+	0xa5, 0x45, 0x92, 0xbe, 0x5d, 0x92, 0xb9, 0x75, 0xb0, 0x06, 0xd1, 0x34, 0x57, 0xd0, 0x8c, 0x69,
+	0xb4, 0xea, 0x32, 0x53, 0x5f, 0x96, 0xb4, 0x65, 0xad, 0x83, 0x14, 0xd3, 0xb8, 0x9c, 0xfc, 0x4f,
+	0xbd, 0xfa, 0x35, 0x00, 0x2c, 0x51, 0xfc, 0xa8, 0x60, 0x09, 0x00, 0x00,
 }
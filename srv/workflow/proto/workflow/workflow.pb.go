@@ -485,6 +485,7 @@ type AddRequest struct {
 	Params               map[string]string `protobuf:"bytes,8,rep,name=params,proto3" json:"params" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Database             string            `protobuf:"bytes,9,opt,name=database,proto3" json:"database"`
 	Writer               string            `protobuf:"bytes,10,opt,name=writer,proto3" json:"writer"`
+	Nodes                []*GraphNode      `protobuf:"bytes,11,rep,name=nodes,proto3" json:"nodes"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -585,6 +586,125 @@ func (m *AddRequest) GetWriter() string {
 	return ""
 }
 
+func (m *AddRequest) GetNodes() []*GraphNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// 流程图的节点
+type GraphNode struct {
+	NodeId               string        `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id"`
+	NodeType             string        `protobuf:"bytes,2,opt,name=node_type,json=nodeType,proto3" json:"node_type"`
+	NextNode             string        `protobuf:"bytes,3,opt,name=next_node,json=nextNode,proto3" json:"next_node"`
+	Routes               []*GraphRoute `protobuf:"bytes,4,rep,name=routes,proto3" json:"routes"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GraphNode) Reset()         { *m = GraphNode{} }
+func (m *GraphNode) String() string { return proto.CompactTextString(m) }
+func (*GraphNode) ProtoMessage()    {}
+func (*GraphNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_892c7f566756b0be, []int{8}
+}
+
+func (m *GraphNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphNode.Unmarshal(m, b)
+}
+func (m *GraphNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphNode.Marshal(b, m, deterministic)
+}
+func (m *GraphNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphNode.Merge(m, src)
+}
+func (m *GraphNode) XXX_Size() int {
+	return xxx_messageInfo_GraphNode.Size(m)
+}
+func (m *GraphNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphNode proto.InternalMessageInfo
+
+func (m *GraphNode) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *GraphNode) GetNodeType() string {
+	if m != nil {
+		return m.NodeType
+	}
+	return ""
+}
+
+func (m *GraphNode) GetNextNode() string {
+	if m != nil {
+		return m.NextNode
+	}
+	return ""
+}
+
+func (m *GraphNode) GetRoutes() []*GraphRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+// 流程图的条件路由
+type GraphRoute struct {
+	Guard                string   `protobuf:"bytes,1,opt,name=guard,proto3" json:"guard"`
+	NextNode             string   `protobuf:"bytes,2,opt,name=next_node,json=nextNode,proto3" json:"next_node"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GraphRoute) Reset()         { *m = GraphRoute{} }
+func (m *GraphRoute) String() string { return proto.CompactTextString(m) }
+func (*GraphRoute) ProtoMessage()    {}
+func (*GraphRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_892c7f566756b0be, []int{9}
+}
+
+func (m *GraphRoute) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GraphRoute.Unmarshal(m, b)
+}
+func (m *GraphRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GraphRoute.Marshal(b, m, deterministic)
+}
+func (m *GraphRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GraphRoute.Merge(m, src)
+}
+func (m *GraphRoute) XXX_Size() int {
+	return xxx_messageInfo_GraphRoute.Size(m)
+}
+func (m *GraphRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_GraphRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GraphRoute proto.InternalMessageInfo
+
+func (m *GraphRoute) GetGuard() string {
+	if m != nil {
+		return m.Guard
+	}
+	return ""
+}
+
+func (m *GraphRoute) GetNextNode() string {
+	if m != nil {
+		return m.NextNode
+	}
+	return ""
+}
+
 type AddResponse struct {
 	WfId                 string   `protobuf:"bytes,1,opt,name=wf_id,json=wfId,proto3" json:"wf_id"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -596,7 +716,7 @@ func (m *AddResponse) Reset()         { *m = AddResponse{} }
 func (m *AddResponse) String() string { return proto.CompactTextString(m) }
 func (*AddResponse) ProtoMessage()    {}
 func (*AddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_892c7f566756b0be, []int{8}
+	return fileDescriptor_892c7f566756b0be, []int{10}
 }
 
 func (m *AddResponse) XXX_Unmarshal(b []byte) error {
@@ -643,7 +763,7 @@ func (m *ModifyRequest) Reset()         { *m = ModifyRequest{} }
 func (m *ModifyRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyRequest) ProtoMessage()    {}
 func (*ModifyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_892c7f566756b0be, []int{9}
+	return fileDescriptor_892c7f566756b0be, []int{11}
 }
 
 func (m *ModifyRequest) XXX_Unmarshal(b []byte) error {
@@ -730,7 +850,7 @@ func (m *ModifyResponse) Reset()         { *m = ModifyResponse{} }
 func (m *ModifyResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyResponse) ProtoMessage()    {}
 func (*ModifyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_892c7f566756b0be, []int{10}
+	return fileDescriptor_892c7f566756b0be, []int{12}
 }
 
 func (m *ModifyResponse) XXX_Unmarshal(b []byte) error {
@@ -764,7 +884,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_892c7f566756b0be, []int{11}
+	return fileDescriptor_892c7f566756b0be, []int{13}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -809,7 +929,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_892c7f566756b0be, []int{12}
+	return fileDescriptor_892c7f566756b0be, []int{14}
 }
 
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
@@ -841,6 +961,8 @@ func init() {
 	proto.RegisterType((*WorkflowResponse)(nil), "workflow.WorkflowResponse")
 	proto.RegisterType((*AddRequest)(nil), "workflow.AddRequest")
 	proto.RegisterMapType((map[string]string)(nil), "workflow.AddRequest.ParamsEntry")
+	proto.RegisterType((*GraphNode)(nil), "workflow.GraphNode")
+	proto.RegisterType((*GraphRoute)(nil), "workflow.GraphRoute")
 	proto.RegisterType((*AddResponse)(nil), "workflow.AddResponse")
 	proto.RegisterType((*ModifyRequest)(nil), "workflow.ModifyRequest")
 	proto.RegisterMapType((map[string]string)(nil), "workflow.ModifyRequest.ParamsEntry")
@@ -852,54 +974,60 @@ func init() {
 func init() { proto.RegisterFile("workflow.proto", fileDescriptor_892c7f566756b0be) }
 
 var fileDescriptor_892c7f566756b0be = []byte{
-	// 773 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdb, 0x6e, 0x9b, 0x4c,
-	0x10, 0x0e, 0xc6, 0xc6, 0x30, 0x8e, 0x13, 0x67, 0xff, 0xe4, 0x0f, 0x21, 0xff, 0xc1, 0x22, 0x37,
-	0x51, 0x2f, 0xac, 0x2a, 0x95, 0xaa, 0xf4, 0x70, 0x13, 0x2b, 0x69, 0xe5, 0x4a, 0x3d, 0x88, 0xb6,
-	0xc9, 0x25, 0x5a, 0x9b, 0xa5, 0xa2, 0xb1, 0x0d, 0x05, 0x1c, 0xc4, 0x23, 0xf4, 0x11, 0xfa, 0x16,
-	0x7d, 0x88, 0xde, 0xf6, 0x25, 0xfa, 0x24, 0x15, 0xcb, 0x2e, 0x07, 0x1b, 0x9c, 0xaa, 0xcd, 0x5d,
-	0x66, 0xbe, 0xdd, 0x2f, 0x33, 0xf3, 0xed, 0x7c, 0x18, 0xb6, 0x22, 0xd7, 0xbf, 0xb6, 0xa7, 0x6e,
-	0x34, 0xf0, 0x7c, 0x37, 0x74, 0x91, 0xcc, 0x63, 0xfd, 0x87, 0x08, 0xf2, 0x15, 0x0b, 0xd0, 0x5f,
-	0xd0, 0x8a, 0x6c, 0xd3, 0xb1, 0x54, 0xa1, 0x2f, 0x1c, 0x2b, 0x46, 0x33, 0xb2, 0x47, 0x16, 0xda,
-	0x87, 0x76, 0x64, 0x9b, 0x73, 0x3c, 0x23, 0x6a, 0x83, 0xa6, 0xa5, 0xc8, 0x7e, 0x85, 0x67, 0x04,
-	0x1d, 0x82, 0x32, 0x23, 0xf3, 0x45, 0x0a, 0x89, 0x14, 0x92, 0x93, 0x04, 0x05, 0x0f, 0x40, 0x76,
-	0x02, 0xf3, 0x06, 0x4f, 0x1d, 0x4b, 0x6d, 0xf6, 0x85, 0x63, 0xd9, 0x68, 0x3b, 0xc1, 0x65, 0x12,
-	0x26, 0xd0, 0x07, 0xdf, 0x5d, 0x78, 0xc9, 0x3f, 0x6a, 0xd1, 0x6b, 0x6d, 0x1a, 0x8f, 0x2c, 0xb4,
-	0x07, 0x12, 0xf6, 0x28, 0x20, 0x51, 0xa0, 0x85, 0xbd, 0x24, 0x7d, 0x0f, 0x76, 0xf0, 0x64, 0x42,
-	0xbc, 0xd0, 0x74, 0x7d, 0xd3, 0x72, 0x82, 0x99, 0x13, 0x04, 0x6a, 0x9b, 0xb2, 0x6e, 0xa7, 0xc0,
-	0x6b, 0xff, 0x3c, 0x4d, 0xa3, 0x23, 0xe8, 0xf2, 0xe6, 0xcc, 0x30, 0xf6, 0x88, 0x2a, 0x53, 0xa6,
-	0x4d, 0x9e, 0x7c, 0x17, 0x7b, 0x04, 0x3d, 0x04, 0xc9, 0xc3, 0x3e, 0x9e, 0x05, 0xaa, 0xd2, 0x17,
-	0x8f, 0x3b, 0x27, 0xff, 0x0d, 0xb2, 0x01, 0xf1, 0x61, 0x0c, 0xde, 0xd0, 0x03, 0x17, 0xf3, 0xd0,
-	0x8f, 0x0d, 0x76, 0x1a, 0xfd, 0x0b, 0x30, 0xf1, 0x09, 0x0e, 0x89, 0x65, 0xe2, 0x50, 0x05, 0xca,
-	0xac, 0xb0, 0xcc, 0x59, 0x58, 0x84, 0xc7, 0xb1, 0xda, 0x29, 0xc1, 0xc3, 0x38, 0x81, 0x17, 0x9e,
-	0xc5, 0x6f, 0x6f, 0xa6, 0x30, 0xcb, 0xa4, 0xb7, 0x39, 0x3c, 0x8e, 0xd5, 0x6e, 0x09, 0x1e, 0xc6,
-	0xda, 0x23, 0xe8, 0x14, 0x4a, 0x42, 0x3d, 0x10, 0xaf, 0x49, 0xcc, 0x94, 0x4a, 0xfe, 0x44, 0xbb,
-	0xd0, 0xba, 0xc1, 0xd3, 0x05, 0x97, 0x29, 0x0d, 0x1e, 0x37, 0x4e, 0x05, 0xfd, 0xab, 0x00, 0x3d,
-	0xde, 0x57, 0x60, 0x90, 0x4f, 0x0b, 0x12, 0x84, 0x25, 0x85, 0x52, 0x96, 0x4c, 0xa1, 0x5c, 0x86,
-	0x46, 0x51, 0x86, 0x43, 0x50, 0xdc, 0xf1, 0x47, 0x32, 0x09, 0x4d, 0x26, 0xaa, 0x62, 0xc8, 0x69,
-	0x62, 0xb4, 0x56, 0xd5, 0xbf, 0x41, 0xc2, 0x93, 0xd0, 0x71, 0xe7, 0x4c, 0x55, 0x16, 0x21, 0x0d,
-	0x64, 0x0b, 0x87, 0x78, 0x8c, 0x03, 0x42, 0xd5, 0x54, 0x8c, 0x2c, 0xd6, 0x2f, 0x60, 0xa7, 0x50,
-	0x71, 0xe0, 0xb9, 0xf3, 0x80, 0xa0, 0xfb, 0xa0, 0x70, 0x9d, 0x02, 0x55, 0xa0, 0xca, 0xa1, 0x55,
-	0xe5, 0x8c, 0xfc, 0x90, 0xfe, 0x45, 0x80, 0xdd, 0xf7, 0x01, 0xf1, 0x57, 0xba, 0xcf, 0x5b, 0x14,
-	0x6a, 0x5b, 0x6c, 0xac, 0x69, 0x51, 0xac, 0x6b, 0xb1, 0x59, 0xdb, 0x62, 0x6b, 0xa9, 0xc5, 0x11,
-	0xec, 0x2d, 0x95, 0xf6, 0xdb, 0x6d, 0x0e, 0x61, 0x3b, 0x4b, 0xb3, 0x06, 0x2b, 0x77, 0xb9, 0x58,
-	0x4e, 0x63, 0xa9, 0x9c, 0x61, 0xfe, 0x46, 0xb2, 0x4a, 0x06, 0x90, 0x39, 0x05, 0xe5, 0xa9, 0x2e,
-	0x24, 0x77, 0x93, 0xcf, 0x22, 0xc0, 0x99, 0x65, 0xf1, 0x1a, 0x0a, 0xd6, 0x21, 0xd4, 0x5b, 0x47,
-	0x63, 0x8d, 0x75, 0x88, 0xf5, 0xd6, 0xd1, 0xac, 0xb3, 0x8e, 0xd6, 0xad, 0xd6, 0x21, 0xfd, 0xa2,
-	0x75, 0xb4, 0x2b, 0xac, 0xe3, 0x34, 0xb3, 0x0e, 0x99, 0x2a, 0xd3, 0xcf, 0x07, 0x92, 0x77, 0x5e,
-	0x69, 0x1e, 0xc5, 0xe1, 0x2b, 0xe5, 0xe1, 0x27, 0xef, 0x27, 0xf2, 0x9d, 0x90, 0xf8, 0xcc, 0x54,
-	0x58, 0xf4, 0x27, 0x4b, 0xaf, 0x43, 0x87, 0x16, 0xc4, 0xa4, 0xac, 0x7a, 0x0f, 0xfa, 0xf7, 0x06,
-	0x74, 0x5f, 0xba, 0x96, 0x63, 0xc7, 0x6b, 0x9f, 0xcd, 0xdd, 0x7c, 0x02, 0x0a, 0x06, 0x53, 0xa9,
-	0x4a, 0xaa, 0xdb, 0x8a, 0x2a, 0x4f, 0xb2, 0x81, 0x4b, 0x74, 0xe0, 0x47, 0xf9, 0xc0, 0x4b, 0xa5,
-	0xdf, 0x3a, 0xf3, 0x76, 0xed, 0xcc, 0xe5, 0xbb, 0x9a, 0x79, 0x0f, 0xb6, 0x78, 0x4d, 0xe9, 0xd8,
-	0xf5, 0x11, 0x74, 0xcf, 0xc9, 0x94, 0x84, 0x84, 0x0f, 0xf8, 0x9f, 0xe5, 0xe5, 0x56, 0x0a, 0x8b,
-	0xbc, 0x76, 0x41, 0x7b, 0xb0, 0xc5, 0xa9, 0x52, 0xf2, 0x93, 0x6f, 0x22, 0x28, 0x57, 0xf6, 0x5b,
-	0xe2, 0xdf, 0x38, 0x13, 0x82, 0x5e, 0x40, 0xf7, 0x99, 0x33, 0xb7, 0xae, 0x72, 0xb2, 0xd5, 0x5d,
-	0xe5, 0xfe, 0xa7, 0x1d, 0x56, 0x62, 0xac, 0xe8, 0x0d, 0x74, 0x09, 0x3b, 0x09, 0x57, 0xc9, 0x9f,
-	0x50, 0xe1, 0x2b, 0x59, 0xe5, 0xa9, 0xda, 0xff, 0xb5, 0x78, 0xc6, 0xfb, 0x1c, 0x36, 0x8b, 0x35,
-	0xa2, 0x83, 0x0a, 0x3b, 0x61, 0x6c, 0x5a, 0x15, 0x94, 0x11, 0x3d, 0xa5, 0xaf, 0x3b, 0xe3, 0xd9,
-	0xad, 0xda, 0x42, 0x6d, 0x6f, 0x29, 0x9b, 0xdd, 0xbe, 0xe0, 0x3a, 0x65, 0x04, 0xfb, 0x35, 0xaf,
-	0x4a, 0x53, 0x57, 0x81, 0x22, 0x4d, 0xaa, 0x48, 0x15, 0x4d, 0x49, 0xf6, 0x22, 0x4d, 0x59, 0x44,
-	0x7d, 0x63, 0x2c, 0xd1, 0x1f, 0x65, 0x0f, 0x7e, 0x06, 0x00, 0x00, 0xff, 0xff, 0x94, 0x21, 0x29,
-	0xb2, 0xa6, 0x09, 0x00, 0x00,
+	// 875 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0xeb, 0x44,
+	0x10, 0xc6, 0x76, 0xe2, 0xd8, 0x93, 0xa6, 0x4d, 0xb7, 0x29, 0x75, 0x5d, 0x7e, 0x22, 0xf7, 0xa6,
+	0x20, 0x14, 0xa1, 0x22, 0xa1, 0xf2, 0x23, 0xa1, 0x46, 0x2d, 0x55, 0x90, 0x28, 0xc8, 0x40, 0x7b,
+	0x69, 0x6d, 0xe2, 0x4d, 0x31, 0x4d, 0x62, 0x63, 0x3b, 0x0d, 0x7e, 0x07, 0x5e, 0x80, 0xb7, 0xe0,
+	0x82, 0x47, 0xe0, 0x96, 0x97, 0xe0, 0x49, 0x8e, 0x76, 0xbd, 0xeb, 0x9f, 0xc4, 0x4e, 0x8f, 0x4e,
+	0xcf, 0x5d, 0x66, 0x3e, 0xfb, 0xdb, 0x99, 0xf9, 0x66, 0x3f, 0x07, 0x76, 0x57, 0x7e, 0xf8, 0x38,
+	0x9d, 0xf9, 0xab, 0x41, 0x10, 0xfa, 0xb1, 0x8f, 0x34, 0x11, 0x5b, 0xff, 0x2b, 0xa0, 0xdd, 0xf3,
+	0x00, 0x1d, 0x40, 0x73, 0x35, 0x75, 0x3c, 0xd7, 0x90, 0xfa, 0xd2, 0x99, 0x6e, 0x37, 0x56, 0xd3,
+	0x91, 0x8b, 0x8e, 0xa0, 0xb5, 0x9a, 0x3a, 0x0b, 0x3c, 0x27, 0x86, 0xcc, 0xd2, 0xea, 0x6a, 0x7a,
+	0x8b, 0xe7, 0x04, 0x9d, 0x80, 0x3e, 0x27, 0x8b, 0x65, 0x0a, 0x29, 0x0c, 0xd2, 0x68, 0x82, 0x81,
+	0xc7, 0xa0, 0x79, 0x91, 0xf3, 0x84, 0x67, 0x9e, 0x6b, 0x34, 0xfa, 0xd2, 0x99, 0x66, 0xb7, 0xbc,
+	0xe8, 0x8e, 0x86, 0x14, 0x7a, 0x08, 0xfd, 0x65, 0x40, 0x0f, 0x6a, 0xb2, 0xd7, 0x5a, 0x2c, 0x1e,
+	0xb9, 0xe8, 0x10, 0x54, 0x1c, 0x30, 0x40, 0x65, 0x40, 0x13, 0x07, 0x34, 0xfd, 0x31, 0xec, 0xe3,
+	0xc9, 0x84, 0x04, 0xb1, 0xe3, 0x87, 0x8e, 0xeb, 0x45, 0x73, 0x2f, 0x8a, 0x8c, 0x16, 0x63, 0xdd,
+	0x4b, 0x81, 0x1f, 0xc2, 0xab, 0x34, 0x8d, 0x4e, 0xa1, 0x23, 0x9a, 0x73, 0xe2, 0x24, 0x20, 0x86,
+	0xc6, 0x98, 0x76, 0x44, 0xf2, 0xe7, 0x24, 0x20, 0xe8, 0x73, 0x50, 0x03, 0x1c, 0xe2, 0x79, 0x64,
+	0xe8, 0x7d, 0xe5, 0xac, 0x7d, 0xfe, 0xc1, 0x20, 0x1b, 0x90, 0x18, 0xc6, 0xe0, 0x47, 0xf6, 0xc0,
+	0xf5, 0x22, 0x0e, 0x13, 0x9b, 0x3f, 0x8d, 0xde, 0x07, 0x98, 0x84, 0x04, 0xc7, 0xc4, 0x75, 0x70,
+	0x6c, 0x00, 0x63, 0xd6, 0x79, 0xe6, 0x32, 0x2e, 0xc2, 0xe3, 0xc4, 0x68, 0x97, 0xe0, 0x61, 0x42,
+	0xe1, 0x65, 0xe0, 0x8a, 0xb7, 0x77, 0x52, 0x98, 0x67, 0xd2, 0xb7, 0x05, 0x3c, 0x4e, 0x8c, 0x4e,
+	0x09, 0x1e, 0x26, 0xe6, 0x17, 0xd0, 0x2e, 0x94, 0x84, 0xba, 0xa0, 0x3c, 0x92, 0x84, 0x2b, 0x45,
+	0x7f, 0xa2, 0x1e, 0x34, 0x9f, 0xf0, 0x6c, 0x29, 0x64, 0x4a, 0x83, 0x2f, 0xe5, 0x0b, 0xc9, 0xfa,
+	0x5b, 0x82, 0xae, 0xe8, 0x2b, 0xb2, 0xc9, 0xef, 0x4b, 0x12, 0xc5, 0x25, 0x85, 0x52, 0x96, 0x4c,
+	0xa1, 0x5c, 0x06, 0xb9, 0x28, 0xc3, 0x09, 0xe8, 0xfe, 0xf8, 0x37, 0x32, 0x89, 0x1d, 0x2e, 0xaa,
+	0x6e, 0x6b, 0x69, 0x62, 0xb4, 0x55, 0xd5, 0x77, 0x41, 0xc5, 0x93, 0xd8, 0xf3, 0x17, 0x5c, 0x55,
+	0x1e, 0x21, 0x13, 0x34, 0x17, 0xc7, 0x78, 0x8c, 0x23, 0xc2, 0xd4, 0xd4, 0xed, 0x2c, 0xb6, 0xae,
+	0x61, 0xbf, 0x50, 0x71, 0x14, 0xf8, 0x8b, 0x88, 0xa0, 0x4f, 0x41, 0x17, 0x3a, 0x45, 0x86, 0xc4,
+	0x94, 0x43, 0x9b, 0xca, 0xd9, 0xf9, 0x43, 0xd6, 0x5f, 0x12, 0xf4, 0x7e, 0x89, 0x48, 0xb8, 0xd1,
+	0x7d, 0xde, 0xa2, 0x54, 0xdb, 0xa2, 0xbc, 0xa5, 0x45, 0xa5, 0xae, 0xc5, 0x46, 0x6d, 0x8b, 0xcd,
+	0xb5, 0x16, 0x47, 0x70, 0xb8, 0x56, 0xda, 0x1b, 0xb7, 0x39, 0x84, 0xbd, 0x2c, 0xcd, 0x1b, 0xac,
+	0xbc, 0xcb, 0xc5, 0x72, 0xe4, 0xb5, 0x72, 0x86, 0xf9, 0x8e, 0x64, 0x95, 0x0c, 0x20, 0x73, 0x0a,
+	0xc6, 0x53, 0x5d, 0x48, 0xee, 0x26, 0xff, 0x28, 0x00, 0x97, 0xae, 0x2b, 0x6a, 0x28, 0x58, 0x87,
+	0x54, 0x6f, 0x1d, 0xf2, 0x16, 0xeb, 0x50, 0xea, 0xad, 0xa3, 0x51, 0x67, 0x1d, 0xcd, 0x67, 0xad,
+	0x43, 0x7d, 0x4d, 0xeb, 0x68, 0x55, 0x58, 0xc7, 0x45, 0x66, 0x1d, 0x1a, 0x53, 0xa6, 0x9f, 0x0f,
+	0x24, 0xef, 0xbc, 0xd2, 0x3c, 0x8a, 0xc3, 0xd7, 0xcb, 0xc3, 0xa7, 0xfb, 0xb3, 0x0a, 0xbd, 0x98,
+	0x84, 0xdc, 0x54, 0x78, 0x84, 0x3e, 0x82, 0xe6, 0xc2, 0x77, 0x49, 0x64, 0xb4, 0xd9, 0x61, 0x07,
+	0xf9, 0x61, 0x37, 0x21, 0x0e, 0x7e, 0xbd, 0xf5, 0x5d, 0x62, 0xa7, 0x4f, 0xbc, 0xc4, 0x1f, 0xfe,
+	0x94, 0x40, 0xcf, 0xf8, 0xa8, 0x6a, 0x94, 0x31, 0xdf, 0x1d, 0x95, 0x86, 0xe9, 0xe5, 0x60, 0x00,
+	0x9b, 0x0d, 0x57, 0x8d, 0x26, 0xd8, 0x5c, 0x28, 0x48, 0xfe, 0x88, 0x1d, 0x9a, 0x10, 0x5f, 0x03,
+	0x9a, 0x60, 0x94, 0x9f, 0x80, 0x1a, 0xfa, 0xcb, 0x98, 0x44, 0x46, 0x83, 0xf5, 0xd1, 0x5b, 0xeb,
+	0xc3, 0xa6, 0xa0, 0xcd, 0x9f, 0xb1, 0xbe, 0x01, 0xc8, 0xb3, 0xb4, 0xec, 0x87, 0x25, 0x0e, 0xb3,
+	0x8b, 0xca, 0x82, 0xf2, 0x71, 0x72, 0xf9, 0x38, 0xcb, 0x82, 0x36, 0xd3, 0x82, 0x6f, 0x71, 0xd5,
+	0x55, 0xb0, 0xfe, 0x93, 0xa1, 0xf3, 0xbd, 0xef, 0x7a, 0xd3, 0x64, 0xeb, 0x8d, 0x79, 0x3b, 0x5f,
+	0xbf, 0x82, 0xb7, 0x56, 0x2e, 0x64, 0xba, 0xb2, 0x1b, 0x0b, 0xf9, 0x55, 0xb6, 0x6b, 0x2a, 0x1b,
+	0xdb, 0x69, 0x3e, 0xb6, 0x52, 0xe9, 0xcf, 0xae, 0x5b, 0xab, 0x76, 0xdd, 0xb4, 0xe2, 0xba, 0xbd,
+	0x64, 0x87, 0xba, 0xb0, 0x2b, 0x6a, 0x4a, 0xc7, 0x6e, 0x8d, 0xa0, 0x73, 0x45, 0x66, 0x24, 0x26,
+	0x62, 0xc0, 0xef, 0xad, 0xfb, 0x9a, 0x5e, 0xf0, 0xb0, 0xad, 0xde, 0xd4, 0x85, 0x5d, 0x41, 0x95,
+	0x92, 0x9f, 0xff, 0xab, 0x80, 0x7e, 0x3f, 0xfd, 0x89, 0x84, 0x4f, 0xde, 0x84, 0xa0, 0xef, 0xa0,
+	0xf3, 0xad, 0xb7, 0x70, 0xef, 0x73, 0xb2, 0x4d, 0x9b, 0x12, 0xd6, 0x6f, 0x9e, 0x54, 0x62, 0xbc,
+	0xe8, 0x77, 0xd0, 0x1d, 0xec, 0x53, 0xae, 0x92, 0x35, 0xa3, 0xc2, 0x1f, 0x84, 0xaa, 0xcf, 0x89,
+	0xf9, 0x61, 0x2d, 0x9e, 0xf1, 0xde, 0xc0, 0x4e, 0xb1, 0x46, 0x74, 0x5c, 0xe1, 0xa4, 0x9c, 0xcd,
+	0xac, 0x82, 0x32, 0xa2, 0xaf, 0xd9, 0x76, 0x67, 0x3c, 0xbd, 0x2a, 0x03, 0x32, 0x0f, 0xd7, 0xb2,
+	0xd9, 0xdb, 0xd7, 0x42, 0xa7, 0x8c, 0xe0, 0xa8, 0x66, 0xab, 0x4c, 0x63, 0x13, 0x28, 0xd2, 0xa4,
+	0x8a, 0x54, 0xd1, 0x94, 0x64, 0x37, 0x8d, 0x4d, 0x40, 0xd0, 0x8c, 0x55, 0xf6, 0x7f, 0xf4, 0xb3,
+	0x57, 0x03, 0x00, 0xe3, 0xab, 0x33, 0xe1, 0xa1, 0x0a, 0x00, 0x00,
 }
@@ -0,0 +1,265 @@
+// Package wfclient 流程服务的客户端,各网关共通使用;
+// 状态迁移在流程服务的事务内执行,网关只负责迁移后的通知和台账数据的更新
+package wfclient
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/micro/go-micro/v2/client"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"rxcsoft.cn/pit3/srv/workflow/proto/example"
+	"rxcsoft.cn/pit3/srv/workflow/proto/node"
+	"rxcsoft.cn/pit3/srv/workflow/proto/workflow"
+)
+
+// 流程服务返回的状态迁移事件
+const (
+	EventSubmitted   = "submitted"
+	EventWaiting     = "waiting"
+	EventAdvanced    = "advanced"
+	EventApproved    = "approved"
+	EventRejected    = "rejected"
+	EventWithdrawn   = "withdrawn"
+	EventReassigned  = "reassigned"
+	EventReturned    = "returned"
+	EventResubmitted = "resubmitted"
+	EventReverted    = "reverted"
+)
+
+// 流程实例的状态
+const (
+	ExampleRunning   int64 = 1 // 正在审批
+	ExampleApproved  int64 = 2 // 承认
+	ExampleRejected  int64 = 3 // 却下
+	ExampleWithdrawn int64 = 4 // 申请者取消
+	ExampleReturned  int64 = 5 // 退回修改
+)
+
+// SystemWriter 系统代为处理时的操作者
+const SystemWriter = "SYSTEM"
+
+// conflictDetail 实例已被其他操作更新时流程服务返回的错误(model.ErrConflict)
+const conflictDetail = "申請データは他のユーザーにより更新されました。最新の状態を確認してください"
+
+// WfInfo 流程信息
+type WfInfo struct {
+	Workflow *workflow.Workflow
+	Nodes    []*node.Node
+}
+
+// Effects 状态迁移后各网关执行的处理
+type Effects interface {
+	// NotifyApprovers 通知新的待审批者(状态已经迁移,通知失败只记录日志)
+	NotifyApprovers(db string, wf *WfInfo, ex *example.Example, users []string)
+	// Admit 最终承认后更新台账数据
+	Admit(db string, ex *example.Example) error
+	// Dismiss 却下或取消后恢复台账数据
+	Dismiss(db string, ex *example.Example, userID string)
+	// SendMessage 向用户发送消息
+	SendMessage(recipient, code, content string)
+}
+
+// IsConflict 是否为实例的版本不一致(指定的版本之后实例已被其他操作更新)
+func IsConflict(err error) bool {
+	return err != nil && merrors.Parse(err.Error()).GetDetail() == conflictDetail
+}
+
+func exampleService() example.ExampleService {
+	return example.NewExampleService("workflow", client.DefaultClient)
+}
+
+// FindWfInfo 获取流程和流程的节点
+func FindWfInfo(db, wfID string) (*WfInfo, error) {
+	workflowService := workflow.NewWfService("workflow", client.DefaultClient)
+
+	var req workflow.WorkflowRequest
+	req.WfId = wfID
+	req.Database = db
+
+	response, err := workflowService.FindWorkflow(context.TODO(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeService := node.NewNodeService("workflow", client.DefaultClient)
+
+	var nReq node.NodesRequest
+	nReq.WfId = wfID
+	nReq.Database = db
+
+	nResp, err := nodeService.FindNodes(context.TODO(), &nReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WfInfo{
+		Workflow: response.GetWorkflow(),
+		Nodes:    nResp.GetNodes(),
+	}, nil
+}
+
+// FindExample 获取流程实例
+func FindExample(db, exID string) (*example.Example, error) {
+	var req example.ExampleRequest
+	req.ExId = exID
+	req.Database = db
+
+	response, err := exampleService().FindExample(context.TODO(), &req)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetExample(), nil
+}
+
+// AddExample 添加流程实例
+func AddExample(db string, wf *WfInfo, userID string) (string, error) {
+	var req example.AddRequest
+	req.WfId = wf.Workflow.GetWfId()
+	req.ExName = wf.Workflow.GetWfName() + "_" + userID
+	req.Status = ExampleRunning
+	req.Database = db
+	req.UserId = userID
+	req.Writer = userID
+
+	response, err := exampleService().AddExample(context.TODO(), &req)
+	if err != nil {
+		return "", err
+	}
+
+	return response.GetExId(), nil
+}
+
+// Submit 申请,按各节点的审批者和审批数据的字段值生成开始节点的进程
+func Submit(db, exID, writer string, approvers []*example.NodeApprovers, facts map[string]string) (*example.TransitionResponse, error) {
+	var req example.SubmitRequest
+	req.ExId = exID
+	req.Approvers = approvers
+	req.Facts = facts
+	req.Database = db
+	req.Writer = writer
+
+	return exampleService().Submit(context.TODO(), &req)
+}
+
+// Approve 承认;writer为空时是审批者本人,version为0时不检查实例的版本
+func Approve(db, exID, userID, writer, comment string, version int64) (*example.TransitionResponse, error) {
+	var req example.ApproveRequest
+	req.ExId = exID
+	req.UserId = userID
+	req.Writer = writer
+	req.Comment = comment
+	req.Version = version
+	req.Database = db
+
+	return exampleService().Approve(context.TODO(), &req)
+}
+
+// Reject 却下;writer为空时是审批者本人,version为0时不检查实例的版本
+func Reject(db, exID, userID, writer, comment string, version int64) (*example.TransitionResponse, error) {
+	var req example.RejectRequest
+	req.ExId = exID
+	req.UserId = userID
+	req.Writer = writer
+	req.Comment = comment
+	req.Version = version
+	req.Database = db
+
+	return exampleService().Reject(context.TODO(), &req)
+}
+
+// Withdraw 申请者取消
+func Withdraw(db, exID, userID, comment string, version int64) (*example.TransitionResponse, error) {
+	var req example.WithdrawRequest
+	req.ExId = exID
+	req.UserId = userID
+	req.Comment = comment
+	req.Version = version
+	req.Database = db
+
+	return exampleService().Withdraw(context.TODO(), &req)
+}
+
+// Reassign 审批者转交;escalate为true时是超过处理期限后转交给上级组织的审批者
+func Reassign(db, exID, fromUser, toUser, writer, comment string, escalate bool, version int64) (*example.TransitionResponse, error) {
+	var req example.ReassignRequest
+	req.ExId = exID
+	req.FromUser = fromUser
+	req.ToUser = toUser
+	req.Writer = writer
+	req.Comment = comment
+	req.Escalate = escalate
+	req.Version = version
+	req.Database = db
+
+	return exampleService().Reassign(context.TODO(), &req)
+}
+
+// Return 退回给申请者修改
+func Return(db, exID, userID, writer, comment string, version int64) (*example.TransitionResponse, error) {
+	var req example.ReturnRequest
+	req.ExId = exID
+	req.UserId = userID
+	req.Writer = writer
+	req.Comment = comment
+	req.Version = version
+	req.Database = db
+
+	return exampleService().Return(context.TODO(), &req)
+}
+
+// Resubmit 申请者修改退回的申请后再申请
+func Resubmit(db, exID, userID string, facts map[string]string, version int64) (*example.TransitionResponse, error) {
+	var req example.ResubmitRequest
+	req.ExId = exID
+	req.UserId = userID
+	req.Facts = facts
+	req.Version = version
+	req.Database = db
+
+	return exampleService().Resubmit(context.TODO(), &req)
+}
+
+// Revert 撤销最终承认(version为最终承认后的实例版本)
+func Revert(db, exID, writer string, version int64) (*example.TransitionResponse, error) {
+	var req example.RevertRequest
+	req.ExId = exID
+	req.Writer = writer
+	req.Version = version
+	req.Database = db
+
+	return exampleService().Revert(context.TODO(), &req)
+}
+
+// AfterTransition 状态迁移后的处理(通知审批者和申请者,流程结束时更新台账数据);
+// 最终承认后台账数据更新失败的场合,在流程服务的事务内撤销最终承认
+func AfterTransition(db string, wf *WfInfo, rsp *example.TransitionResponse, userID string, e Effects) error {
+	ex := rsp.GetExample()
+
+	switch rsp.GetEvent() {
+	case EventSubmitted, EventResubmitted, EventAdvanced, EventReassigned:
+		e.NotifyApprovers(db, wf, ex, rsp.GetNotifyUsers())
+	case EventReturned:
+		// 保留审批数据,等待申请者修改后再申请
+		e.SendMessage(ex.GetUserId(), "I_022", "申請データが差し戻されましたので、修正して再申請してください。")
+	case EventApproved:
+		if err := e.Admit(db, ex); err != nil {
+			if _, rerr := Revert(db, ex.GetExId(), SystemWriter, ex.GetVersion()); rerr != nil {
+				return fmt.Errorf("%v (承認の取り消しに失敗しました: %v)", err, rerr)
+			}
+			return err
+		}
+		e.SendMessage(ex.GetUserId(), "I_020", "申請データが承認されましたので、ご確認してください。")
+	case EventRejected, EventWithdrawn:
+		// 结束流程,恢复数据
+		e.Dismiss(db, ex, userID)
+
+		// 申请者自己取消的场合不需要通知
+		if rsp.GetEvent() == EventRejected {
+			e.SendMessage(ex.GetUserId(), "I_021", "申請データが拒否されましたので、ご確認ください")
+		}
+	}
+
+	return nil
+}